@@ -94,3 +94,33 @@ func (c *RemoteNode) GetStatistics(ctx context.Context, hostName string) (*model
 
 	return &statistics, nil
 }
+
+func (c *RemoteNode) GetTenantUsage(ctx context.Context, hostName, className, tenant string) (*models.TenantUsage, error) {
+	p := path.Join("/nodes/tenant-usage", className, tenant)
+	method := http.MethodGet
+	url := url.URL{Scheme: "http", Host: hostName, Path: p}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+	if err != nil {
+		return nil, enterrors.NewErrOpenHttpRequest(err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, enterrors.NewErrSendHttpRequest(err)
+	}
+
+	defer res.Body.Close()
+	body, _ := io.ReadAll(res.Body)
+	if res.StatusCode != http.StatusOK {
+		return nil, enterrors.NewErrUnexpectedStatusCode(res.StatusCode, body)
+	}
+
+	var usage models.TenantUsage
+	err = json.Unmarshal(body, &usage)
+	if err != nil {
+		return nil, enterrors.NewErrUnmarshalBody(err)
+	}
+
+	return &usage, nil
+}
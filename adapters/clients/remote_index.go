@@ -73,7 +73,11 @@ func (c *RemoteIndex) PutObject(ctx context.Context, host, index,
 	}
 
 	clusterapi.IndicesPayloads.SingleObject.SetContentTypeHeaderReq(req)
-	_, err = c.do(c.timeoutUnit*60, req, body, nil, successCode)
+	clusterapi.IndicesPayloads.IfMatch.SetHeaderReq(req, []*int64{obj.IfMatch})
+	code, err := c.do(c.timeoutUnit*60, req, body, nil, successCode)
+	if code == http.StatusPreconditionFailed {
+		return objects.NewErrPreconditionFailed("%v", err)
+	}
 	return err
 }
 
@@ -106,6 +110,7 @@ func (c *RemoteIndex) BatchPutObjects(ctx context.Context, host, index,
 		return duplicateErr(fmt.Errorf("create http request: %w", err), len(objs))
 	}
 	clusterapi.IndicesPayloads.ObjectList.SetContentTypeHeaderReq(req)
+	clusterapi.IndicesPayloads.IfMatch.SetHeaderReq(req, clusterapi.IndicesPayloads.IfMatch.ObjectsIfMatch(objs))
 
 	var resp []error
 	decode := func(data []byte) error {
@@ -258,7 +263,7 @@ func (c *RemoteIndex) Exists(ctx context.Context, hostName, indexName,
 }
 
 func (c *RemoteIndex) DeleteObject(ctx context.Context, hostName, indexName,
-	shardName string, id strfmt.UUID, schemaVersion uint64,
+	shardName string, id strfmt.UUID, ifMatch *int64, schemaVersion uint64,
 ) error {
 	path := fmt.Sprintf("/indices/%s/shards/%s/objects/%s", indexName, shardName, id)
 	method := http.MethodDelete
@@ -274,6 +279,7 @@ func (c *RemoteIndex) DeleteObject(ctx context.Context, hostName, indexName,
 	if err != nil {
 		return errors.Wrap(err, "open http request")
 	}
+	clusterapi.IndicesPayloads.IfMatch.SetHeaderReq(req, []*int64{ifMatch})
 
 	res, err := c.client.Do(req)
 	if err != nil {
@@ -287,6 +293,11 @@ func (c *RemoteIndex) DeleteObject(ctx context.Context, hostName, indexName,
 		return nil
 	}
 
+	if res.StatusCode == http.StatusPreconditionFailed {
+		body, _ := io.ReadAll(res.Body)
+		return objects.NewErrPreconditionFailed("%s", body)
+	}
+
 	if res.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(res.Body)
 		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
@@ -328,6 +339,11 @@ func (c *RemoteIndex) MergeObject(ctx context.Context, hostName, indexName,
 	}
 
 	defer res.Body.Close()
+	if res.StatusCode == http.StatusPreconditionFailed {
+		body, _ := io.ReadAll(res.Body)
+		return objects.NewErrPreconditionFailed("%s", body)
+	}
+
 	if res.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(res.Body)
 		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
@@ -812,7 +828,21 @@ func (c *RemoteIndex) ReInitShard(ctx context.Context,
 func (c *RemoteIndex) IncreaseReplicationFactor(ctx context.Context,
 	hostName, indexName string, dist scaler.ShardDist,
 ) error {
-	path := fmt.Sprintf("/replicas/indices/%s/replication-factor:increase", indexName)
+	return c.distributeReplicaShards(ctx, hostName, indexName, "increase", dist)
+}
+
+// SyncReplicas makes the remote node push the objects of its replicas of
+// the shards in dist to the nodes the shards are mapped to
+func (c *RemoteIndex) SyncReplicas(ctx context.Context,
+	hostName, indexName string, dist scaler.ShardDist,
+) error {
+	return c.distributeReplicaShards(ctx, hostName, indexName, "sync", dist)
+}
+
+func (c *RemoteIndex) distributeReplicaShards(ctx context.Context,
+	hostName, indexName, cmd string, dist scaler.ShardDist,
+) error {
+	path := fmt.Sprintf("/replicas/indices/%s/replication-factor:%s", indexName, cmd)
 
 	method := http.MethodPut
 	url := url.URL{Scheme: "http", Host: hostName, Path: path}
@@ -841,3 +871,50 @@ func (c *RemoteIndex) IncreaseReplicationFactor(ctx context.Context,
 	}
 	return c.retry(ctx, 34, try)
 }
+
+// JoinReplicas prepares the remote node to receive copies of shards
+func (c *RemoteIndex) JoinReplicas(ctx context.Context,
+	hostName, indexName string, shards []string,
+) error {
+	return c.changeReplicaShards(ctx, hostName, indexName, "join", shards)
+}
+
+// DecreaseReplicationFactor removes the replicas of shards held by the remote node
+func (c *RemoteIndex) DecreaseReplicationFactor(ctx context.Context,
+	hostName, indexName string, shards []string,
+) error {
+	return c.changeReplicaShards(ctx, hostName, indexName, "decrease", shards)
+}
+
+func (c *RemoteIndex) changeReplicaShards(ctx context.Context,
+	hostName, indexName, cmd string, shards []string,
+) error {
+	path := fmt.Sprintf("/replicas/indices/%s/replication-factor:%s", indexName, cmd)
+
+	method := http.MethodPut
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	body, err := clusterapi.IndicesPayloads.ReplicaShards.Marshall(shards)
+	if err != nil {
+		return err
+	}
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(), bytes.NewReader(body))
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusNoContent {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		return false, nil
+	}
+	return c.retry(ctx, 34, try)
+}
@@ -120,6 +120,21 @@ func (c *replicationClient) HashTreeLevel(ctx context.Context,
 	return resp, err
 }
 
+func (c *replicationClient) AppliedWatermark(ctx context.Context,
+	host, index, shard string,
+) (int64, error) {
+	var resp int64
+	req, err := newHttpReplicaRequest(
+		ctx, http.MethodGet, host, index, shard,
+		"", "_watermark", nil, 0)
+	if err != nil {
+		return resp, fmt.Errorf("create http request: %w", err)
+	}
+	// readers fall back to other replicas, hence a short deadline
+	err = c.do(c.timeoutUnit*5, req, nil, &resp, 2)
+	return resp, err
+}
+
 func (c *replicationClient) OverwriteObjects(ctx context.Context,
 	host, index, shard string, vobjects []*objects.VObject,
 ) ([]replica.RepairResponse, error) {
@@ -174,18 +189,20 @@ func (c *replicationClient) PutObject(ctx context.Context, host, index,
 	}
 
 	clusterapi.IndicesPayloads.SingleObject.SetContentTypeHeaderReq(req)
+	clusterapi.IndicesPayloads.IfMatch.SetHeaderReq(req, []*int64{obj.IfMatch})
 	err = c.do(c.timeoutUnit*90, req, body, &resp, 9)
 	return resp, err
 }
 
 func (c *replicationClient) DeleteObject(ctx context.Context, host, index,
-	shard, requestID string, uuid strfmt.UUID, schemaVersion uint64,
+	shard, requestID string, uuid strfmt.UUID, ifMatch *int64, schemaVersion uint64,
 ) (replica.SimpleResponse, error) {
 	var resp replica.SimpleResponse
 	req, err := newHttpReplicaRequest(ctx, http.MethodDelete, host, index, shard, requestID, uuid.String(), nil, schemaVersion)
 	if err != nil {
 		return resp, fmt.Errorf("create http request: %w", err)
 	}
+	clusterapi.IndicesPayloads.IfMatch.SetHeaderReq(req, []*int64{ifMatch})
 
 	err = c.do(c.timeoutUnit*90, req, nil, &resp, 9)
 	return resp, err
@@ -205,6 +222,7 @@ func (c *replicationClient) PutObjects(ctx context.Context, host, index,
 	}
 
 	clusterapi.IndicesPayloads.ObjectList.SetContentTypeHeaderReq(req)
+	clusterapi.IndicesPayloads.IfMatch.SetHeaderReq(req, clusterapi.IndicesPayloads.IfMatch.ObjectsIfMatch(objects))
 	err = c.do(c.timeoutUnit*90, req, body, &resp, 9)
 	return resp, err
 }
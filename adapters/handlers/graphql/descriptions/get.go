@@ -45,6 +45,7 @@ const (
 const NetworkGetClassUUID = "The UUID of a Object, assigned by the Weaviate network" // TODO check this with @lauraham
 
 const ConsistencyLevel = "Determines how many replicas must acknowledge a request " +
-	"before it is considered successful. Can be 'ONE', 'QUORUM', or 'ALL'"
+	"before it is considered successful. Can be 'ONE', 'QUORUM', 'ALL', or 'BOUNDED_STALENESS'. " +
+	"Defaults to the consistency level configured for the class or tenant"
 
 const Tenant = "The value by which a tenant is identified, specified in the class schema"
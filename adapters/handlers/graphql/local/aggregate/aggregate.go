@@ -42,7 +42,7 @@ func Build(dbSchema *schema.Schema, config config.Config,
 	var err error
 	var localAggregateObjects *graphql.Object
 	if len(dbSchema.Objects.Classes) > 0 {
-		localAggregateObjects, err = classFields(dbSchema.Objects.Classes, config, modulesProvider)
+		localAggregateObjects, err = classFields(dbSchema.Objects.Classes, dbSchema.Aliases, config, modulesProvider)
 		if err != nil {
 			return nil, err
 		}
@@ -58,7 +58,7 @@ func Build(dbSchema *schema.Schema, config config.Config,
 	return &field, nil
 }
 
-func classFields(databaseSchema []*models.Class,
+func classFields(databaseSchema []*models.Class, aliases map[string]string,
 	config config.Config, modulesProvider ModulesProvider,
 ) (*graphql.Object, error) {
 	fields := graphql.Fields{}
@@ -71,6 +71,11 @@ func classFields(databaseSchema []*models.Class,
 
 		fields[class.Class] = field
 	}
+	for alias, class := range aliases {
+		if field, ok := fields[class]; ok {
+			fields[alias] = field
+		}
+	}
 
 	return graphql.NewObject(graphql.ObjectConfig{
 		Name:        "AggregateObjectsObj",
@@ -58,7 +58,8 @@ func makeResolveClass(modulesProvider ModulesProvider, class *models.Class) grap
 }
 
 func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider, class *models.Class) (interface{}, error) {
-	className := schema.ClassName(p.Info.FieldName)
+	// the field name is either the class name or an alias pointing to it
+	className := schema.ClassName(class.Class)
 	source, ok := p.Source.(map[string]interface{})
 	if !ok {
 		return nil, fmt.Errorf("expected source to be a map, but was %t", p.Source)
@@ -80,7 +81,7 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		return nil, fmt.Errorf("could not extract properties for class '%s': %w", className, err)
 	}
 
-	groupBy, err := extractGroupBy(p.Args, p.Info.FieldName)
+	groupBy, err := extractGroupBy(p.Args, class.Class)
 	if err != nil {
 		return nil, fmt.Errorf("could not extract groupBy path: %w", err)
 	}
@@ -98,7 +99,7 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		return nil, fmt.Errorf("could not extract objectLimit: %w", err)
 	}
 
-	filters, err := common_filters.ExtractFilters(p.Args, p.Info.FieldName)
+	filters, err := common_filters.ExtractFilters(p.Args, class.Class)
 	if err != nil {
 		return nil, fmt.Errorf("could not extract filters: %w", err)
 	}
@@ -87,6 +87,11 @@ func (b *classBuilder) kinds(kindSchema *models.Schema) (*graphql.Object, error)
 		}
 		classFields[class.Class] = classField
 	}
+	for alias, class := range b.schema.Aliases {
+		if classField, ok := classFields[class]; ok {
+			classFields[alias] = classField
+		}
+	}
 
 	classes := graphql.NewObject(graphql.ObjectConfig{
 		Name:        "GetObjectsObj",
@@ -211,6 +216,13 @@ func (b *classBuilder) additionalClassificationField(class *models.Class) *graph
 				"scope":            &graphql.Field{Type: graphql.NewList(graphql.String)},
 				"classifiedFields": &graphql.Field{Type: graphql.NewList(graphql.String)},
 				"completed":        &graphql.Field{Type: graphql.String},
+				"confidence": &graphql.Field{Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+					Name: fmt.Sprintf("%sAdditionalClassificationConfidence", class.Class),
+					Fields: graphql.Fields{
+						"property":   &graphql.Field{Type: graphql.String},
+						"confidence": &graphql.Field{Type: graphql.Float},
+					},
+				}))},
 			},
 		}),
 	}
@@ -30,9 +30,10 @@ func consistencyLevelArgument(class *models.Class) *graphql.ArgumentConfig {
 		Type: graphql.NewEnum(graphql.EnumConfig{
 			Name: fmt.Sprintf("%sConsistencyLevelEnum", class.Class),
 			Values: graphql.EnumValueConfigMap{
-				string(replica.One):    &graphql.EnumValueConfig{},
-				string(replica.Quorum): &graphql.EnumValueConfig{},
-				string(replica.All):    &graphql.EnumValueConfig{},
+				string(replica.One):              &graphql.EnumValueConfig{},
+				string(replica.Quorum):           &graphql.EnumValueConfig{},
+				string(replica.All):              &graphql.EnumValueConfig{},
+				string(replica.BoundedStaleness): &graphql.EnumValueConfig{},
 			},
 		}),
 	}
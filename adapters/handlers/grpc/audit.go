@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+	"time"
+
+	openapierrors "github.com/go-openapi/errors"
+	pbv1 "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/audit"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// makeAuditInterceptor writes one audit event per request. The principal is
+// recorded by the service once it authenticated the request.
+func makeAuditInterceptor(auditLog *audit.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, "/grpc.health.") {
+			return handler(ctx, req)
+		}
+
+		operation := path.Base(info.FullMethod)
+		class := grpcOperationClass(operation)
+		if !auditLog.Enabled(class) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		ctx, rec := audit.NewContext(ctx)
+		rec.SetOperation(operation)
+		recordGRPCRequest(rec, req)
+
+		resp, err := handler(ctx, req)
+
+		auditLog.Log(rec.Event(audit.ProtocolGRPC, class, grpcAuditStatus(err), err, start))
+		return resp, err
+	}
+}
+
+func grpcOperationClass(operation string) string {
+	switch operation {
+	case "Search", "TenantsGet":
+		return config.AuditOperationRead
+	case "BatchObjects", "BatchDelete":
+		return config.AuditOperationWrite
+	default:
+		return config.AuditOperationAdmin
+	}
+}
+
+// recordGRPCRequest records collection, tenant, object ids and filters of the
+// request. Batches spanning several collections or tenants leave the
+// respective field empty.
+func recordGRPCRequest(rec *audit.Record, req interface{}) {
+	switch r := req.(type) {
+	case *pbv1.SearchRequest:
+		rec.SetTarget(r.Collection, r.Tenant)
+		if r.Filters != nil {
+			rec.SetFilter(protojson.Format(r.Filters))
+		}
+	case *pbv1.BatchDeleteRequest:
+		rec.SetTarget(r.Collection, r.GetTenant())
+		if r.Filters != nil {
+			rec.SetFilter(protojson.Format(r.Filters))
+		}
+	case *pbv1.TenantsGetRequest:
+		rec.SetTarget(r.Collection, "")
+	case *pbv1.BatchObjectsRequest:
+		var collection, tenant string
+		for i, obj := range r.Objects {
+			if i == 0 {
+				collection, tenant = obj.Collection, obj.Tenant
+			}
+			if obj.Collection != collection {
+				collection = ""
+			}
+			if obj.Tenant != tenant {
+				tenant = ""
+			}
+			rec.AddObjectIDs(obj.Uuid)
+		}
+		rec.SetTarget(collection, tenant)
+	}
+}
+
+func grpcAuditStatus(err error) string {
+	if err == nil {
+		return audit.StatusOK
+	}
+	if errors.As(err, &autherrs.Forbidden{}) {
+		return audit.StatusDenied
+	}
+	// authentication failures are reported with an HTTP status code
+	var apiErr openapierrors.Error
+	if errors.As(err, &apiErr) {
+		return audit.StatusFromHTTP(int(apiErr.Code()))
+	}
+	switch status.Code(err) {
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return audit.StatusDenied
+	case codes.InvalidArgument, codes.NotFound, codes.AlreadyExists,
+		codes.FailedPrecondition, codes.OutOfRange:
+		return audit.StatusUserError
+	default:
+		return audit.StatusServerError
+	}
+}
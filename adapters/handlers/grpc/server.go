@@ -65,6 +65,10 @@ func CreateGRPCServer(state *state.State) *GRPCServer {
 		interceptors = append(interceptors, makeMetricsInterceptor(state.Logger, state.Metrics))
 	}
 
+	if state.AuditLog != nil {
+		interceptors = append(interceptors, makeAuditInterceptor(state.AuditLog))
+	}
+
 	if len(interceptors) > 0 {
 		o = append(o, grpc.ChainUnaryInterceptor(interceptors...))
 	}
@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -25,6 +26,14 @@ import (
 // should be called from a central place. This way we can make sure it's
 // impossible to forget to add it to a new endpoint.
 func (s *Service) principalFromContext(ctx context.Context) (*models.Principal, error) {
+	principal, err := s.authenticate(ctx)
+	if err == nil {
+		audit.SetPrincipal(ctx, principal)
+	}
+	return principal, err
+}
+
+func (s *Service) authenticate(ctx context.Context) (*models.Principal, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return s.tryAnonymous()
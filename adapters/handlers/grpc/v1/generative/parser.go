@@ -12,6 +12,8 @@
 package generative
 
 import (
+	"slices"
+
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
@@ -127,6 +129,7 @@ func (p *Parser) extract(req *pb.GenerativeSearch, class *models.Class) *generat
 			p.providerName = providerName
 			p.returnMetadata = query.ReturnMetadata
 		}
+		generative.ImageProperties = p.appendImageProperties(generative.ImageProperties, req.Single.GetImageProperties())
 	}
 	if req.Grouped != nil {
 		generative.Task = &req.Grouped.Task
@@ -137,10 +140,21 @@ func (p *Parser) extract(req *pb.GenerativeSearch, class *models.Class) *generat
 			// if users do not supply a properties, all properties need to be extracted
 			generative.PropertiesToExtract = append(generative.PropertiesToExtract, schema.GetPropertyNamesFromClass(class, false)...)
 		}
+		generative.ImageProperties = p.appendImageProperties(generative.ImageProperties, req.Grouped.GetImageProperties())
 	}
+	generative.PropertiesToExtract = append(generative.PropertiesToExtract, generative.ImageProperties...)
 	return &generative
 }
 
+func (p *Parser) appendImageProperties(imageProperties []string, in *pb.TextArray) []string {
+	for _, name := range in.GetValues() {
+		if !slices.Contains(imageProperties, name) {
+			imageProperties = append(imageProperties, name)
+		}
+	}
+	return imageProperties
+}
+
 func (p *Parser) anthropic(in *pb.GenerativeAnthropic) map[string]any {
 	if in == nil {
 		return nil
@@ -128,6 +128,28 @@ func Test_RequestParser(t *testing.T) {
 				PropertiesToExtract: getPropNames(class.Properties),
 			},
 		},
+		{
+			name:       "generative search with image props; new non-dynamic",
+			uses127Api: true,
+			in: &pb.GenerativeSearch{
+				Single: &pb.GenerativeSearch_Single{
+					Prompt:          "prompt",
+					ImageProperties: &pb.TextArray{Values: []string{"image"}},
+				},
+				Grouped: &pb.GenerativeSearch_Grouped{
+					Task:            "task",
+					Properties:      &pb.TextArray{Values: getPropNames(class.Properties)},
+					ImageProperties: &pb.TextArray{Values: []string{"image", "thumbnail"}},
+				},
+			},
+			expected: &generate.Params{
+				Prompt:              makeStrPtr("prompt"),
+				Task:                makeStrPtr("task"),
+				Properties:          getPropNames(class.Properties),
+				ImageProperties:     []string{"image", "thumbnail"},
+				PropertiesToExtract: append(getPropNames(class.Properties), "image", "thumbnail"),
+			},
+		},
 		{
 			name:       "generative search with props; new non-dynamic",
 			uses127Api: true,
@@ -71,6 +71,13 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 
 	out.ClassName = req.Collection
 	out.ReplicationProperties = extractReplicationProperties(req.ConsistencyLevel)
+	if req.ConsistencyToken != nil {
+		repl, err := withConsistencyToken(out.ReplicationProperties, req.ConsistencyToken)
+		if err != nil {
+			return dto.GetParams{}, err
+		}
+		out.ReplicationProperties = repl
+	}
 
 	out.Tenant = req.Tenant
 
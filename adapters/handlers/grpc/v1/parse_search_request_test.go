@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
@@ -171,6 +172,10 @@ func TestGRPCRequest(t *testing.T) {
 	}
 	defaultPagination := &filters.Pagination{Limit: 10}
 	quorum := pb.ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM
+	token := replication.NewConsistencyToken()
+	token.Add(classname, "shard1", 5)
+	tokenString := token.String()
+	invalidToken := "not-a-token"
 	someString1 := "a word"
 	someString2 := "other"
 
@@ -1301,6 +1306,29 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "Consistency token",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				ConsistencyLevel: &quorum, ConsistencyToken: &tokenString,
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				Properties:            defaultTestClassProps,
+				AdditionalProperties:  additional.Properties{Vector: true, NoProps: false},
+				ReplicationProperties: &additional.ReplicationProperties{ConsistencyLevel: "QUORUM", ConsistencyToken: token},
+			},
+			error: false,
+		},
+		{
+			name: "Invalid consistency token",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				ConsistencyToken: &invalidToken,
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
 		{
 			name: "Generative",
 			req: &pb.SearchRequest{
@@ -25,6 +25,7 @@ import (
 
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
@@ -99,6 +100,7 @@ func (s *Service) batchDelete(ctx context.Context, req *pb.BatchDeleteRequest) (
 	}
 	replicationProperties := extractReplicationProperties(req.ConsistencyLevel)
 
+	req.Collection = s.resolveAlias(req.Collection)
 	params, err := batchDeleteParamsFromProto(req, s.schemaManager.ReadOnlyClass)
 	if err != nil {
 		return nil, fmt.Errorf("batch delete params: %w", err)
@@ -142,6 +144,9 @@ func (s *Service) batchObjects(ctx context.Context, req *pb.BatchObjectsRequest)
 	if err != nil {
 		return nil, fmt.Errorf("extract auth: %w", err)
 	}
+	for _, obj := range req.Objects {
+		obj.Collection = s.resolveAlias(obj.Collection)
+	}
 	objs, objOriginalIndex, objectParsingErrors := BatchFromProto(req, s.schemaManager.ReadOnlyClass)
 
 	var objErrors []*pb.BatchObjectsReply_BatchError
@@ -158,10 +163,17 @@ func (s *Service) batchObjects(ctx context.Context, req *pb.BatchObjectsRequest)
 		return result, nil
 	}
 
-	replicationProperties := extractReplicationProperties(req.ConsistencyLevel)
+	replicationProperties, err := withConsistencyToken(extractReplicationProperties(req.ConsistencyLevel), req.ConsistencyToken)
+	if err != nil {
+		return nil, err
+	}
+	if replicationProperties.ConsistencyToken == nil {
+		replicationProperties.ConsistencyToken = replication.NewConsistencyToken()
+	}
 
 	all := "ALL"
-	response, err := s.batchManager.AddObjects(ctx, principal, objs, []*string{&all}, replicationProperties)
+	response, err := s.batchManager.AddObjectsIfMatch(ctx, principal, objs, []*string{&all},
+		replicationProperties, batchIfMatchFromProto(req, objOriginalIndex, len(objs)))
 	if err != nil {
 		return nil, err
 	}
@@ -173,12 +185,31 @@ func (s *Service) batchObjects(ctx context.Context, req *pb.BatchObjectsRequest)
 	}
 
 	result := &pb.BatchObjectsReply{
-		Took:   float32(time.Since(before).Seconds()),
-		Errors: objErrors,
+		Took:             float32(time.Since(before).Seconds()),
+		Errors:           objErrors,
+		ConsistencyToken: replicationProperties.ConsistencyToken.String(),
 	}
 	return result, nil
 }
 
+// batchIfMatchFromProto returns the preconditions of the parsed objects in
+// the order of the parsed batch, or nil if no object carries one.
+func batchIfMatchFromProto(req *pb.BatchObjectsRequest, objOriginalIndex map[int]int, count int) []*int64 {
+	var ifMatch []*int64
+	for i := 0; i < count; i++ {
+		obj := req.Objects[objOriginalIndex[i]]
+		if obj.IfMatch == nil {
+			continue
+		}
+		if ifMatch == nil {
+			ifMatch = make([]*int64, count)
+		}
+		updateTime := *obj.IfMatch
+		ifMatch[i] = &updateTime
+	}
+	return ifMatch
+}
+
 func (s *Service) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
 	var result *pb.SearchReply
 	var errInner error
@@ -200,6 +231,7 @@ func (s *Service) search(ctx context.Context, req *pb.SearchRequest) (*pb.Search
 		return nil, fmt.Errorf("extract auth: %w", err)
 	}
 
+	req.Collection = s.resolveAlias(req.Collection)
 	scheme := s.schemaManager.GetSchemaSkipAuth()
 	parser := NewParser(
 		req.Uses_127Api,
@@ -230,6 +262,15 @@ func (s *Service) search(ctx context.Context, req *pb.SearchRequest) (*pb.Search
 	return replier.Search(res, before, searchParams, scheme)
 }
 
+// resolveAlias returns the collection an alias points to. Names which are not
+// aliases are returned unchanged.
+func (s *Service) resolveAlias(name string) string {
+	if class := s.schemaManager.ResolveAlias(name); class != "" {
+		return class
+	}
+	return name
+}
+
 func (s *Service) validateClassAndProperty(searchParams dto.GetParams) error {
 	class := s.schemaManager.ReadOnlyClass(searchParams.ClassName)
 	if class == nil {
@@ -262,3 +303,19 @@ func extractReplicationProperties(level *pb.ConsistencyLevel) *additional.Replic
 		return nil
 	}
 }
+
+// withConsistencyToken attaches the consistency token sent by the client to
+// the replication properties, creating them if necessary.
+func withConsistencyToken(repl *additional.ReplicationProperties, token *string) (*additional.ReplicationProperties, error) {
+	if repl == nil {
+		repl = &additional.ReplicationProperties{}
+	}
+	if token != nil {
+		t, err := replication.ParseConsistencyToken(*token)
+		if err != nil {
+			return nil, err
+		}
+		repl.ConsistencyToken = t
+	}
+	return repl, nil
+}
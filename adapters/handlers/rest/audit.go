@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/tailor-inc/graphql/language/parser"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// makeAddAuditLogging writes one audit event per request. The event is
+// enriched by the API authorizer (principal, operation and target) and by
+// individual handlers (object ids, filters) through the request context.
+func makeAddAuditLogging(auditLog *audit.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if auditLog == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodOptions || !auditedPath(r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			class := restOperationClass(r.Method, r.URL.Path)
+			if !auditLog.Enabled(class) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			start := time.Now()
+			ctx, rec := audit.NewContext(r.Context())
+			sw := &statusResponseWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(sw, r.WithContext(ctx))
+
+			if rec.Operation() == "" {
+				rec.SetOperation(r.Method + " " + r.URL.Path)
+			}
+			protocol := audit.ProtocolREST
+			if isGraphQLPath(r.URL.Path) {
+				protocol = audit.ProtocolGraphQL
+			}
+			auditLog.Log(rec.Event(protocol, class, audit.StatusFromHTTP(sw.status), nil, start))
+		})
+	}
+}
+
+// makeAuditAPIAuthorizer wraps the API authorizer which go-swagger calls once
+// the principal of a request is known. At this point the route is matched,
+// so operation and path parameters are recorded as well.
+func makeAuditAPIAuthorizer(authorizer runtime.Authorizer) runtime.Authorizer {
+	return runtime.AuthorizerFunc(func(r *http.Request, principal interface{}) error {
+		rec := audit.FromContext(r.Context())
+		if rec != nil {
+			p, _ := principal.(*models.Principal)
+			rec.SetPrincipal(p)
+			rec.SetTarget(r.URL.Query().Get("class"), r.URL.Query().Get("tenant"))
+			if route := middleware.MatchedRouteFrom(r); route != nil {
+				if route.Operation != nil {
+					rec.SetOperation(route.Operation.ID)
+				}
+				rec.SetTarget(route.Params.Get("className"), route.Params.Get("tenantName"))
+				rec.AddObjectIDs(route.Params.Get("id"))
+			}
+		}
+		return authorizer.Authorize(r, principal)
+	})
+}
+
+type statusResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusResponseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Flush keeps streaming responses working behind the audit logging
+func (w *statusResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// graphQLAuditFilter summarizes a GraphQL query for the audit log by its
+// operation name and the queried classes, e.g. "MyQuery: Get.Article
+// Aggregate.Author". Arguments are left out, as they may hold the values
+// users search for.
+func graphQLAuditFilter(query, operationName string) string {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return ""
+	}
+
+	var op *ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		if d, ok := def.(*ast.OperationDefinition); ok {
+			if operationName == "" || (d.Name != nil && d.Name.Value == operationName) {
+				op = d
+				break
+			}
+		}
+	}
+	if op == nil {
+		return ""
+	}
+
+	var queried []string
+	for _, sel := range selectedFields(op.SelectionSet) {
+		classes := selectedFields(sel.SelectionSet)
+		if len(classes) == 0 {
+			queried = append(queried, sel.Name.Value)
+		}
+		for _, class := range classes {
+			queried = append(queried, sel.Name.Value+"."+class.Name.Value)
+		}
+	}
+
+	name := op.Operation
+	if op.Name != nil {
+		name = op.Name.Value
+	}
+	return name + ": " + strings.Join(queried, " ")
+}
+
+func selectedFields(set *ast.SelectionSet) []*ast.Field {
+	if set == nil {
+		return nil
+	}
+	fields := make([]*ast.Field, 0, len(set.Selections))
+	for _, sel := range set.Selections {
+		if f, ok := sel.(*ast.Field); ok && f.Name != nil {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// auditedPath excludes the probes, the API discovery documents and metrics
+func auditedPath(path string) bool {
+	return strings.HasPrefix(path, "/v1/") &&
+		!strings.HasPrefix(path, "/v1/.well-known/")
+}
+
+func isGraphQLPath(path string) bool {
+	return path == "/v1/graphql" || strings.HasPrefix(path, "/v1/graphql/")
+}
+
+// restOperationClass derives the operation class from the request. GraphQL
+// is read-only, objects and batch requests are reads or writes depending on
+// the method and everything else is administrative.
+func restOperationClass(method, path string) string {
+	switch {
+	case isGraphQLPath(path):
+		return config.AuditOperationRead
+	case strings.HasPrefix(path, "/v1/objects"), strings.HasPrefix(path, "/v1/batch/"):
+		if method == http.MethodGet || method == http.MethodHead ||
+			strings.HasSuffix(path, "/validate") {
+			return config.AuditOperationRead
+		}
+		return config.AuditOperationWrite
+	default:
+		return config.AuditOperationAdmin
+	}
+}
@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/runtime/security"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestAuditLogging(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	buf := &bytes.Buffer{}
+	auditLog := audit.NewLogger([]string{config.AuditOperationRead, config.AuditOperationWrite},
+		logger, audit.NewJSONSink(buf))
+	apiAuthorizer := makeAuditAPIAuthorizer(security.Authorized())
+
+	handler := makeAddAuditLogging(auditLog)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Nil(t, apiAuthorizer.Authorize(r, &models.Principal{Username: "jane"}))
+		audit.AddObjectIDs(r.Context(), "id1")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	}))
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest(http.MethodPost, "/v1/batch/objects?tenant=tenant1", nil),
+		httptest.NewRequest(http.MethodGet, "/v1/.well-known/ready", nil),
+		httptest.NewRequest(http.MethodOptions, "/v1/objects", nil),
+		httptest.NewRequest(http.MethodDelete, "/v1/schema/Article", nil),
+	} {
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	var events []audit.Event
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var e audit.Event
+		require.Nil(t, dec.Decode(&e))
+		events = append(events, e)
+	}
+
+	require.Len(t, events, 1)
+	e := events[0]
+	assert.Equal(t, audit.ProtocolREST, e.Protocol)
+	assert.Equal(t, "POST /v1/batch/objects", e.Operation)
+	assert.Equal(t, config.AuditOperationWrite, e.OperationClass)
+	assert.Equal(t, "jane", e.Principal)
+	assert.Equal(t, "tenant1", e.Tenant)
+	assert.Equal(t, []string{"id1"}, e.ObjectIDs)
+	assert.Equal(t, audit.StatusUserError, e.Status)
+}
+
+func TestAuditLoggingFlushes(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	auditLog := audit.NewLogger([]string{config.AuditOperationRead}, logger, audit.NewJSONSink(&bytes.Buffer{}))
+
+	handler := makeAddAuditLogging(auditLog)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f, ok := w.(http.Flusher)
+		require.True(t, ok)
+		f.Flush()
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/objects", nil))
+	assert.True(t, rec.Flushed)
+}
+
+func TestGraphQLAuditFilter(t *testing.T) {
+	query := `query Search {
+		Get {
+			Article(where: {path: ["secret"], operator: Equal, valueText: "jane@example.com"}) { title }
+			Author { name }
+		}
+	}
+	query Count { Aggregate { Article { meta { count } } } }`
+
+	assert.Equal(t, "Search: Get.Article Get.Author", graphQLAuditFilter(query, ""))
+	assert.Equal(t, "Count: Aggregate.Article", graphQLAuditFilter(query, "Count"))
+	assert.Equal(t, "query: Get.Article", graphQLAuditFilter(`{ Get { Article { title } } }`, ""))
+	assert.Equal(t, "", graphQLAuditFilter(`{ Get {`, ""))
+}
+
+func TestRestOperationClass(t *testing.T) {
+	for _, tt := range []struct {
+		method, path, class string
+	}{
+		{http.MethodPost, "/v1/graphql", config.AuditOperationRead},
+		{http.MethodPost, "/v1/graphql/batch", config.AuditOperationRead},
+		{http.MethodGet, "/v1/objects/Article/8a6e2d62-5b3e-4d7a-9c3e-0d6b0f6f6c01", config.AuditOperationRead},
+		{http.MethodHead, "/v1/objects/Article/8a6e2d62-5b3e-4d7a-9c3e-0d6b0f6f6c01", config.AuditOperationRead},
+		{http.MethodPost, "/v1/objects/validate", config.AuditOperationRead},
+		{http.MethodPost, "/v1/objects", config.AuditOperationWrite},
+		{http.MethodDelete, "/v1/batch/objects", config.AuditOperationWrite},
+		{http.MethodGet, "/v1/schema", config.AuditOperationAdmin},
+		{http.MethodPost, "/v1/backups/filesystem", config.AuditOperationAdmin},
+	} {
+		assert.Equal(t, tt.class, restOperationClass(tt.method, tt.path), tt.method+" "+tt.path)
+	}
+}
@@ -118,7 +118,7 @@ type shards interface {
 	Exists(ctx context.Context, indexName, shardName string,
 		id strfmt.UUID) (bool, error)
 	DeleteObject(ctx context.Context, indexName, shardName string,
-		id strfmt.UUID, schemaVersion uint64) error
+		id strfmt.UUID, ifMatch *int64, schemaVersion uint64) error
 	MergeObject(ctx context.Context, indexName, shardName string,
 		mergeDoc objects.MergeDocument, schemaVersion uint64) error
 	MultiGetObjects(ctx context.Context, indexName, shardName string,
@@ -386,6 +386,11 @@ func (i *indices) postObjectSingle(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	if err := IndicesPayloads.IfMatch.ApplyToObjects(r, []*storobj.Object{obj}); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	schemaVersion, err := extractSchemaVersionFromUrlQuery(r.URL.Query())
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
@@ -393,7 +398,7 @@ func (i *indices) postObjectSingle(w http.ResponseWriter, r *http.Request,
 	}
 
 	if err := i.shards.PutObject(r.Context(), index, shard, obj, schemaVersion); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), IndicesPayloads.IfMatch.ErrorStatus(err))
 		return
 	}
 
@@ -415,6 +420,11 @@ func (i *indices) postObjectBatch(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	if err := IndicesPayloads.IfMatch.ApplyToObjects(r, objs); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	schemaVersion, err := extractSchemaVersionFromUrlQuery(r.URL.Query())
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
@@ -568,9 +578,19 @@ func (i *indices) deleteObject() http.Handler {
 			return
 		}
 
-		err = i.shards.DeleteObject(r.Context(), index, shard, strfmt.UUID(id), schemaVersion)
+		ifMatch, err := IndicesPayloads.IfMatch.Unmarshal(r, 1)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var objIfMatch *int64
+		if ifMatch != nil {
+			objIfMatch = ifMatch[0]
+		}
+
+		err = i.shards.DeleteObject(r.Context(), index, shard, strfmt.UUID(id), objIfMatch, schemaVersion)
+		if err != nil {
+			http.Error(w, err.Error(), IndicesPayloads.IfMatch.ErrorStatus(err))
 			return
 		}
 
@@ -615,7 +635,7 @@ func (i *indices) mergeObject() http.Handler {
 		}
 
 		if err = i.shards.MergeObject(r.Context(), index, shard, mergeDoc, schemaVersion); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			http.Error(w, err.Error(), IndicesPayloads.IfMatch.ErrorStatus(err))
 			return
 		}
 
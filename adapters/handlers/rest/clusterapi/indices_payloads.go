@@ -19,6 +19,8 @@ import (
 	"io"
 	"math"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/weaviate/weaviate/entities/dto"
 
@@ -62,6 +64,8 @@ type indicesPayloads struct {
 	UpdateShardsStatusResults updateShardsStatusResultsPayload
 	ShardFiles                shardFilesPayload
 	IncreaseReplicationFactor increaseReplicationFactorPayload
+	ReplicaShards             replicaShardsPayload
+	IfMatch                   ifMatchPayload
 }
 
 type increaseReplicationFactorPayload struct{}
@@ -88,6 +92,111 @@ func (p increaseReplicationFactorPayload) Unmarshal(in []byte) (scaler.ShardDist
 	return pay.ShardDist, nil
 }
 
+// replicaShardsPayload lists the shards whose local replicas are joining or removed
+type replicaShardsPayload struct{}
+
+func (p replicaShardsPayload) Marshall(shards []string) ([]byte, error) {
+	type payload struct {
+		Shards []string `json:"shards"`
+	}
+
+	return json.Marshal(payload{Shards: shards})
+}
+
+func (p replicaShardsPayload) Unmarshal(in []byte) ([]string, error) {
+	type payload struct {
+		Shards []string `json:"shards"`
+	}
+
+	pay := payload{}
+	if err := json.Unmarshal(in, &pay); err != nil {
+		return nil, fmt.Errorf("unmarshal replica shards payload: %w", err)
+	}
+
+	return pay.Shards, nil
+}
+
+// ifMatchPayload transfers the preconditions of conditional writes in a
+// request header, as they are not part of the binary object encoding. The
+// header holds one comma-separated entry per object, empty if the write
+// of that object is unconditional.
+type ifMatchPayload struct{}
+
+func (p ifMatchPayload) Header() string {
+	return "X-Weaviate-If-Match"
+}
+
+// SetHeaderReq sets the header if at least one of the writes is conditional
+func (p ifMatchPayload) SetHeaderReq(r *http.Request, ifMatch []*int64) {
+	conditional := false
+	values := make([]string, len(ifMatch))
+	for i, v := range ifMatch {
+		if v != nil {
+			values[i] = strconv.FormatInt(*v, 10)
+			conditional = true
+		}
+	}
+	if conditional {
+		r.Header.Set(p.Header(), strings.Join(values, ","))
+	}
+}
+
+// Unmarshal returns the preconditions of n writes. It returns nil if none
+// of them is conditional.
+func (p ifMatchPayload) Unmarshal(r *http.Request, n int) ([]*int64, error) {
+	header := r.Header.Get(p.Header())
+	if header == "" {
+		return nil, nil
+	}
+	values := strings.Split(header, ",")
+	if len(values) != n {
+		return nil, fmt.Errorf("%s: got %d values for %d objects", p.Header(), len(values), n)
+	}
+	ifMatch := make([]*int64, n)
+	for i, v := range values {
+		if v == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p.Header(), err)
+		}
+		ifMatch[i] = &parsed
+	}
+	return ifMatch, nil
+}
+
+// ObjectsIfMatch returns the preconditions of objs, or nil if none of them
+// is conditional
+func (p ifMatchPayload) ObjectsIfMatch(objs []*storobj.Object) []*int64 {
+	ifMatch := make([]*int64, len(objs))
+	for i, obj := range objs {
+		ifMatch[i] = obj.IfMatch
+	}
+	return ifMatch
+}
+
+// ApplyToObjects sets the preconditions sent with the request on objs
+func (p ifMatchPayload) ApplyToObjects(r *http.Request, objs []*storobj.Object) error {
+	ifMatch, err := p.Unmarshal(r, len(objs))
+	if err != nil || ifMatch == nil {
+		return err
+	}
+	for i, obj := range objs {
+		obj.IfMatch = ifMatch[i]
+	}
+	return nil
+}
+
+// ErrorStatus returns the status code of a failed write, a rejected
+// precondition is reported as 412 so the caller can restore the error type
+func (p ifMatchPayload) ErrorStatus(err error) int {
+	if errors.As(err, &objects.ErrPreconditionFailed{}) {
+		return http.StatusPreconditionFailed
+	}
+	return http.StatusInternalServerError
+}
+
 type errorListPayload struct{}
 
 func (e errorListPayload) MIME() string {
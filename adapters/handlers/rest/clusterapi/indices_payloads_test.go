@@ -13,6 +13,7 @@ package clusterapi
 
 import (
 	"encoding/json"
+	"net/http"
 	"testing"
 	"time"
 
@@ -146,6 +147,44 @@ func (p searchParamsPayloadOld) Unmarshal(in []byte) ([]float32, string, float32
 }
 
 // This tests the backward compatibility of the searchParamsPayload with the old version in 1.25 and before (copied from the old code above)
+func Test_ifMatchPayload(t *testing.T) {
+	p := IndicesPayloads.IfMatch
+	v1, v2 := int64(0), int64(1700000000000)
+
+	t.Run("unconditional writes do not set the header", func(t *testing.T) {
+		r, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.Nil(t, err)
+		p.SetHeaderReq(r, []*int64{nil, nil})
+		assert.Empty(t, r.Header.Get(p.Header()))
+
+		ifMatch, err := p.Unmarshal(r, 2)
+		require.Nil(t, err)
+		assert.Nil(t, ifMatch)
+	})
+
+	t.Run("mixed writes round trip", func(t *testing.T) {
+		r, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.Nil(t, err)
+		p.SetHeaderReq(r, []*int64{&v1, nil, &v2})
+
+		ifMatch, err := p.Unmarshal(r, 3)
+		require.Nil(t, err)
+		require.Len(t, ifMatch, 3)
+		assert.Equal(t, v1, *ifMatch[0])
+		assert.Nil(t, ifMatch[1])
+		assert.Equal(t, v2, *ifMatch[2])
+	})
+
+	t.Run("length mismatch", func(t *testing.T) {
+		r, err := http.NewRequest(http.MethodPost, "/", nil)
+		require.Nil(t, err)
+		p.SetHeaderReq(r, []*int64{&v1, &v2})
+
+		_, err = p.Unmarshal(r, 3)
+		assert.NotNil(t, err)
+	})
+}
+
 func TestBackwardCompatibilitySearch(t *testing.T) {
 	payload := searchParamsPayload{}
 	tests := []struct {
@@ -40,7 +40,7 @@ type replicator interface {
 	ReplicateUpdate(ctx context.Context, indexName, shardName,
 		requestID string, mergeDoc *objects.MergeDocument, schemaVersion uint64) replica.SimpleResponse
 	ReplicateDeletion(ctx context.Context, indexName, shardName,
-		requestID string, uuid strfmt.UUID, schemaVersion uint64) replica.SimpleResponse
+		requestID string, uuid strfmt.UUID, ifMatch *int64, schemaVersion uint64) replica.SimpleResponse
 	ReplicateDeletions(ctx context.Context, indexName, shardName,
 		requestID string, uuids []strfmt.UUID, dryRun bool, schemaVersion uint64) replica.SimpleResponse
 	ReplicateReferences(ctx context.Context, indexName, shardName,
@@ -60,11 +60,15 @@ type replicator interface {
 		initialToken, finalToken uint64, limit int) (result []replica.RepairResponse, lastTokenRead uint64, err error)
 	HashTreeLevel(ctx context.Context, index, shard string,
 		level int, discriminant *hashtree.Bitset) (digests []hashtree.Digest, err error)
+	AppliedWatermark(ctx context.Context, index, shard string) (int64, error)
 }
 
 type localScaler interface {
 	LocalScaleOut(ctx context.Context, className string,
 		dist scaler.ShardDist) error
+	LocalJoinReplicas(ctx context.Context, className string, shards []string) error
+	LocalSyncReplicas(ctx context.Context, className string, dist scaler.ShardDist) error
+	LocalScaleIn(ctx context.Context, className string, shards []string) error
 }
 
 type replicatedIndices struct {
@@ -85,6 +89,8 @@ var (
 		`\/shards\/(` + sh + `)\/objects/_digest`)
 	regexObjectsDigestsInTokenRange = regexp.MustCompile(`\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects/digestsInTokenRange`)
+	regxAppliedWatermark = regexp.MustCompile(`\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/objects/_watermark`)
 	regxHashTreeLevel = regexp.MustCompile(`\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects\/hashtree\/(` + l + `)`)
 	regxObjects = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
@@ -92,7 +98,9 @@ var (
 	regxReferences = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects/references`)
 	regxIncreaseRepFactor = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
-		`\/replication-factor:increase`)
+		`\/replication-factor:(increase|sync)`)
+	regxReplicaShards = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
+		`\/replication-factor:(join|decrease)`)
 	regxCommitPhase = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `):(commit|abort)`)
 )
@@ -134,6 +142,14 @@ func (i *replicatedIndices) indicesHandler() http.HandlerFunc {
 				return
 			}
 
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		case regxAppliedWatermark.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getAppliedWatermark().ServeHTTP(w, r)
+				return
+			}
+
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 		case regxHashTreeLevel.MatchString(path):
@@ -206,6 +222,15 @@ func (i *replicatedIndices) indicesHandler() http.HandlerFunc {
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 
+		case regxReplicaShards.MatchString(path):
+			if r.Method == http.MethodPut {
+				i.changeReplicaShards().ServeHTTP(w, r)
+				return
+			}
+
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
 		case regxCommitPhase.MatchString(path):
 			if r.Method == http.MethodPost {
 				i.executeCommitPhase().ServeHTTP(w, r)
@@ -266,12 +291,12 @@ func (i *replicatedIndices) executeCommitPhase() http.Handler {
 func (i *replicatedIndices) increaseReplicationFactor() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := regxIncreaseRepFactor.FindStringSubmatch(r.URL.Path)
-		if len(args) != 2 {
+		if len(args) != 3 {
 			http.Error(w, "invalid URI", http.StatusBadRequest)
 			return
 		}
 
-		index := args[1]
+		index, cmd := args[1], args[2]
 
 		bodyBytes, err := io.ReadAll(r.Body)
 		if err != nil {
@@ -285,7 +310,52 @@ func (i *replicatedIndices) increaseReplicationFactor() http.Handler {
 			return
 		}
 
-		if err := i.scaler.LocalScaleOut(r.Context(), index, dist); err != nil {
+		if cmd == "sync" {
+			err = i.scaler.LocalSyncReplicas(r.Context(), index, dist)
+		} else {
+			err = i.scaler.LocalScaleOut(r.Context(), index, dist)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (i *replicatedIndices) changeReplicaShards() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxReplicaShards.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, cmd := args[1], args[2]
+
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		shards, err := IndicesPayloads.ReplicaShards.Unmarshal(bodyBytes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		switch cmd {
+		case "join":
+			err = i.scaler.LocalJoinReplicas(r.Context(), index, shards)
+		case "decrease":
+			err = i.scaler.LocalScaleIn(r.Context(), index, shards)
+		default:
+			http.Error(w, fmt.Sprintf("unrecognized command: %s", cmd), http.StatusNotImplemented)
+			return
+		}
+		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
@@ -477,6 +547,33 @@ func (i *replicatedIndices) getObjectsDigestsInTokenRange() http.Handler {
 	})
 }
 
+func (i *replicatedIndices) getAppliedWatermark() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxAppliedWatermark.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		watermark, err := i.shards.AppliedWatermark(r.Context(), index, shard)
+		if err != nil {
+			http.Error(w, "applied watermark: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := json.Marshal(watermark)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(resBytes)
+	})
+}
+
 func (i *replicatedIndices) getHashTreeLevel() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := regxHashTreeLevel.FindStringSubmatch(r.URL.Path)
@@ -588,7 +685,18 @@ func (i *replicatedIndices) deleteObject() http.Handler {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-		resp := i.shards.ReplicateDeletion(r.Context(), index, shard, requestID, strfmt.UUID(id), schemaVersion)
+
+		ifMatch, err := IndicesPayloads.IfMatch.Unmarshal(r, 1)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var objIfMatch *int64
+		if ifMatch != nil {
+			objIfMatch = ifMatch[0]
+		}
+
+		resp := i.shards.ReplicateDeletion(r.Context(), index, shard, requestID, strfmt.UUID(id), objIfMatch, schemaVersion)
 		if localIndexNotReady(resp) {
 			http.Error(w, resp.FirstError().Error(), http.StatusServiceUnavailable)
 			return
@@ -670,6 +778,11 @@ func (i *replicatedIndices) postObjectSingle(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	if err := IndicesPayloads.IfMatch.ApplyToObjects(r, []*storobj.Object{obj}); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	resp := i.shards.ReplicateObject(r.Context(), index, shard, requestID, obj, schemaVersion)
 	if localIndexNotReady(resp) {
 		http.Error(w, resp.FirstError().Error(), http.StatusServiceUnavailable)
@@ -701,6 +814,11 @@ func (i *replicatedIndices) postObjectBatch(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	if err := IndicesPayloads.IfMatch.ApplyToObjects(r, objs); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	resp := i.shards.ReplicateObjects(r.Context(), index, shard, requestID, objs, schemaVersion)
 	if localIndexNotReady(resp) {
 		http.Error(w, resp.FirstError().Error(), http.StatusServiceUnavailable)
@@ -46,6 +46,9 @@ func TestMaintenanceModeReplicatedIndices(t *testing.T) {
 		{"POST", "/objects"},
 		{"DELETE", "/objects"},
 		{"PUT", "/replication-factor:increase"},
+		{"PUT", "/replication-factor:sync"},
+		{"PUT", "/replication-factor:join"},
+		{"PUT", "/replication-factor:decrease"},
 		{"POST", ":commit"},
 		{"POST", ":abort"},
 	}
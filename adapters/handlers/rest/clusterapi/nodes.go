@@ -26,6 +26,7 @@ import (
 type nodesManager interface {
 	GetNodeStatus(ctx context.Context, className, output string) (*models.NodeStatus, error)
 	GetStatistics(ctx context.Context) (*models.Statistics, error)
+	GetTenantUsage(ctx context.Context, className, tenant string) (*models.TenantUsage, error)
 }
 
 type nodes struct {
@@ -38,9 +39,11 @@ func NewNodes(manager nodesManager, auth auth) *nodes {
 }
 
 var (
-	regxNodes      = regexp.MustCompile(`/status`)
-	regxNodesClass = regexp.MustCompile(`/status/(` + entschema.ClassNameRegexCore + `)`)
-	regxStatistics = regexp.MustCompile(`/statistics`)
+	regxNodes       = regexp.MustCompile(`/status`)
+	regxNodesClass  = regexp.MustCompile(`/status/(` + entschema.ClassNameRegexCore + `)`)
+	regxStatistics  = regexp.MustCompile(`/statistics`)
+	regxTenantUsage = regexp.MustCompile(`/tenant-usage/(` + entschema.ClassNameRegexCore +
+		`)/(` + entschema.ShardNameRegexCore + `)`)
 )
 
 func (s *nodes) Nodes() http.Handler {
@@ -51,6 +54,16 @@ func (s *nodes) nodesHandler() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		path := r.URL.Path
 		switch {
+		// needs to be matched first, as a tenant could be named "status"
+		case regxTenantUsage.MatchString(path):
+			if r.Method != http.MethodGet {
+				msg := fmt.Sprintf("/nodes api path %q not found", path)
+				http.Error(w, msg, http.StatusMethodNotAllowed)
+				return
+			}
+
+			s.incomingTenantUsage().ServeHTTP(w, r)
+			return
 		case regxNodes.MatchString(path) || regxNodesClass.MatchString(path):
 			if r.Method != http.MethodGet {
 				msg := fmt.Sprintf("/nodes api path %q not found", path)
@@ -140,3 +153,36 @@ func (s *nodes) incomingStatistics() http.Handler {
 		w.Write(statisticsBytes)
 	})
 }
+
+func (s *nodes) incomingTenantUsage() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+
+		args := regxTenantUsage.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+		className, tenant := args[1], args[2]
+
+		usage, err := s.nodesManager.GetTenantUsage(r.Context(), className, tenant)
+		if err != nil {
+			http.Error(w, "/nodes fulfill request: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		if usage == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		usageBytes, err := json.Marshal(usage)
+		if err != nil {
+			http.Error(w, "/nodes marshal response: "+err.Error(),
+				http.StatusInternalServerError)
+		}
+
+		w.Write(usageBytes)
+	})
+}
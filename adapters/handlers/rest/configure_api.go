@@ -117,6 +117,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/quota"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
@@ -143,6 +144,7 @@ type vectorRepo interface {
 	traverser.VectorSearcher
 	classification.VectorRepo
 	scaler.BackUpper
+	scaler.LocalReplicas
 	SetSchemaGetter(schemaUC.SchemaGetter)
 	WaitForStartup(ctx context.Context) error
 	Shutdown(ctx context.Context) error
@@ -315,6 +317,14 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	remoteIndexClient := clients.NewRemoteIndex(appState.ClusterHttpClient)
 	remoteNodesClient := clients.NewRemoteNode(appState.ClusterHttpClient)
 	replicationClient := clients.NewReplicationClient(appState.ClusterHttpClient)
+
+	var logArchiver db.LogArchiver
+	if cfg := appState.ServerConfig.Config.BackupWALArchive; cfg.Enabled() {
+		appState.LogArchiver = backup.NewLogArchiver(appState.Cluster.LocalName(),
+			appState.ServerConfig.Config.Persistence.DataPath, cfg, appState.Logger)
+		logArchiver = appState.LogArchiver
+	}
+
 	repo, err := db.New(appState.Logger, db.Config{
 		ServerVersion:                  config.ServerVersion,
 		GitHash:                        build.Revision,
@@ -327,6 +337,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		SeparateObjectsCompactions:     appState.ServerConfig.Config.Persistence.LSMSeparateObjectsCompactions,
 		MaxSegmentSize:                 appState.ServerConfig.Config.Persistence.LSMMaxSegmentSize,
 		HNSWMaxLogSize:                 appState.ServerConfig.Config.Persistence.HNSWMaxLogSize,
+		HNSWSnapshotIntervalSeconds:    appState.ServerConfig.Config.Persistence.HNSWSnapshotIntervalSeconds,
 		HNSWWaitForCachePrefill:        appState.ServerConfig.Config.HNSWStartupWaitForVectorCache,
 		HNSWFlatSearchConcurrency:      appState.ServerConfig.Config.HNSWFlatSearchConcurrency,
 		VisitedListPoolMaxSize:         appState.ServerConfig.Config.HNSWVisitedListPoolMaxSize,
@@ -347,6 +358,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		// the required minimum to only apply to newly created classes - not block
 		// loading existing ones.
 		Replication: replication.GlobalConfig{MinimumFactor: 1},
+		LogArchiver: logArchiver,
 	}, remoteIndexClient, appState.Cluster, remoteNodesClient, replicationClient, appState.Metrics, appState.MemWatch) // TODO client
 	if err != nil {
 		appState.Logger.
@@ -355,6 +367,8 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	}
 
 	appState.DB = repo
+	// hand off writes missed by replicas as soon as they rejoin
+	appState.Cluster.OnJoin(repo.NodeJoined)
 	if appState.ServerConfig.Config.Monitoring.Enabled {
 		appState.TenantActivity.SetSource(appState.DB)
 	}
@@ -393,9 +407,10 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		appState.Cluster, localClassifierRepo, appState.Logger)
 	appState.ClassificationRepo = classifierRepo
 
-	scaler := scaler.New(appState.Cluster, vectorRepo,
+	scaler := scaler.New(appState.Cluster, vectorRepo, vectorRepo,
 		remoteIndexClient, appState.Logger, appState.ServerConfig.Config.Persistence.DataPath)
 	appState.Scaler = scaler
+	repo.SetScaleOperations(scaler)
 
 	// let classTenantDataEvents be nil if the metadata server is not enabled since the metadata
 	// server/querierManager are the users of the channel
@@ -518,6 +533,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	)
 
 	offloadmod, _ := appState.Modules.OffloadBackend("offload-s3")
+	appState.TenantQuotas = quota.NewEnforcer(appState.ClusterService.SchemaReader(), repo)
 	schemaManager, err := schemaUC.NewManager(migrator,
 		appState.ClusterService.Raft,
 		appState.ClusterService.SchemaReader(),
@@ -525,7 +541,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		appState.Logger, appState.Authorizer, appState.ServerConfig.Config,
 		vectorIndex.ParseAndValidateConfig, appState.Modules, inverted.ValidateConfig,
 		appState.Modules, appState.Cluster, scaler,
-		offloadmod,
+		offloadmod, appState.TenantQuotas,
 	)
 	if err != nil {
 		appState.Logger.
@@ -539,10 +555,20 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	appState.RemoteNodeIncoming = sharding.NewRemoteNodeIncoming(repo)
 	appState.RemoteReplicaIncoming = replica.NewRemoteReplicaIncoming(repo, appState.ClusterService.SchemaReader())
 
+	appState.BackupBackends = configureBackupBackends(appState)
 	backupManager := backup.NewHandler(appState.Logger, appState.Authorizer,
-		schemaManager, repo, appState.Modules)
+		schemaManager, repo, appState.BackupBackends)
 	appState.BackupManager = backupManager
 
+	if appState.LogArchiver != nil {
+		backupManager.SetLogArchiver(appState.LogArchiver)
+		if err := appState.LogArchiver.Start(appState.BackupBackends); err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not start commit log archiving")
+		}
+	}
+
 	enterrors.GoWrapper(func() { clusterapi.Serve(appState) }, appState.Logger)
 
 	vectorRepo.SetSchemaGetter(schemaManager)
@@ -552,7 +578,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	appState.Traverser = traverser.NewTraverser(appState.ServerConfig, appState.Locks,
 		appState.Logger, appState.Authorizer, vectorRepo, explorer, schemaManager,
 		appState.Modules, traverser.NewMetrics(appState.Metrics),
-		appState.ServerConfig.Config.MaximumConcurrentGetRequests)
+		appState.ServerConfig.Config.MaximumConcurrentGetRequests, appState.TenantQuotas)
 
 	updateSchemaCallback := makeUpdateSchemaCall(appState)
 	executor.RegisterSchemaUpdateCallback(updateSchemaCallback)
@@ -583,9 +609,13 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	// this sleep was used to block GraphQL and give time to RAFT to start.
 	time.Sleep(2 * time.Second)
 
+	appState.Classifier = classification.New(schemaManager, appState.ClassificationRepo, appState.DB, // the DB is the vectorrepo
+		appState.Authorizer,
+		appState.Logger, appState.Modules)
+
 	batchManager := objects.NewBatchManager(vectorRepo, appState.Modules,
 		appState.Locks, schemaManager, appState.ServerConfig, appState.Logger,
-		appState.Authorizer, appState.Metrics)
+		appState.Authorizer, appState.Metrics, appState.TenantQuotas, appState.Classifier)
 	appState.BatchManager = batchManager
 
 	err = migrator.AdjustFilterablePropSettings(ctx)
@@ -684,20 +714,23 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.ServerConfig.Config.Authentication,
 		appState.APIKey, appState.OIDC)
 
+	api.APIAuthorizer = makeAuditAPIAuthorizer(api.APIAuthorizer)
+
 	api.Logger = func(msg string, args ...interface{}) {
 		appState.Logger.WithFields(logrus.Fields{"action": "restapi_management", "version": build.Version}).Infof(msg, args...)
 	}
 
-	classifier := classification.New(appState.SchemaManager, appState.ClassificationRepo, appState.DB, // the DB is the vectorrepo
-		appState.Authorizer,
-		appState.Logger, appState.Modules)
+	classifier := appState.Classifier
+	enterrors.GoWrapper(func() {
+		classifier.RunContinuousClassifications(context.Background())
+	}, appState.Logger)
 
 	setupAuthZHandlers(api, appState.Metrics, appState.Authorizer, appState.Logger)
 	setupSchemaHandlers(api, appState.SchemaManager, appState.Metrics, appState.Logger)
 	objectsManager := objects.NewManager(appState.Locks,
 		appState.SchemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, appState.DB, appState.Modules,
-		objects.NewMetrics(appState.Metrics), appState.MemWatch)
+		objects.NewMetrics(appState.Metrics), appState.MemWatch, appState.TenantQuotas, classifier)
 	setupObjectHandlers(api, objectsManager, appState.ServerConfig.Config, appState.Logger,
 		appState.Modules, appState.Metrics)
 	setupObjectBatchHandlers(api, appState.BatchManager, appState.Metrics, appState.Logger)
@@ -707,7 +740,8 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Metrics, appState.Logger)
 	setupClassificationHandlers(api, classifier, appState.Metrics, appState.Logger)
 	backupScheduler := startBackupScheduler(appState)
-	setupBackupHandlers(api, backupScheduler, appState.Metrics, appState.Logger)
+	backupSchedules := startBackupSchedules(appState, backupScheduler)
+	setupBackupHandlers(api, backupScheduler, backupSchedules, appState.Metrics, appState.Logger)
 	setupNodesHandlers(api, appState.SchemaManager, appState.DB, appState)
 
 	grpcServer := createGrpcServer(appState)
@@ -744,6 +778,13 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		// gracefully stop gRPC server
 		grpcServer.GracefulStop()
 
+		if err := appState.AuditLog.Close(); err != nil {
+			appState.Logger.WithField("action", "stop_audit_log").
+				Errorf("failed to close audit log: %s", err.Error())
+		}
+
+		backupSchedules.Close()
+
 		if appState.ServerConfig.Config.Sentry.Enabled {
 			sentry.Flush(2 * time.Second)
 		}
@@ -751,6 +792,13 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
 
+		if appState.LogArchiver != nil {
+			if err := appState.LogArchiver.Close(ctx); err != nil {
+				appState.Logger.WithField("action", "stop_wal_archive").
+					Errorf("failed to archive commit logs: %s", err.Error())
+			}
+		}
+
 		if err := appState.ClusterService.Close(ctx); err != nil {
 			panic(err)
 		}
@@ -765,13 +813,26 @@ func startBackupScheduler(appState *state.State) *backup.Scheduler {
 	backupScheduler := backup.NewScheduler(
 		appState.Authorizer,
 		clients.NewClusterBackups(appState.ClusterHttpClient),
-		appState.DB, appState.Modules,
+		appState.DB, appState.BackupBackends,
 		membership{appState.Cluster, appState.ClusterService},
 		appState.SchemaManager,
 		appState.Logger)
 	return backupScheduler
 }
 
+// startBackupSchedules runs the backup schedules stored in the cluster,
+// backups are only created and deleted while this node is the leader
+func startBackupSchedules(appState *state.State, scheduler *backup.Scheduler) *backup.ScheduleManager {
+	schedules := backup.NewScheduleManager(
+		appState.Authorizer,
+		scheduler,
+		appState.ClusterService,
+		appState.ClusterService.SchemaReader(),
+		appState.Logger)
+	schedules.Start()
+	return schedules
+}
+
 // TODO: Split up and don't write into global variables. Instead return an appState
 func startupRoutine(ctx context.Context, options *swag.CommandLineOptionsGroup) *state.State {
 	appState := &state.State{}
@@ -826,6 +887,7 @@ func startupRoutine(ctx context.Context, options *swag.CommandLineOptionsGroup)
 	appState.OIDC = configureOIDC(appState)
 	appState.APIKey = configureAPIKey(appState)
 	appState.AnonymousAccess = configureAnonymousAccess(appState)
+	appState.AuditLog = configureAuditLog(appState)
 	appState.Authorizer = configureAuthorizer(appState)
 
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
@@ -22,10 +22,12 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/utils"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/oidc"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/traverser"
@@ -106,8 +108,33 @@ func configureAnonymousAccess(appState *state.State) *anonymous.Client {
 	return anonymous.New(appState.ServerConfig.Config)
 }
 
+// configureAuditLog returns nil if auditing is disabled, the audit logger
+// and everything wrapping it treat that as a no-op
+func configureAuditLog(appState *state.State) *audit.Logger {
+	l, err := audit.New(appState.ServerConfig.Config.Audit, appState.Logger)
+	if err != nil {
+		appState.Logger.WithField("action", "audit_init").WithError(err).Fatal("audit log could not start up")
+		os.Exit(1)
+	}
+
+	return l
+}
+
+// configureBackupBackends wraps the backup modules so that backups are
+// encrypted client-side if backup encryption is enabled
+func configureBackupBackends(appState *state.State) backup.BackupBackendProvider {
+	keys, err := backup.NewKeyProvider(appState.ServerConfig.Config.BackupEncryption)
+	if err != nil {
+		appState.Logger.WithField("action", "backup_encryption_init").WithError(err).Fatal("backup encryption could not start up")
+		os.Exit(1)
+	}
+
+	return backup.NewEncryptedBackends(appState.Modules, keys,
+		appState.ServerConfig.Config.BackupEncryption.AllowUnencrypted)
+}
+
 func configureAuthorizer(appState *state.State) authorization.Authorizer {
-	return authorization.New(appState.ServerConfig.Config)
+	return audit.NewAuthorizer(authorization.New(appState.ServerConfig.Config), appState.AuditLog)
 }
 
 func timeTillDeadline(ctx context.Context) string {
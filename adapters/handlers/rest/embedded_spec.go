@@ -150,6 +150,260 @@ func init() {
         }
       }
     },
+    "/aliases": {
+      "get": {
+        "description": "Get all aliases. Optionally only the aliases pointing to a specific collection are returned.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "List aliases.",
+        "operationId": "aliases.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only return the aliases pointing to this class.",
+            "name": "class",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved the aliases.",
+            "schema": {
+              "$ref": "#/definitions/AliasResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "description": "Create an alias pointing to an existing collection. Requests addressing the alias are served by the collection it points to. An alias cannot have the name of an existing collection or alias.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create an alias for a class.",
+        "operationId": "aliases.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully created the alias.",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid alias. For example the name is already used by a class or another alias.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/aliases/{aliasName}": {
+      "delete": {
+        "description": "Remove an alias. The collection it points to is not affected.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Delete an alias.",
+        "operationId": "aliases.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the alias.",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully deleted the alias."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Alias or class not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "get": {
+        "description": "Get the collection an alias points to.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get an alias.",
+        "operationId": "aliases.get.alias",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the alias.",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved the alias.",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Alias or class not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "put": {
+        "description": "Atomically point an existing alias to another collection. Every request addressing the alias is served either by the old or by the new collection. Only the ` + "`" + `class` + "`" + ` of the body is used.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Point an alias to another class.",
+        "operationId": "aliases.update",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the alias.",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully updated the alias.",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Alias or class not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid alias. For example the name is already used by a class or another alias.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
     "/authz/roles": {
       "get": {
         "tags": [
@@ -687,28 +941,19 @@ func init() {
         ]
       }
     },
-    "/backups/{backend}": {
+    "/backups/schedules": {
       "get": {
-        "description": "[Coming soon] List all backups in progress not implemented yet.",
+        "description": "Returns all backup schedules, the backups they created and when they run next.",
         "tags": [
           "backups"
         ],
-        "summary": "List backups in progress",
-        "operationId": "backups.list",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          }
-        ],
+        "summary": "List backup schedules",
+        "operationId": "backups.schedules.list",
         "responses": {
           "200": {
-            "description": "Existed backups",
+            "description": "Backup schedules successfully returned.",
             "schema": {
-              "$ref": "#/definitions/BackupListResponse"
+              "$ref": "#/definitions/BackupScheduleListResponse"
             }
           },
           "401": {
@@ -720,12 +965,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid backup list.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -738,34 +977,27 @@ func init() {
         ]
       },
       "post": {
-        "description": "Start creating a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eNotes: \u003cbr/\u003e- Weaviate uses gzip compression by default. \u003cbr/\u003e- Weaviate stays usable while a backup process is ongoing.",
+        "description": "Create a schedule which periodically creates backups of a set of collections. \u003cbr/\u003e\u003cbr/\u003eSchedules are stored in the cluster and executed by the current leader. Backups which are no longer kept by the retention rules of the schedule are deleted from the backend.",
         "tags": [
           "backups"
         ],
-        "summary": "Start a backup process",
-        "operationId": "backups.create",
+        "summary": "Create a backup schedule",
+        "operationId": "backups.schedules.create",
         "parameters": [
-          {
-            "type": "string",
-            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BackupCreateRequest"
+              "$ref": "#/definitions/BackupSchedule"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup create process successfully started.",
+            "description": "Backup schedule successfully created.",
             "schema": {
-              "$ref": "#/definitions/BackupCreateResponse"
+              "$ref": "#/definitions/BackupSchedule"
             }
           },
           "401": {
@@ -778,7 +1010,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid backup creation attempt.",
+            "description": "Invalid backup schedule or a schedule with this name already exists.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -795,22 +1027,290 @@ func init() {
         ]
       }
     },
-    "/backups/{backend}/{id}": {
+    "/backups/schedules/{name}": {
       "get": {
-        "description": "Returns status of backup creation attempt for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using this endpoint.",
+        "description": "Returns a backup schedule, the backups it created and when it runs next.",
         "tags": [
           "backups"
         ],
-        "summary": "Get backup process status",
-        "operationId": "backups.create.status",
+        "summary": "Get a backup schedule",
+        "operationId": "backups.schedules.get",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
+            "description": "The name of the backup schedule.",
+            "name": "name",
             "in": "path",
             "required": true
-          },
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup schedule successfully returned.",
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup schedule does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "put": {
+        "description": "Replace the definition of a backup schedule. The new retention rules also apply to the backups the schedule created before.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Update a backup schedule",
+        "operationId": "backups.schedules.update",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the backup schedule.",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup schedule successfully updated.",
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup schedule does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup schedule.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "delete": {
+        "description": "Delete a backup schedule. The backups it created are kept in the backend.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Delete a backup schedule",
+        "operationId": "backups.schedules.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the backup schedule.",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Backup schedule successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup schedule does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/{backend}": {
+      "get": {
+        "description": "[Coming soon] List all backups in progress not implemented yet.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "List backups in progress",
+        "operationId": "backups.list",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Existed backups",
+            "schema": {
+              "$ref": "#/definitions/BackupListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup list.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "post": {
+        "description": "Start creating a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eNotes: \u003cbr/\u003e- Weaviate uses gzip compression by default. \u003cbr/\u003e- Weaviate stays usable while a backup process is ongoing.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Start a backup process",
+        "operationId": "backups.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup create process successfully started.",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup creation attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/{backend}/{id}": {
+      "get": {
+        "description": "Returns status of backup creation attempt for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using this endpoint.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Get backup process status",
+        "operationId": "backups.create.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
@@ -1119,6 +1619,15 @@ func init() {
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyTokenParameterHeader"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, each object is only written if its lastUpdateTimeUnix matches the stored object. Objects with lastUpdateTimeUnix unset or 0 are only written if they do not exist yet.",
+            "name": "conditional",
+            "in": "query"
           }
         ],
         "responses": {
@@ -1129,6 +1638,12 @@ func init() {
               "items": {
                 "$ref": "#/definitions/ObjectsGetResponse"
               }
+            },
+            "headers": {
+              "X-Weaviate-Consistency-Token": {
+                "type": "string",
+                "description": "Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes."
+              }
             }
           },
           "400": {
@@ -1283,7 +1798,200 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
+        "parameters": [
+          {
+            "description": "parameters to start a classification",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Successfully started classification.",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.post"
+        ]
+      }
+    },
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "classification id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the classification, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Classification does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.get"
+        ]
+      }
+    },
+    "/cluster/raft": {
+      "get": {
+        "description": "Returns the leader, term, log indexes, the members of the latest Raft configuration and the latest snapshot as seen by the node serving the request.",
+        "tags": [
+          "cluster"
+        ],
+        "summary": "See the Raft state of the cluster",
+        "operationId": "cluster.get.raft",
+        "responses": {
+          "200": {
+            "description": "Raft state successfully returned",
+            "schema": {
+              "$ref": "#/definitions/RaftStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.cluster.raft.get"
+        ]
+      }
+    },
+    "/cluster/raft/peers/{id}": {
+      "delete": {
+        "description": "Removes a peer, typically a node that is permanently gone, from the Raft configuration. The request is forwarded to the leader. The current leader can not be removed, and neither can voters whose removal would leave too few voters for a quorum.",
+        "tags": [
+          "cluster"
+        ],
+        "summary": "Remove a peer from the Raft configuration",
+        "operationId": "cluster.raft.remove.peer",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of the peer, which is the name of the node.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Peer successfully removed"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The peer is not part of the Raft configuration.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The peer can not be removed, e.g. because it is the current leader or the voters left would be too few for a quorum.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1295,50 +2003,49 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.cluster.raft.manipulate"
         ]
       }
     },
-    "/classifications/": {
+    "/cluster/raft/peers/{id}/promote": {
       "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "description": "Turns a non-voting member of the Raft configuration into a voter. The request is forwarded to the leader.",
         "tags": [
-          "classifications"
+          "cluster"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "summary": "Promote a non-voter to a voter",
+        "operationId": "cluster.raft.promote.peer",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
+            "type": "string",
+            "description": "The ID of the peer, which is the name of the node.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
+          "204": {
+            "description": "Peer successfully promoted"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "400": {
-            "description": "Incorrect request",
+          "404": {
+            "description": "The peer is not part of the Raft configuration.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
+          "422": {
+            "description": "The peer can not be promoted, e.g. because it already is a voter.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1351,32 +2058,23 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.cluster.raft.manipulate"
         ]
       }
     },
-    "/classifications/{id}": {
-      "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+    "/cluster/raft/snapshot": {
+      "post": {
+        "description": "Forces the node serving the request to take a Raft snapshot of its state, which also truncates its log. Returns the latest snapshot if nothing new was applied since the last one.",
         "tags": [
-          "classifications"
-        ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "classification id",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
+          "cluster"
         ],
+        "summary": "Force a Raft snapshot",
+        "operationId": "cluster.raft.snapshot",
         "responses": {
           "200": {
-            "description": "Found the classification, returned as body",
+            "description": "Snapshot successfully taken",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/RaftSnapshot"
             }
           },
           "401": {
@@ -1388,9 +2086,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Classification does not exist"
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1399,7 +2094,7 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.get"
+          "weaviate.cluster.raft.manipulate"
         ]
       }
     },
@@ -1807,6 +2502,9 @@ func init() {
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyTokenParameterHeader"
           }
         ],
         "responses": {
@@ -1814,6 +2512,12 @@ func init() {
             "description": "Object created.",
             "schema": {
               "$ref": "#/definitions/Object"
+            },
+            "headers": {
+              "X-Weaviate-Consistency-Token": {
+                "type": "string",
+                "description": "Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes."
+              }
             }
           },
           "400": {
@@ -1931,6 +2635,9 @@ func init() {
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
+          {
+            "$ref": "#/parameters/CommonConsistencyTokenParameterHeader"
+          },
           {
             "$ref": "#/parameters/CommonNodeNameParameterQuery"
           },
@@ -2014,6 +2721,12 @@ func init() {
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyTokenParameterHeader"
+          },
+          {
+            "$ref": "#/parameters/CommonIfMatchParameterHeader"
           }
         ],
         "responses": {
@@ -2021,6 +2734,12 @@ func init() {
             "description": "Successfully received.",
             "schema": {
               "$ref": "#/definitions/Object"
+            },
+            "headers": {
+              "X-Weaviate-Consistency-Token": {
+                "type": "string",
+                "description": "Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes."
+              }
             }
           },
           "401": {
@@ -2035,6 +2754,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The precondition given in the If-Match header is not met, the object has been modified or deleted in the meantime.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -2079,13 +2804,25 @@ func init() {
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
+          {
+            "$ref": "#/parameters/CommonConsistencyTokenParameterHeader"
+          },
+          {
+            "$ref": "#/parameters/CommonIfMatchParameterHeader"
+          },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "204": {
-            "description": "Successfully deleted."
+            "description": "Successfully deleted.",
+            "headers": {
+              "X-Weaviate-Consistency-Token": {
+                "type": "string",
+                "description": "Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes."
+              }
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -2105,6 +2842,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The precondition given in the If-Match header is not met, the object has been modified or deleted in the meantime.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
@@ -2150,6 +2893,9 @@ func init() {
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
+          {
+            "$ref": "#/parameters/CommonConsistencyTokenParameterHeader"
+          },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
           }
@@ -2222,11 +2968,23 @@ func init() {
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyTokenParameterHeader"
+          },
+          {
+            "$ref": "#/parameters/CommonIfMatchParameterHeader"
           }
         ],
         "responses": {
           "204": {
-            "description": "Successfully applied. No content provided."
+            "description": "Successfully applied. No content provided.",
+            "headers": {
+              "X-Weaviate-Consistency-Token": {
+                "type": "string",
+                "description": "Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes."
+              }
+            }
           },
           "400": {
             "description": "The patch-JSON is malformed.",
@@ -2246,6 +3004,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The precondition given in the If-Match header is not met, the object has been modified or deleted in the meantime.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "The patch-JSON is valid but unprocessable.",
             "schema": {
@@ -3108,7 +3872,173 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid Object class",
+            "description": "Invalid Object class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.add.meta"
+        ]
+      }
+    },
+    "/schema/diff": {
+      "get": {
+        "description": "Describes how the class definitions differ between two schema versions, e.g. to find out when a vector index setting was changed.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Compare the schema at two versions.",
+        "operationId": "schema.diff",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version to compare from. Defaults to the oldest version in the schema history.",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version to compare to. Defaults to the latest version in the schema history.",
+            "name": "to",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The differences between both versions.",
+            "schema": {
+              "$ref": "#/definitions/SchemaDiff"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The schema history does not reach back to one of the versions.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid versions, e.g. from is newer than to.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema/history": {
+      "get": {
+        "description": "Returns who changed which class definition how and when, in the order the changes were applied. The schema version of a change is its index in the raft log.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the history of schema changes.",
+        "operationId": "schema.history",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only return the changes of this class.",
+            "name": "class",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The recorded schema changes.",
+            "schema": {
+              "$ref": "#/definitions/SchemaHistoryResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema/history/{version}": {
+      "get": {
+        "description": "Returns all class definitions as they were right after the schema change with the given version had been applied.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Export the schema as of a version.",
+        "operationId": "schema.history.export",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The schema version to export the schema at.",
+            "name": "version",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The schema as of the version.",
+            "schema": {
+              "$ref": "#/definitions/Schema"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The schema history does not reach back to this version.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid version.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3121,7 +4051,7 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.local.query.meta"
         ]
       }
     },
@@ -3198,6 +4128,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Class"
             }
+          },
+          {
+            "type": "boolean",
+            "description": "If true, changes of the vector index config which can only be applied by rebuilding the vector index, such as a different index type, distance or compression, are applied by rebuilding it in the background. Such changes are rejected otherwise.",
+            "name": "rebuildVectorIndex",
+            "in": "query"
           }
         ],
         "responses": {
@@ -3342,6 +4278,65 @@ func init() {
         ]
       }
     },
+    "/schema/{className}/properties/{propertyName}": {
+      "delete": {
+        "description": "Remove a property from a collection. The property is removed from the schema right away. Its indexes and the values stored on objects are cleaned up in the background.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Remove a property from an Object class.",
+        "operationId": "schema.objects.properties.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the property from the Object class."
+          },
+          "400": {
+            "description": "Could not delete the property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class or property not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
     "/schema/{className}/shards": {
       "get": {
         "description": "Get the status of every shard in the cluster.",
@@ -3814,6 +4809,62 @@ func init() {
           }
         }
       }
+    },
+    "/schema/{className}/tenants/{tenantName}/usage": {
+      "get": {
+        "description": "Returns the resource usage (objects, vectors, bytes on disk and queries) of a tenant together with its effective quotas",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the resource usage of a tenant",
+        "operationId": "tenants.usage",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenantName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The resource usage of the tenant",
+            "schema": {
+              "$ref": "#/definitions/TenantUsage"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Tenant not found"
+          },
+          "422": {
+            "description": "Invalid tenant or class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
     }
   },
   "definitions": {
@@ -3824,6 +4875,33 @@ func init() {
         "type": "object"
       }
     },
+    "Alias": {
+      "description": "An alternative name of a class. Requests addressing the alias are served by the class it points to.",
+      "type": "object",
+      "properties": {
+        "alias": {
+          "description": "The name of the alias.",
+          "type": "string"
+        },
+        "class": {
+          "description": "The name of the class the alias points to.",
+          "type": "string"
+        }
+      }
+    },
+    "AliasResponse": {
+      "description": "List of aliases.",
+      "type": "object",
+      "properties": {
+        "aliases": {
+          "description": "The aliases.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Alias"
+          }
+        }
+      }
+    },
     "BM25Config": {
       "description": "tuning parameters for the BM25 algorithm",
       "type": "object",
@@ -4031,6 +5109,13 @@ func init() {
     "BackupRestoreRequest": {
       "description": "Request body for restoring a backup for a set of classes",
       "properties": {
+        "class_mapping": {
+          "description": "Allows restoring classes under a different name, mapping the class name stored in the backup to the new name. Cross-references between restored classes are rewritten accordingly.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
         "config": {
           "description": "Custom configuration for the backup restoration process",
           "type": "object",
@@ -4056,6 +5141,19 @@ func init() {
           "additionalProperties": {
             "type": "string"
           }
+        },
+        "point_in_time": {
+          "description": "Replays the commit logs archived after the backup up to this time. Logs are archived in segments, writes are restored up to the last segment archived before this time. Requires commit log archiving to be enabled on all nodes.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "tenants": {
+          "description": "List of tenants to restore. Only applies to multi-tenant classes, all tenants are restored if empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
@@ -4077,52 +5175,203 @@ func init() {
           "description": "error message if restoration failed",
           "type": "string"
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "BackupRestoreStatusResponse": {
+      "description": "The definition of a backup restore metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backup backend, contains bucket and path",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "BackupSchedule": {
+      "description": "A schedule which periodically creates backups of a set of collections",
+      "required": [
+        "name",
+        "cron",
+        "backend"
+      ],
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+          "type": "string"
+        },
+        "bucket": {
+          "description": "Name of the bucket, container, volume, etc",
+          "type": "string"
+        },
+        "cron": {
+          "description": "A cron expression with 5 fields (minute, hour, day of month, month, day of week) evaluated in UTC, or one of ` + "`" + `@yearly` + "`" + `, ` + "`" + `@monthly` + "`" + `, ` + "`" + `@weekly` + "`" + `, ` + "`" + `@daily` + "`" + `, ` + "`" + `@hourly` + "`" + `.",
+          "type": "string"
+        },
+        "exclude": {
+          "description": "List of collections to exclude from the backups. Cannot be used together with ` + "`" + `include` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "include": {
+          "description": "List of collections to include in the backups. Cannot be used together with ` + "`" + `exclude` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "The name of the schedule, it is used as prefix of the backup IDs. Only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "nextRun": {
+          "description": "When the schedule creates its next backup. Empty if the schedule is paused.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
+        "path": {
+          "description": "The path within the bucket",
+          "type": "string"
+        },
+        "paused": {
+          "description": "Paused schedules don't create backups. The retention rules still apply.",
+          "type": "boolean"
+        },
+        "retention": {
+          "$ref": "#/definitions/BackupScheduleRetention"
+        },
+        "runs": {
+          "description": "The backups created by the schedule, oldest first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BackupScheduleRun"
+          },
+          "readOnly": true
+        },
+        "updatedAt": {
+          "description": "When the schedule was created or last updated.",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        }
+      }
+    },
+    "BackupScheduleListResponse": {
+      "description": "The list of backup schedules",
+      "properties": {
+        "schedules": {
+          "description": "The backup schedules ordered by name.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BackupSchedule"
+          }
+        }
+      }
+    },
+    "BackupScheduleRetention": {
+      "description": "Rules deciding which backups of a schedule are kept. A successful backup is kept if any rule selects it. All backups are kept if no rule is set.",
+      "properties": {
+        "keepDaily": {
+          "description": "Keep the most recent backup of each of the last days on which a backup was created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "keepLast": {
+          "description": "Keep the most recent backups.",
+          "type": "integer",
+          "format": "int64"
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
+        "keepMonthly": {
+          "description": "Keep the most recent backup of each of the last months in which a backup was created.",
+          "type": "integer",
+          "format": "int64"
         },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED",
-            "CANCELED"
-          ]
+        "keepWeekly": {
+          "description": "Keep the most recent backup of each of the last ISO weeks in which a backup was created.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "BackupRestoreStatusResponse": {
-      "description": "The definition of a backup restore metadata",
+    "BackupScheduleRun": {
+      "description": "A backup created by a schedule",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
+        "completedAt": {
+          "description": "When the backup completed.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "deletedAt": {
+          "description": "When the backup was deleted by the retention rules, empty if it still exists.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
         },
         "error": {
-          "description": "error message if restoration failed",
+          "description": "error message if creation failed",
           "type": "string"
         },
         "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "description": "The ID of the backup.",
           "type": "string"
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backup backend, contains bucket and path",
-          "type": "string"
+        "startedAt": {
+          "description": "When the backup was started.",
+          "type": "string",
+          "format": "date-time"
         },
         "status": {
-          "description": "phase of backup restoration process",
+          "description": "phase of the backup creation process",
           "type": "string",
-          "default": "STARTED",
           "enum": [
             "STARTED",
             "TRANSFERRING",
@@ -4491,6 +5740,14 @@ func init() {
           "description": "Manage how the index should be sharded and distributed in the cluster",
           "type": "object"
         },
+        "uniqueKey": {
+          "description": "Names of the properties whose combined values identify an object within the collection (a.k.a. 'natural key'). Batch imports resolve an existing object by these values and update it instead of creating a duplicate. Objects imported without an id get an id derived from the key. Text properties of the key must use the tokenization 'field'. Immutable after the collection was created.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
         "vectorConfig": {
           "description": "Configure named vectors. Either use this field or ` + "`" + `vectorizer` + "`" + `, ` + "`" + `vectorIndexType` + "`" + `, and ` + "`" + `vectorIndexConfig` + "`" + ` fields. Available from ` + "`" + `v1.24.0` + "`" + `.",
           "type": "object",
@@ -4541,6 +5798,11 @@ func init() {
             "inCountry"
           ]
         },
+        "continuous": {
+          "description": "keep classifying objects of the class which are added or updated after the initial run completed. Only one continuous classification can be active per class, scheduling another one replaces it.",
+          "type": "boolean",
+          "default": false
+        },
         "error": {
           "description": "error message if status == failed",
           "type": "string",
@@ -4912,6 +6174,10 @@ func init() {
           "description": "Whether or not multi-tenancy is enabled for this class (default: false).",
           "type": "boolean",
           "x-omitempty": false
+        },
+        "tenantQuotas": {
+          "description": "Default resource limits for every tenant of this class. Quotas set on a tenant take precedence.",
+          "$ref": "#/definitions/TenantQuotas"
         }
       }
     },
@@ -4985,6 +6251,13 @@ func init() {
           "format": "boolean",
           "x-omitempty": false
         },
+        "failedVectorIndexRebuilds": {
+          "description": "The target vectors whose vector index rebuild failed. The rebuild is retried once the shard is loaded again.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "loaded": {
           "description": "The load status of the shard.",
           "type": "boolean",
@@ -5001,6 +6274,32 @@ func init() {
           "format": "int64",
           "x-omitempty": false
         },
+        "rebuildingVectorIndexes": {
+          "description": "The target vectors whose vector index is being rebuilt in the background. The legacy vector is listed as an empty string.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "rebuiltVectors": {
+          "description": "The number of vectors added by the ongoing rebuilds of vector indexes.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "reindexedObjects": {
+          "description": "The number of objects processed by the ongoing reindexing of properties.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "reindexingProperties": {
+          "description": "The properties whose tokenization or inverted index settings are being applied in the background.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "vectorIndexingStatus": {
           "description": "The status of the vector indexing process.",
           "format": "string",
@@ -5047,6 +6346,13 @@ func init() {
           "description": "The name of the node.",
           "type": "string"
         },
+        "replicationOperations": {
+          "description": "Replication factor changes coordinated by the node.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReplicationScaleOperation"
+          }
+        },
         "shards": {
           "description": "The list of the shards with it's statistics.",
           "type": "array",
@@ -5471,6 +6777,56 @@ func init() {
       "description": "Names and values of an individual property. A returned response may also contain additional metadata, such as from classification or feature projection.",
       "type": "object"
     },
+    "RaftPeer": {
+      "description": "A member of the raft configuration.",
+      "properties": {
+        "address": {
+          "description": "The raft address of the peer.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the peer, which is the name of the node.",
+          "type": "string"
+        },
+        "leader": {
+          "description": "Whether the peer is the current leader.",
+          "type": "boolean"
+        },
+        "suffrage": {
+          "description": "Whether the peer takes part in elections and commits.",
+          "type": "string",
+          "enum": [
+            "VOTER",
+            "NONVOTER",
+            "STAGING"
+          ]
+        }
+      }
+    },
+    "RaftSnapshot": {
+      "description": "Metadata of a raft snapshot.",
+      "properties": {
+        "id": {
+          "description": "The ID of the snapshot.",
+          "type": "string"
+        },
+        "index": {
+          "description": "The index of the last log entry included in the snapshot.",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "size": {
+          "description": "The size of the snapshot in bytes.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "term": {
+          "description": "The term of the last log entry included in the snapshot.",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
     "RaftStatistics": {
       "description": "The definition of Raft statistics.",
       "properties": {
@@ -5531,6 +6887,58 @@ func init() {
         }
       }
     },
+    "RaftStatus": {
+      "description": "The raft state of the cluster as seen by the node serving the request.",
+      "properties": {
+        "appliedIndex": {
+          "description": "The index of the last log entry applied to the schema.",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "commitIndex": {
+          "description": "The index of the last committed log entry.",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "lastIndex": {
+          "description": "The index of the last log entry.",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "leaderAddress": {
+          "description": "The raft address of the current leader, empty if the leader is unknown.",
+          "type": "string"
+        },
+        "leaderId": {
+          "description": "The ID of the current leader, empty if the leader is unknown.",
+          "type": "string"
+        },
+        "nodeId": {
+          "description": "The ID of the node serving the request.",
+          "type": "string"
+        },
+        "peers": {
+          "description": "The members of the latest raft configuration.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RaftPeer"
+          }
+        },
+        "snapshot": {
+          "description": "The latest snapshot stored on the node serving the request.",
+          "$ref": "#/definitions/RaftSnapshot"
+        },
+        "state": {
+          "description": "The raft state of the node serving the request, one of Leader, Follower, Candidate or Shutdown.",
+          "type": "string"
+        },
+        "term": {
+          "description": "The current term.",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
     "ReferenceMetaClassification": {
       "description": "This meta field contains additional info about the classified reference property",
       "properties": {
@@ -5598,6 +7006,17 @@ func init() {
           "type": "boolean",
           "x-omitempty": false
         },
+        "consistencyLevel": {
+          "description": "Default consistency level of requests which do not specify one. ` + "`" + `BOUNDED_STALENESS` + "`" + ` reads are answered by a single replica if it has been in sync within ` + "`" + `maxStalenessSeconds` + "`" + ` and fall back to ` + "`" + `QUORUM` + "`" + ` otherwise; writes use ` + "`" + `QUORUM` + "`" + `. Defaults to ` + "`" + `QUORUM` + "`" + `.",
+          "type": "string",
+          "enum": [
+            "ONE",
+            "QUORUM",
+            "ALL",
+            "BOUNDED_STALENESS"
+          ],
+          "x-omitempty": true
+        },
         "deletionStrategy": {
           "description": "Conflict resolution strategy for deleted objects.",
           "type": "string",
@@ -5610,6 +7029,62 @@ func init() {
         "factor": {
           "description": "Number of times a class is replicated (default: 1).",
           "type": "integer"
+        },
+        "maxStalenessSeconds": {
+          "description": "Maximum staleness in seconds of ` + "`" + `BOUNDED_STALENESS` + "`" + ` reads (default: 10).",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        }
+      }
+    },
+    "ReplicationScaleOperation": {
+      "description": "A change of the replication factor of a collection which runs in the background",
+      "properties": {
+        "class": {
+          "description": "The collection whose replication factor is changed.",
+          "type": "string"
+        },
+        "completedAt": {
+          "description": "When the operation completed.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "error": {
+          "description": "error message if the operation failed",
+          "type": "string"
+        },
+        "fromFactor": {
+          "description": "The replication factor before the change.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "The ID of the operation.",
+          "type": "string"
+        },
+        "startedAt": {
+          "description": "When the operation was started.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "phase of the operation",
+          "type": "string",
+          "enum": [
+            "STARTED",
+            "COPYING",
+            "SYNCING",
+            "REMOVING",
+            "SUCCESS",
+            "FAILED"
+          ]
+        },
+        "toFactor": {
+          "description": "The requested replication factor.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
@@ -5689,6 +7164,41 @@ func init() {
         }
       }
     },
+    "SchemaChange": {
+      "description": "A single change of a class definition.",
+      "properties": {
+        "class": {
+          "description": "The name of the changed class.",
+          "type": "string"
+        },
+        "timeUnix": {
+          "description": "When the change was accepted by the cluster, in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "type": {
+          "description": "The kind of change.",
+          "type": "string",
+          "enum": [
+            "ADD_CLASS",
+            "RESTORE_CLASS",
+            "UPDATE_CLASS",
+            "DELETE_CLASS",
+            "ADD_PROPERTY",
+            "DELETE_PROPERTY"
+          ]
+        },
+        "user": {
+          "description": "The user who made the change, empty if unknown or if authentication is disabled.",
+          "type": "string"
+        },
+        "version": {
+          "description": "The schema version of the change, which is the index of the change in the raft log.",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
     "SchemaClusterStatus": {
       "description": "Indicates the health of the schema in a cluster.",
       "type": "object",
@@ -5719,10 +7229,49 @@ func init() {
         }
       }
     },
+    "SchemaDiff": {
+      "description": "The differences between the schema at two versions.",
+      "properties": {
+        "differences": {
+          "description": "Human-readable descriptions of the differences, empty if the schema is identical at both versions.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "from": {
+          "description": "The version the comparison starts from.",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "to": {
+          "description": "The version the comparison ends at.",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
     "SchemaHistory": {
       "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
       "type": "object"
     },
+    "SchemaHistoryResponse": {
+      "description": "The recorded changes of class definitions.",
+      "properties": {
+        "changes": {
+          "description": "The changes in the order they were applied.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaChange"
+          }
+        },
+        "oldestVersion": {
+          "description": "The oldest version the schema can be exported or compared at. Older changes are no longer part of the history.",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
     "ShardStatus": {
       "description": "The status of a single shard",
       "properties": {
@@ -5885,9 +7434,47 @@ func init() {
             "UNFREEZING"
           ]
         },
-        "name": {
-          "description": "The name of the tenant (required).",
-          "type": "string"
+        "consistencyLevel": {
+          "description": "Default consistency level of requests to the tenant which do not specify one. Overrides the ` + "`" + `consistencyLevel` + "`" + ` of the class replication config.",
+          "type": "string",
+          "enum": [
+            "ONE",
+            "QUORUM",
+            "ALL",
+            "BOUNDED_STALENESS"
+          ]
+        },
+        "name": {
+          "description": "The name of the tenant (required).",
+          "type": "string"
+        },
+        "quotas": {
+          "description": "Resource limits of the tenant. Limits which are not set fall back to the tenant quotas of the class.",
+          "$ref": "#/definitions/TenantQuotas"
+        }
+      }
+    },
+    "TenantQuotas": {
+      "description": "Resource limits of a tenant. A limit of 0 (or an unset limit) means unlimited.",
+      "type": "object",
+      "properties": {
+        "maxDiskBytes": {
+          "description": "The maximum disk usage of the tenant in bytes.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "maxObjects": {
+          "description": "The maximum number of objects stored for the tenant.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "maxQueriesPerSecond": {
+          "description": "The maximum number of queries per second for the tenant. The limit is enforced on each node individually.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
         }
       }
     },
@@ -5918,6 +7505,44 @@ func init() {
         }
       ]
     },
+    "TenantUsage": {
+      "description": "The resource usage of a single tenant, derived from the statistics of the tenant's shard.",
+      "type": "object",
+      "properties": {
+        "diskUsageBytes": {
+          "description": "The disk usage of the tenant's shard in bytes.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "name": {
+          "description": "The name of the tenant.",
+          "type": "string"
+        },
+        "objectCount": {
+          "description": "The number of objects stored for the tenant.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "queryCount": {
+          "description": "The number of queries served for the tenant by the node handling this request since it was started.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "quotas": {
+          "description": "The effective quotas of the tenant, i.e. the tenant's own quotas merged with the defaults of the class.",
+          "$ref": "#/definitions/TenantQuotas"
+        },
+        "vectorCount": {
+          "description": "The number of vectors stored for the tenant, summed over all vector indexes.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "Vector": {
       "description": "A vector representation of the object. If provided at object creation, this wil take precedence over any vectorizer setting.",
       "type": "array",
@@ -6153,6 +7778,19 @@ func init() {
       "name": "consistency_level",
       "in": "query"
     },
+    "CommonConsistencyTokenParameterHeader": {
+      "type": "string",
+      "description": "Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.",
+      "name": "X-Weaviate-Consistency-Token",
+      "in": "header"
+    },
+    "CommonIfMatchParameterHeader": {
+      "type": "integer",
+      "format": "int64",
+      "description": "Only apply the write if the stored object was last updated at exactly this unix time in milliseconds (its lastUpdateTimeUnix). 0 requires that the object does not exist.",
+      "name": "If-Match",
+      "in": "header"
+    },
     "CommonIncludeParameterQuery": {
       "type": "string",
       "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
@@ -6344,7 +7982,275 @@ func init() {
             }
           },
           "404": {
-            "description": "Not found, no oidc provider present"
+            "description": "Not found, no oidc provider present"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/.well-known/ready": {
+      "get": {
+        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
+        "summary": "Get application readiness.",
+        "operationId": "weaviate.wellknown.readiness",
+        "responses": {
+          "200": {
+            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          },
+          "503": {
+            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          }
+        }
+      }
+    },
+    "/aliases": {
+      "get": {
+        "description": "Get all aliases. Optionally only the aliases pointing to a specific collection are returned.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "List aliases.",
+        "operationId": "aliases.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only return the aliases pointing to this class.",
+            "name": "class",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved the aliases.",
+            "schema": {
+              "$ref": "#/definitions/AliasResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "description": "Create an alias pointing to an existing collection. Requests addressing the alias are served by the collection it points to. An alias cannot have the name of an existing collection or alias.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create an alias for a class.",
+        "operationId": "aliases.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully created the alias.",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid alias. For example the name is already used by a class or another alias.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/aliases/{aliasName}": {
+      "delete": {
+        "description": "Remove an alias. The collection it points to is not affected.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Delete an alias.",
+        "operationId": "aliases.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the alias.",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully deleted the alias."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Alias or class not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "get": {
+        "description": "Get the collection an alias points to.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get an alias.",
+        "operationId": "aliases.get.alias",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the alias.",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved the alias.",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Alias or class not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "put": {
+        "description": "Atomically point an existing alias to another collection. Every request addressing the alias is served either by the old or by the new collection. Only the ` + "`" + `class` + "`" + ` of the body is used.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Point an alias to another class.",
+        "operationId": "aliases.update",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the alias.",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully updated the alias.",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Alias or class not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid alias. For example the name is already used by a class or another alias.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6353,23 +8259,9 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
-      }
-    },
-    "/.well-known/ready": {
-      "get": {
-        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
-        "summary": "Get application readiness.",
-        "operationId": "weaviate.wellknown.readiness",
-        "responses": {
-          "200": {
-            "description": "The application has completed its start-up routine and is ready to accept traffic."
-          },
-          "503": {
-            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
-          }
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
     "/authz/roles": {
@@ -6796,15 +8688,267 @@ func init() {
     "/authz/users/{id}/revoke": {
       "post": {
         "tags": [
-          "authz"
+          "authz"
+        ],
+        "summary": "Revoke a role from a user",
+        "operationId": "revokeRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user or key ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "properties": {
+                "roles": {
+                  "description": "the roles that revoked from the key or user",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Role revoked successfully"
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.revoke.role"
+        ]
+      }
+    },
+    "/authz/users/{id}/roles": {
+      "get": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "get roles assigned to user or a key",
+        "operationId": "getRolesForUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user or key ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Role assigned users",
+            "schema": {
+              "$ref": "#/definitions/RolesListResponse"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no role found for user/key"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.users.roles"
+        ]
+      }
+    },
+    "/backups/schedules": {
+      "get": {
+        "description": "Returns all backup schedules, the backups they created and when they run next.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "List backup schedules",
+        "operationId": "backups.schedules.list",
+        "responses": {
+          "200": {
+            "description": "Backup schedules successfully returned.",
+            "schema": {
+              "$ref": "#/definitions/BackupScheduleListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "post": {
+        "description": "Create a schedule which periodically creates backups of a set of collections. \u003cbr/\u003e\u003cbr/\u003eSchedules are stored in the cluster and executed by the current leader. Backups which are no longer kept by the retention rules of the schedule are deleted from the backend.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Create a backup schedule",
+        "operationId": "backups.schedules.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup schedule successfully created.",
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup schedule or a schedule with this name already exists.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backups/schedules/{name}": {
+      "get": {
+        "description": "Returns a backup schedule, the backups it created and when it runs next.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Get a backup schedule",
+        "operationId": "backups.schedules.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the backup schedule.",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup schedule successfully returned.",
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup schedule does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "put": {
+        "description": "Replace the definition of a backup schedule. The new retention rules also apply to the backups the schedule created before.",
+        "tags": [
+          "backups"
         ],
-        "summary": "Revoke a role from a user",
-        "operationId": "revokeRole",
+        "summary": "Update a backup schedule",
+        "operationId": "backups.schedules.update",
         "parameters": [
           {
             "type": "string",
-            "description": "user or key ID",
-            "name": "id",
+            "description": "The name of the backup schedule.",
+            "name": "name",
             "in": "path",
             "required": true
           },
@@ -6813,26 +8957,15 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "properties": {
-                "roles": {
-                  "description": "the roles that revoked from the key or user",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
+              "$ref": "#/definitions/BackupSchedule"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Role revoked successfully"
-          },
-          "400": {
-            "description": "Bad request",
+            "description": "Backup schedule successfully updated.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupSchedule"
             }
           },
           "401": {
@@ -6844,6 +8977,18 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Backup schedule does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup schedule.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -6852,38 +8997,28 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.revoke.role"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/authz/users/{id}/roles": {
-      "get": {
+      },
+      "delete": {
+        "description": "Delete a backup schedule. The backups it created are kept in the backend.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "get roles assigned to user or a key",
-        "operationId": "getRolesForUser",
+        "summary": "Delete a backup schedule",
+        "operationId": "backups.schedules.delete",
         "parameters": [
           {
             "type": "string",
-            "description": "user or key ID",
-            "name": "id",
+            "description": "The name of the backup schedule.",
+            "name": "name",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Role assigned users",
-            "schema": {
-              "$ref": "#/definitions/RolesListResponse"
-            }
-          },
-          "400": {
-            "description": "Bad request",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Backup schedule successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6895,7 +9030,10 @@ func init() {
             }
           },
           "404": {
-            "description": "no role found for user/key"
+            "description": "Not Found - Backup schedule does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6905,7 +9043,7 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.users.roles"
+          "weaviate.local.backup"
         ]
       }
     },
@@ -7344,6 +9482,18 @@ func init() {
             "description": "Determines how many replicas must acknowledge a request before it is considered successful",
             "name": "consistency_level",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.",
+            "name": "X-Weaviate-Consistency-Token",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, each object is only written if its lastUpdateTimeUnix matches the stored object. Objects with lastUpdateTimeUnix unset or 0 are only written if they do not exist yet.",
+            "name": "conditional",
+            "in": "query"
           }
         ],
         "responses": {
@@ -7354,6 +9504,12 @@ func init() {
               "items": {
                 "$ref": "#/definitions/ObjectsGetResponse"
               }
+            },
+            "headers": {
+              "X-Weaviate-Consistency-Token": {
+                "type": "string",
+                "description": "Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes."
+              }
             }
           },
           "400": {
@@ -7510,14 +9666,207 @@ func init() {
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
-          "403": {
-            "description": "Forbidden",
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
+        "parameters": [
+          {
+            "description": "parameters to start a classification",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Successfully started classification.",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.post"
+        ]
+      }
+    },
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "classification id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the classification, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Classification does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.get"
+        ]
+      }
+    },
+    "/cluster/raft": {
+      "get": {
+        "description": "Returns the leader, term, log indexes, the members of the latest Raft configuration and the latest snapshot as seen by the node serving the request.",
+        "tags": [
+          "cluster"
+        ],
+        "summary": "See the Raft state of the cluster",
+        "operationId": "cluster.get.raft",
+        "responses": {
+          "200": {
+            "description": "Raft state successfully returned",
+            "schema": {
+              "$ref": "#/definitions/RaftStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.cluster.raft.get"
+        ]
+      }
+    },
+    "/cluster/raft/peers/{id}": {
+      "delete": {
+        "description": "Removes a peer, typically a node that is permanently gone, from the Raft configuration. The request is forwarded to the leader. The current leader can not be removed, and neither can voters whose removal would leave too few voters for a quorum.",
+        "tags": [
+          "cluster"
+        ],
+        "summary": "Remove a peer from the Raft configuration",
+        "operationId": "cluster.raft.remove.peer",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of the peer, which is the name of the node.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Peer successfully removed"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The peer is not part of the Raft configuration.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The peer can not be removed, e.g. because it is the current leader or the voters left would be too few for a quorum.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7529,50 +9878,49 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.cluster.raft.manipulate"
         ]
       }
     },
-    "/classifications/": {
+    "/cluster/raft/peers/{id}/promote": {
       "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "description": "Turns a non-voting member of the Raft configuration into a voter. The request is forwarded to the leader.",
         "tags": [
-          "classifications"
+          "cluster"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "summary": "Promote a non-voter to a voter",
+        "operationId": "cluster.raft.promote.peer",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
+            "type": "string",
+            "description": "The ID of the peer, which is the name of the node.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
+          "204": {
+            "description": "Peer successfully promoted"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "400": {
-            "description": "Incorrect request",
+          "404": {
+            "description": "The peer is not part of the Raft configuration.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
+          "422": {
+            "description": "The peer can not be promoted, e.g. because it already is a voter.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7585,32 +9933,23 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.cluster.raft.manipulate"
         ]
       }
     },
-    "/classifications/{id}": {
-      "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+    "/cluster/raft/snapshot": {
+      "post": {
+        "description": "Forces the node serving the request to take a Raft snapshot of its state, which also truncates its log. Returns the latest snapshot if nothing new was applied since the last one.",
         "tags": [
-          "classifications"
-        ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "classification id",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
+          "cluster"
         ],
+        "summary": "Force a Raft snapshot",
+        "operationId": "cluster.raft.snapshot",
         "responses": {
           "200": {
-            "description": "Found the classification, returned as body",
+            "description": "Snapshot successfully taken",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/RaftSnapshot"
             }
           },
           "401": {
@@ -7622,9 +9961,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Classification does not exist"
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -7633,7 +9969,7 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.get"
+          "weaviate.cluster.raft.manipulate"
         ]
       }
     },
@@ -8079,6 +10415,12 @@ func init() {
             "description": "Determines how many replicas must acknowledge a request before it is considered successful",
             "name": "consistency_level",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.",
+            "name": "X-Weaviate-Consistency-Token",
+            "in": "header"
           }
         ],
         "responses": {
@@ -8086,6 +10428,12 @@ func init() {
             "description": "Object created.",
             "schema": {
               "$ref": "#/definitions/Object"
+            },
+            "headers": {
+              "X-Weaviate-Consistency-Token": {
+                "type": "string",
+                "description": "Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes."
+              }
             }
           },
           "400": {
@@ -8209,6 +10557,12 @@ func init() {
             "name": "consistency_level",
             "in": "query"
           },
+          {
+            "type": "string",
+            "description": "Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.",
+            "name": "X-Weaviate-Consistency-Token",
+            "in": "header"
+          },
           {
             "type": "string",
             "description": "The target node which should fulfill the request",
@@ -8301,6 +10655,19 @@ func init() {
             "description": "Determines how many replicas must acknowledge a request before it is considered successful",
             "name": "consistency_level",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.",
+            "name": "X-Weaviate-Consistency-Token",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Only apply the write if the stored object was last updated at exactly this unix time in milliseconds (its lastUpdateTimeUnix). 0 requires that the object does not exist.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
@@ -8308,6 +10675,12 @@ func init() {
             "description": "Successfully received.",
             "schema": {
               "$ref": "#/definitions/Object"
+            },
+            "headers": {
+              "X-Weaviate-Consistency-Token": {
+                "type": "string",
+                "description": "Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes."
+              }
             }
           },
           "401": {
@@ -8322,6 +10695,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The precondition given in the If-Match header is not met, the object has been modified or deleted in the meantime.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -8369,6 +10748,19 @@ func init() {
             "name": "consistency_level",
             "in": "query"
           },
+          {
+            "type": "string",
+            "description": "Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.",
+            "name": "X-Weaviate-Consistency-Token",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Only apply the write if the stored object was last updated at exactly this unix time in milliseconds (its lastUpdateTimeUnix). 0 requires that the object does not exist.",
+            "name": "If-Match",
+            "in": "header"
+          },
           {
             "type": "string",
             "description": "Specifies the tenant in a request targeting a multi-tenant class",
@@ -8378,7 +10770,13 @@ func init() {
         ],
         "responses": {
           "204": {
-            "description": "Successfully deleted."
+            "description": "Successfully deleted.",
+            "headers": {
+              "X-Weaviate-Consistency-Token": {
+                "type": "string",
+                "description": "Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes."
+              }
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -8398,6 +10796,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The precondition given in the If-Match header is not met, the object has been modified or deleted in the meantime.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
@@ -8446,6 +10850,12 @@ func init() {
             "name": "consistency_level",
             "in": "query"
           },
+          {
+            "type": "string",
+            "description": "Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.",
+            "name": "X-Weaviate-Consistency-Token",
+            "in": "header"
+          },
           {
             "type": "string",
             "description": "Specifies the tenant in a request targeting a multi-tenant class",
@@ -8524,11 +10934,30 @@ func init() {
             "description": "Determines how many replicas must acknowledge a request before it is considered successful",
             "name": "consistency_level",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.",
+            "name": "X-Weaviate-Consistency-Token",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Only apply the write if the stored object was last updated at exactly this unix time in milliseconds (its lastUpdateTimeUnix). 0 requires that the object does not exist.",
+            "name": "If-Match",
+            "in": "header"
           }
         ],
         "responses": {
           "204": {
-            "description": "Successfully applied. No content provided."
+            "description": "Successfully applied. No content provided.",
+            "headers": {
+              "X-Weaviate-Consistency-Token": {
+                "type": "string",
+                "description": "Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes."
+              }
+            }
           },
           "400": {
             "description": "The patch-JSON is malformed.",
@@ -8548,6 +10977,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The precondition given in the If-Match header is not met, the object has been modified or deleted in the meantime.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "The patch-JSON is valid but unprocessable.",
             "schema": {
@@ -9437,9 +11872,169 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Added the new Object class to the schema.",
+            "description": "Added the new Object class to the schema.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Object class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.add.meta"
+        ]
+      }
+    },
+    "/schema/diff": {
+      "get": {
+        "description": "Describes how the class definitions differ between two schema versions, e.g. to find out when a vector index setting was changed.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Compare the schema at two versions.",
+        "operationId": "schema.diff",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version to compare from. Defaults to the oldest version in the schema history.",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The version to compare to. Defaults to the latest version in the schema history.",
+            "name": "to",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The differences between both versions.",
+            "schema": {
+              "$ref": "#/definitions/SchemaDiff"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The schema history does not reach back to one of the versions.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid versions, e.g. from is newer than to.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema/history": {
+      "get": {
+        "description": "Returns who changed which class definition how and when, in the order the changes were applied. The schema version of a change is its index in the raft log.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the history of schema changes.",
+        "operationId": "schema.history",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only return the changes of this class.",
+            "name": "class",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The recorded schema changes.",
+            "schema": {
+              "$ref": "#/definitions/SchemaHistoryResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema/history/{version}": {
+      "get": {
+        "description": "Returns all class definitions as they were right after the schema change with the given version had been applied.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Export the schema as of a version.",
+        "operationId": "schema.history.export",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The schema version to export the schema at.",
+            "name": "version",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The schema as of the version.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Schema"
             }
           },
           "401": {
@@ -9451,8 +12046,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The schema history does not reach back to this version.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Object class",
+            "description": "Invalid version.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9465,7 +12066,7 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.local.query.meta"
         ]
       }
     },
@@ -9542,6 +12143,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Class"
             }
+          },
+          {
+            "type": "boolean",
+            "description": "If true, changes of the vector index config which can only be applied by rebuilding the vector index, such as a different index type, distance or compression, are applied by rebuilding it in the background. Such changes are rejected otherwise.",
+            "name": "rebuildVectorIndex",
+            "in": "query"
           }
         ],
         "responses": {
@@ -9686,6 +12293,65 @@ func init() {
         ]
       }
     },
+    "/schema/{className}/properties/{propertyName}": {
+      "delete": {
+        "description": "Remove a property from a collection. The property is removed from the schema right away. Its indexes and the values stored on objects are cleaned up in the background.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Remove a property from an Object class.",
+        "operationId": "schema.objects.properties.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the property from the Object class."
+          },
+          "400": {
+            "description": "Could not delete the property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class or property not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
     "/schema/{className}/shards": {
       "get": {
         "description": "Get the status of every shard in the cluster.",
@@ -10158,6 +12824,62 @@ func init() {
           }
         }
       }
+    },
+    "/schema/{className}/tenants/{tenantName}/usage": {
+      "get": {
+        "description": "Returns the resource usage (objects, vectors, bytes on disk and queries) of a tenant together with its effective quotas",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the resource usage of a tenant",
+        "operationId": "tenants.usage",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenantName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The resource usage of the tenant",
+            "schema": {
+              "$ref": "#/definitions/TenantUsage"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Tenant not found"
+          },
+          "422": {
+            "description": "Invalid tenant or class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
     }
   },
   "definitions": {
@@ -10168,6 +12890,33 @@ func init() {
         "type": "object"
       }
     },
+    "Alias": {
+      "description": "An alternative name of a class. Requests addressing the alias are served by the class it points to.",
+      "type": "object",
+      "properties": {
+        "alias": {
+          "description": "The name of the alias.",
+          "type": "string"
+        },
+        "class": {
+          "description": "The name of the class the alias points to.",
+          "type": "string"
+        }
+      }
+    },
+    "AliasResponse": {
+      "description": "List of aliases.",
+      "type": "object",
+      "properties": {
+        "aliases": {
+          "description": "The aliases.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Alias"
+          }
+        }
+      }
+    },
     "BM25Config": {
       "description": "tuning parameters for the BM25 algorithm",
       "type": "object",
@@ -10378,6 +13127,13 @@ func init() {
     "BackupRestoreRequest": {
       "description": "Request body for restoring a backup for a set of classes",
       "properties": {
+        "class_mapping": {
+          "description": "Allows restoring classes under a different name, mapping the class name stored in the backup to the new name. Cross-references between restored classes are rewritten accordingly.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
         "config": {
           "description": "Custom configuration for the backup restoration process",
           "type": "object",
@@ -10403,6 +13159,19 @@ func init() {
           "additionalProperties": {
             "type": "string"
           }
+        },
+        "point_in_time": {
+          "description": "Replays the commit logs archived after the backup up to this time. Logs are archived in segments, writes are restored up to the last segment archived before this time. Requires commit log archiving to be enabled on all nodes.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "tenants": {
+          "description": "List of tenants to restore. Only applies to multi-tenant classes, all tenants are restored if empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
@@ -10447,29 +13216,180 @@ func init() {
         }
       }
     },
-    "BackupRestoreStatusResponse": {
-      "description": "The definition of a backup restore metadata",
+    "BackupRestoreStatusResponse": {
+      "description": "The definition of a backup restore metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backup backend, contains bucket and path",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "BackupSchedule": {
+      "description": "A schedule which periodically creates backups of a set of collections",
+      "required": [
+        "name",
+        "cron",
+        "backend"
+      ],
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+          "type": "string"
+        },
+        "bucket": {
+          "description": "Name of the bucket, container, volume, etc",
+          "type": "string"
+        },
+        "cron": {
+          "description": "A cron expression with 5 fields (minute, hour, day of month, month, day of week) evaluated in UTC, or one of ` + "`" + `@yearly` + "`" + `, ` + "`" + `@monthly` + "`" + `, ` + "`" + `@weekly` + "`" + `, ` + "`" + `@daily` + "`" + `, ` + "`" + `@hourly` + "`" + `.",
+          "type": "string"
+        },
+        "exclude": {
+          "description": "List of collections to exclude from the backups. Cannot be used together with ` + "`" + `include` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "include": {
+          "description": "List of collections to include in the backups. Cannot be used together with ` + "`" + `exclude` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "The name of the schedule, it is used as prefix of the backup IDs. Only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "nextRun": {
+          "description": "When the schedule creates its next backup. Empty if the schedule is paused.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
+        "path": {
+          "description": "The path within the bucket",
+          "type": "string"
+        },
+        "paused": {
+          "description": "Paused schedules don't create backups. The retention rules still apply.",
+          "type": "boolean"
+        },
+        "retention": {
+          "$ref": "#/definitions/BackupScheduleRetention"
+        },
+        "runs": {
+          "description": "The backups created by the schedule, oldest first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BackupScheduleRun"
+          },
+          "readOnly": true
+        },
+        "updatedAt": {
+          "description": "When the schedule was created or last updated.",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        }
+      }
+    },
+    "BackupScheduleListResponse": {
+      "description": "The list of backup schedules",
+      "properties": {
+        "schedules": {
+          "description": "The backup schedules ordered by name.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BackupSchedule"
+          }
+        }
+      }
+    },
+    "BackupScheduleRetention": {
+      "description": "Rules deciding which backups of a schedule are kept. A successful backup is kept if any rule selects it. All backups are kept if no rule is set.",
+      "properties": {
+        "keepDaily": {
+          "description": "Keep the most recent backup of each of the last days on which a backup was created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "keepLast": {
+          "description": "Keep the most recent backups.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "keepMonthly": {
+          "description": "Keep the most recent backup of each of the last months in which a backup was created.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "keepWeekly": {
+          "description": "Keep the most recent backup of each of the last ISO weeks in which a backup was created.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "BackupScheduleRun": {
+      "description": "A backup created by a schedule",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
+        "completedAt": {
+          "description": "When the backup completed.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "deletedAt": {
+          "description": "When the backup was deleted by the retention rules, empty if it still exists.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
         },
         "error": {
-          "description": "error message if restoration failed",
+          "description": "error message if creation failed",
           "type": "string"
         },
         "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "description": "The ID of the backup.",
           "type": "string"
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backup backend, contains bucket and path",
-          "type": "string"
+        "startedAt": {
+          "description": "When the backup was started.",
+          "type": "string",
+          "format": "date-time"
         },
         "status": {
-          "description": "phase of backup restoration process",
+          "description": "phase of the backup creation process",
           "type": "string",
-          "default": "STARTED",
           "enum": [
             "STARTED",
             "TRANSFERRING",
@@ -10970,6 +13890,14 @@ func init() {
           "description": "Manage how the index should be sharded and distributed in the cluster",
           "type": "object"
         },
+        "uniqueKey": {
+          "description": "Names of the properties whose combined values identify an object within the collection (a.k.a. 'natural key'). Batch imports resolve an existing object by these values and update it instead of creating a duplicate. Objects imported without an id get an id derived from the key. Text properties of the key must use the tokenization 'field'. Immutable after the collection was created.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
         "vectorConfig": {
           "description": "Configure named vectors. Either use this field or ` + "`" + `vectorizer` + "`" + `, ` + "`" + `vectorIndexType` + "`" + `, and ` + "`" + `vectorIndexConfig` + "`" + ` fields. Available from ` + "`" + `v1.24.0` + "`" + `.",
           "type": "object",
@@ -11020,6 +13948,11 @@ func init() {
             "inCountry"
           ]
         },
+        "continuous": {
+          "description": "keep classifying objects of the class which are added or updated after the initial run completed. Only one continuous classification can be active per class, scheduling another one replaces it.",
+          "type": "boolean",
+          "default": false
+        },
         "error": {
           "description": "error message if status == failed",
           "type": "string",
@@ -11417,6 +14350,10 @@ func init() {
           "description": "Whether or not multi-tenancy is enabled for this class (default: false).",
           "type": "boolean",
           "x-omitempty": false
+        },
+        "tenantQuotas": {
+          "description": "Default resource limits for every tenant of this class. Quotas set on a tenant take precedence.",
+          "$ref": "#/definitions/TenantQuotas"
         }
       }
     },
@@ -11490,6 +14427,13 @@ func init() {
           "format": "boolean",
           "x-omitempty": false
         },
+        "failedVectorIndexRebuilds": {
+          "description": "The target vectors whose vector index rebuild failed. The rebuild is retried once the shard is loaded again.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "loaded": {
           "description": "The load status of the shard.",
           "type": "boolean",
@@ -11506,6 +14450,32 @@ func init() {
           "format": "int64",
           "x-omitempty": false
         },
+        "rebuildingVectorIndexes": {
+          "description": "The target vectors whose vector index is being rebuilt in the background. The legacy vector is listed as an empty string.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "rebuiltVectors": {
+          "description": "The number of vectors added by the ongoing rebuilds of vector indexes.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "reindexedObjects": {
+          "description": "The number of objects processed by the ongoing reindexing of properties.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "reindexingProperties": {
+          "description": "The properties whose tokenization or inverted index settings are being applied in the background.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "vectorIndexingStatus": {
           "description": "The status of the vector indexing process.",
           "format": "string",
@@ -11552,6 +14522,13 @@ func init() {
           "description": "The name of the node.",
           "type": "string"
         },
+        "replicationOperations": {
+          "description": "Replication factor changes coordinated by the node.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReplicationScaleOperation"
+          }
+        },
         "shards": {
           "description": "The list of the shards with it's statistics.",
           "type": "array",
@@ -11993,6 +14970,56 @@ func init() {
       "description": "Names and values of an individual property. A returned response may also contain additional metadata, such as from classification or feature projection.",
       "type": "object"
     },
+    "RaftPeer": {
+      "description": "A member of the raft configuration.",
+      "properties": {
+        "address": {
+          "description": "The raft address of the peer.",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the peer, which is the name of the node.",
+          "type": "string"
+        },
+        "leader": {
+          "description": "Whether the peer is the current leader.",
+          "type": "boolean"
+        },
+        "suffrage": {
+          "description": "Whether the peer takes part in elections and commits.",
+          "type": "string",
+          "enum": [
+            "VOTER",
+            "NONVOTER",
+            "STAGING"
+          ]
+        }
+      }
+    },
+    "RaftSnapshot": {
+      "description": "Metadata of a raft snapshot.",
+      "properties": {
+        "id": {
+          "description": "The ID of the snapshot.",
+          "type": "string"
+        },
+        "index": {
+          "description": "The index of the last log entry included in the snapshot.",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "size": {
+          "description": "The size of the snapshot in bytes.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "term": {
+          "description": "The term of the last log entry included in the snapshot.",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
     "RaftStatistics": {
       "description": "The definition of Raft statistics.",
       "properties": {
@@ -12053,6 +15080,58 @@ func init() {
         }
       }
     },
+    "RaftStatus": {
+      "description": "The raft state of the cluster as seen by the node serving the request.",
+      "properties": {
+        "appliedIndex": {
+          "description": "The index of the last log entry applied to the schema.",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "commitIndex": {
+          "description": "The index of the last committed log entry.",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "lastIndex": {
+          "description": "The index of the last log entry.",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "leaderAddress": {
+          "description": "The raft address of the current leader, empty if the leader is unknown.",
+          "type": "string"
+        },
+        "leaderId": {
+          "description": "The ID of the current leader, empty if the leader is unknown.",
+          "type": "string"
+        },
+        "nodeId": {
+          "description": "The ID of the node serving the request.",
+          "type": "string"
+        },
+        "peers": {
+          "description": "The members of the latest raft configuration.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RaftPeer"
+          }
+        },
+        "snapshot": {
+          "description": "The latest snapshot stored on the node serving the request.",
+          "$ref": "#/definitions/RaftSnapshot"
+        },
+        "state": {
+          "description": "The raft state of the node serving the request, one of Leader, Follower, Candidate or Shutdown.",
+          "type": "string"
+        },
+        "term": {
+          "description": "The current term.",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
     "ReferenceMetaClassification": {
       "description": "This meta field contains additional info about the classified reference property",
       "properties": {
@@ -12120,6 +15199,17 @@ func init() {
           "type": "boolean",
           "x-omitempty": false
         },
+        "consistencyLevel": {
+          "description": "Default consistency level of requests which do not specify one. ` + "`" + `BOUNDED_STALENESS` + "`" + ` reads are answered by a single replica if it has been in sync within ` + "`" + `maxStalenessSeconds` + "`" + ` and fall back to ` + "`" + `QUORUM` + "`" + ` otherwise; writes use ` + "`" + `QUORUM` + "`" + `. Defaults to ` + "`" + `QUORUM` + "`" + `.",
+          "type": "string",
+          "enum": [
+            "ONE",
+            "QUORUM",
+            "ALL",
+            "BOUNDED_STALENESS"
+          ],
+          "x-omitempty": true
+        },
         "deletionStrategy": {
           "description": "Conflict resolution strategy for deleted objects.",
           "type": "string",
@@ -12132,6 +15222,62 @@ func init() {
         "factor": {
           "description": "Number of times a class is replicated (default: 1).",
           "type": "integer"
+        },
+        "maxStalenessSeconds": {
+          "description": "Maximum staleness in seconds of ` + "`" + `BOUNDED_STALENESS` + "`" + ` reads (default: 10).",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        }
+      }
+    },
+    "ReplicationScaleOperation": {
+      "description": "A change of the replication factor of a collection which runs in the background",
+      "properties": {
+        "class": {
+          "description": "The collection whose replication factor is changed.",
+          "type": "string"
+        },
+        "completedAt": {
+          "description": "When the operation completed.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "error": {
+          "description": "error message if the operation failed",
+          "type": "string"
+        },
+        "fromFactor": {
+          "description": "The replication factor before the change.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "The ID of the operation.",
+          "type": "string"
+        },
+        "startedAt": {
+          "description": "When the operation was started.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "phase of the operation",
+          "type": "string",
+          "enum": [
+            "STARTED",
+            "COPYING",
+            "SYNCING",
+            "REMOVING",
+            "SUCCESS",
+            "FAILED"
+          ]
+        },
+        "toFactor": {
+          "description": "The requested replication factor.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
@@ -12211,6 +15357,41 @@ func init() {
         }
       }
     },
+    "SchemaChange": {
+      "description": "A single change of a class definition.",
+      "properties": {
+        "class": {
+          "description": "The name of the changed class.",
+          "type": "string"
+        },
+        "timeUnix": {
+          "description": "When the change was accepted by the cluster, in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "type": {
+          "description": "The kind of change.",
+          "type": "string",
+          "enum": [
+            "ADD_CLASS",
+            "RESTORE_CLASS",
+            "UPDATE_CLASS",
+            "DELETE_CLASS",
+            "ADD_PROPERTY",
+            "DELETE_PROPERTY"
+          ]
+        },
+        "user": {
+          "description": "The user who made the change, empty if unknown or if authentication is disabled.",
+          "type": "string"
+        },
+        "version": {
+          "description": "The schema version of the change, which is the index of the change in the raft log.",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
     "SchemaClusterStatus": {
       "description": "Indicates the health of the schema in a cluster.",
       "type": "object",
@@ -12241,10 +15422,49 @@ func init() {
         }
       }
     },
+    "SchemaDiff": {
+      "description": "The differences between the schema at two versions.",
+      "properties": {
+        "differences": {
+          "description": "Human-readable descriptions of the differences, empty if the schema is identical at both versions.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "from": {
+          "description": "The version the comparison starts from.",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "to": {
+          "description": "The version the comparison ends at.",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
     "SchemaHistory": {
       "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
       "type": "object"
     },
+    "SchemaHistoryResponse": {
+      "description": "The recorded changes of class definitions.",
+      "properties": {
+        "changes": {
+          "description": "The changes in the order they were applied.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SchemaChange"
+          }
+        },
+        "oldestVersion": {
+          "description": "The oldest version the schema can be exported or compared at. Older changes are no longer part of the history.",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
     "ShardStatus": {
       "description": "The status of a single shard",
       "properties": {
@@ -12407,9 +15627,47 @@ func init() {
             "UNFREEZING"
           ]
         },
+        "consistencyLevel": {
+          "description": "Default consistency level of requests to the tenant which do not specify one. Overrides the ` + "`" + `consistencyLevel` + "`" + ` of the class replication config.",
+          "type": "string",
+          "enum": [
+            "ONE",
+            "QUORUM",
+            "ALL",
+            "BOUNDED_STALENESS"
+          ]
+        },
         "name": {
           "description": "The name of the tenant (required).",
           "type": "string"
+        },
+        "quotas": {
+          "description": "Resource limits of the tenant. Limits which are not set fall back to the tenant quotas of the class.",
+          "$ref": "#/definitions/TenantQuotas"
+        }
+      }
+    },
+    "TenantQuotas": {
+      "description": "Resource limits of a tenant. A limit of 0 (or an unset limit) means unlimited.",
+      "type": "object",
+      "properties": {
+        "maxDiskBytes": {
+          "description": "The maximum disk usage of the tenant in bytes.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "maxObjects": {
+          "description": "The maximum number of objects stored for the tenant.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "maxQueriesPerSecond": {
+          "description": "The maximum number of queries per second for the tenant. The limit is enforced on each node individually.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
         }
       }
     },
@@ -12441,6 +15699,44 @@ func init() {
         }
       ]
     },
+    "TenantUsage": {
+      "description": "The resource usage of a single tenant, derived from the statistics of the tenant's shard.",
+      "type": "object",
+      "properties": {
+        "diskUsageBytes": {
+          "description": "The disk usage of the tenant's shard in bytes.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "name": {
+          "description": "The name of the tenant.",
+          "type": "string"
+        },
+        "objectCount": {
+          "description": "The number of objects stored for the tenant.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "queryCount": {
+          "description": "The number of queries served for the tenant by the node handling this request since it was started.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "quotas": {
+          "description": "The effective quotas of the tenant, i.e. the tenant's own quotas merged with the defaults of the class.",
+          "$ref": "#/definitions/TenantQuotas"
+        },
+        "vectorCount": {
+          "description": "The number of vectors stored for the tenant, summed over all vector indexes.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "Vector": {
       "description": "A vector representation of the object. If provided at object creation, this wil take precedence over any vectorizer setting.",
       "type": "array",
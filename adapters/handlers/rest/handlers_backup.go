@@ -12,7 +12,12 @@
 package rest
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/backups"
@@ -25,6 +30,7 @@ import (
 
 type backupHandlers struct {
 	manager             *ubak.Scheduler
+	schedules           *ubak.ScheduleManager
 	metricRequestsTotal restApiRequestsTotal
 }
 
@@ -177,14 +183,17 @@ func (s *backupHandlers) restoreBackup(params backups.BackupsRestoreParams,
 		path = params.Body.Config.Path
 	}
 	meta, err := s.manager.Restore(params.HTTPRequest.Context(), principal, &ubak.BackupRequest{
-		ID:          params.ID,
-		Backend:     params.Backend,
-		Include:     params.Body.Include,
-		Exclude:     params.Body.Exclude,
-		NodeMapping: params.Body.NodeMapping,
-		Compression: compressionFromRCfg(params.Body.Config),
-		Bucket:      bucket,
-		Path:        path,
+		ID:           params.ID,
+		Backend:      params.Backend,
+		Include:      params.Body.Include,
+		Exclude:      params.Body.Exclude,
+		NodeMapping:  params.Body.NodeMapping,
+		ClassMapping: params.Body.ClassMapping,
+		Tenants:      params.Body.Tenants,
+		PointInTime:  pointInTime(params.Body.PointInTime),
+		Compression:  compressionFromRCfg(params.Body.Config),
+		Bucket:       bucket,
+		Path:         path,
 	})
 	if err != nil {
 		s.metricRequestsTotal.logError("", err)
@@ -306,10 +315,201 @@ func (s *backupHandlers) list(params backups.BackupsListParams,
 	return backups.NewBackupsListOK().WithPayload(*payload)
 }
 
+func (s *backupHandlers) listSchedules(params backups.BackupsSchedulesListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	list, err := s.schedules.List(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsSchedulesListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := &models.BackupScheduleListResponse{Schedules: make([]*models.BackupSchedule, len(list))}
+	for i := range list {
+		payload.Schedules[i] = scheduleToModel(&list[i])
+	}
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsSchedulesListOK().WithPayload(payload)
+}
+
+func (s *backupHandlers) getSchedule(params backups.BackupsSchedulesGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	status, err := s.schedules.Get(params.HTTPRequest.Context(), principal, params.Name)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsSchedulesGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return backups.NewBackupsSchedulesGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsSchedulesGetOK().WithPayload(scheduleToModel(status))
+}
+
+func (s *backupHandlers) createSchedule(params backups.BackupsSchedulesCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	status, err := s.schedules.Create(params.HTTPRequest.Context(), principal, scheduleFromModel(params.Body))
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsSchedulesCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return backups.NewBackupsSchedulesCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsSchedulesCreateOK().WithPayload(scheduleToModel(status))
+}
+
+func (s *backupHandlers) updateSchedule(params backups.BackupsSchedulesUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	sched := scheduleFromModel(params.Body)
+	if sched.Name != params.Name {
+		err := fmt.Errorf("schedule name %q in body does not match %q in path", sched.Name, params.Name)
+		s.metricRequestsTotal.logUserError("")
+		return backups.NewBackupsSchedulesUpdateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	status, err := s.schedules.Update(params.HTTPRequest.Context(), principal, sched)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsSchedulesUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return backups.NewBackupsSchedulesUpdateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return backups.NewBackupsSchedulesUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsSchedulesUpdateOK().WithPayload(scheduleToModel(status))
+}
+
+func (s *backupHandlers) deleteSchedule(params backups.BackupsSchedulesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := s.schedules.Delete(params.HTTPRequest.Context(), principal, params.Name); err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsSchedulesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return backups.NewBackupsSchedulesDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsSchedulesDeleteNoContent()
+}
+
+func scheduleFromModel(m *models.BackupSchedule) backup.Schedule {
+	sched := backup.Schedule{
+		Name:    swag.StringValue(m.Name),
+		Cron:    swag.StringValue(m.Cron),
+		Backend: swag.StringValue(m.Backend),
+		Include: m.Include,
+		Exclude: m.Exclude,
+		Bucket:  m.Bucket,
+		Path:    m.Path,
+		Paused:  m.Paused,
+	}
+	if r := m.Retention; r != nil {
+		sched.Retention = backup.Retention{
+			KeepLast:    int(r.KeepLast),
+			KeepDaily:   int(r.KeepDaily),
+			KeepWeekly:  int(r.KeepWeekly),
+			KeepMonthly: int(r.KeepMonthly),
+		}
+	}
+	return sched
+}
+
+func scheduleToModel(st *ubak.ScheduleStatus) *models.BackupSchedule {
+	sched := &st.Schedule
+	r := sched.Retention
+	m := &models.BackupSchedule{
+		Name:    swag.String(sched.Name),
+		Cron:    swag.String(sched.Cron),
+		Backend: swag.String(sched.Backend),
+		Include: sched.Include,
+		Exclude: sched.Exclude,
+		Bucket:  sched.Bucket,
+		Path:    sched.Path,
+		Paused:  sched.Paused,
+		Retention: &models.BackupScheduleRetention{
+			KeepLast:    int64(r.KeepLast),
+			KeepDaily:   int64(r.KeepDaily),
+			KeepWeekly:  int64(r.KeepWeekly),
+			KeepMonthly: int64(r.KeepMonthly),
+		},
+		UpdatedAt: strfmt.DateTime(sched.UpdatedAt),
+		NextRun:   dateTimeOrNil(st.NextRun),
+		Runs:      make([]*models.BackupScheduleRun, len(st.Runs)),
+	}
+	for i, run := range st.Runs {
+		m.Runs[i] = &models.BackupScheduleRun{
+			ID:          run.BackupID,
+			Status:      string(run.Status),
+			Error:       run.Error,
+			StartedAt:   strfmt.DateTime(run.StartedAt),
+			CompletedAt: dateTimeOrNil(run.CompletedAt),
+			DeletedAt:   dateTimeOrNil(run.DeletedAt),
+		}
+	}
+	return m
+}
+
+func dateTimeOrNil(t time.Time) *strfmt.DateTime {
+	if t.IsZero() {
+		return nil
+	}
+	dt := strfmt.DateTime(t)
+	return &dt
+}
+
 func setupBackupHandlers(api *operations.WeaviateAPI,
-	scheduler *ubak.Scheduler, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+	scheduler *ubak.Scheduler, schedules *ubak.ScheduleManager,
+	metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
 ) {
-	h := &backupHandlers{scheduler, newBackupRequestsTotal(metrics, logger)}
+	h := &backupHandlers{scheduler, schedules, newBackupRequestsTotal(metrics, logger)}
 	api.BackupsBackupsCreateHandler = backups.
 		BackupsCreateHandlerFunc(h.createBackup)
 	api.BackupsBackupsCreateStatusHandler = backups.
@@ -320,6 +520,16 @@ func setupBackupHandlers(api *operations.WeaviateAPI,
 		BackupsRestoreStatusHandlerFunc(h.restoreBackupStatus)
 	api.BackupsBackupsCancelHandler = backups.BackupsCancelHandlerFunc(h.cancel)
 	api.BackupsBackupsListHandler = backups.BackupsListHandlerFunc(h.list)
+	api.BackupsBackupsSchedulesListHandler = backups.
+		BackupsSchedulesListHandlerFunc(h.listSchedules)
+	api.BackupsBackupsSchedulesGetHandler = backups.
+		BackupsSchedulesGetHandlerFunc(h.getSchedule)
+	api.BackupsBackupsSchedulesCreateHandler = backups.
+		BackupsSchedulesCreateHandlerFunc(h.createSchedule)
+	api.BackupsBackupsSchedulesUpdateHandler = backups.
+		BackupsSchedulesUpdateHandlerFunc(h.updateSchedule)
+	api.BackupsBackupsSchedulesDeleteHandler = backups.
+		BackupsSchedulesDeleteHandlerFunc(h.deleteSchedule)
 }
 
 type backupRequestsTotal struct {
@@ -342,3 +552,10 @@ func (e *backupRequestsTotal) logError(className string, err error) {
 		e.logServerError(className, err)
 	}
 }
+
+func pointInTime(dt *strfmt.DateTime) time.Time {
+	if dt == nil {
+		return time.Time{}
+	}
+	return time.Time(*dt).UTC()
+}
@@ -12,6 +12,8 @@
 package rest
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 
 	middleware "github.com/go-openapi/runtime/middleware"
@@ -21,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/verbosity"
+	"github.com/weaviate/weaviate/usecases/audit"
 	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
@@ -34,15 +37,29 @@ type batchObjectHandlers struct {
 func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getWriteReplicationProperties(params.ConsistencyLevel, params.XWeaviateConsistencyToken)
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
 		return batch.NewBatchObjectsCreateBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	objs, err := h.manager.AddObjects(params.HTTPRequest.Context(), principal,
-		params.Body.Objects, params.Body.Fields, repl)
+	var ifMatch []*int64
+	if params.Conditional != nil && *params.Conditional {
+		// in conditional mode, each object's lastUpdateTimeUnix is the
+		// precondition it is written against
+		ifMatch = make([]*int64, len(params.Body.Objects))
+		for i, obj := range params.Body.Objects {
+			if obj == nil {
+				continue
+			}
+			updateTime := obj.LastUpdateTimeUnix
+			ifMatch[i] = &updateTime
+		}
+	}
+
+	objs, err := h.manager.AddObjectsIfMatch(params.HTTPRequest.Context(), principal,
+		params.Body.Objects, params.Body.Fields, repl, ifMatch)
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
 		switch err.(type) {
@@ -61,8 +78,15 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 		}
 	}
 
+	for _, obj := range objs {
+		if obj.Err == nil {
+			audit.AddObjectIDs(params.HTTPRequest.Context(), obj.UUID.String())
+		}
+	}
+
 	h.metricRequestsTotal.logOk("")
 	return batch.NewBatchObjectsCreateOK().
+		WithXWeaviateConsistencyToken(repl.ConsistencyToken.String()).
 		WithPayload(h.objectsResponse(objs))
 }
 
@@ -92,7 +116,7 @@ func (h *batchObjectHandlers) objectsResponse(input objects.BatchObjects) []*mod
 func (h *batchObjectHandlers) addReferences(params batch.BatchReferencesCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
 		return batch.NewBatchReferencesCreateBadRequest().
@@ -153,7 +177,7 @@ func (h *batchObjectHandlers) referencesResponse(input objects.BatchReferences)
 func (h *batchObjectHandlers) deleteObjects(params batch.BatchObjectsDeleteParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
 		return batch.NewBatchObjectsDeleteBadRequest().
@@ -161,6 +185,7 @@ func (h *batchObjectHandlers) deleteObjects(params batch.BatchObjectsDeleteParam
 	}
 
 	tenant := getTenant(params.Tenant)
+	auditBatchDelete(params.HTTPRequest.Context(), params.Body.Match, tenant)
 
 	res, err := h.manager.DeleteObjects(params.HTTPRequest.Context(), principal,
 		params.Body.Match, params.Body.DryRun, params.Body.Output, repl, tenant)
@@ -186,6 +211,18 @@ func (h *batchObjectHandlers) deleteObjects(params batch.BatchObjectsDeleteParam
 		WithPayload(h.objectsDeleteResponse(res))
 }
 
+func auditBatchDelete(ctx context.Context, match *models.BatchDeleteMatch, tenant string) {
+	if match == nil {
+		return
+	}
+	audit.SetTarget(ctx, match.Class, tenant)
+	if match.Where != nil {
+		if where, err := json.Marshal(match.Where); err == nil {
+			audit.SetFilter(ctx, string(where))
+		}
+	}
+}
+
 func (h *batchObjectHandlers) objectsDeleteResponse(input *objects.BatchDeleteResponse) *models.BatchDeleteResponse {
 	var successful, failed int64
 	output := input.Output
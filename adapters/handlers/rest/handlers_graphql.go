@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graphql"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -114,6 +115,9 @@ func setupGraphQLHandlers(
 
 		ctx := params.HTTPRequest.Context()
 		ctx = context.WithValue(ctx, "principal", principal)
+		// only the operation and the queried classes, the query itself may hold
+		// sensitive values in its filters
+		audit.SetFilter(ctx, graphQLAuditFilter(query, operationName))
 
 		result := graphQL.Resolve(ctx, query,
 			operationName, variables)
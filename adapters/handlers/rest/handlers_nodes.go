@@ -130,6 +130,10 @@ func setupNodesHandlers(api *operations.WeaviateAPI,
 		NodesGetClassHandlerFunc(h.getNodesStatusByClass)
 	api.ClusterClusterGetStatisticsHandler = cluster.
 		ClusterGetStatisticsHandlerFunc(h.getNodesStatistics)
+
+	raftManager := nodesUC.NewRaftManager(appState.Logger, appState.Authorizer,
+		appState.ClusterService)
+	setupRaftHandlers(api, raftManager, h.metricRequestsTotal)
 }
 
 type nodesRequestsTotal struct {
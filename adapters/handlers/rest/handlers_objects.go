@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/config"
@@ -78,7 +79,7 @@ type objectsManager interface {
 func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getWriteReplicationProperties(params.ConsistencyLevel, params.XWeaviateConsistencyToken)
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
 		return objects.NewObjectsCreateBadRequest().
@@ -111,7 +112,9 @@ func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 	}
 
 	h.metricRequestsTotal.logOk(className)
-	return objects.NewObjectsCreateOK().WithPayload(object)
+	return objects.NewObjectsCreateOK().
+		WithXWeaviateConsistencyToken(repl.ConsistencyToken.String()).
+		WithPayload(object)
 }
 
 func (h *objectHandlers) validateObject(params objects.ObjectsValidateParams,
@@ -175,7 +178,7 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 		}
 	}
 
-	replProps, err := getReplicationProperties(params.ConsistencyLevel, params.NodeName)
+	replProps, err := getReplicationProperties(params.ConsistencyLevel, params.NodeName, params.XWeaviateConsistencyToken)
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
 		return objects.NewObjectsClassGetBadRequest().
@@ -321,12 +324,13 @@ func (h *objectHandlers) query(params objects.ObjectsListParams,
 func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getWriteReplicationProperties(params.ConsistencyLevel, params.XWeaviateConsistencyToken)
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
 		return objects.NewObjectsCreateBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
+	repl.IfMatch = params.IfMatch
 
 	tenant := getTenant(params.Tenant)
 
@@ -343,6 +347,9 @@ func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 		case uco.ErrMultiTenancy:
 			return objects.NewObjectsClassDeleteUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrPreconditionFailed:
+			return objects.NewObjectsClassDeletePreconditionFailed().
+				WithPayload(errPayloadFromSingleErr(err))
 		default:
 			return objects.NewObjectsClassDeleteInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -350,19 +357,21 @@ func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 	}
 
 	h.metricRequestsTotal.logOk(params.ClassName)
-	return objects.NewObjectsClassDeleteNoContent()
+	return objects.NewObjectsClassDeleteNoContent().
+		WithXWeaviateConsistencyToken(repl.ConsistencyToken.String())
 }
 
 func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 	principal *models.Principal,
 ) middleware.Responder {
 	className := getClassName(params.Body)
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getWriteReplicationProperties(params.ConsistencyLevel, params.XWeaviateConsistencyToken)
 	if err != nil {
 		h.metricRequestsTotal.logError(className, err)
 		return objects.NewObjectsCreateBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
+	repl.IfMatch = params.IfMatch
 
 	object, err := h.manager.UpdateObject(params.HTTPRequest.Context(),
 		principal, params.ClassName, params.ID, params.Body, repl)
@@ -377,6 +386,9 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 		} else if errors.As(err, &autherrs.Forbidden{}) {
 			return objects.NewObjectsClassPutForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &uco.ErrPreconditionFailed{}) {
+			return objects.NewObjectsClassPutPreconditionFailed().
+				WithPayload(errPayloadFromSingleErr(err))
 		} else {
 			return objects.NewObjectsClassPutInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -389,13 +401,15 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 	}
 
 	h.metricRequestsTotal.logOk(className)
-	return objects.NewObjectsClassPutOK().WithPayload(object)
+	return objects.NewObjectsClassPutOK().
+		WithXWeaviateConsistencyToken(repl.ConsistencyToken.String()).
+		WithPayload(object)
 }
 
 func (h *objectHandlers) headObject(params objects.ObjectsClassHeadParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil, params.XWeaviateConsistencyToken)
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
 		return objects.NewObjectsCreateBadRequest().
@@ -436,12 +450,13 @@ func (h *objectHandlers) patchObject(params objects.ObjectsClassPatchParams, pri
 	updates.ID = params.ID
 	updates.Class = params.ClassName
 
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getWriteReplicationProperties(params.ConsistencyLevel, params.XWeaviateConsistencyToken)
 	if err != nil {
 		h.metricRequestsTotal.logError(getClassName(updates), err)
 		return objects.NewObjectsCreateBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
+	repl.IfMatch = params.IfMatch
 
 	objErr := h.manager.MergeObject(params.HTTPRequest.Context(), principal, updates, repl)
 	if objErr != nil {
@@ -458,6 +473,9 @@ func (h *objectHandlers) patchObject(params objects.ObjectsClassPatchParams, pri
 		case objErr.UnprocessableEntity():
 			return objects.NewObjectsClassPatchUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(objErr))
+		case objErr.PreconditionFailed():
+			return objects.NewObjectsClassPatchPreconditionFailed().
+				WithPayload(errPayloadFromSingleErr(objErr))
 		default:
 			return objects.NewObjectsClassPatchInternalServerError().
 				WithPayload(errPayloadFromSingleErr(objErr))
@@ -465,7 +483,8 @@ func (h *objectHandlers) patchObject(params objects.ObjectsClassPatchParams, pri
 	}
 
 	h.metricRequestsTotal.logOk(getClassName(updates))
-	return objects.NewObjectsClassPatchNoContent()
+	return objects.NewObjectsClassPatchNoContent().
+		WithXWeaviateConsistencyToken(repl.ConsistencyToken.String())
 }
 
 func (h *objectHandlers) addObjectReference(
@@ -479,7 +498,7 @@ func (h *objectHandlers) addObjectReference(
 		Ref:      *params.Body,
 	}
 
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
 		return objects.NewObjectsCreateBadRequest().
@@ -522,7 +541,7 @@ func (h *objectHandlers) putObjectReferences(params objects.ObjectsClassReferenc
 		Refs:     params.Body,
 	}
 
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
 		return objects.NewObjectsCreateBadRequest().
@@ -566,7 +585,7 @@ func (h *objectHandlers) deleteObjectReference(params objects.ObjectsClassRefere
 		Reference: *params.Body,
 	}
 
-	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil, nil)
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
 		return objects.NewObjectsCreateBadRequest().
@@ -870,8 +889,8 @@ func getModuleParams(moduleParams map[string]interface{}) map[string]interface{}
 	return moduleParams
 }
 
-func getReplicationProperties(consistencyLvl, nodeName *string) (*additional.ReplicationProperties, error) {
-	if nodeName == nil && consistencyLvl == nil {
+func getReplicationProperties(consistencyLvl, nodeName, token *string) (*additional.ReplicationProperties, error) {
+	if nodeName == nil && consistencyLvl == nil && token == nil {
 		return nil, nil
 	}
 
@@ -890,17 +909,40 @@ func getReplicationProperties(consistencyLvl, nodeName *string) (*additional.Rep
 		return nil, newErrReplication(fmt.Errorf("consistency_level and node_name are mutually exclusive"))
 	}
 
+	if token != nil {
+		repl.ConsistencyToken, err = replication.ParseConsistencyToken(*token)
+		if err != nil {
+			return nil, newErrReplication(err)
+		}
+	}
+
 	return &repl, nil
 }
 
+// getWriteReplicationProperties is like getReplicationProperties, but always
+// returns a consistency token to record the writes of the request in.
+func getWriteReplicationProperties(consistencyLvl, token *string) (*additional.ReplicationProperties, error) {
+	repl, err := getReplicationProperties(consistencyLvl, nil, token)
+	if err != nil {
+		return nil, err
+	}
+	if repl == nil {
+		repl = &additional.ReplicationProperties{}
+	}
+	if repl.ConsistencyToken == nil {
+		repl.ConsistencyToken = replication.NewConsistencyToken()
+	}
+	return repl, nil
+}
+
 func getConsistencyLevel(lvl *string) (string, error) {
 	if lvl != nil {
 		switch replica.ConsistencyLevel(*lvl) {
-		case replica.One, replica.Quorum, replica.All:
+		case replica.One, replica.Quorum, replica.All, replica.BoundedStaleness:
 			return *lvl, nil
 		default:
 			return "", fmt.Errorf("unrecognized consistency level '%v', "+
-				"try one of the following: ['ONE', 'QUORUM', 'ALL']", *lvl)
+				"try one of the following: ['ONE', 'QUORUM', 'ALL', 'BOUNDED_STALENESS']", *lvl)
 		}
 	}
 
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	nodesUC "github.com/weaviate/weaviate/usecases/nodes"
+)
+
+type raftHandlers struct {
+	manager             *nodesUC.RaftManager
+	metricRequestsTotal restApiRequestsTotal
+}
+
+func (h *raftHandlers) getRaft(params cluster.ClusterGetRaftParams, principal *models.Principal) middleware.Responder {
+	status, err := h.manager.GetRaftStatus(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return cluster.NewClusterGetRaftForbidden().WithPayload(errPayloadFromSingleErr(err))
+		}
+		return cluster.NewClusterGetRaftInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return cluster.NewClusterGetRaftOK().WithPayload(status)
+}
+
+func (h *raftHandlers) snapshot(params cluster.ClusterRaftSnapshotParams, principal *models.Principal) middleware.Responder {
+	snapshot, err := h.manager.TakeSnapshot(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return cluster.NewClusterRaftSnapshotForbidden().WithPayload(errPayloadFromSingleErr(err))
+		}
+		return cluster.NewClusterRaftSnapshotInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return cluster.NewClusterRaftSnapshotOK().WithPayload(snapshot)
+}
+
+func (h *raftHandlers) removePeer(params cluster.ClusterRaftRemovePeerParams, principal *models.Principal) middleware.Responder {
+	if err := h.manager.RemovePeer(params.HTTPRequest.Context(), principal, params.ID); err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return cluster.NewClusterRaftRemovePeerForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return cluster.NewClusterRaftRemovePeerNotFound().WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return cluster.NewClusterRaftRemovePeerUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return cluster.NewClusterRaftRemovePeerInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return cluster.NewClusterRaftRemovePeerNoContent()
+}
+
+func (h *raftHandlers) promotePeer(params cluster.ClusterRaftPromotePeerParams, principal *models.Principal) middleware.Responder {
+	if err := h.manager.PromotePeer(params.HTTPRequest.Context(), principal, params.ID); err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return cluster.NewClusterRaftPromotePeerForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return cluster.NewClusterRaftPromotePeerNotFound().WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return cluster.NewClusterRaftPromotePeerUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return cluster.NewClusterRaftPromotePeerInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return cluster.NewClusterRaftPromotePeerNoContent()
+}
+
+func setupRaftHandlers(api *operations.WeaviateAPI, manager *nodesUC.RaftManager,
+	metricRequestsTotal restApiRequestsTotal,
+) {
+	h := &raftHandlers{manager, metricRequestsTotal}
+	api.ClusterClusterGetRaftHandler = cluster.ClusterGetRaftHandlerFunc(h.getRaft)
+	api.ClusterClusterRaftSnapshotHandler = cluster.ClusterRaftSnapshotHandlerFunc(h.snapshot)
+	api.ClusterClusterRaftRemovePeerHandler = cluster.ClusterRaftRemovePeerHandlerFunc(h.removePeer)
+	api.ClusterClusterRaftPromotePeerHandler = cluster.ClusterRaftPromotePeerHandlerFunc(h.promotePeer)
+}
@@ -12,6 +12,7 @@
 package rest
 
 import (
+	stderrors "errors"
 	"fmt"
 
 	"github.com/go-openapi/runtime/middleware"
@@ -53,8 +54,9 @@ func (s *schemaHandlers) addClass(params schema.SchemaObjectsCreateParams,
 func (s *schemaHandlers) updateClass(params schema.SchemaObjectsUpdateParams,
 	principal *models.Principal,
 ) middleware.Responder {
+	rebuildVectorIndex := params.RebuildVectorIndex != nil && *params.RebuildVectorIndex
 	err := s.manager.UpdateClass(params.HTTPRequest.Context(), principal, params.ClassName,
-		params.ObjectClass)
+		params.ObjectClass, rebuildVectorIndex)
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		if err == schemaUC.ErrNotFound {
@@ -137,6 +139,30 @@ func (s *schemaHandlers) addClassProperty(params schema.SchemaObjectsPropertiesA
 	return schema.NewSchemaObjectsPropertiesAddOK().WithPayload(params.Body)
 }
 
+func (s *schemaHandlers) deleteClassProperty(params schema.SchemaObjectsPropertiesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.DeleteClassProperty(params.HTTPRequest.Context(), principal, params.ClassName, params.PropertyName)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		if stderrors.Is(err, schemaUC.ErrNotFound) {
+			return schema.NewSchemaObjectsPropertiesDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsPropertiesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesDeleteBadRequest().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsPropertiesDeleteOK()
+}
+
 func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 	dbSchema, err := s.manager.GetConsistentSchema(principal, *params.Consistency)
 	if err != nil {
@@ -322,6 +348,30 @@ func (s *schemaHandlers) getTenant(
 	return schema.NewTenantsGetOneOK().WithPayload(tenants[0])
 }
 
+func (s *schemaHandlers) tenantUsage(params schema.TenantsUsageParams, principal *models.Principal) middleware.Responder {
+	usage, err := s.manager.TenantUsage(params.HTTPRequest.Context(), principal, params.ClassName, params.TenantName)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		if stderrors.Is(err, schemaUC.ErrNotFound) {
+			return schema.NewTenantsUsageNotFound()
+		}
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewTenantsUsageForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrMultiTenancy:
+			return schema.NewTenantsUsageUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsUsageInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewTenantsUsageOK().WithPayload(usage)
+}
+
 func (s *schemaHandlers) tenantExists(params schema.TenantExistsParams, principal *models.Principal) middleware.Responder {
 	if err := s.manager.ConsistentTenantExists(params.HTTPRequest.Context(), principal, params.ClassName, *params.Consistency, params.TenantName); err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
@@ -341,6 +391,210 @@ func (s *schemaHandlers) tenantExists(params schema.TenantExistsParams, principa
 	return schema.NewTenantExistsOK()
 }
 
+func (s *schemaHandlers) getAliases(params schema.AliasesGetParams, principal *models.Principal) middleware.Responder {
+	var class string
+	if params.Class != nil {
+		class = *params.Class
+	}
+	aliases, err := s.manager.GetAliases(params.HTTPRequest.Context(), principal, class)
+	if err != nil {
+		s.metricRequestsTotal.logError(class, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewAliasesGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewAliasesGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(class)
+	return schema.NewAliasesGetOK().WithPayload(&models.AliasResponse{Aliases: aliases})
+}
+
+func (s *schemaHandlers) getAlias(params schema.AliasesGetAliasParams, principal *models.Principal) middleware.Responder {
+	alias, err := s.manager.GetAlias(params.HTTPRequest.Context(), principal, params.AliasName)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.AliasName, err)
+		if stderrors.Is(err, schemaUC.ErrNotFound) {
+			return schema.NewAliasesGetAliasNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewAliasesGetAliasForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewAliasesGetAliasInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(alias.Class)
+	return schema.NewAliasesGetAliasOK().WithPayload(alias)
+}
+
+func (s *schemaHandlers) addAlias(params schema.AliasesCreateParams, principal *models.Principal) middleware.Responder {
+	alias, err := s.manager.AddAlias(params.HTTPRequest.Context(), principal, params.Body)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.Body.Class, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewAliasesCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewAliasesCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(alias.Class)
+	return schema.NewAliasesCreateOK().WithPayload(alias)
+}
+
+func (s *schemaHandlers) updateAlias(params schema.AliasesUpdateParams, principal *models.Principal) middleware.Responder {
+	alias, err := s.manager.UpdateAlias(params.HTTPRequest.Context(), principal, params.AliasName, params.Body.Class)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.Body.Class, err)
+		if stderrors.Is(err, schemaUC.ErrNotFound) {
+			return schema.NewAliasesUpdateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewAliasesUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewAliasesUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(alias.Class)
+	return schema.NewAliasesUpdateOK().WithPayload(alias)
+}
+
+func (s *schemaHandlers) deleteAlias(params schema.AliasesDeleteParams, principal *models.Principal) middleware.Responder {
+	if err := s.manager.DeleteAlias(params.HTTPRequest.Context(), principal, params.AliasName); err != nil {
+		s.metricRequestsTotal.logError(params.AliasName, err)
+		if stderrors.Is(err, schemaUC.ErrNotFound) {
+			return schema.NewAliasesDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewAliasesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewAliasesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.AliasName)
+	return schema.NewAliasesDeleteOK()
+}
+
+func (s *schemaHandlers) getSchemaHistory(params schema.SchemaHistoryParams, principal *models.Principal) middleware.Responder {
+	var class string
+	if params.Class != nil {
+		class = *params.Class
+	}
+	history, err := s.manager.GetSchemaHistory(params.HTTPRequest.Context(), principal, class)
+	if err != nil {
+		s.metricRequestsTotal.logError(class, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaHistoryForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaHistoryInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(class)
+	return schema.NewSchemaHistoryOK().WithPayload(history)
+}
+
+func (s *schemaHandlers) exportSchemaHistory(params schema.SchemaHistoryExportParams, principal *models.Principal) middleware.Responder {
+	if params.Version < 0 {
+		err := fmt.Errorf("invalid version %d", params.Version)
+		s.metricRequestsTotal.logUserError("")
+		return schema.NewSchemaHistoryExportUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	exported, err := s.manager.GetSchemaAtVersion(params.HTTPRequest.Context(), principal, uint64(params.Version))
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		if stderrors.Is(err, schemaUC.ErrNotFound) {
+			return schema.NewSchemaHistoryExportNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaHistoryExportForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaHistoryExportInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaHistoryExportOK().WithPayload(exported)
+}
+
+func (s *schemaHandlers) diffSchema(params schema.SchemaDiffParams, principal *models.Principal) middleware.Responder {
+	from, err := schemaVersionParam(params.From)
+	if err != nil {
+		s.metricRequestsTotal.logUserError("")
+		return schema.NewSchemaDiffUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	to, err := schemaVersionParam(params.To)
+	if err != nil {
+		s.metricRequestsTotal.logUserError("")
+		return schema.NewSchemaDiffUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	diff, err := s.manager.DiffSchema(params.HTTPRequest.Context(), principal, from, to)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		if stderrors.Is(err, schemaUC.ErrNotFound) {
+			return schema.NewSchemaDiffNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaDiffForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaDiffUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaDiffOK().WithPayload(diff)
+}
+
+// schemaVersionParam converts an optional version query parameter, versions
+// are raft indexes and can't be negative
+func schemaVersionParam(v *int64) (*uint64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if *v < 0 {
+		return nil, fmt.Errorf("invalid version %d", *v)
+	}
+	version := uint64(*v)
+	return &version, nil
+}
+
 func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) {
 	h := &schemaHandlers{manager, newSchemaRequestsTotal(metrics, logger)}
 
@@ -350,6 +604,8 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 		SchemaObjectsDeleteHandlerFunc(h.deleteClass)
 	api.SchemaSchemaObjectsPropertiesAddHandler = schema.
 		SchemaObjectsPropertiesAddHandlerFunc(h.addClassProperty)
+	api.SchemaSchemaObjectsPropertiesDeleteHandler = schema.
+		SchemaObjectsPropertiesDeleteHandlerFunc(h.deleteClassProperty)
 
 	api.SchemaSchemaObjectsUpdateHandler = schema.
 		SchemaObjectsUpdateHandlerFunc(h.updateClass)
@@ -370,6 +626,17 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 	api.SchemaTenantsGetHandler = schema.TenantsGetHandlerFunc(h.getTenants)
 	api.SchemaTenantExistsHandler = schema.TenantExistsHandlerFunc(h.tenantExists)
 	api.SchemaTenantsGetOneHandler = schema.TenantsGetOneHandlerFunc(h.getTenant)
+	api.SchemaTenantsUsageHandler = schema.TenantsUsageHandlerFunc(h.tenantUsage)
+
+	api.SchemaAliasesGetHandler = schema.AliasesGetHandlerFunc(h.getAliases)
+	api.SchemaAliasesGetAliasHandler = schema.AliasesGetAliasHandlerFunc(h.getAlias)
+	api.SchemaAliasesCreateHandler = schema.AliasesCreateHandlerFunc(h.addAlias)
+	api.SchemaAliasesUpdateHandler = schema.AliasesUpdateHandlerFunc(h.updateAlias)
+	api.SchemaAliasesDeleteHandler = schema.AliasesDeleteHandlerFunc(h.deleteAlias)
+
+	api.SchemaSchemaHistoryHandler = schema.SchemaHistoryHandlerFunc(h.getSchemaHistory)
+	api.SchemaSchemaHistoryExportHandler = schema.SchemaHistoryExportHandlerFunc(h.exportSchemaHistory)
+	api.SchemaSchemaDiffHandler = schema.SchemaDiffHandlerFunc(h.diffSchema)
 }
 
 type schemaRequestsTotal struct {
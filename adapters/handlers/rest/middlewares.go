@@ -141,6 +141,8 @@ func makeSetupGlobalMiddleware(appState *state.State, context *middleware.Contex
 		}
 		// Must be the last middleware as it might skip the next handler
 		handler = addClusterHandlerMiddleware(handler, appState)
+		// Wraps the cluster handler, so that cluster requests are audited as well
+		handler = makeAddAuditLogging(appState.AuditLog)(handler)
 		if appState.ServerConfig.Config.Sentry.Enabled {
 			handler = addSentryHandler(handler)
 		}
@@ -24,6 +24,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
@@ -49,10 +50,18 @@ type BatchObjectsCreateParams struct {
 	  In: body
 	*/
 	Body BatchObjectsCreateBody
+	/*If true, each object is only written if its lastUpdateTimeUnix matches the stored object. Objects with lastUpdateTimeUnix unset or 0 are only written if they do not exist yet.
+	  In: query
+	*/
+	Conditional *bool
 	/*Determines how many replicas must acknowledge a request before it is considered successful
 	  In: query
 	*/
 	ConsistencyLevel *string
+	/*Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.
+	  In: header
+	*/
+	XWeaviateConsistencyToken *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -94,16 +103,48 @@ func (o *BatchObjectsCreateParams) BindRequest(r *http.Request, route *middlewar
 		res = append(res, errors.Required("body", "body", ""))
 	}
 
+	qConditional, qhkConditional, _ := qs.GetOK("conditional")
+	if err := o.bindConditional(qConditional, qhkConditional, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qConsistencyLevel, qhkConsistencyLevel, _ := qs.GetOK("consistency_level")
 	if err := o.bindConsistencyLevel(qConsistencyLevel, qhkConsistencyLevel, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	if err := o.bindXWeaviateConsistencyToken(r.Header[http.CanonicalHeaderKey("X-Weaviate-Consistency-Token")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindConditional binds and validates parameter Conditional from query.
+func (o *BatchObjectsCreateParams) bindConditional(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("conditional", "query", "bool", raw)
+	}
+	o.Conditional = &value
+
+	return nil
+}
+
 // bindConsistencyLevel binds and validates parameter ConsistencyLevel from query.
 func (o *BatchObjectsCreateParams) bindConsistencyLevel(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -121,3 +162,20 @@ func (o *BatchObjectsCreateParams) bindConsistencyLevel(rawData []string, hasKey
 
 	return nil
 }
+
+// bindXWeaviateConsistencyToken binds and validates parameter XWeaviateConsistencyToken from header.
+func (o *BatchObjectsCreateParams) bindXWeaviateConsistencyToken(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.XWeaviateConsistencyToken = &raw
+
+	return nil
+}
@@ -33,6 +33,10 @@ BatchObjectsCreateOK Request succeeded, see response body to get detailed inform
 swagger:response batchObjectsCreateOK
 */
 type BatchObjectsCreateOK struct {
+	/*Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes.
+
+	 */
+	XWeaviateConsistencyToken string `json:"X-Weaviate-Consistency-Token"`
 
 	/*
 	  In: Body
@@ -46,6 +50,17 @@ func NewBatchObjectsCreateOK() *BatchObjectsCreateOK {
 	return &BatchObjectsCreateOK{}
 }
 
+// WithXWeaviateConsistencyToken adds the xWeaviateConsistencyToken to the batch objects create o k response
+func (o *BatchObjectsCreateOK) WithXWeaviateConsistencyToken(xWeaviateConsistencyToken string) *BatchObjectsCreateOK {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+	return o
+}
+
+// SetXWeaviateConsistencyToken sets the xWeaviateConsistencyToken to the batch objects create o k response
+func (o *BatchObjectsCreateOK) SetXWeaviateConsistencyToken(xWeaviateConsistencyToken string) {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+}
+
 // WithPayload adds the payload to the batch objects create o k response
 func (o *BatchObjectsCreateOK) WithPayload(payload []*models.ObjectsGetResponse) *BatchObjectsCreateOK {
 	o.Payload = payload
@@ -60,6 +75,13 @@ func (o *BatchObjectsCreateOK) SetPayload(payload []*models.ObjectsGetResponse)
 // WriteResponse to the client
 func (o *BatchObjectsCreateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Weaviate-Consistency-Token
+
+	xWeaviateConsistencyToken := o.XWeaviateConsistencyToken
+	if xWeaviateConsistencyToken != "" {
+		rw.Header().Set("X-Weaviate-Consistency-Token", xWeaviateConsistencyToken)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
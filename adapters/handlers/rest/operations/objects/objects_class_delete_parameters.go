@@ -23,6 +23,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
@@ -57,10 +58,18 @@ type ObjectsClassDeleteParams struct {
 	  In: path
 	*/
 	ID strfmt.UUID
+	/*Only apply the write if the stored object was last updated at exactly this unix time in milliseconds (its lastUpdateTimeUnix). 0 requires that the object does not exist.
+	  In: header
+	*/
+	IfMatch *int64
 	/*Specifies the tenant in a request targeting a multi-tenant class
 	  In: query
 	*/
 	Tenant *string
+	/*Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.
+	  In: header
+	*/
+	XWeaviateConsistencyToken *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -89,10 +98,18 @@ func (o *ObjectsClassDeleteParams) BindRequest(r *http.Request, route *middlewar
 		res = append(res, err)
 	}
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qTenant, qhkTenant, _ := qs.GetOK("tenant")
 	if err := o.bindTenant(qTenant, qhkTenant, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	if err := o.bindXWeaviateConsistencyToken(r.Header[http.CanonicalHeaderKey("X-Weaviate-Consistency-Token")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -164,6 +181,28 @@ func (o *ObjectsClassDeleteParams) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *ObjectsClassDeleteParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("If-Match", "header", "int64", raw)
+	}
+	o.IfMatch = &value
+
+	return nil
+}
+
 // bindTenant binds and validates parameter Tenant from query.
 func (o *ObjectsClassDeleteParams) bindTenant(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -181,3 +220,20 @@ func (o *ObjectsClassDeleteParams) bindTenant(rawData []string, hasKey bool, for
 
 	return nil
 }
+
+// bindXWeaviateConsistencyToken binds and validates parameter XWeaviateConsistencyToken from header.
+func (o *ObjectsClassDeleteParams) bindXWeaviateConsistencyToken(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.XWeaviateConsistencyToken = &raw
+
+	return nil
+}
@@ -33,6 +33,10 @@ ObjectsClassDeleteNoContent Successfully deleted.
 swagger:response objectsClassDeleteNoContent
 */
 type ObjectsClassDeleteNoContent struct {
+	/*Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes.
+
+	 */
+	XWeaviateConsistencyToken string `json:"X-Weaviate-Consistency-Token"`
 }
 
 // NewObjectsClassDeleteNoContent creates ObjectsClassDeleteNoContent with default headers values
@@ -41,9 +45,27 @@ func NewObjectsClassDeleteNoContent() *ObjectsClassDeleteNoContent {
 	return &ObjectsClassDeleteNoContent{}
 }
 
+// WithXWeaviateConsistencyToken adds the xWeaviateConsistencyToken to the objects class delete no content response
+func (o *ObjectsClassDeleteNoContent) WithXWeaviateConsistencyToken(xWeaviateConsistencyToken string) *ObjectsClassDeleteNoContent {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+	return o
+}
+
+// SetXWeaviateConsistencyToken sets the xWeaviateConsistencyToken to the objects class delete no content response
+func (o *ObjectsClassDeleteNoContent) SetXWeaviateConsistencyToken(xWeaviateConsistencyToken string) {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+}
+
 // WriteResponse to the client
 func (o *ObjectsClassDeleteNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Weaviate-Consistency-Token
+
+	xWeaviateConsistencyToken := o.XWeaviateConsistencyToken
+	if xWeaviateConsistencyToken != "" {
+		rw.Header().Set("X-Weaviate-Consistency-Token", xWeaviateConsistencyToken)
+	}
+
 	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
 
 	rw.WriteHeader(204)
@@ -189,6 +211,51 @@ func (o *ObjectsClassDeleteNotFound) WriteResponse(rw http.ResponseWriter, produ
 	rw.WriteHeader(404)
 }
 
+// ObjectsClassDeletePreconditionFailedCode is the HTTP code returned for type ObjectsClassDeletePreconditionFailed
+const ObjectsClassDeletePreconditionFailedCode int = 412
+
+/*
+ObjectsClassDeletePreconditionFailed The precondition given in the If-Match header is not met, the object has been modified or deleted in the meantime.
+
+swagger:response objectsClassDeletePreconditionFailed
+*/
+type ObjectsClassDeletePreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassDeletePreconditionFailed creates ObjectsClassDeletePreconditionFailed with default headers values
+func NewObjectsClassDeletePreconditionFailed() *ObjectsClassDeletePreconditionFailed {
+
+	return &ObjectsClassDeletePreconditionFailed{}
+}
+
+// WithPayload adds the payload to the objects class delete precondition failed response
+func (o *ObjectsClassDeletePreconditionFailed) WithPayload(payload *models.ErrorResponse) *ObjectsClassDeletePreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class delete precondition failed response
+func (o *ObjectsClassDeletePreconditionFailed) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassDeletePreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassDeleteUnprocessableEntityCode is the HTTP code returned for type ObjectsClassDeleteUnprocessableEntity
 const ObjectsClassDeleteUnprocessableEntityCode int = 422
 
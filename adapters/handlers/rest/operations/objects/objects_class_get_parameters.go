@@ -69,6 +69,10 @@ type ObjectsClassGetParams struct {
 	  In: query
 	*/
 	Tenant *string
+	/*Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.
+	  In: header
+	*/
+	XWeaviateConsistencyToken *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -111,6 +115,10 @@ func (o *ObjectsClassGetParams) BindRequest(r *http.Request, route *middleware.M
 	if err := o.bindTenant(qTenant, qhkTenant, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	if err := o.bindXWeaviateConsistencyToken(r.Header[http.CanonicalHeaderKey("X-Weaviate-Consistency-Token")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -235,3 +243,20 @@ func (o *ObjectsClassGetParams) bindTenant(rawData []string, hasKey bool, format
 
 	return nil
 }
+
+// bindXWeaviateConsistencyToken binds and validates parameter XWeaviateConsistencyToken from header.
+func (o *ObjectsClassGetParams) bindXWeaviateConsistencyToken(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.XWeaviateConsistencyToken = &raw
+
+	return nil
+}
@@ -61,6 +61,10 @@ type ObjectsClassHeadParams struct {
 	  In: query
 	*/
 	Tenant *string
+	/*Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.
+	  In: header
+	*/
+	XWeaviateConsistencyToken *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -93,6 +97,10 @@ func (o *ObjectsClassHeadParams) BindRequest(r *http.Request, route *middleware.
 	if err := o.bindTenant(qTenant, qhkTenant, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	if err := o.bindXWeaviateConsistencyToken(r.Header[http.CanonicalHeaderKey("X-Weaviate-Consistency-Token")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -181,3 +189,20 @@ func (o *ObjectsClassHeadParams) bindTenant(rawData []string, hasKey bool, forma
 
 	return nil
 }
+
+// bindXWeaviateConsistencyToken binds and validates parameter XWeaviateConsistencyToken from header.
+func (o *ObjectsClassHeadParams) bindXWeaviateConsistencyToken(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.XWeaviateConsistencyToken = &raw
+
+	return nil
+}
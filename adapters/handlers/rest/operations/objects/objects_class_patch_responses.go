@@ -33,6 +33,10 @@ ObjectsClassPatchNoContent Successfully applied. No content provided.
 swagger:response objectsClassPatchNoContent
 */
 type ObjectsClassPatchNoContent struct {
+	/*Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes.
+
+	 */
+	XWeaviateConsistencyToken string `json:"X-Weaviate-Consistency-Token"`
 }
 
 // NewObjectsClassPatchNoContent creates ObjectsClassPatchNoContent with default headers values
@@ -41,9 +45,27 @@ func NewObjectsClassPatchNoContent() *ObjectsClassPatchNoContent {
 	return &ObjectsClassPatchNoContent{}
 }
 
+// WithXWeaviateConsistencyToken adds the xWeaviateConsistencyToken to the objects class patch no content response
+func (o *ObjectsClassPatchNoContent) WithXWeaviateConsistencyToken(xWeaviateConsistencyToken string) *ObjectsClassPatchNoContent {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+	return o
+}
+
+// SetXWeaviateConsistencyToken sets the xWeaviateConsistencyToken to the objects class patch no content response
+func (o *ObjectsClassPatchNoContent) SetXWeaviateConsistencyToken(xWeaviateConsistencyToken string) {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+}
+
 // WriteResponse to the client
 func (o *ObjectsClassPatchNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Weaviate-Consistency-Token
+
+	xWeaviateConsistencyToken := o.XWeaviateConsistencyToken
+	if xWeaviateConsistencyToken != "" {
+		rw.Header().Set("X-Weaviate-Consistency-Token", xWeaviateConsistencyToken)
+	}
+
 	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
 
 	rw.WriteHeader(204)
@@ -189,6 +211,51 @@ func (o *ObjectsClassPatchNotFound) WriteResponse(rw http.ResponseWriter, produc
 	rw.WriteHeader(404)
 }
 
+// ObjectsClassPatchPreconditionFailedCode is the HTTP code returned for type ObjectsClassPatchPreconditionFailed
+const ObjectsClassPatchPreconditionFailedCode int = 412
+
+/*
+ObjectsClassPatchPreconditionFailed The precondition given in the If-Match header is not met, the object has been modified or deleted in the meantime.
+
+swagger:response objectsClassPatchPreconditionFailed
+*/
+type ObjectsClassPatchPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassPatchPreconditionFailed creates ObjectsClassPatchPreconditionFailed with default headers values
+func NewObjectsClassPatchPreconditionFailed() *ObjectsClassPatchPreconditionFailed {
+
+	return &ObjectsClassPatchPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the objects class patch precondition failed response
+func (o *ObjectsClassPatchPreconditionFailed) WithPayload(payload *models.ErrorResponse) *ObjectsClassPatchPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class patch precondition failed response
+func (o *ObjectsClassPatchPreconditionFailed) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassPatchPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassPatchUnprocessableEntityCode is the HTTP code returned for type ObjectsClassPatchUnprocessableEntity
 const ObjectsClassPatchUnprocessableEntityCode int = 422
 
@@ -33,6 +33,10 @@ ObjectsClassPutOK Successfully received.
 swagger:response objectsClassPutOK
 */
 type ObjectsClassPutOK struct {
+	/*Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes.
+
+	 */
+	XWeaviateConsistencyToken string `json:"X-Weaviate-Consistency-Token"`
 
 	/*
 	  In: Body
@@ -46,6 +50,17 @@ func NewObjectsClassPutOK() *ObjectsClassPutOK {
 	return &ObjectsClassPutOK{}
 }
 
+// WithXWeaviateConsistencyToken adds the xWeaviateConsistencyToken to the objects class put o k response
+func (o *ObjectsClassPutOK) WithXWeaviateConsistencyToken(xWeaviateConsistencyToken string) *ObjectsClassPutOK {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+	return o
+}
+
+// SetXWeaviateConsistencyToken sets the xWeaviateConsistencyToken to the objects class put o k response
+func (o *ObjectsClassPutOK) SetXWeaviateConsistencyToken(xWeaviateConsistencyToken string) {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+}
+
 // WithPayload adds the payload to the objects class put o k response
 func (o *ObjectsClassPutOK) WithPayload(payload *models.Object) *ObjectsClassPutOK {
 	o.Payload = payload
@@ -60,6 +75,13 @@ func (o *ObjectsClassPutOK) SetPayload(payload *models.Object) {
 // WriteResponse to the client
 func (o *ObjectsClassPutOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Weaviate-Consistency-Token
+
+	xWeaviateConsistencyToken := o.XWeaviateConsistencyToken
+	if xWeaviateConsistencyToken != "" {
+		rw.Header().Set("X-Weaviate-Consistency-Token", xWeaviateConsistencyToken)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -164,6 +186,51 @@ func (o *ObjectsClassPutNotFound) WriteResponse(rw http.ResponseWriter, producer
 	rw.WriteHeader(404)
 }
 
+// ObjectsClassPutPreconditionFailedCode is the HTTP code returned for type ObjectsClassPutPreconditionFailed
+const ObjectsClassPutPreconditionFailedCode int = 412
+
+/*
+ObjectsClassPutPreconditionFailed The precondition given in the If-Match header is not met, the object has been modified or deleted in the meantime.
+
+swagger:response objectsClassPutPreconditionFailed
+*/
+type ObjectsClassPutPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassPutPreconditionFailed creates ObjectsClassPutPreconditionFailed with default headers values
+func NewObjectsClassPutPreconditionFailed() *ObjectsClassPutPreconditionFailed {
+
+	return &ObjectsClassPutPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the objects class put precondition failed response
+func (o *ObjectsClassPutPreconditionFailed) WithPayload(payload *models.ErrorResponse) *ObjectsClassPutPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class put precondition failed response
+func (o *ObjectsClassPutPreconditionFailed) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassPutPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassPutUnprocessableEntityCode is the HTTP code returned for type ObjectsClassPutUnprocessableEntity
 const ObjectsClassPutUnprocessableEntityCode int = 422
 
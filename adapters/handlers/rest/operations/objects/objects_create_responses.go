@@ -33,6 +33,10 @@ ObjectsCreateOK Object created.
 swagger:response objectsCreateOK
 */
 type ObjectsCreateOK struct {
+	/*Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes.
+
+	 */
+	XWeaviateConsistencyToken string `json:"X-Weaviate-Consistency-Token"`
 
 	/*
 	  In: Body
@@ -46,6 +50,17 @@ func NewObjectsCreateOK() *ObjectsCreateOK {
 	return &ObjectsCreateOK{}
 }
 
+// WithXWeaviateConsistencyToken adds the xWeaviateConsistencyToken to the objects create o k response
+func (o *ObjectsCreateOK) WithXWeaviateConsistencyToken(xWeaviateConsistencyToken string) *ObjectsCreateOK {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+	return o
+}
+
+// SetXWeaviateConsistencyToken sets the xWeaviateConsistencyToken to the objects create o k response
+func (o *ObjectsCreateOK) SetXWeaviateConsistencyToken(xWeaviateConsistencyToken string) {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+}
+
 // WithPayload adds the payload to the objects create o k response
 func (o *ObjectsCreateOK) WithPayload(payload *models.Object) *ObjectsCreateOK {
 	o.Payload = payload
@@ -60,6 +75,13 @@ func (o *ObjectsCreateOK) SetPayload(payload *models.Object) {
 // WriteResponse to the client
 func (o *ObjectsCreateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Weaviate-Consistency-Token
+
+	xWeaviateConsistencyToken := o.XWeaviateConsistencyToken
+	if xWeaviateConsistencyToken != "" {
+		rw.Header().Set("X-Weaviate-Consistency-Token", xWeaviateConsistencyToken)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
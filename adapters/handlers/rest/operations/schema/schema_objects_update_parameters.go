@@ -24,6 +24,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 
 	"github.com/weaviate/weaviate/entities/models"
@@ -56,6 +57,10 @@ type SchemaObjectsUpdateParams struct {
 	  In: body
 	*/
 	ObjectClass *models.Class
+	/*If true, changes of the vector index config which can only be applied by rebuilding the vector index, such as a different index type, distance or compression, are applied by rebuilding it in the background. Such changes are rejected otherwise.
+	  In: query
+	*/
+	RebuildVectorIndex *bool
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -67,6 +72,8 @@ func (o *SchemaObjectsUpdateParams) BindRequest(r *http.Request, route *middlewa
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rClassName, rhkClassName, _ := route.Params.GetOK("className")
 	if err := o.bindClassName(rClassName, rhkClassName, route.Formats); err != nil {
 		res = append(res, err)
@@ -99,6 +106,11 @@ func (o *SchemaObjectsUpdateParams) BindRequest(r *http.Request, route *middlewa
 	} else {
 		res = append(res, errors.Required("objectClass", "body", ""))
 	}
+
+	qRebuildVectorIndex, qhkRebuildVectorIndex, _ := qs.GetOK("rebuildVectorIndex")
+	if err := o.bindRebuildVectorIndex(qRebuildVectorIndex, qhkRebuildVectorIndex, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -118,3 +130,26 @@ func (o *SchemaObjectsUpdateParams) bindClassName(rawData []string, hasKey bool,
 
 	return nil
 }
+
+// bindRebuildVectorIndex binds and validates parameter RebuildVectorIndex from query.
+func (o *SchemaObjectsUpdateParams) bindRebuildVectorIndex(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("rebuildVectorIndex", "query", "bool", raw)
+	}
+	o.RebuildVectorIndex = &value
+
+	return nil
+}
@@ -21,12 +21,16 @@ import (
 	"net/url"
 	golangswaggerpaths "path"
 	"strings"
+
+	"github.com/go-openapi/swag"
 )
 
 // SchemaObjectsUpdateURL generates an URL for the schema objects update operation
 type SchemaObjectsUpdateURL struct {
 	ClassName string
 
+	RebuildVectorIndex *bool
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -66,6 +70,18 @@ func (o *SchemaObjectsUpdateURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var rebuildVectorIndexQ string
+	if o.RebuildVectorIndex != nil {
+		rebuildVectorIndexQ = swag.FormatBool(*o.RebuildVectorIndex)
+	}
+	if rebuildVectorIndexQ != "" {
+		qs.Set("rebuildVectorIndex", rebuildVectorIndexQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -95,6 +95,21 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		BackupsBackupsRestoreStatusHandler: backups.BackupsRestoreStatusHandlerFunc(func(params backups.BackupsRestoreStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsRestoreStatus has not yet been implemented")
 		}),
+		BackupsBackupsSchedulesCreateHandler: backups.BackupsSchedulesCreateHandlerFunc(func(params backups.BackupsSchedulesCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesCreate has not yet been implemented")
+		}),
+		BackupsBackupsSchedulesDeleteHandler: backups.BackupsSchedulesDeleteHandlerFunc(func(params backups.BackupsSchedulesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesDelete has not yet been implemented")
+		}),
+		BackupsBackupsSchedulesGetHandler: backups.BackupsSchedulesGetHandlerFunc(func(params backups.BackupsSchedulesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesGet has not yet been implemented")
+		}),
+		BackupsBackupsSchedulesListHandler: backups.BackupsSchedulesListHandlerFunc(func(params backups.BackupsSchedulesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesList has not yet been implemented")
+		}),
+		BackupsBackupsSchedulesUpdateHandler: backups.BackupsSchedulesUpdateHandlerFunc(func(params backups.BackupsSchedulesUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesUpdate has not yet been implemented")
+		}),
 		BatchBatchObjectsCreateHandler: batch.BatchObjectsCreateHandlerFunc(func(params batch.BatchObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchObjectsCreate has not yet been implemented")
 		}),
@@ -110,9 +125,21 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ClassificationsClassificationsPostHandler: classifications.ClassificationsPostHandlerFunc(func(params classifications.ClassificationsPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation classifications.ClassificationsPost has not yet been implemented")
 		}),
+		ClusterClusterGetRaftHandler: cluster.ClusterGetRaftHandlerFunc(func(params cluster.ClusterGetRaftParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterGetRaft has not yet been implemented")
+		}),
 		ClusterClusterGetStatisticsHandler: cluster.ClusterGetStatisticsHandlerFunc(func(params cluster.ClusterGetStatisticsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation cluster.ClusterGetStatistics has not yet been implemented")
 		}),
+		ClusterClusterRaftPromotePeerHandler: cluster.ClusterRaftPromotePeerHandlerFunc(func(params cluster.ClusterRaftPromotePeerParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterRaftPromotePeer has not yet been implemented")
+		}),
+		ClusterClusterRaftRemovePeerHandler: cluster.ClusterRaftRemovePeerHandlerFunc(func(params cluster.ClusterRaftRemovePeerParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterRaftRemovePeer has not yet been implemented")
+		}),
+		ClusterClusterRaftSnapshotHandler: cluster.ClusterRaftSnapshotHandlerFunc(func(params cluster.ClusterRaftSnapshotParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterRaftSnapshot has not yet been implemented")
+		}),
 		AuthzCreateRoleHandler: authz.CreateRoleHandlerFunc(func(params authz.CreateRoleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation authz.CreateRole has not yet been implemented")
 		}),
@@ -209,9 +236,33 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		AuthzRevokeRoleHandler: authz.RevokeRoleHandlerFunc(func(params authz.RevokeRoleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation authz.RevokeRole has not yet been implemented")
 		}),
+		SchemaAliasesCreateHandler: schema.AliasesCreateHandlerFunc(func(params schema.AliasesCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.AliasesCreate has not yet been implemented")
+		}),
+		SchemaAliasesDeleteHandler: schema.AliasesDeleteHandlerFunc(func(params schema.AliasesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.AliasesDelete has not yet been implemented")
+		}),
+		SchemaAliasesGetHandler: schema.AliasesGetHandlerFunc(func(params schema.AliasesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.AliasesGet has not yet been implemented")
+		}),
+		SchemaAliasesGetAliasHandler: schema.AliasesGetAliasHandlerFunc(func(params schema.AliasesGetAliasParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.AliasesGetAlias has not yet been implemented")
+		}),
+		SchemaAliasesUpdateHandler: schema.AliasesUpdateHandlerFunc(func(params schema.AliasesUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.AliasesUpdate has not yet been implemented")
+		}),
+		SchemaSchemaDiffHandler: schema.SchemaDiffHandlerFunc(func(params schema.SchemaDiffParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaDiff has not yet been implemented")
+		}),
 		SchemaSchemaDumpHandler: schema.SchemaDumpHandlerFunc(func(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaDump has not yet been implemented")
 		}),
+		SchemaSchemaHistoryHandler: schema.SchemaHistoryHandlerFunc(func(params schema.SchemaHistoryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaHistory has not yet been implemented")
+		}),
+		SchemaSchemaHistoryExportHandler: schema.SchemaHistoryExportHandlerFunc(func(params schema.SchemaHistoryExportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaHistoryExport has not yet been implemented")
+		}),
 		SchemaSchemaObjectsCreateHandler: schema.SchemaObjectsCreateHandlerFunc(func(params schema.SchemaObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsCreate has not yet been implemented")
 		}),
@@ -224,6 +275,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsPropertiesAddHandler: schema.SchemaObjectsPropertiesAddHandlerFunc(func(params schema.SchemaObjectsPropertiesAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesAdd has not yet been implemented")
 		}),
+		SchemaSchemaObjectsPropertiesDeleteHandler: schema.SchemaObjectsPropertiesDeleteHandlerFunc(func(params schema.SchemaObjectsPropertiesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesDelete has not yet been implemented")
+		}),
 		SchemaSchemaObjectsShardsGetHandler: schema.SchemaObjectsShardsGetHandlerFunc(func(params schema.SchemaObjectsShardsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsShardsGet has not yet been implemented")
 		}),
@@ -251,6 +305,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaTenantsUpdateHandler: schema.TenantsUpdateHandlerFunc(func(params schema.TenantsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.TenantsUpdate has not yet been implemented")
 		}),
+		SchemaTenantsUsageHandler: schema.TenantsUsageHandlerFunc(func(params schema.TenantsUsageParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsUsage has not yet been implemented")
+		}),
 		WeaviateRootHandler: WeaviateRootHandlerFunc(func(params WeaviateRootParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation WeaviateRoot has not yet been implemented")
 		}),
@@ -345,6 +402,16 @@ type WeaviateAPI struct {
 	BackupsBackupsRestoreHandler backups.BackupsRestoreHandler
 	// BackupsBackupsRestoreStatusHandler sets the operation handler for the backups restore status operation
 	BackupsBackupsRestoreStatusHandler backups.BackupsRestoreStatusHandler
+	// BackupsBackupsSchedulesCreateHandler sets the operation handler for the backups schedules create operation
+	BackupsBackupsSchedulesCreateHandler backups.BackupsSchedulesCreateHandler
+	// BackupsBackupsSchedulesDeleteHandler sets the operation handler for the backups schedules delete operation
+	BackupsBackupsSchedulesDeleteHandler backups.BackupsSchedulesDeleteHandler
+	// BackupsBackupsSchedulesGetHandler sets the operation handler for the backups schedules get operation
+	BackupsBackupsSchedulesGetHandler backups.BackupsSchedulesGetHandler
+	// BackupsBackupsSchedulesListHandler sets the operation handler for the backups schedules list operation
+	BackupsBackupsSchedulesListHandler backups.BackupsSchedulesListHandler
+	// BackupsBackupsSchedulesUpdateHandler sets the operation handler for the backups schedules update operation
+	BackupsBackupsSchedulesUpdateHandler backups.BackupsSchedulesUpdateHandler
 	// BatchBatchObjectsCreateHandler sets the operation handler for the batch objects create operation
 	BatchBatchObjectsCreateHandler batch.BatchObjectsCreateHandler
 	// BatchBatchObjectsDeleteHandler sets the operation handler for the batch objects delete operation
@@ -355,8 +422,16 @@ type WeaviateAPI struct {
 	ClassificationsClassificationsGetHandler classifications.ClassificationsGetHandler
 	// ClassificationsClassificationsPostHandler sets the operation handler for the classifications post operation
 	ClassificationsClassificationsPostHandler classifications.ClassificationsPostHandler
+	// ClusterClusterGetRaftHandler sets the operation handler for the cluster get raft operation
+	ClusterClusterGetRaftHandler cluster.ClusterGetRaftHandler
 	// ClusterClusterGetStatisticsHandler sets the operation handler for the cluster get statistics operation
 	ClusterClusterGetStatisticsHandler cluster.ClusterGetStatisticsHandler
+	// ClusterClusterRaftPromotePeerHandler sets the operation handler for the cluster raft promote peer operation
+	ClusterClusterRaftPromotePeerHandler cluster.ClusterRaftPromotePeerHandler
+	// ClusterClusterRaftRemovePeerHandler sets the operation handler for the cluster raft remove peer operation
+	ClusterClusterRaftRemovePeerHandler cluster.ClusterRaftRemovePeerHandler
+	// ClusterClusterRaftSnapshotHandler sets the operation handler for the cluster raft snapshot operation
+	ClusterClusterRaftSnapshotHandler cluster.ClusterRaftSnapshotHandler
 	// AuthzCreateRoleHandler sets the operation handler for the create role operation
 	AuthzCreateRoleHandler authz.CreateRoleHandler
 	// AuthzDeleteRoleHandler sets the operation handler for the delete role operation
@@ -421,8 +496,24 @@ type WeaviateAPI struct {
 	AuthzRemovedPermissionHandler authz.RemovedPermissionHandler
 	// AuthzRevokeRoleHandler sets the operation handler for the revoke role operation
 	AuthzRevokeRoleHandler authz.RevokeRoleHandler
+	// SchemaAliasesCreateHandler sets the operation handler for the aliases create operation
+	SchemaAliasesCreateHandler schema.AliasesCreateHandler
+	// SchemaAliasesDeleteHandler sets the operation handler for the aliases delete operation
+	SchemaAliasesDeleteHandler schema.AliasesDeleteHandler
+	// SchemaAliasesGetHandler sets the operation handler for the aliases get operation
+	SchemaAliasesGetHandler schema.AliasesGetHandler
+	// SchemaAliasesGetAliasHandler sets the operation handler for the aliases get alias operation
+	SchemaAliasesGetAliasHandler schema.AliasesGetAliasHandler
+	// SchemaAliasesUpdateHandler sets the operation handler for the aliases update operation
+	SchemaAliasesUpdateHandler schema.AliasesUpdateHandler
+	// SchemaSchemaDiffHandler sets the operation handler for the schema diff operation
+	SchemaSchemaDiffHandler schema.SchemaDiffHandler
 	// SchemaSchemaDumpHandler sets the operation handler for the schema dump operation
 	SchemaSchemaDumpHandler schema.SchemaDumpHandler
+	// SchemaSchemaHistoryHandler sets the operation handler for the schema history operation
+	SchemaSchemaHistoryHandler schema.SchemaHistoryHandler
+	// SchemaSchemaHistoryExportHandler sets the operation handler for the schema history export operation
+	SchemaSchemaHistoryExportHandler schema.SchemaHistoryExportHandler
 	// SchemaSchemaObjectsCreateHandler sets the operation handler for the schema objects create operation
 	SchemaSchemaObjectsCreateHandler schema.SchemaObjectsCreateHandler
 	// SchemaSchemaObjectsDeleteHandler sets the operation handler for the schema objects delete operation
@@ -431,6 +522,8 @@ type WeaviateAPI struct {
 	SchemaSchemaObjectsGetHandler schema.SchemaObjectsGetHandler
 	// SchemaSchemaObjectsPropertiesAddHandler sets the operation handler for the schema objects properties add operation
 	SchemaSchemaObjectsPropertiesAddHandler schema.SchemaObjectsPropertiesAddHandler
+	// SchemaSchemaObjectsPropertiesDeleteHandler sets the operation handler for the schema objects properties delete operation
+	SchemaSchemaObjectsPropertiesDeleteHandler schema.SchemaObjectsPropertiesDeleteHandler
 	// SchemaSchemaObjectsShardsGetHandler sets the operation handler for the schema objects shards get operation
 	SchemaSchemaObjectsShardsGetHandler schema.SchemaObjectsShardsGetHandler
 	// SchemaSchemaObjectsShardsUpdateHandler sets the operation handler for the schema objects shards update operation
@@ -449,6 +542,8 @@ type WeaviateAPI struct {
 	SchemaTenantsGetOneHandler schema.TenantsGetOneHandler
 	// SchemaTenantsUpdateHandler sets the operation handler for the tenants update operation
 	SchemaTenantsUpdateHandler schema.TenantsUpdateHandler
+	// SchemaTenantsUsageHandler sets the operation handler for the tenants usage operation
+	SchemaTenantsUsageHandler schema.TenantsUsageHandler
 	// WeaviateRootHandler sets the operation handler for the weaviate root operation
 	WeaviateRootHandler WeaviateRootHandler
 	// WeaviateWellknownLivenessHandler sets the operation handler for the weaviate wellknown liveness operation
@@ -566,6 +661,21 @@ func (o *WeaviateAPI) Validate() error {
 	if o.BackupsBackupsRestoreStatusHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsRestoreStatusHandler")
 	}
+	if o.BackupsBackupsSchedulesCreateHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesCreateHandler")
+	}
+	if o.BackupsBackupsSchedulesDeleteHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesDeleteHandler")
+	}
+	if o.BackupsBackupsSchedulesGetHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesGetHandler")
+	}
+	if o.BackupsBackupsSchedulesListHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesListHandler")
+	}
+	if o.BackupsBackupsSchedulesUpdateHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesUpdateHandler")
+	}
 	if o.BatchBatchObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchObjectsCreateHandler")
 	}
@@ -581,9 +691,21 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ClassificationsClassificationsPostHandler == nil {
 		unregistered = append(unregistered, "classifications.ClassificationsPostHandler")
 	}
+	if o.ClusterClusterGetRaftHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterGetRaftHandler")
+	}
 	if o.ClusterClusterGetStatisticsHandler == nil {
 		unregistered = append(unregistered, "cluster.ClusterGetStatisticsHandler")
 	}
+	if o.ClusterClusterRaftPromotePeerHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterRaftPromotePeerHandler")
+	}
+	if o.ClusterClusterRaftRemovePeerHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterRaftRemovePeerHandler")
+	}
+	if o.ClusterClusterRaftSnapshotHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterRaftSnapshotHandler")
+	}
 	if o.AuthzCreateRoleHandler == nil {
 		unregistered = append(unregistered, "authz.CreateRoleHandler")
 	}
@@ -680,9 +802,33 @@ func (o *WeaviateAPI) Validate() error {
 	if o.AuthzRevokeRoleHandler == nil {
 		unregistered = append(unregistered, "authz.RevokeRoleHandler")
 	}
+	if o.SchemaAliasesCreateHandler == nil {
+		unregistered = append(unregistered, "schema.AliasesCreateHandler")
+	}
+	if o.SchemaAliasesDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.AliasesDeleteHandler")
+	}
+	if o.SchemaAliasesGetHandler == nil {
+		unregistered = append(unregistered, "schema.AliasesGetHandler")
+	}
+	if o.SchemaAliasesGetAliasHandler == nil {
+		unregistered = append(unregistered, "schema.AliasesGetAliasHandler")
+	}
+	if o.SchemaAliasesUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.AliasesUpdateHandler")
+	}
+	if o.SchemaSchemaDiffHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaDiffHandler")
+	}
 	if o.SchemaSchemaDumpHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaDumpHandler")
 	}
+	if o.SchemaSchemaHistoryHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaHistoryHandler")
+	}
+	if o.SchemaSchemaHistoryExportHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaHistoryExportHandler")
+	}
 	if o.SchemaSchemaObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsCreateHandler")
 	}
@@ -695,6 +841,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsPropertiesAddHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesAddHandler")
 	}
+	if o.SchemaSchemaObjectsPropertiesDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesDeleteHandler")
+	}
 	if o.SchemaSchemaObjectsShardsGetHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsShardsGetHandler")
 	}
@@ -722,6 +871,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaTenantsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.TenantsUpdateHandler")
 	}
+	if o.SchemaTenantsUsageHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsUsageHandler")
+	}
 	if o.WeaviateRootHandler == nil {
 		unregistered = append(unregistered, "WeaviateRootHandler")
 	}
@@ -870,6 +1022,26 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/backups/schedules"] = backups.NewBackupsSchedulesCreate(o.context, o.BackupsBackupsSchedulesCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/backups/schedules/{name}"] = backups.NewBackupsSchedulesDelete(o.context, o.BackupsBackupsSchedulesDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/backups/schedules/{name}"] = backups.NewBackupsSchedulesGet(o.context, o.BackupsBackupsSchedulesGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/backups/schedules"] = backups.NewBackupsSchedulesList(o.context, o.BackupsBackupsSchedulesListHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/backups/schedules/{name}"] = backups.NewBackupsSchedulesUpdate(o.context, o.BackupsBackupsSchedulesUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/batch/objects"] = batch.NewBatchObjectsCreate(o.context, o.BatchBatchObjectsCreateHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -890,10 +1062,26 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/cluster/raft"] = cluster.NewClusterGetRaft(o.context, o.ClusterClusterGetRaftHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/cluster/statistics"] = cluster.NewClusterGetStatistics(o.context, o.ClusterClusterGetStatisticsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/cluster/raft/peers/{id}/promote"] = cluster.NewClusterRaftPromotePeer(o.context, o.ClusterClusterRaftPromotePeerHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/cluster/raft/peers/{id}"] = cluster.NewClusterRaftRemovePeer(o.context, o.ClusterClusterRaftRemovePeerHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/cluster/raft/snapshot"] = cluster.NewClusterRaftSnapshot(o.context, o.ClusterClusterRaftSnapshotHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/authz/roles"] = authz.NewCreateRole(o.context, o.AuthzCreateRoleHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1019,10 +1207,42 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/authz/users/{id}/revoke"] = authz.NewRevokeRole(o.context, o.AuthzRevokeRoleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/aliases"] = schema.NewAliasesCreate(o.context, o.SchemaAliasesCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/aliases/{aliasName}"] = schema.NewAliasesDelete(o.context, o.SchemaAliasesDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/aliases"] = schema.NewAliasesGet(o.context, o.SchemaAliasesGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/aliases/{aliasName}"] = schema.NewAliasesGetAlias(o.context, o.SchemaAliasesGetAliasHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/aliases/{aliasName}"] = schema.NewAliasesUpdate(o.context, o.SchemaAliasesUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/diff"] = schema.NewSchemaDiff(o.context, o.SchemaSchemaDiffHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/schema"] = schema.NewSchemaDump(o.context, o.SchemaSchemaDumpHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/history"] = schema.NewSchemaHistory(o.context, o.SchemaSchemaHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/history/{version}"] = schema.NewSchemaHistoryExport(o.context, o.SchemaSchemaHistoryExportHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1039,6 +1259,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/schema/{className}/properties"] = schema.NewSchemaObjectsPropertiesAdd(o.context, o.SchemaSchemaObjectsPropertiesAddHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/{className}/properties/{propertyName}"] = schema.NewSchemaObjectsPropertiesDelete(o.context, o.SchemaSchemaObjectsPropertiesDeleteHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1078,6 +1302,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/schema/{className}/tenants/{tenantName}/usage"] = schema.NewTenantsUsage(o.context, o.SchemaTenantsUsageHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"][""] = NewWeaviateRoot(o.context, o.WeaviateRootHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -23,11 +23,13 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	rCluster "github.com/weaviate/weaviate/cluster"
 	"github.com/weaviate/weaviate/exp/metadata"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/oidc"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/backup"
+	"github.com/weaviate/weaviate/usecases/classification"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/locks"
@@ -35,6 +37,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/quota"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
@@ -50,6 +53,7 @@ type State struct {
 	AnonymousAccess       *anonymous.Client
 	APIKey                *apikey.Client
 	Authorizer            authorization.Authorizer
+	AuditLog              *audit.Logger
 	ServerConfig          *config.WeaviateConfig
 	Locks                 locks.ConnectorSchemaLock
 	Logger                *logrus.Logger
@@ -65,14 +69,18 @@ type State struct {
 	Traverser             *traverser.Traverser
 
 	ClassificationRepo *classifications.DistributedRepo
+	Classifier         *classification.Classifier
 	Metrics            *monitoring.PrometheusMetrics
 	ServerMetrics      *monitoring.ServerMetrics
 	BackupManager      *backup.Handler
+	BackupBackends     backup.BackupBackendProvider
+	LogArchiver        *backup.LogArchiver
 	DB                 *db.DB
 	BatchManager       *objects.BatchManager
 	ClusterHttpClient  *http.Client
 	ReindexCtxCancel   context.CancelFunc
 	MemWatch           *memwatch.Monitor
+	TenantQuotas       *quota.Enforcer
 
 	ClusterService *rCluster.Service
 	TenantActivity *tenantactivity.Handler
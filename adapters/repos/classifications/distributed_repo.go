@@ -35,6 +35,9 @@ type DistributedRepo struct {
 type localRepo interface {
 	Get(ctx context.Context, id strfmt.UUID) (*models.Classification, error)
 	Put(ctx context.Context, classification models.Classification) error
+	List(ctx context.Context) ([]*models.Classification, error)
+	PutModel(ctx context.Context, model classification.Model) error
+	GetModels(ctx context.Context, class string) ([]*classification.Model, error)
 }
 
 func NewDistributeRepo(remoteClient cluster.Client,
@@ -85,15 +88,57 @@ func (r *DistributedRepo) Put(ctx context.Context,
 	return r.localRepo.Put(ctx, pl)
 }
 
+func (r *DistributedRepo) List(ctx context.Context) ([]*models.Classification, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.localRepo.List(ctx)
+}
+
+func (r *DistributedRepo) GetModels(ctx context.Context,
+	class string,
+) ([]*classification.Model, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.localRepo.GetModels(ctx, class)
+}
+
+func (r *DistributedRepo) PutModel(ctx context.Context,
+	model classification.Model,
+) error {
+	r.Lock()
+	defer r.Unlock()
+
+	tx, err := r.txRemote.BeginTransaction(ctx, classification.TransactionPutModel,
+		classification.TransactionPutModelPayload{
+			Model: model,
+		}, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	err = r.txRemote.CommitWriteTransaction(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return r.localRepo.PutModel(ctx, model)
+}
+
 func (r *DistributedRepo) incomingCommit(ctx context.Context,
 	tx *cluster.Transaction,
 ) error {
-	if tx.Type != classification.TransactionPut {
+	switch tx.Type {
+	case classification.TransactionPut:
+		return r.localRepo.Put(ctx, tx.Payload.(classification.TransactionPutPayload).
+			Classification)
+	case classification.TransactionPutModel:
+		return r.localRepo.PutModel(ctx, tx.Payload.(classification.TransactionPutModelPayload).
+			Model)
+	default:
 		return errors.Errorf("unrecognized tx type: %s", tx.Type)
 	}
-
-	return r.localRepo.Put(ctx, tx.Payload.(classification.TransactionPutPayload).
-		Classification)
 }
 
 func (r *DistributedRepo) TxManager() *cluster.TxManager {
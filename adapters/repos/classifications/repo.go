@@ -12,10 +12,12 @@
 package classifications
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"os"
+	"sync"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -26,12 +28,20 @@ import (
 	bolt "go.etcd.io/bbolt"
 )
 
-var classificationsBucket = []byte("classifications")
+var (
+	classificationsBucket = []byte("classifications")
+	modelsBucket          = []byte("classification_models")
+)
 
 type Repo struct {
 	logger  logrus.FieldLogger
 	baseDir string
 	db      *bolt.DB
+
+	// models are consulted on every insert when classifying on insert, so
+	// they are decoded once and kept in memory per class
+	modelsLock sync.RWMutex
+	models     map[string][]*classification.Model
 }
 
 func NewRepo(baseDir string, logger logrus.FieldLogger) (*Repo, error) {
@@ -67,6 +77,10 @@ func (r *Repo) init() error {
 			return errors.Wrapf(err, "create classifications bucket '%s'",
 				string(helpers.ObjectsBucket))
 		}
+		if _, err := tx.CreateBucketIfNotExists(modelsBucket); err != nil {
+			return errors.Wrapf(err, "create classification models bucket '%s'",
+				string(modelsBucket))
+		}
 		return nil
 	})
 	if err != nil {
@@ -111,4 +125,87 @@ func (r *Repo) Get(ctx context.Context, id strfmt.UUID) (*models.Classification,
 	return &c, nil
 }
 
+func (r *Repo) List(ctx context.Context) ([]*models.Classification, error) {
+	var out []*models.Classification
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(classificationsBucket).ForEach(func(k, v []byte) error {
+			var c models.Classification
+			if err := json.Unmarshal(v, &c); err != nil {
+				return errors.Wrapf(err, "parse classification %s from JSON", k)
+			}
+			out = append(out, &c)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (r *Repo) modelKey(class, property string) []byte {
+	return []byte(class + "/" + property)
+}
+
+func (r *Repo) PutModel(ctx context.Context, model classification.Model) error {
+	modelJSON, err := json.Marshal(model)
+	if err != nil {
+		return errors.Wrap(err, "marshal classification model to JSON")
+	}
+
+	r.modelsLock.Lock()
+	defer r.modelsLock.Unlock()
+
+	err = r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(modelsBucket)
+		return b.Put(r.modelKey(model.Class, model.Property), modelJSON)
+	})
+	if err != nil {
+		return err
+	}
+
+	// invalidate, the next read decodes all models of the class again
+	delete(r.models, model.Class)
+	return nil
+}
+
+func (r *Repo) GetModels(ctx context.Context, class string) ([]*classification.Model, error) {
+	r.modelsLock.RLock()
+	models, ok := r.models[class]
+	r.modelsLock.RUnlock()
+	if ok {
+		return models, nil
+	}
+
+	r.modelsLock.Lock()
+	defer r.modelsLock.Unlock()
+
+	if models, ok := r.models[class]; ok {
+		return models, nil
+	}
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		prefix := r.modelKey(class, "")
+		c := tx.Bucket(modelsBucket).Cursor()
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			var model classification.Model
+			if err := json.Unmarshal(v, &model); err != nil {
+				return errors.Wrapf(err, "parse classification model %s from JSON", k)
+			}
+			models = append(models, &model)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if r.models == nil {
+		r.models = map[string][]*classification.Model{}
+	}
+	r.models[class] = models
+	return models, nil
+}
+
 var _ = classification.Repo(&Repo{})
@@ -18,10 +18,12 @@ import (
 	"context"
 	"testing"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/classification"
 )
 
 func Test_ClassificationsRepo(t *testing.T) {
@@ -59,6 +61,73 @@ func Test_ClassificationsRepo(t *testing.T) {
 		require.Nil(t, err)
 		assert.Equal(t, &expectedTwo, res)
 	})
+
+	t.Run("listing stored classifications", func(t *testing.T) {
+		res, err := r.List(context.Background())
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i, c := range res {
+			ids[i] = c.ID
+		}
+		assert.ElementsMatch(t, []strfmt.UUID{exampleOne().ID, exampleTwo().ID}, ids)
+	})
+
+	t.Run("asking for models of a class without models", func(t *testing.T) {
+		res, err := r.GetModels(context.Background(), "ExampleClassOne")
+		require.Nil(t, err)
+		assert.Len(t, res, 0)
+	})
+
+	t.Run("storing models", func(t *testing.T) {
+		for _, model := range []classification.Model{
+			exampleModel("ExampleClassOne", "topic", "a"),
+			exampleModel("ExampleClassOne", "sentiment", "b"),
+			exampleModel("ExampleClassOneAndAHalf", "topic", "c"),
+		} {
+			require.Nil(t, r.PutModel(context.Background(), model))
+		}
+	})
+
+	t.Run("retrieving models of a class", func(t *testing.T) {
+		res, err := r.GetModels(context.Background(), "ExampleClassOne")
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, "sentiment", res[0].Property)
+		assert.Equal(t, "topic", res[1].Property)
+		assert.Equal(t, []string{"a", "other"}, res[1].Labels)
+	})
+
+	t.Run("replacing a model", func(t *testing.T) {
+		require.Nil(t, r.PutModel(context.Background(),
+			exampleModel("ExampleClassOne", "topic", "d")))
+
+		res, err := r.GetModels(context.Background(), "ExampleClassOne")
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, []string{"d", "other"}, res[1].Labels)
+	})
+
+	t.Run("models are loaded after a restart", func(t *testing.T) {
+		require.Nil(t, r.db.Close())
+		r, err = NewRepo(dirName, logger)
+		require.Nil(t, err)
+
+		res, err := r.GetModels(context.Background(), "ExampleClassOne")
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, []string{"d", "other"}, res[1].Labels)
+	})
+}
+
+func exampleModel(class, prop, label string) classification.Model {
+	return classification.Model{
+		Class:    class,
+		Property: prop,
+		Labels:   []string{label, "other"},
+		Weights:  [][]float32{{1, 0}, {0, 1}},
+		Bias:     []float32{0, 0},
+	}
 }
 
 func exampleOne() models.Classification {
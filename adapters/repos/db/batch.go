@@ -14,6 +14,7 @@ package db
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -46,7 +47,9 @@ func (db *DB) BatchPutObjects(ctx context.Context, objs objects.BatchObjects,
 			continue
 		}
 		queue := objectByClass[item.Object.Class]
-		queue.objects = append(queue.objects, storobj.FromObject(item.Object, item.Object.Vector, item.Object.Vectors))
+		object := storobj.FromObject(item.Object, item.Object.Vector, item.Object.Vectors)
+		object.IfMatch = item.IfMatch
+		queue.objects = append(queue.objects, object)
 		queue.originalIndex = append(queue.originalIndex, item.OriginalIndex)
 		objectByClass[item.Object.Class] = queue
 	}
@@ -100,6 +103,14 @@ func (db *DB) BatchPutObjects(ctx context.Context, objs objects.BatchObjects,
 				objs[queue.originalIndex[i]].Err = err
 			}
 		}
+		// objects resolved by their unique key were written to the id of
+		// the existing object
+		for i, object := range queue.objects {
+			if item := &objs[queue.originalIndex[i]]; item.UUID != object.ID() {
+				item.UUID = object.ID()
+				item.Object.ID = object.ID()
+			}
+		}
 	}
 
 	return objs, nil
@@ -173,7 +184,9 @@ func (db *DB) BatchDeleteObjects(ctx context.Context, params objects.BatchDelete
 	}
 
 	// find all DocIDs in all shards that match the filter
+	before := time.Now()
 	shardDocIDs, err := idx.findUUIDs(ctx, params.Filters, tenant, repl)
+	idx.metrics.BatchDelete(before, "filter_total")
 	if err != nil {
 		return objects.BatchDeleteResult{}, errors.Wrapf(err, "cannot find objects")
 	}
@@ -39,8 +39,15 @@ func (db *DB) GetUnclassified(ctx context.Context, className string,
 	for i, prop := range propsToReturnTmp {
 		props[i] = search.SelectProperty{Name: prop}
 	}
-	mergedFilter := mergeUserFilterWithRefCountFilter(filter, className, properties,
-		libfilters.OperatorEqual, 0)
+
+	// references can be filtered by their count, any other property is
+	// unclassified if it has no value, which is checked after the search
+	refProps, valueProps := db.splitReferenceProperties(className, properties)
+	mergedFilter := filter
+	if len(refProps) > 0 {
+		mergedFilter = mergeUserFilterWithRefCountFilter(filter, className, refProps,
+			libfilters.OperatorEqual, 0)
+	}
 	res, err := db.Search(ctx, dto.GetParams{
 		ClassName: className,
 		Filters:   mergedFilter,
@@ -56,8 +63,85 @@ func (db *DB) GetUnclassified(ctx context.Context, className string,
 		},
 		Properties: props,
 	})
+	if err != nil || len(valueProps) == 0 {
+		return res, err
+	}
 
-	return res, err
+	unclassified := res[:0]
+	for _, item := range res {
+		if !hasAnyPropertyValue(item, valueProps) {
+			unclassified = append(unclassified, item)
+		}
+	}
+
+	return unclassified, nil
+}
+
+// TODO: why is this logic in the persistence package? This is business-logic,
+// move out of here!
+func (db *DB) GetClassified(ctx context.Context, className string,
+	properties []string, filter *libfilters.LocalFilter,
+) ([]search.Result, error) {
+	props := make(search.SelectProperties, len(properties))
+	for i, prop := range properties {
+		props[i] = search.SelectProperty{Name: prop}
+	}
+
+	res, err := db.Search(ctx, dto.GetParams{
+		ClassName: className,
+		Filters:   filter,
+		Pagination: &libfilters.Pagination{
+			Limit: 10000, // TODO: gh-1219 increase
+		},
+		AdditionalProperties: additional.Properties{
+			Vector: true,
+		},
+		Properties: props,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	classified := res[:0]
+	for _, item := range res {
+		if hasAnyPropertyValue(item, properties) {
+			classified = append(classified, item)
+		}
+	}
+
+	return classified, nil
+}
+
+func (db *DB) splitReferenceProperties(className string,
+	properties []string,
+) (refProps []string, valueProps []string) {
+	class := db.schemaGetter.ReadOnlyClass(className)
+	if class == nil {
+		return properties, nil
+	}
+
+	for _, name := range properties {
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil || schema.IsRefDataType(prop.DataType) {
+			refProps = append(refProps, name)
+		} else {
+			valueProps = append(valueProps, name)
+		}
+	}
+	return refProps, valueProps
+}
+
+func hasAnyPropertyValue(item search.Result, properties []string) bool {
+	props, ok := item.Schema.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	for _, prop := range properties {
+		if props[prop] != nil {
+			return true
+		}
+	}
+	return false
 }
 
 // TODO: why is this logic in the persistence package? This is business-logic,
@@ -105,6 +105,27 @@ func TestClassifications(t *testing.T) {
 		assert.Equal(t, strfmt.UUID("a2bbcbdc-76e1-477d-9e72-a6d2cfb50109"), res[0].ID)
 	})
 
+	t.Run("finding all unclassified text properties", func(t *testing.T) {
+		res, err := repo.GetUnclassified(context.Background(),
+			"Article", []string{"topic"}, nil, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 6)
+		for _, item := range res {
+			assert.Nil(t, item.Schema.(map[string]interface{})["topic"])
+		}
+	})
+
+	t.Run("finding all classified text properties", func(t *testing.T) {
+		res, err := repo.GetClassified(context.Background(),
+			"Article", []string{"topic"}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		for _, item := range res {
+			assert.NotEmpty(t, item.Schema.(map[string]interface{})["topic"])
+			assert.Len(t, item.Vector, 3)
+		}
+	})
+
 	t.Run("aggregating over item neighbors", func(t *testing.T) {
 		t.Run("close to politics (no filters)", func(t *testing.T) {
 			res, err := repo.AggregateNeighbors(context.Background(),
@@ -278,6 +299,11 @@ func classificationTestSchema() []*models.Class {
 					Name:     "mainCategory",
 					DataType: []string{"MainCategory"},
 				},
+				{
+					Name:         "topic",
+					DataType:     schema.DataTypeText.PropString(),
+					Tokenization: models.PropertyTokenizationField,
+				},
 			},
 		},
 	}
@@ -357,6 +383,7 @@ func classificationTestArticles() search.Results {
 			Vector:    []float32{1, 0, 0},
 			Schema: map[string]interface{}{
 				"description":   "This article talks about politics",
+				"topic":         "politics",
 				"exactCategory": models.MultipleRef{beaconRef(idCategoryPolitics)},
 				"mainCategory":  models.MultipleRef{beaconRef(idMainCategoryPoliticsAndSociety)},
 			},
@@ -367,6 +394,7 @@ func classificationTestArticles() search.Results {
 			Vector:    []float32{0, 1, 0},
 			Schema: map[string]interface{}{
 				"description":   "This articles talks about society",
+				"topic":         "society",
 				"exactCategory": models.MultipleRef{beaconRef(idCategorySociety)},
 				"mainCategory":  models.MultipleRef{beaconRef(idMainCategoryPoliticsAndSociety)},
 			},
@@ -377,6 +405,7 @@ func classificationTestArticles() search.Results {
 			Vector:    []float32{0, 0, 1},
 			Schema: map[string]interface{}{
 				"description":   "This article talks about food",
+				"topic":         "food",
 				"exactCategory": models.MultipleRef{beaconRef(idCategoryFoodAndDrink)},
 				"mainCategory":  models.MultipleRef{beaconRef(idMainCategoryFoodAndDrink)},
 			},
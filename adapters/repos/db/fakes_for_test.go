@@ -190,7 +190,7 @@ func (f *fakeRemoteClient) Exists(ctx context.Context, hostName, indexName,
 }
 
 func (f *fakeRemoteClient) DeleteObject(ctx context.Context, hostName, indexName,
-	shardName string, id strfmt.UUID, schemaVersion uint64,
+	shardName string, id strfmt.UUID, ifMatch *int64, schemaVersion uint64,
 ) error {
 	return nil
 }
@@ -284,6 +284,10 @@ func (f *fakeRemoteNodeClient) GetStatistics(ctx context.Context, hostName strin
 	return &models.Statistics{}, nil
 }
 
+func (f *fakeRemoteNodeClient) GetTenantUsage(ctx context.Context, hostName, className, tenant string) (*models.TenantUsage, error) {
+	return &models.TenantUsage{}, nil
+}
+
 type fakeReplicationClient struct{}
 
 var _ replica.Client = (*fakeReplicationClient)(nil)
@@ -295,7 +299,7 @@ func (f *fakeReplicationClient) PutObject(ctx context.Context, host, index, shar
 }
 
 func (f *fakeReplicationClient) DeleteObject(ctx context.Context, host, index, shard, requestID string,
-	id strfmt.UUID, schemaVersion uint64,
+	id strfmt.UUID, ifMatch *int64, schemaVersion uint64,
 ) (replica.SimpleResponse, error) {
 	return replica.SimpleResponse{}, nil
 }
@@ -380,3 +384,8 @@ func (c *fakeReplicationClient) HashTreeLevel(ctx context.Context, host, index,
 ) (digests []hashtree.Digest, err error) {
 	return nil, nil
 }
+
+func (c *fakeReplicationClient) AppliedWatermark(ctx context.Context, host, index, shard string,
+) (int64, error) {
+	return 0, nil
+}
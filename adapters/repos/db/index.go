@@ -175,6 +175,13 @@ type Index struct {
 	invertedIndexConfig     schema.InvertedIndexConfig
 	invertedIndexConfigLock sync.Mutex
 
+	// the latest property settings applied to the shards, used to determine
+	// which properties need to be reindexed when the settings change
+	propertyIndexSettings propertyIndexSettingsMap
+
+	// values of deleted properties are stripped from objects read
+	deletedProperties deletedProperties
+
 	// This lock should be used together with the db indexLock.
 	//
 	// The db indexlock locks the map that contains all indices against changes and should be used while iterating.
@@ -198,6 +205,13 @@ type Index struct {
 	shardTransferMutex shardTransfer
 	lastBackup         atomic.Pointer[BackupState]
 
+	// writes to shards being copied to this node
+	joining joiningShards
+	// when local replicas were last in sync
+	syncs replicaSyncs
+	// latest replicated writes applied to local replicas
+	watermarks appliedWatermarks
+
 	// canceled when either Shutdown or Drop called
 	closingCtx    context.Context
 	closingCancel context.CancelFunc
@@ -273,6 +287,13 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 		shardCreateLocks:       esync.NewKeyLocker(),
 	}
 	index.closingCtx, index.closingCancel = context.WithCancel(context.Background())
+	repl.SetSyncTracker(index)
+	if cfg.Handoff != nil {
+		repl.SetHandoff(cfg.Handoff)
+	}
+	if class != nil {
+		index.propertyIndexSettings.setProperties(true, class.Properties...)
+	}
 
 	index.initCycleCallbacks()
 
@@ -284,6 +305,10 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 		return nil, fmt.Errorf("init index %q: %w", index.ID(), err)
 	}
 
+	if err := index.deletedProperties.load(index.path()); err != nil {
+		return nil, fmt.Errorf("init index %q: %w", index.ID(), err)
+	}
+
 	if err := index.initAndStoreShards(ctx, class, shardState, promMetrics); err != nil {
 		return nil, err
 	}
@@ -478,6 +503,23 @@ func (i *Index) IterateShards(ctx context.Context, cb func(index *Index, shard S
 }
 
 func (i *Index) addProperty(ctx context.Context, props ...*models.Property) error {
+	i.propertyIndexSettings.setProperties(false, props...)
+
+	names := make([]string, len(props))
+	for j, prop := range props {
+		names[j] = prop.Name
+	}
+	readded, err := i.deletedProperties.readd(time.Now().UnixMilli(), names...)
+	if err != nil {
+		return errors.Wrapf(err, "extend idx '%s' with properties '%v", i.ID(), props)
+	}
+	if len(readded) > 0 {
+		// the buckets of the new property are created from scratch
+		if err := i.dropReaddedPropertyData(ctx, readded...); err != nil {
+			return errors.Wrapf(err, "extend idx '%s' with properties '%v", i.ID(), props)
+		}
+	}
+
 	eg := enterrors.NewErrorGroupWrapper(i.logger)
 	eg.SetLimit(_NUMCPU)
 
@@ -592,6 +634,7 @@ type IndexConfig struct {
 	SeparateObjectsCompactions     bool
 	MaxSegmentSize                 int64
 	HNSWMaxLogSize                 int64
+	HNSWSnapshotIntervalSeconds    int
 	HNSWWaitForCachePrefill        bool
 	HNSWFlatSearchConcurrency      int
 	VisitedListPoolMaxSize         int
@@ -601,6 +644,9 @@ type IndexConfig struct {
 	AvoidMMap                      bool
 	DisableLazyLoadShards          bool
 	ForceFullReplicasSearch        bool
+	LogArchiver                    LogArchiver
+	// Handoff is optional, it stores writes missed by unavailable replicas
+	Handoff *replica.Handoff
 
 	TrackVectorDimensions bool
 }
@@ -661,6 +707,10 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object,
 			object.Class(), i.Config.ClassName)
 	}
 
+	if replProps != nil && replProps.IfMatch != nil {
+		object.IfMatch = replProps.IfMatch
+	}
+
 	shardName, err := i.determineObjectShard(ctx, object.ID(), object.Object.Tenant)
 	if err != nil {
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
@@ -674,6 +724,7 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object,
 		if err := i.replicator.PutObject(ctx, shardName, object, cl, schemaVersion); err != nil {
 			return fmt.Errorf("replicate insertion: shard=%q: %w", shardName, err)
 		}
+		i.addToConsistencyToken(replProps, shardName, object.LastUpdateTimeUnix())
 		return nil
 	}
 
@@ -854,7 +905,14 @@ func (i *Index) putObjectBatch(ctx context.Context, objects []*storobj.Object,
 		}
 	}
 
+	for pos, err := range i.resolveUniqueKeys(ctx, objects, replProps) {
+		out[pos] = err
+	}
+
 	for pos, obj := range objects {
+		if out[pos] != nil {
+			continue
+		}
 		if err := i.validateMultiTenancy(obj.Object.Tenant); err != nil {
 			out[pos] = err
 			continue
@@ -894,6 +952,13 @@ func (i *Index) putObjectBatch(ctx context.Context, objects []*storobj.Object,
 			if replProps != nil {
 				errs = i.replicator.PutObjects(ctx, shardName, group.objects,
 					replica.ConsistencyLevel(replProps.ConsistencyLevel), schemaVersion)
+				var updateTime int64
+				for j, err := range errs {
+					if err == nil {
+						updateTime = max(updateTime, group.objects[j].LastUpdateTimeUnix())
+					}
+				}
+				i.addToConsistencyToken(replProps, shardName, updateTime)
 			} else {
 				shard, release, err := i.GetShard(ctx, shardName)
 				if err != nil {
@@ -994,7 +1059,18 @@ func (i *Index) AddReferencesBatch(ctx context.Context, refs objects.BatchRefere
 	for shardName, group := range byShard {
 		var errs []error
 		if i.replicationEnabled() {
+			// the replicas update the source objects at the time recorded in the token
+			updateTime := time.Now().UnixMilli()
+			for j := range group.refs {
+				group.refs[j].UpdateTime = updateTime
+			}
 			errs = i.replicator.AddReferences(ctx, shardName, group.refs, replica.ConsistencyLevel(replProps.ConsistencyLevel), schemaVersion)
+			for _, err := range errs {
+				if err == nil {
+					i.addToConsistencyToken(replProps, shardName, updateTime)
+					break
+				}
+			}
 		} else {
 			shard, release, err := i.GetShard(ctx, shardName)
 			if err != nil {
@@ -1061,9 +1137,12 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 		if replProps.NodeName != "" {
 			obj, err = i.replicator.NodeObject(ctx, replProps.NodeName, shardName, id, props, addl)
 		} else {
-			obj, err = i.replicator.GetOne(ctx,
+			obj, err = i.replicator.WithConsistencyToken(replProps.ConsistencyToken).GetOne(ctx,
 				replica.ConsistencyLevel(replProps.ConsistencyLevel), shardName, id, props, addl)
 		}
+		if err == nil {
+			i.stripDeletedProps(obj)
+		}
 		return obj, err
 	}
 
@@ -1083,6 +1162,7 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 		}
 	}
 
+	i.stripDeletedProps(obj)
 	return obj, nil
 }
 
@@ -1172,6 +1252,7 @@ func (i *Index) multiObjectByID(ctx context.Context,
 		}
 	}
 
+	i.stripDeletedProps(out...)
 	return out, nil
 }
 
@@ -1218,7 +1299,7 @@ func (i *Index) exists(ctx context.Context, id strfmt.UUID,
 			replProps = defaultConsistency()
 		}
 		cl := replica.ConsistencyLevel(replProps.ConsistencyLevel)
-		return i.replicator.Exists(ctx, cl, shardName, id)
+		return i.replicator.WithConsistencyToken(replProps.ConsistencyToken).Exists(ctx, cl, shardName, id)
 	}
 
 	shard, release, err := i.GetShard(ctx, shardName)
@@ -1369,16 +1450,17 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 
 	if i.replicationEnabled() {
 		if replProps == nil {
-			replProps = defaultConsistency(replica.One)
+			replProps = defaultConsistency()
 		}
 		l := replica.ConsistencyLevel(replProps.ConsistencyLevel)
-		err = i.replicator.CheckConsistency(ctx, l, outObjects)
+		err = i.replicator.WithConsistencyToken(replProps.ConsistencyToken).CheckConsistency(ctx, l, outObjects)
 		if err != nil {
 			i.logger.WithField("action", "object_search").
 				Errorf("failed to check consistency of search results: %v", err)
 		}
 	}
 
+	i.stripDeletedProps(outObjects...)
 	return outObjects, outScores, nil
 }
 
@@ -1700,10 +1782,10 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors [][]float3
 
 	if i.replicationEnabled() {
 		if replProps == nil {
-			replProps = defaultConsistency(replica.One)
+			replProps = defaultConsistency()
 		}
 		l := replica.ConsistencyLevel(replProps.ConsistencyLevel)
-		err = i.replicator.CheckConsistency(ctx, l, out)
+		err = i.replicator.WithConsistencyToken(replProps.ConsistencyToken).CheckConsistency(ctx, l, out)
 		if err != nil {
 			i.logger.WithField("action", "object_vector_search").
 				Errorf("failed to check consistency of search results: %v", err)
@@ -1774,14 +1856,21 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
 	}
 
+	var ifMatch *int64
+	if replProps != nil {
+		ifMatch = replProps.IfMatch
+	}
+
 	if i.replicationEnabled() {
 		if replProps == nil {
 			replProps = defaultConsistency()
 		}
 		cl := replica.ConsistencyLevel(replProps.ConsistencyLevel)
-		if err := i.replicator.DeleteObject(ctx, shardName, id, cl, schemaVersion); err != nil {
+		deletedUpdateTime, err := i.replicator.DeleteObject(ctx, shardName, id, ifMatch, cl, schemaVersion)
+		if err != nil {
 			return fmt.Errorf("replicate deletion: shard=%q %w", shardName, err)
 		}
+		i.addDeletionToConsistencyToken(replProps, shardName, deletedUpdateTime)
 		return nil
 	}
 
@@ -1792,7 +1881,7 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
 	}
 
 	if shard == nil {
-		if err := i.remote.DeleteObject(ctx, shardName, id, schemaVersion); err != nil {
+		if err := i.remote.DeleteObject(ctx, shardName, id, ifMatch, schemaVersion); err != nil {
 			return fmt.Errorf("delete remote object: shard=%q: %w", shardName, err)
 		}
 		return nil
@@ -1802,14 +1891,14 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
 	// no replication, local shard
 	i.shardTransferMutex.RLock()
 	defer i.shardTransferMutex.RUnlock()
-	if err = shard.DeleteObject(ctx, id); err != nil {
+	if err = shard.DeleteObject(ctx, id, ifMatch); err != nil {
 		return fmt.Errorf("delete local object: shard=%q: %w", shardName, err)
 	}
 	return nil
 }
 
 func (i *Index) IncomingDeleteObject(ctx context.Context, shardName string,
-	id strfmt.UUID, schemaVersion uint64,
+	id strfmt.UUID, ifMatch *int64, schemaVersion uint64,
 ) error {
 	i.shardTransferMutex.RLock()
 	defer i.shardTransferMutex.RUnlock()
@@ -1820,7 +1909,7 @@ func (i *Index) IncomingDeleteObject(ctx context.Context, shardName string,
 	}
 	defer release()
 
-	return shard.DeleteObject(ctx, id)
+	return shard.DeleteObject(ctx, id, ifMatch)
 }
 
 func (i *Index) getClass() *models.Class {
@@ -1939,6 +2028,10 @@ func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
 		return err
 	}
 
+	if replProps != nil && replProps.IfMatch != nil {
+		merge.IfMatch = replProps.IfMatch
+	}
+
 	shardName, err := i.determineObjectShard(ctx, merge.ID, tenant)
 	if err != nil {
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
@@ -1952,6 +2045,7 @@ func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
 		if err := i.replicator.MergeObject(ctx, shardName, &merge, cl, schemaVersion); err != nil {
 			return fmt.Errorf("replicate single update: %w", err)
 		}
+		i.addToConsistencyToken(replProps, shardName, merge.UpdateTime)
 		return nil
 	}
 
@@ -2379,9 +2473,6 @@ func (i *Index) IncomingUpdateShardStatus(ctx context.Context, shardName, target
 func (i *Index) findUUIDs(ctx context.Context,
 	filters *filters.LocalFilter, tenant string, repl *additional.ReplicationProperties,
 ) (map[string][]strfmt.UUID, error) {
-	before := time.Now()
-	defer i.metrics.BatchDelete(before, "filter_total")
-
 	if err := i.validateMultiTenancy(tenant); err != nil {
 		return nil, err
 	}
@@ -2468,8 +2559,10 @@ func (i *Index) batchDeleteObjects(ctx context.Context, shardUUIDs map[string][]
 
 			var objs objects.BatchSimpleObjects
 			if i.replicationEnabled() {
-				objs = i.replicator.DeleteObjects(ctx, shardName, uuids,
+				var deletedUpdateTime int64
+				objs, deletedUpdateTime = i.replicator.DeleteObjects(ctx, shardName, uuids,
 					dryRun, replica.ConsistencyLevel(replProps.ConsistencyLevel), schemaVersion)
+				i.addDeletionToConsistencyToken(replProps, shardName, deletedUpdateTime)
 			} else {
 				shard, release, err := i.GetShard(ctx, shardName)
 				if err != nil {
@@ -2520,14 +2613,32 @@ func (i *Index) IncomingDeleteObjectBatch(ctx context.Context, shardName string,
 	return shard.DeleteObjectBatch(ctx, uuids, dryRun)
 }
 
-func defaultConsistency(l ...replica.ConsistencyLevel) *additional.ReplicationProperties {
-	rp := &additional.ReplicationProperties{}
-	if len(l) != 0 {
-		rp.ConsistencyLevel = string(l[0])
-	} else {
-		rp.ConsistencyLevel = string(replica.Quorum)
+// addToConsistencyToken records a successful replicated write to shard in
+// the consistency token of the request, if any
+func (i *Index) addToConsistencyToken(replProps *additional.ReplicationProperties,
+	shard string, updateTime int64,
+) {
+	if replProps != nil && updateTime > 0 {
+		replProps.ConsistencyToken.Add(i.Config.ClassName.String(), shard, updateTime)
 	}
-	return rp
+}
+
+// addDeletionToConsistencyToken records a successful replicated deletion in
+// the consistency token of the request, if any. Replicas keep no deletion
+// time, so the token records a time just after the deleted version: a
+// replica which still holds that version is not caught up.
+func (i *Index) addDeletionToConsistencyToken(replProps *additional.ReplicationProperties,
+	shard string, deletedUpdateTime int64,
+) {
+	if deletedUpdateTime > 0 {
+		i.addToConsistencyToken(replProps, shard, deletedUpdateTime+1)
+	}
+}
+
+// defaultConsistency leaves the consistency level unset, so that the
+// replicator resolves the default level of the class or tenant
+func defaultConsistency() *additional.ReplicationProperties {
+	return &additional.ReplicationProperties{}
 }
 
 func objectSearchPreallocate(limit int, shards []string) ([]*storobj.Object, []float32) {
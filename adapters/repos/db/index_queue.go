@@ -276,6 +276,25 @@ func (q *IndexQueue) ResetWith(v batchIndexer) error {
 	return nil
 }
 
+// replaceIndex makes the queue use the given index from now on. Pending
+// vectors are discarded, the caller is expected to have added them to the
+// new index already.
+func (q *IndexQueue) replaceIndex(v batchIndexer) error {
+	if !asyncEnabled() {
+		q.indexLock.Lock()
+		q.index = v
+		q.indexLock.Unlock()
+		return nil
+	}
+
+	q.PauseIndexing()
+	if err := q.ResetWith(v); err != nil {
+		return err
+	}
+	q.ResumeIndexing()
+	return nil
+}
+
 // Push adds a list of vectors to the queue.
 func (q *IndexQueue) Push(ctx context.Context, vectors ...vectorDescriptor) error {
 	if ctx.Err() != nil {
@@ -353,13 +372,13 @@ func (q *IndexQueue) Delete(ids ...uint64) error {
 	start := time.Now()
 	defer q.metrics.Delete(start, len(ids))
 
+	q.indexLock.RLock()
+	defer q.indexLock.RUnlock()
+
 	if !asyncEnabled() {
 		return q.index.Delete(ids...)
 	}
 
-	q.indexLock.RLock()
-	defer q.indexLock.RUnlock()
-
 	for i := range ids {
 		if q.index.ContainsNode(ids[i]) {
 			err := q.index.Delete(ids[i])
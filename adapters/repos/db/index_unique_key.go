@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/multi"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	shardingcfg "github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+// resolveUniqueKeys makes objects of a class with a unique key update the
+// existing object with the same key instead of creating a duplicate. It
+// replaces the id of each object by the id of the existing object. Objects
+// of the batch sharing a key get the same id, so that the last one wins just
+// like for duplicate ids. Existing objects are looked up in all shards of
+// the tenant, but uniqueness is only enforced per shard by the shard itself,
+// unless the class is sharded by its unique key. Then ids are derived from
+// the key and no lookup is needed.
+func (i *Index) resolveUniqueKeys(ctx context.Context, objects []*storobj.Object,
+	replProps *additional.ReplicationProperties,
+) []error {
+	className := i.Config.ClassName.String()
+	class := i.getSchema.ReadOnlyClass(className)
+	if !schema.HasUniqueKey(class) {
+		return nil
+	}
+
+	errs := make([]error, len(objects))
+	values := make([][]interface{}, len(objects))
+	keys := make([]string, len(objects))
+	byTenant := map[string][]int{}
+	for pos, obj := range objects {
+		v, err := schema.UniqueKeyValues(class, obj.Properties())
+		if err != nil {
+			errs[pos] = err
+			continue
+		}
+		values[pos] = v
+		keys[pos] = schema.UniqueKeyString(v)
+		byTenant[obj.Object.Tenant] = append(byTenant[obj.Object.Tenant], pos)
+	}
+
+	if cfg, ok := class.ShardingConfig.(shardingcfg.Config); ok && cfg.RoutesByUniqueKey() {
+		return errs
+	}
+
+	for tenant, positions := range byTenant {
+		ids := map[string]strfmt.UUID{}
+		for _, pos := range positions {
+			ids[keys[pos]] = objects[pos].ID()
+		}
+
+		existing, err := i.lookupUniqueKeys(ctx, class, tenant, positions, values, replProps)
+		if err != nil {
+			for _, pos := range positions {
+				errs[pos] = fmt.Errorf("resolve unique key: %w", err)
+			}
+			continue
+		}
+		for key, id := range existing {
+			ids[key] = id
+		}
+
+		for _, pos := range positions {
+			objects[pos].SetID(ids[keys[pos]])
+		}
+	}
+
+	return errs
+}
+
+// lookupUniqueKeys returns the ids of the existing objects with the keys of
+// the objects at the given positions
+func (i *Index) lookupUniqueKeys(ctx context.Context, class *models.Class, tenant string,
+	positions []int, values [][]interface{}, replProps *additional.ReplicationProperties,
+) (map[string]strfmt.UUID, error) {
+	wanted := map[string]struct{}{}
+	var operands []filters.Clause
+	for _, pos := range positions {
+		key := schema.UniqueKeyString(values[pos])
+		if _, ok := wanted[key]; ok {
+			continue
+		}
+		wanted[key] = struct{}{}
+		operands = append(operands, uniqueKeyClause(class, values[pos]))
+	}
+
+	filter := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorOr,
+		Operands: operands,
+	}}
+	// The filter matches tokens of text keys, so there may be arbitrarily many
+	// candidates per key. All of them are compared by their actual values.
+	found, err := i.findUUIDs(ctx, filter, tenant, replProps)
+	if err != nil {
+		return nil, err
+	}
+	var ids []multi.Identifier
+	for _, uuids := range found {
+		for _, id := range uuids {
+			ids = append(ids, multi.Identifier{ID: id.String(), ClassName: class.Class})
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	candidates, err := i.multiObjectByID(ctx, ids, tenant)
+	if err != nil {
+		return nil, err
+	}
+	return matchUniqueKeys(class, candidates, wanted), nil
+}
+
+// matchUniqueKeys returns the ids of the candidates which have one of the
+// wanted keys. Should several objects have the same key, e.g. because they
+// were written before the key was enforced by the shard they are in, the
+// smallest id is picked, so that all writers agree on the same object.
+func matchUniqueKeys(class *models.Class, candidates []*storobj.Object,
+	wanted map[string]struct{},
+) map[string]strfmt.UUID {
+	out := map[string]strfmt.UUID{}
+	for _, candidate := range candidates {
+		if candidate == nil {
+			continue
+		}
+		v, err := schema.UniqueKeyValues(class, candidate.Properties())
+		if err != nil {
+			// the candidate does not have a complete key
+			continue
+		}
+		key := schema.UniqueKeyString(v)
+		if _, ok := wanted[key]; !ok {
+			continue
+		}
+		if id, ok := out[key]; !ok || candidate.ID() < id {
+			out[key] = candidate.ID()
+		}
+	}
+	return out
+}
+
+// uniqueKeyClause returns a filter which matches at least all objects with
+// the given normalized unique key values
+func uniqueKeyClause(class *models.Class, values []interface{}) filters.Clause {
+	operands := make([]filters.Clause, len(class.UniqueKey))
+	for j, name := range class.UniqueKey {
+		prop, _ := schema.GetPropertyByName(class, name)
+		dt := schema.DataType(prop.DataType[0])
+		value := values[j]
+		switch dt {
+		case schema.DataTypeInt:
+			value = int(value.(float64))
+		case schema.DataTypeUUID:
+			// uuids are filtered on as text
+			dt = schema.DataTypeText
+		}
+		operands[j] = filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: schema.ClassName(class.Class), Property: schema.PropertyName(name)},
+			Value:    &filters.Value{Value: value, Type: dt},
+		}
+	}
+	if len(operands) == 1 {
+		return operands[0]
+	}
+	return filters.Clause{Operator: filters.OperatorAnd, Operands: operands}
+}
@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestIndex_UniqueKey(t *testing.T) {
+	ctx := testCtx()
+	className := "Product"
+	class := &models.Class{
+		Class: className,
+		Properties: []*models.Property{
+			{
+				Name:         "sku",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+			{
+				Name:     "name",
+				DataType: schema.DataTypeText.PropString(),
+			},
+		},
+		UniqueKey: []string{"sku"},
+	}
+	shd, idx := testShardWithSettings(t, ctx, class, enthnsw.UserConfig{Skip: true}, true, false)
+	defer func() {
+		require.Nil(t, idx.drop())
+		require.Nil(t, os.RemoveAll(idx.Config.RootPath))
+	}()
+
+	product := func(id strfmt.UUID, sku, name string) *storobj.Object {
+		obj := testObject(className)
+		if id != "" {
+			obj.SetID(id)
+		}
+		obj.Object.Properties = map[string]interface{}{"sku": sku, "name": name}
+		return obj
+	}
+	nameOf := func(t *testing.T, id strfmt.UUID) interface{} {
+		obj, err := shd.ObjectByID(ctx, id, nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, obj)
+		return obj.Properties().(map[string]interface{})["name"]
+	}
+
+	first := product("", "A-1", "first")
+
+	t.Run("put object with a new key", func(t *testing.T) {
+		require.Nil(t, shd.PutObject(ctx, first))
+	})
+
+	t.Run("put another object with the same key fails", func(t *testing.T) {
+		err := shd.PutObject(ctx, product("", "A-1", "second"))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "already used by object "+first.ID().String())
+	})
+
+	t.Run("put the same object again succeeds", func(t *testing.T) {
+		require.Nil(t, shd.PutObject(ctx, product(first.ID(), "A-1", "updated")))
+		assert.Equal(t, "updated", nameOf(t, first.ID()))
+	})
+
+	t.Run("batch updates the object with the same key", func(t *testing.T) {
+		batch := []*storobj.Object{
+			product("", "A-1", "batch"),
+			product("", "B-2", "new 1"),
+			product("", "B-2", "new 2"),
+		}
+		errs := idx.putObjectBatch(ctx, batch, nil, 0)
+		for _, err := range errs {
+			require.Nil(t, err)
+		}
+
+		assert.Equal(t, first.ID(), batch[0].ID())
+		assert.Equal(t, "batch", nameOf(t, first.ID()))
+		assert.Equal(t, batch[1].ID(), batch[2].ID(),
+			"objects of the batch with the same key get the same id")
+		assert.Equal(t, "new 2", nameOf(t, batch[2].ID()))
+	})
+
+	t.Run("batch rejects objects without a key", func(t *testing.T) {
+		obj := testObject(className)
+		obj.Object.Properties = map[string]interface{}{"name": "no sku"}
+		errs := idx.putObjectBatch(ctx, []*storobj.Object{obj}, nil, 0)
+		require.Len(t, errs, 1)
+		assert.NotNil(t, errs[0])
+	})
+
+	t.Run("changing the key to a used one fails", func(t *testing.T) {
+		other := product(strfmt.UUID(uuid.NewString()), "C-3", "other")
+		require.Nil(t, shd.PutObject(ctx, other))
+		err := shd.PutObject(ctx, product(other.ID(), "A-1", "other"))
+		require.NotNil(t, err)
+	})
+}
+
+func TestIndex_UniqueKeyTokenized(t *testing.T) {
+	ctx := testCtx()
+	className := "Book"
+	class := &models.Class{
+		Class: className,
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:     "edition",
+				DataType: schema.DataTypeInt.PropString(),
+			},
+		},
+		UniqueKey: []string{"title"},
+	}
+	shd, idx := testShardWithSettings(t, ctx, class, enthnsw.UserConfig{Skip: true}, true, false)
+	defer func() {
+		require.Nil(t, idx.drop())
+		require.Nil(t, os.RemoveAll(idx.Config.RootPath))
+	}()
+
+	book := func(title string, edition int) *storobj.Object {
+		obj := testObject(className)
+		obj.Object.Properties = map[string]interface{}{"title": title, "edition": float64(edition)}
+		return obj
+	}
+
+	// all titles contain the tokens of the key looked up below, the object
+	// with the exact key is written last
+	for n := 0; n < 3*10; n++ {
+		require.Nil(t, shd.PutObject(ctx, book(fmt.Sprintf("the lost city part %d", n), 1)))
+	}
+	exact := book("the lost city", 1)
+	require.Nil(t, shd.PutObject(ctx, exact))
+
+	batch := []*storobj.Object{book("the lost city", 2)}
+	errs := idx.putObjectBatch(ctx, batch, nil, 0)
+	for _, err := range errs {
+		require.Nil(t, err)
+	}
+	assert.Equal(t, exact.ID(), batch[0].ID())
+
+	obj, err := shd.ObjectByID(ctx, exact.ID(), nil, additional.Properties{})
+	require.Nil(t, err)
+	require.NotNil(t, obj)
+	assert.Equal(t, float64(2), obj.Properties().(map[string]interface{})["edition"])
+}
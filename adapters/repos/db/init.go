@@ -57,6 +57,12 @@ func (db *DB) init(ctx context.Context) error {
 		}
 	}
 
+	if !db.config.Replication.HintedHandoff.Disabled {
+		if err := db.initHandoff(ctx); err != nil {
+			return errors.Wrap(err, "init hinted handoff")
+		}
+	}
+
 	objects := db.schemaGetter.GetSchemaSkipAuth().Objects
 	if objects != nil {
 		for _, class := range objects.Classes {
@@ -94,6 +100,7 @@ func (db *DB) init(ctx context.Context) error {
 				SeparateObjectsCompactions:     db.config.SeparateObjectsCompactions,
 				MaxSegmentSize:                 db.config.MaxSegmentSize,
 				HNSWMaxLogSize:                 db.config.HNSWMaxLogSize,
+				HNSWSnapshotIntervalSeconds:    db.config.HNSWSnapshotIntervalSeconds,
 				HNSWWaitForCachePrefill:        db.config.HNSWWaitForCachePrefill,
 				HNSWFlatSearchConcurrency:      db.config.HNSWFlatSearchConcurrency,
 				VisitedListPoolMaxSize:         db.config.VisitedListPoolMaxSize,
@@ -101,6 +108,8 @@ func (db *DB) init(ctx context.Context) error {
 				AvoidMMap:                      db.config.AvoidMMap,
 				DisableLazyLoadShards:          db.config.DisableLazyLoadShards,
 				ForceFullReplicasSearch:        db.config.ForceFullReplicasSearch,
+				LogArchiver:                    db.config.LogArchiver,
+				Handoff:                        db.handoff,
 				ReplicationFactor:              NewAtomicInt64(class.ReplicationConfig.Factor),
 				AsyncReplicationEnabled:        class.ReplicationConfig.AsyncEnabled,
 				DeletionStrategy:               class.ReplicationConfig.DeletionStrategy,
@@ -255,6 +255,65 @@ func (t *JsonShardMetaData) UnTrackProperty(propName string, value float32) erro
 	return nil
 }
 
+// Removes all values tracked for the given property and persists the change
+func (t *JsonShardMetaData) DropProperty(propName string) error {
+	if t == nil {
+		return nil
+	}
+
+	t.Lock()
+	defer t.Unlock()
+	if t.closed {
+		return fmt.Errorf("tracker is closed")
+	}
+	if t.data == nil {
+		return nil
+	}
+
+	delete(t.data.BucketedData, propName)
+	delete(t.data.SumData, propName)
+	delete(t.data.CountData, propName)
+
+	return t.lockFreeFlush()
+}
+
+// Replaces the values tracked for a property with the values tracked under
+// another name, removes the latter and persists the change
+func (t *JsonShardMetaData) ReplaceProperty(propName, replacementName string) error {
+	if t == nil {
+		return nil
+	}
+
+	t.Lock()
+	defer t.Unlock()
+	if t.closed {
+		return fmt.Errorf("tracker is closed")
+	}
+	if t.data == nil {
+		return nil
+	}
+
+	delete(t.data.BucketedData, propName)
+	delete(t.data.SumData, propName)
+	delete(t.data.CountData, propName)
+
+	if bucketed, ok := t.data.BucketedData[replacementName]; ok {
+		t.data.BucketedData[propName] = bucketed
+	}
+	if sum, ok := t.data.SumData[replacementName]; ok {
+		t.data.SumData[propName] = sum
+	}
+	if count, ok := t.data.CountData[replacementName]; ok {
+		t.data.CountData[propName] = count
+	}
+
+	delete(t.data.BucketedData, replacementName)
+	delete(t.data.SumData, replacementName)
+	delete(t.data.CountData, replacementName)
+
+	return t.lockFreeFlush()
+}
+
 // Returns the bucket that the given value belongs to
 func (t *JsonShardMetaData) bucketFromValue(value float32) int {
 	if t == nil {
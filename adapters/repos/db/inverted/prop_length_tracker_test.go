@@ -325,6 +325,63 @@ func Test_PropertyLengthTracker_Persistence(t *testing.T) {
 	})
 }
 
+func Test_PropertyLengthTracker_DropProperty(t *testing.T) {
+	path := path.Join(t.TempDir(), "my_test_shard")
+	l := logrus.New()
+
+	tracker, err := NewJsonShardMetaData(path, l)
+	require.Nil(t, err)
+	require.Nil(t, tracker.TrackProperty("prop_0", 4))
+	require.Nil(t, tracker.TrackProperty("prop_1", 8))
+
+	require.Nil(t, tracker.DropProperty("prop_0"))
+	// dropping an unknown property is a no-op
+	require.Nil(t, tracker.DropProperty("prop_2"))
+	require.Nil(t, tracker.Close())
+
+	tracker, err = NewJsonShardMetaData(path, l)
+	require.Nil(t, err)
+	defer tracker.Close()
+
+	sum, count, _, err := tracker.PropertyTally("prop_0")
+	require.Nil(t, err)
+	assert.Equal(t, 0, sum)
+	assert.Equal(t, 0, count)
+
+	mean, err := tracker.PropertyMean("prop_1")
+	require.Nil(t, err)
+	assert.Equal(t, float32(8), mean)
+}
+
+func Test_PropertyLengthTracker_ReplaceProperty(t *testing.T) {
+	path := path.Join(t.TempDir(), "my_test_shard")
+	l := logrus.New()
+
+	tracker, err := NewJsonShardMetaData(path, l)
+	require.Nil(t, err)
+	require.Nil(t, tracker.TrackProperty("prop_0", 4))
+	require.Nil(t, tracker.TrackProperty("prop_0_new", 2))
+	require.Nil(t, tracker.TrackProperty("prop_0_new", 6))
+
+	require.Nil(t, tracker.ReplaceProperty("prop_0", "prop_0_new"))
+	require.Nil(t, tracker.Close())
+
+	tracker, err = NewJsonShardMetaData(path, l)
+	require.Nil(t, err)
+	defer tracker.Close()
+
+	sum, count, mean, err := tracker.PropertyTally("prop_0")
+	require.Nil(t, err)
+	assert.Equal(t, 8, sum)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, float64(4), mean)
+
+	sum, count, _, err = tracker.PropertyTally("prop_0_new")
+	require.Nil(t, err)
+	assert.Equal(t, 0, sum)
+	assert.Equal(t, 0, count)
+}
+
 // Testing the switch from the old property length tracker to the new one
 func TestFormatConversion(t *testing.T) {
 	dirName := t.TempDir()
@@ -0,0 +1,37 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+// LogArchiver is notified about the files of a shard which need to be archived
+// continuously to allow for a point-in-time recovery on top of a backup.
+type LogArchiver interface {
+	// ArchiveLog registers a newly created append-only commit log, i.e. an
+	// lsmkv write-ahead-log or an HNSW commit log. It is called before anything
+	// is written to the file.
+	ArchiveLog(path string)
+	// ArchiveState registers a small file which is rewritten in place, such as
+	// the doc id counter. Its latest content is archived as a whole.
+	ArchiveState(path string)
+}
+
+func (s *Shard) commitLogHook() func(path string) {
+	if s.index.Config.LogArchiver == nil {
+		return nil
+	}
+	return s.index.Config.LogArchiver.ArchiveLog
+}
+
+func (s *Shard) archiveState(path string) {
+	if s.index.Config.LogArchiver != nil {
+		s.index.Config.LogArchiver.ArchiveState(path)
+	}
+}
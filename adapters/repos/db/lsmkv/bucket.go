@@ -121,6 +121,10 @@ type Bucket struct {
 	// processes when memory pressure is high
 	allocChecker memwatch.AllocChecker
 
+	// optionally supplied to be notified about every newly created
+	// write-ahead-log, e.g. to archive it
+	commitLogHook func(path string)
+
 	// optional segment size limit. If set, a compaction will skip segments that
 	// sum to more than the specified value.
 	maxSegmentSize int64
@@ -864,6 +868,9 @@ func (b *Bucket) setNewActiveMemtable() error {
 	if err != nil {
 		return errors.Wrap(err, "init commit logger")
 	}
+	if b.commitLogHook != nil {
+		b.commitLogHook(cl.path)
+	}
 
 	mt, err := newMemtable(path, b.strategy, b.secondaryIndices, cl, b.metrics, b.logger)
 	if err != nil {
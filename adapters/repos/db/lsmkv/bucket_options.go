@@ -152,6 +152,15 @@ func WithMaxSegmentSize(maxSegmentSize int64) BucketOption {
 	}
 }
 
+// WithCommitLogHook registers a function which is called with the path of
+// every write-ahead-log created by the bucket.
+func WithCommitLogHook(hook func(path string)) BucketOption {
+	return func(b *Bucket) error {
+		b.commitLogHook = hook
+		return nil
+	}
+}
+
 func WithSegmentsCleanupInterval(interval time.Duration) BucketOption {
 	return func(b *Bucket) error {
 		b.segmentsCleanupInterval = interval
@@ -149,3 +149,27 @@ func (s *segment) precomputeCountNetAdditions(updatedCountNetAdditions int) ([]s
 	}
 	return []string{cnaPath}, nil
 }
+
+// CountNetAdditionsFromDisk sums up the count net additions persisted for
+// the segments of the replace bucket in dir, without loading the bucket.
+// Writes which have not been flushed to a segment yet, as well as segments
+// without a persisted count, are not included.
+func CountNetAdditionsFromDisk(dir string) (int, error) {
+	segments, err := filepath.Glob(filepath.Join(dir, "segment-*.db"))
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, segment := range segments {
+		data, err := loadWithChecksum(countNetPathFromSegmentPath(segment), 12)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return 0, fmt.Errorf("load count net additions of %s: %w", segment, err)
+		}
+		count += int(binary.LittleEndian.Uint64(data[0:8]))
+	}
+	return count, nil
+}
@@ -50,6 +50,13 @@ func TestCNA(t *testing.T) {
 				WithStrategy(StrategyReplace),
 			},
 		},
+		{
+			name: "countCNAFromDisk",
+			f:    countCNAFromDisk,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+			},
+		},
 	}
 	tests.run(ctx, t)
 }
@@ -320,3 +327,32 @@ func corruptCNAFile(fname string, corruptValue uint64) error {
 
 	return f.Close()
 }
+
+func countCNAFromDisk(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+
+	require.Nil(t, b.Put([]byte("a"), []byte("1")))
+	require.Nil(t, b.Put([]byte("b"), []byte("1")))
+	require.Nil(t, b.FlushMemtable())
+	require.Nil(t, b.Put([]byte("b"), []byte("2")))
+	require.Nil(t, b.Put([]byte("c"), []byte("1")))
+	require.Nil(t, b.Delete([]byte("a")))
+	require.Nil(t, b.FlushMemtable())
+	expected := b.Count()
+	require.Nil(t, b.Shutdown(ctx))
+
+	count, err := CountNetAdditionsFromDisk(dirName)
+	require.Nil(t, err)
+	assert.Equal(t, 2, expected)
+	assert.Equal(t, expected, count)
+
+	count, err = CountNetAdditionsFromDisk(path.Join(dirName, "missing"))
+	require.Nil(t, err)
+	assert.Equal(t, 0, count)
+}
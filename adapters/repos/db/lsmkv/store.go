@@ -58,6 +58,9 @@ type Store struct {
 
 	closeLock sync.RWMutex
 	closed    bool
+
+	// optional, passed on to all buckets, see [WithCommitLogHook]
+	commitLogHook func(path string)
 }
 
 // New initializes a new [Store] based on the root dir. If state is present on
@@ -81,6 +84,20 @@ func New(dir, rootDir string, logger logrus.FieldLogger, metrics *Metrics,
 	return s, s.init()
 }
 
+// SetCommitLogHook registers a function which is called with the path of every
+// write-ahead-log created by buckets of this store. It only applies to buckets
+// created or loaded afterwards.
+func (s *Store) SetCommitLogHook(hook func(path string)) {
+	s.commitLogHook = hook
+}
+
+func (s *Store) bucketOptions(opts []BucketOption) []BucketOption {
+	if s.commitLogHook == nil {
+		return opts
+	}
+	return append(opts[:len(opts):len(opts)], WithCommitLogHook(s.commitLogHook))
+}
+
 func (s *Store) Bucket(name string) *Bucket {
 	s.bucketAccessLock.RLock()
 	defer s.bucketAccessLock.RUnlock()
@@ -191,7 +208,7 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 	// bucket can be concurrently loaded with another buckets but
 	// the same bucket will be loaded only once
 	b, err := s.bcreator.NewBucket(ctx, s.bucketDir(bucketName), s.rootDir, s.logger, s.metrics,
-		compactionCallbacks, s.cycleCallbacks.flushCallbacks, opts...)
+		compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.bucketOptions(opts)...)
 	if err != nil {
 		return err
 	}
@@ -402,7 +419,7 @@ func (s *Store) CreateBucket(ctx context.Context, bucketName string,
 	}
 
 	b, err := s.bcreator.NewBucket(ctx, bucketDir, s.rootDir, s.logger, s.metrics,
-		compactionCallbacks, s.cycleCallbacks.flushCallbacks, opts...)
+		compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.bucketOptions(opts)...)
 	if err != nil {
 		return err
 	}
@@ -466,6 +483,35 @@ func (s *Store) ReplaceBuckets(ctx context.Context, bucketName, replacementBucke
 	return nil
 }
 
+// DropBucket shuts down the bucket and removes it from the store including
+// all of its files on disk. Dropping a bucket which does not exist is a no-op.
+func (s *Store) DropBucket(ctx context.Context, bucketName string) error {
+	s.closeLock.RLock()
+	defer s.closeLock.RUnlock()
+
+	if s.closed {
+		return fmt.Errorf("%w: dropping bucket %q in store %q", ErrAlreadyClosed, bucketName, s.dir)
+	}
+
+	s.bucketAccessLock.Lock()
+	bucket := s.bucketsByName[bucketName]
+	delete(s.bucketsByName, bucketName)
+	s.bucketAccessLock.Unlock()
+
+	if bucket == nil {
+		return nil
+	}
+
+	if err := bucket.Shutdown(ctx); err != nil {
+		return errors.Wrapf(err, "failed shutting down bucket '%s'", bucketName)
+	}
+	if err := os.RemoveAll(bucket.dir); err != nil {
+		return errors.Wrapf(err, "failed removing dir '%s'", bucket.dir)
+	}
+
+	return nil
+}
+
 func (s *Store) RenameBucket(ctx context.Context, bucketName, newBucketName string) error {
 	s.closeLock.RLock()
 	defer s.closeLock.RUnlock()
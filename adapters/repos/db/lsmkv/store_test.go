@@ -110,3 +110,34 @@ func TestCreateBucketConcurrency(t *testing.T) {
 	mockBucketCreator.AssertNumberOfCalls(t, "NewBucket", 1)
 	mockBucketCreator.AssertExpectations(t)
 }
+
+func TestDropBucket(t *testing.T) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+
+	store, err := New(dirName, dirName, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	defer store.Shutdown(ctx)
+
+	require.Nil(t, store.CreateOrLoadBucket(ctx, "bucket1"))
+	require.Nil(t, store.Bucket("bucket1").Put([]byte("key"), []byte("value")))
+	require.Nil(t, store.Bucket("bucket1").FlushAndSwitch())
+	require.DirExists(t, store.bucketDir("bucket1"))
+
+	require.Nil(t, store.DropBucket(ctx, "bucket1"))
+	require.Nil(t, store.Bucket("bucket1"))
+	require.NoDirExists(t, store.bucketDir("bucket1"))
+
+	// dropping a missing bucket is a no-op
+	require.Nil(t, store.DropBucket(ctx, "bucket1"))
+
+	// a bucket with the same name starts out empty
+	require.Nil(t, store.CreateOrLoadBucket(ctx, "bucket1"))
+	value, err := store.Bucket("bucket1").Get([]byte("key"))
+	require.Nil(t, err)
+	require.Nil(t, value)
+}
@@ -20,9 +20,6 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
-	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
-	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
-	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
@@ -119,6 +116,7 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			SeparateObjectsCompactions:     m.db.config.SeparateObjectsCompactions,
 			MaxSegmentSize:                 m.db.config.MaxSegmentSize,
 			HNSWMaxLogSize:                 m.db.config.HNSWMaxLogSize,
+			HNSWSnapshotIntervalSeconds:    m.db.config.HNSWSnapshotIntervalSeconds,
 			HNSWWaitForCachePrefill:        m.db.config.HNSWWaitForCachePrefill,
 			HNSWFlatSearchConcurrency:      m.db.config.HNSWFlatSearchConcurrency,
 			VisitedListPoolMaxSize:         m.db.config.VisitedListPoolMaxSize,
@@ -126,6 +124,8 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			AvoidMMap:                      m.db.config.AvoidMMap,
 			DisableLazyLoadShards:          m.db.config.DisableLazyLoadShards,
 			ForceFullReplicasSearch:        m.db.config.ForceFullReplicasSearch,
+			LogArchiver:                    m.db.config.LogArchiver,
+			Handoff:                        m.db.handoff,
 			ReplicationFactor:              NewAtomicInt64(class.ReplicationConfig.Factor),
 			AsyncReplicationEnabled:        class.ReplicationConfig.AsyncEnabled,
 			DeletionStrategy:               class.ReplicationConfig.DeletionStrategy,
@@ -323,9 +323,15 @@ func (m *Migrator) AddProperty(ctx context.Context, className string, prop ...*m
 	return idx.addProperty(ctx, prop...)
 }
 
-// DropProperty is ignored, API compliant change
+// DropProperty removes the data of a property which was deleted from the
+// schema. The cleanup runs in the background, see [ShardPropertyDropper].
 func (m *Migrator) DropProperty(ctx context.Context, className string, propertyName string) error {
-	// ignore but don't error
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot drop property of a non-existing index for %s", className)
+	}
+
+	idx.dropPropertyInBackground(propertyName)
 	return nil
 }
 
@@ -598,28 +604,37 @@ func (m *Migrator) UpdateVectorIndexConfigs(ctx context.Context,
 	return idx.updateVectorIndexConfigs(ctx, updated)
 }
 
+// ValidateVectorIndexConfigUpdate validates an update of the vector index
+// config. Changes an existing index cannot apply, such as a different index
+// type or distance, are valid as well. They are applied by rebuilding the
+// index from the stored vectors, see vectorIndexRequiresRebuild. Class
+// updates have to ask for such a rebuild explicitly.
 func (m *Migrator) ValidateVectorIndexConfigUpdate(
 	old, updated schemaConfig.VectorIndexConfig,
 ) error {
-	// hnsw is the only supported vector index type at the moment, so no need
-	// to check, we can always use that an hnsw-specific validation should be
-	// used for now.
-	switch old.IndexType() {
-	case vectorindex.VectorIndexTypeHNSW:
-		return hnsw.ValidateUserConfigUpdate(old, updated)
-	case vectorindex.VectorIndexTypeFLAT:
-		return flat.ValidateUserConfigUpdate(old, updated)
-	case vectorindex.VectorIndexTypeDYNAMIC:
-		return dynamic.ValidateUserConfigUpdate(old, updated)
+	if updated == nil {
+		return fmt.Errorf("missing vector index config")
 	}
-	return fmt.Errorf("Invalid index type: %s", old.IndexType())
+
+	switch updated.IndexType() {
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT,
+		vectorindex.VectorIndexTypeDYNAMIC:
+		return nil
+	}
+	return fmt.Errorf("Invalid index type: %s", updated.IndexType())
+}
+
+// VectorIndexRequiresRebuild returns whether an update of the vector index
+// config is applied by rebuilding the index
+func (m *Migrator) VectorIndexRequiresRebuild(old, updated schemaConfig.VectorIndexConfig) bool {
+	return vectorIndexRequiresRebuild(old, updated)
 }
 
 func (m *Migrator) ValidateVectorIndexConfigsUpdate(old, updated map[string]schemaConfig.VectorIndexConfig,
 ) error {
 	for vecName := range old {
 		if err := m.ValidateVectorIndexConfigUpdate(old[vecName], updated[vecName]); err != nil {
-			return fmt.Errorf("vector %q", vecName)
+			return fmt.Errorf("vector %q: %w", vecName, err)
 		}
 	}
 	return nil
@@ -648,6 +663,26 @@ func (m *Migrator) UpdateInvertedIndexConfig(ctx context.Context, className stri
 	return idx.updateInvertedIndexConfig(ctx, conf)
 }
 
+// UpdatePropertyIndexes applies changed tokenization and inverted index
+// settings of properties. The affected inverted indexes are rebuilt in the
+// background, shards keep using the previous settings until their rebuilt
+// indexes replace the current ones.
+func (m *Migrator) UpdatePropertyIndexes(ctx context.Context, className string,
+	props []*models.Property,
+) error {
+	indexID := indexID(schema.ClassName(className))
+
+	m.classLocks.Lock(indexID)
+	defer m.classLocks.Unlock(indexID)
+
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update property indexes of non-existing index for %s", className)
+	}
+
+	return idx.updatePropertyIndexes(ctx, props)
+}
+
 func (m *Migrator) UpdateReplicationConfig(ctx context.Context, className string, cfg *models.ReplicationConfig) error {
 	if cfg == nil {
 		return nil
@@ -85,6 +85,11 @@ func (db *DB) IncomingGetNodeStatus(ctx context.Context, className, verbosity st
 	return db.LocalNodeStatus(ctx, className, verbosity), nil
 }
 
+// scaleOperations lists the replication factor changes coordinated by this node
+type scaleOperations interface {
+	Operations(className string) []*models.ReplicationScaleOperation
+}
+
 func (db *DB) LocalNodeStatus(ctx context.Context, className, output string) *models.NodeStatus {
 	if className != "" && db.GetIndex(schema.ClassName(className)) == nil {
 		// class not found
@@ -113,6 +118,9 @@ func (db *DB) LocalNodeStatus(ctx context.Context, className, output string) *mo
 		Stats:      nodeStats,
 		BatchStats: db.localNodeBatchStats(),
 	}
+	if db.scaleOperations != nil {
+		status.ReplicationOperations = db.scaleOperations.Operations(className)
+	}
 
 	return &status
 }
@@ -172,11 +180,14 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 		// Don't force load a lazy shard to get nodes status
 		if lazy, ok := shard.(*LazyLoadShard); ok {
 			if !lazy.isLoaded() {
+				reindexing, reindexed := shard.propertyReindexProgress()
 				shardStatus := &models.NodeShardStatus{
 					Name:                 name,
 					Class:                shard.Index().Config.ClassName.String(),
 					VectorIndexingStatus: shard.GetStatus().String(),
 					Loaded:               false,
+					ReindexingProperties: reindexing,
+					ReindexedObjects:     reindexed,
 				}
 				*status = append(*status, shardStatus)
 				shardCount++
@@ -205,14 +216,22 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 			compressed = shard.VectorIndex().Compressed()
 		}
 
+		reindexing, reindexed := shard.propertyReindexProgress()
+		rebuilding, rebuilt, failed := shard.vectorIndexRebuildProgress()
+
 		shardStatus := &models.NodeShardStatus{
-			Name:                 name,
-			Class:                shard.Index().Config.ClassName.String(),
-			ObjectCount:          objectCount,
-			VectorIndexingStatus: shard.GetStatus().String(),
-			VectorQueueLength:    queueLen,
-			Compressed:           compressed,
-			Loaded:               true,
+			Name:                      name,
+			Class:                     shard.Index().Config.ClassName.String(),
+			ObjectCount:               objectCount,
+			VectorIndexingStatus:      shard.GetStatus().String(),
+			VectorQueueLength:         queueLen,
+			Compressed:                compressed,
+			Loaded:                    true,
+			ReindexingProperties:      reindexing,
+			ReindexedObjects:          reindexed,
+			RebuildingVectorIndexes:   rebuilding,
+			RebuiltVectors:            rebuilt,
+			FailedVectorIndexRebuilds: failed,
 		}
 		*status = append(*status, shardStatus)
 		shardCount++
@@ -41,7 +41,7 @@ type Replicator interface {
 	ReplicateUpdate(ctx context.Context, shard, requestID string,
 		doc *objects.MergeDocument) replica.SimpleResponse
 	ReplicateDeletion(ctx context.Context, shardName, requestID string,
-		uuid strfmt.UUID) replica.SimpleResponse
+		uuid strfmt.UUID, ifMatch *int64) replica.SimpleResponse
 	ReplicateDeletions(ctx context.Context, shardName, requestID string,
 		uuids []strfmt.UUID, dryRun bool, schemaVersion uint64) replica.SimpleResponse
 	ReplicateReferences(ctx context.Context, shard, requestID string,
@@ -86,14 +86,14 @@ func (db *DB) ReplicateUpdate(ctx context.Context, class,
 }
 
 func (db *DB) ReplicateDeletion(ctx context.Context, class,
-	shard, requestID string, uuid strfmt.UUID,
+	shard, requestID string, uuid strfmt.UUID, ifMatch *int64,
 ) replica.SimpleResponse {
 	index, pr := db.replicatedIndex(class)
 	if pr != nil {
 		return *pr
 	}
 
-	return index.ReplicateDeletion(ctx, shard, requestID, uuid)
+	return index.ReplicateDeletion(ctx, shard, requestID, uuid, ifMatch)
 }
 
 func (db *DB) ReplicateDeletions(ctx context.Context, class,
@@ -173,6 +173,12 @@ func (i *Index) writableShard(name string) (ShardLike, func(), *replica.SimpleRe
 }
 
 func (i *Index) ReplicateObject(ctx context.Context, shard, requestID string, object *storobj.Object) replica.SimpleResponse {
+	if resp, ok := i.joining.prepare(shard, requestID, func(ctx context.Context, s ShardLike) replica.SimpleResponse {
+		return s.preparePutObject(ctx, requestID, object)
+	}); ok {
+		return resp
+	}
+
 	localShard, release, pr := i.writableShard(shard)
 	if pr != nil {
 		return *pr
@@ -184,6 +190,12 @@ func (i *Index) ReplicateObject(ctx context.Context, shard, requestID string, ob
 }
 
 func (i *Index) ReplicateUpdate(ctx context.Context, shard, requestID string, doc *objects.MergeDocument) replica.SimpleResponse {
+	if resp, ok := i.joining.prepare(shard, requestID, func(ctx context.Context, s ShardLike) replica.SimpleResponse {
+		return s.prepareMergeObject(ctx, requestID, doc)
+	}); ok {
+		return resp
+	}
+
 	localShard, release, pr := i.writableShard(shard)
 	if pr != nil {
 		return *pr
@@ -194,7 +206,13 @@ func (i *Index) ReplicateUpdate(ctx context.Context, shard, requestID string, do
 	return localShard.prepareMergeObject(ctx, requestID, doc)
 }
 
-func (i *Index) ReplicateDeletion(ctx context.Context, shard, requestID string, uuid strfmt.UUID) replica.SimpleResponse {
+func (i *Index) ReplicateDeletion(ctx context.Context, shard, requestID string, uuid strfmt.UUID, ifMatch *int64) replica.SimpleResponse {
+	if resp, ok := i.joining.prepare(shard, requestID, func(ctx context.Context, s ShardLike) replica.SimpleResponse {
+		return s.prepareDeleteObject(ctx, requestID, uuid, ifMatch)
+	}); ok {
+		return resp
+	}
+
 	localShard, release, pr := i.writableShard(shard)
 	if pr != nil {
 		return *pr
@@ -202,10 +220,16 @@ func (i *Index) ReplicateDeletion(ctx context.Context, shard, requestID string,
 
 	defer release()
 
-	return localShard.prepareDeleteObject(ctx, requestID, uuid)
+	return localShard.prepareDeleteObject(ctx, requestID, uuid, ifMatch)
 }
 
 func (i *Index) ReplicateObjects(ctx context.Context, shard, requestID string, objects []*storobj.Object, schemaVersion uint64) replica.SimpleResponse {
+	if resp, ok := i.joining.prepare(shard, requestID, func(ctx context.Context, s ShardLike) replica.SimpleResponse {
+		return s.preparePutObjects(ctx, requestID, objects)
+	}); ok {
+		return resp
+	}
+
 	localShard, release, pr := i.writableShard(shard)
 	if pr != nil {
 		return *pr
@@ -217,6 +241,12 @@ func (i *Index) ReplicateObjects(ctx context.Context, shard, requestID string, o
 }
 
 func (i *Index) ReplicateDeletions(ctx context.Context, shard, requestID string, uuids []strfmt.UUID, dryRun bool, schemaVersion uint64) replica.SimpleResponse {
+	if resp, ok := i.joining.prepare(shard, requestID, func(ctx context.Context, s ShardLike) replica.SimpleResponse {
+		return s.prepareDeleteObjects(ctx, requestID, uuids, dryRun)
+	}); ok {
+		return resp
+	}
+
 	localShard, release, pr := i.writableShard(shard)
 	if pr != nil {
 		return *pr
@@ -228,6 +258,12 @@ func (i *Index) ReplicateDeletions(ctx context.Context, shard, requestID string,
 }
 
 func (i *Index) ReplicateReferences(ctx context.Context, shard, requestID string, refs []objects.BatchReference) replica.SimpleResponse {
+	if resp, ok := i.joining.prepare(shard, requestID, func(ctx context.Context, s ShardLike) replica.SimpleResponse {
+		return s.prepareAddReferences(ctx, requestID, refs)
+	}); ok {
+		return resp
+	}
+
 	localShard, release, pr := i.writableShard(shard)
 	if pr != nil {
 		return *pr
@@ -239,6 +275,11 @@ func (i *Index) ReplicateReferences(ctx context.Context, shard, requestID string
 }
 
 func (i *Index) CommitReplication(shard, requestID string) interface{} {
+	if i.joining.commit(shard, requestID) {
+		// a nil response would be reported as an unknown request
+		return replica.SimpleResponse{}
+	}
+
 	localShard, release, err := i.getOrInitShard(context.Background(), shard)
 	if err != nil {
 		return replica.SimpleResponse{Errors: []replica.Error{
@@ -252,6 +293,10 @@ func (i *Index) CommitReplication(shard, requestID string) interface{} {
 }
 
 func (i *Index) AbortReplication(shard, requestID string) interface{} {
+	if i.joining.abort(shard, requestID) {
+		return replica.SimpleResponse{}
+	}
+
 	localShard, release, err := i.getOrInitShard(context.Background(), shard)
 	if err != nil {
 		return replica.SimpleResponse{Errors: []replica.Error{
@@ -311,7 +356,17 @@ func (i *Index) IncomingReinitShard(ctx context.Context, shardName string) error
 		return err
 	}
 
-	return i.initLocalShard(ctx, shardName)
+	if err := i.initLocalShard(ctx, shardName); err != nil {
+		return err
+	}
+
+	// apply writes forwarded while the shard was copied
+	shard, release, err := i.getOrInitShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return i.joining.finish(ctx, i, shard)
 }
 
 func (s *Shard) filePutter(ctx context.Context,
@@ -350,7 +405,7 @@ func (idx *Index) OverwriteObjects(ctx context.Context,
 
 	for i, u := range updates {
 		if u.ID != "" && u.Deleted {
-			err := s.DeleteObject(ctx, u.ID)
+			err := s.DeleteObject(ctx, u.ID, nil)
 			if err != nil {
 				r := replica.RepairResponse{
 					ID:  u.ID.String(),
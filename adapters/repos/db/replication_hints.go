@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+const (
+	hintsDir    = "hinted-handoff"
+	hintsBucket = "hints"
+)
+
+// hintStore persists the writes missed by unavailable replicas until they
+// are handed off, see [replica.Handoff]
+type hintStore struct {
+	store           *lsmkv.Store
+	bucket          *lsmkv.Bucket
+	compactionCycle cyclemanager.CycleManager
+	flushCycle      cyclemanager.CycleManager
+}
+
+func newHintStore(ctx context.Context, rootPath string, logger logrus.FieldLogger) (*hintStore, error) {
+	logger = logger.WithField("action", "hinted_handoff")
+	compactionCallbacks := cyclemanager.NewCallbackGroup("hints/compaction", logger, 1)
+	flushCallbacks := cyclemanager.NewCallbackGroup("hints/flush", logger, 1)
+	s := &hintStore{
+		compactionCycle: cyclemanager.NewManager(cyclemanager.CompactionCycleTicker(),
+			compactionCallbacks.CycleCallback, logger),
+		flushCycle: cyclemanager.NewManager(cyclemanager.MemtableFlushCycleTicker(),
+			flushCallbacks.CycleCallback, logger),
+	}
+
+	dir := filepath.Join(rootPath, hintsDir)
+	store, err := lsmkv.New(dir, rootPath, logger, nil,
+		compactionCallbacks, cyclemanager.NewCallbackGroupNoop(), flushCallbacks)
+	if err != nil {
+		return nil, fmt.Errorf("init lsmkv store at %s: %w", dir, err)
+	}
+	if err := store.CreateOrLoadBucket(ctx, hintsBucket,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace)); err != nil {
+		return nil, fmt.Errorf("create bucket %s: %w", hintsBucket, err)
+	}
+	s.store = store
+	s.bucket = store.Bucket(hintsBucket)
+	s.compactionCycle.Start()
+	s.flushCycle.Start()
+	return s, nil
+}
+
+func (s *hintStore) Put(key, value []byte) error {
+	return s.bucket.Put(key, value)
+}
+
+func (s *hintStore) Delete(key []byte) error {
+	return s.bucket.Delete(key)
+}
+
+func (s *hintStore) Scan(prefix []byte, f func(key, value []byte) bool) error {
+	c := s.bucket.Cursor()
+	defer c.Close()
+
+	var k, v []byte
+	if len(prefix) == 0 {
+		k, v = c.First()
+	} else {
+		k, v = c.Seek(prefix)
+	}
+	for ; k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		// the cursor may reuse its buffers
+		if !f(append([]byte{}, k...), append([]byte{}, v...)) {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *hintStore) Shutdown(ctx context.Context) error {
+	if err := s.compactionCycle.StopAndWait(ctx); err != nil {
+		return fmt.Errorf("stop compaction cycle: %w", err)
+	}
+	if err := s.flushCycle.StopAndWait(ctx); err != nil {
+		return fmt.Errorf("stop flush cycle: %w", err)
+	}
+	return s.store.Shutdown(ctx)
+}
+
+// initHandoff opens the hint store and starts handing off hints
+func (db *DB) initHandoff(ctx context.Context) error {
+	hints, err := newHintStore(ctx, db.config.RootPath, db.logger)
+	if err != nil {
+		return err
+	}
+	handoff, err := replica.NewHandoff(db.schemaGetter.NodeName(), hints, db.replicaClient,
+		db.nodeResolver, db.config.Replication.HintedHandoff, db.promMetrics, db.logger)
+	if err != nil {
+		hints.Shutdown(ctx)
+		return err
+	}
+	handoff.Start(0)
+	db.hints, db.handoff = hints, handoff
+	return nil
+}
+
+// NodeJoined hands off the writes missed by node. It is called when node
+// (re)joins the cluster.
+func (db *DB) NodeJoined(node string) {
+	if db.handoff != nil {
+		db.handoff.NodeJoined(node)
+	}
+}
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHintStore(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		dir       = t.TempDir()
+		logger, _ = test.NewNullLogger()
+	)
+
+	scan := func(s *hintStore, prefix string) []string {
+		var keys []string
+		require.Nil(t, s.Scan([]byte(prefix), func(key, value []byte) bool {
+			keys = append(keys, string(key))
+			return true
+		}))
+		return keys
+	}
+
+	s, err := newHintStore(ctx, dir, logger)
+	require.Nil(t, err)
+	for _, k := range []string{"B\x002", "A\x001", "B\x001", "BB\x001"} {
+		require.Nil(t, s.Put([]byte(k), []byte("v")))
+	}
+	assert.Equal(t, []string{"B\x001", "B\x002"}, scan(s, "B\x00"))
+	assert.Equal(t, []string{"A\x001", "B\x001", "B\x002", "BB\x001"}, scan(s, ""))
+	assert.Empty(t, scan(s, "C\x00"))
+
+	require.Nil(t, s.Delete([]byte("B\x001")))
+	assert.Equal(t, []string{"B\x002"}, scan(s, "B\x00"))
+
+	t.Run("stop early", func(t *testing.T) {
+		n := 0
+		require.Nil(t, s.Scan(nil, func(key, value []byte) bool {
+			n++
+			return false
+		}))
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("reopen", func(t *testing.T) {
+		require.Nil(t, s.Shutdown(ctx))
+		s, err = newHintStore(ctx, dir, logger)
+		require.Nil(t, err)
+		defer s.Shutdown(ctx)
+		assert.Equal(t, []string{"A\x001", "B\x002", "BB\x001"}, scan(s, ""))
+	})
+}
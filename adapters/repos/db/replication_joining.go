@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+// maxJoiningWrites is the maximum number of writes buffered per joining shard
+const maxJoiningWrites = 10_000
+
+// errJoiningWritesLost is returned when the writes forwarded to a joining
+// shard exceeded maxJoiningWrites and have been discarded
+var errJoiningWritesLost = errors.New("too many writes forwarded while the shard was copied")
+
+// joiningShards buffers writes forwarded to shards which are being copied to
+// this node while the replication factor of their class is increased. The
+// writes are applied in commit order once the copy has been loaded, so that
+// they take precedence over the copied data.
+//
+// At most maxJoiningWrites are buffered per shard. Once exceeded, all writes
+// are discarded and loading the copy fails, so that the copy is aborted.
+type joiningShards struct {
+	sync.Mutex
+	shards map[string]*joiningShard
+}
+
+type joiningShard struct {
+	prepared  map[string]joiningWrite
+	committed []joiningWrite
+	lost      bool // writes have been discarded
+}
+
+// joiningWrite prepares a buffered write on the loaded shard
+type joiningWrite struct {
+	requestID string
+	prepare   func(context.Context, ShardLike) replica.SimpleResponse
+}
+
+// start buffers writes to the given shards
+func (j *joiningShards) start(names []string) {
+	j.Lock()
+	defer j.Unlock()
+	if j.shards == nil {
+		j.shards = make(map[string]*joiningShard, len(names))
+	}
+	for _, name := range names {
+		if _, ok := j.shards[name]; !ok {
+			j.shards[name] = &joiningShard{prepared: map[string]joiningWrite{}}
+		}
+	}
+}
+
+// stop discards the buffered writes of the given shards
+func (j *joiningShards) stop(names []string) {
+	j.Lock()
+	defer j.Unlock()
+	for _, name := range names {
+		delete(j.shards, name)
+	}
+}
+
+// prepare buffers the write if shard is joining. It reports whether it did so.
+func (j *joiningShards) prepare(shard, requestID string,
+	f func(context.Context, ShardLike) replica.SimpleResponse,
+) (replica.SimpleResponse, bool) {
+	j.Lock()
+	defer j.Unlock()
+	js, ok := j.shards[shard]
+	if !ok {
+		return replica.SimpleResponse{}, false
+	}
+	if !js.lost && len(js.prepared)+len(js.committed) >= maxJoiningWrites {
+		js.lost, js.prepared, js.committed = true, map[string]joiningWrite{}, nil
+	}
+	if js.lost {
+		return replica.SimpleResponse{Errors: []replica.Error{
+			{Msg: errJoiningWritesLost.Error(), Err: errJoiningWritesLost},
+		}}, true
+	}
+	js.prepared[requestID] = joiningWrite{requestID: requestID, prepare: f}
+	return replica.SimpleResponse{}, true
+}
+
+// commit marks a buffered write as committed. It reports whether the shard is joining.
+func (j *joiningShards) commit(shard, requestID string) bool {
+	j.Lock()
+	defer j.Unlock()
+	js, ok := j.shards[shard]
+	if !ok {
+		return false
+	}
+	if w, ok := js.prepared[requestID]; ok {
+		delete(js.prepared, requestID)
+		js.committed = append(js.committed, w)
+	}
+	return true
+}
+
+// abort discards a buffered write. It reports whether the shard is joining.
+func (j *joiningShards) abort(shard, requestID string) bool {
+	j.Lock()
+	defer j.Unlock()
+	js, ok := j.shards[shard]
+	if ok {
+		delete(js.prepared, requestID)
+	}
+	return ok
+}
+
+// finish applies the buffered writes to the loaded shard and stops buffering.
+// Writes which have been prepared but not committed yet are prepared on the
+// shard, so that their commit succeeds. It fails if writes have been discarded.
+func (j *joiningShards) finish(ctx context.Context, i *Index, shard ShardLike) error {
+	j.Lock()
+	defer j.Unlock()
+	js, ok := j.shards[shard.Name()]
+	if !ok {
+		return nil
+	}
+	delete(j.shards, shard.Name())
+	if js.lost {
+		return fmt.Errorf("shard %q: %w", shard.Name(), errJoiningWritesLost)
+	}
+
+	for _, w := range js.committed {
+		if resp := w.prepare(ctx, shard); resp.FirstError() != nil {
+			i.logger.WithField("action", "apply_forwarded_write").
+				WithField("shard", shard.Name()).Error(resp.FirstError())
+			continue
+		}
+		shard.commitReplication(ctx, w.requestID, &i.shardTransferMutex)
+	}
+	for _, w := range js.prepared {
+		w.prepare(ctx, shard)
+	}
+	return nil
+}
+
+// JoinShardReplicas buffers writes to shards which are going to be copied to
+// this node, until the copied shard has been loaded
+func (db *DB) JoinShardReplicas(ctx context.Context, class string, shards []string) error {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return fmt.Errorf("class %q not found", class)
+	}
+	idx.joining.start(shards)
+	return nil
+}
+
+// DropShardReplicas removes the local replicas of shards which don't belong
+// to this node anymore
+func (db *DB) DropShardReplicas(ctx context.Context, class string, shards []string) error {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return fmt.Errorf("class %q not found", class)
+	}
+	idx.joining.stop(shards)
+	idx.syncs.forget(shards)
+	idx.watermarks.forget(shards)
+	if err := idx.dropShards(shards); err != nil {
+		return err
+	}
+	// shards which haven't been loaded are not known to the index
+	for _, name := range shards {
+		if err := os.RemoveAll(shardPath(idx.path(), name)); err != nil {
+			return fmt.Errorf("remove shard %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// SyncShardReplicas pushes the objects of local shards which are missing or
+// stale on the nodes the shards are mapped to in dist. Deletions are not
+// propagated.
+func (db *DB) SyncShardReplicas(ctx context.Context, class string, dist map[string][]string) error {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return fmt.Errorf("class %q not found", class)
+	}
+	for name, nodes := range dist {
+		hosts := make([]string, len(nodes))
+		for i, node := range nodes {
+			host, ok := db.nodeResolver.NodeHostname(node)
+			if !ok {
+				return fmt.Errorf("cannot resolve node name %q", node)
+			}
+			hosts[i] = host
+		}
+		shard, release, err := idx.getOrInitShard(ctx, name)
+		if err != nil {
+			return fmt.Errorf("shard %q: %w", name, err)
+		}
+		err = shard.pushToReplicas(ctx, hosts)
+		release()
+		if err != nil {
+			return fmt.Errorf("shard %q: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func TestJoiningShards(t *testing.T) {
+	var (
+		j    joiningShards
+		noop = func(context.Context, ShardLike) replica.SimpleResponse { return replica.SimpleResponse{} }
+	)
+
+	_, ok := j.prepare("S1", "r1", noop)
+	assert.False(t, ok, "shard isn't joining")
+	assert.False(t, j.commit("S1", "r1"))
+
+	j.start([]string{"S1"})
+	_, ok = j.prepare("S1", "r1", noop)
+	assert.True(t, ok)
+	_, ok = j.prepare("S1", "r2", noop)
+	assert.True(t, ok)
+	_, ok = j.prepare("S1", "r3", noop)
+	assert.True(t, ok)
+
+	assert.True(t, j.commit("S1", "r2"))
+	assert.True(t, j.commit("S1", "r1"))
+	assert.True(t, j.abort("S1", "r3"))
+
+	js := j.shards["S1"]
+	assert.Empty(t, js.prepared)
+	if assert.Len(t, js.committed, 2) {
+		assert.Equal(t, "r2", js.committed[0].requestID, "commit order")
+		assert.Equal(t, "r1", js.committed[1].requestID)
+	}
+
+	// starting again keeps buffered writes
+	j.start([]string{"S1"})
+	assert.Len(t, j.shards["S1"].committed, 2)
+
+	j.stop([]string{"S1"})
+	assert.False(t, j.abort("S1", "r1"))
+}
+
+type namedShard struct {
+	ShardLike
+	name string
+}
+
+func (s namedShard) Name() string { return s.name }
+
+func TestJoiningShardsLostWrites(t *testing.T) {
+	var (
+		j    joiningShards
+		noop = func(context.Context, ShardLike) replica.SimpleResponse { return replica.SimpleResponse{} }
+	)
+
+	j.start([]string{"S1"})
+	for i := 0; i < maxJoiningWrites; i++ {
+		resp, ok := j.prepare("S1", fmt.Sprint(i), noop)
+		assert.True(t, ok)
+		assert.Nil(t, resp.FirstError())
+		assert.True(t, j.commit("S1", fmt.Sprint(i)))
+	}
+
+	resp, ok := j.prepare("S1", "overflow", noop)
+	assert.True(t, ok)
+	assert.ErrorIs(t, resp.FirstError(), errJoiningWritesLost)
+	assert.Empty(t, j.shards["S1"].committed, "buffered writes are discarded")
+
+	err := j.finish(context.Background(), nil, namedShard{name: "S1"})
+	assert.ErrorIs(t, err, errJoiningWritesLost)
+	_, ok = j.prepare("S1", "r1", noop)
+	assert.False(t, ok, "shard isn't joining anymore")
+}
@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"sync"
+	"time"
+)
+
+// replicaSyncs keeps track of when the local replicas of shards were last
+// known to be in sync with the other replicas. Bounded staleness reads are
+// answered by the local replica alone as long as it is recent enough.
+type replicaSyncs struct {
+	sync.Mutex
+	last map[string]time.Time
+}
+
+// mark records that the local replica of shard is in sync at time t
+func (r *replicaSyncs) mark(shard string, t time.Time) {
+	r.Lock()
+	defer r.Unlock()
+	if r.last == nil {
+		r.last = make(map[string]time.Time)
+	}
+	if t.After(r.last[shard]) {
+		r.last[shard] = t
+	}
+}
+
+// get returns when the local replica of shard was last in sync or the zero
+// time if this is unknown
+func (r *replicaSyncs) get(shard string) time.Time {
+	r.Lock()
+	defer r.Unlock()
+	return r.last[shard]
+}
+
+// forget discards the sync times of the given shards
+func (r *replicaSyncs) forget(shards []string) {
+	r.Lock()
+	defer r.Unlock()
+	for _, name := range shards {
+		delete(r.last, name)
+	}
+}
+
+// LastSync returns when the local replica of shard last completed an
+// anti-entropy sync without differences. Acknowledged writes don't count,
+// since the replica might have missed earlier ones.
+func (i *Index) LastSync(shard string) time.Time {
+	return i.syncs.get(shard)
+}
+
+// appliedWatermarks keeps track of the latest update time of the replicated
+// writes applied to the local replicas of shards since they were loaded.
+// Reads which must reflect the writes of a consistency token can be answered
+// by a replica whose watermark reaches the token.
+type appliedWatermarks struct {
+	sync.Mutex
+	latest map[string]int64
+}
+
+// mark records that a write with updateTime has been applied to shard
+func (w *appliedWatermarks) mark(shard string, updateTime int64) {
+	w.Lock()
+	defer w.Unlock()
+	if w.latest == nil {
+		w.latest = make(map[string]int64)
+	}
+	if updateTime > w.latest[shard] {
+		w.latest[shard] = updateTime
+	}
+}
+
+// get returns the watermark of shard or 0 if no write has been applied
+func (w *appliedWatermarks) get(shard string) int64 {
+	w.Lock()
+	defer w.Unlock()
+	return w.latest[shard]
+}
+
+// forget discards the watermarks of the given shards
+func (w *appliedWatermarks) forget(shards []string) {
+	w.Lock()
+	defer w.Unlock()
+	for _, name := range shards {
+		delete(w.latest, name)
+	}
+}
+
+// AppliedWatermark returns the latest update time of the replicated writes
+// applied to the local replica of shard, or 0 if this is unknown
+func (i *Index) AppliedWatermark(shard string) int64 {
+	return i.watermarks.get(shard)
+}
@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplicaSyncs(t *testing.T) {
+	var (
+		r   replicaSyncs
+		now = time.Now()
+	)
+
+	assert.True(t, r.get("S1").IsZero(), "never synced")
+
+	r.mark("S1", now)
+	r.mark("S1", now.Add(-time.Minute))
+	assert.Equal(t, now, r.get("S1"), "older syncs are ignored")
+	assert.True(t, r.get("S2").IsZero())
+
+	r.forget([]string{"S1"})
+	assert.True(t, r.get("S1").IsZero())
+}
+
+func TestAppliedWatermarks(t *testing.T) {
+	var w appliedWatermarks
+
+	assert.Equal(t, int64(0), w.get("S1"), "nothing applied")
+
+	w.mark("S1", 5)
+	w.mark("S1", 3)
+	assert.Equal(t, int64(5), w.get("S1"), "older writes do not lower the watermark")
+	assert.Equal(t, int64(0), w.get("S2"))
+
+	w.forget([]string{"S1"})
+	assert.Equal(t, int64(0), w.get("S1"))
+}
@@ -52,6 +52,9 @@ type DB struct {
 	startupComplete   atomic.Bool
 	resourceScanState *resourceScanState
 	memMonitor        *memwatch.Monitor
+	scaleOperations   scaleOperations
+	hints             *hintStore
+	handoff           *replica.Handoff
 
 	// indexLock is an RWMutex which allows concurrent access to various indexes,
 	// but only one modification at a time. R/W can be a bit confusing here,
@@ -108,6 +111,12 @@ func (db *DB) SetSchemaGetter(sg schemaUC.SchemaGetter) {
 	db.schemaGetter = sg
 }
 
+// SetScaleOperations sets the source of the replication factor changes
+// reported as part of the node status
+func (db *DB) SetScaleOperations(ops scaleOperations) {
+	db.scaleOperations = ops
+}
+
 func (db *DB) WaitForStartup(ctx context.Context) error {
 	err := db.init(ctx)
 	if err != nil {
@@ -189,6 +198,7 @@ type Config struct {
 	SeparateObjectsCompactions     bool
 	MaxSegmentSize                 int64
 	HNSWMaxLogSize                 int64
+	HNSWSnapshotIntervalSeconds    int
 	HNSWWaitForCachePrefill        bool
 	HNSWFlatSearchConcurrency      int
 	VisitedListPoolMaxSize         int
@@ -199,6 +209,8 @@ type Config struct {
 	DisableLazyLoadShards          bool
 	ForceFullReplicasSearch        bool
 	Replication                    replication.GlobalConfig
+	// LogArchiver is optional, it is notified about commit logs to be archived
+	LogArchiver LogArchiver
 }
 
 // GetIndex returns the index if it exists or nil if it doesn't
@@ -317,6 +329,15 @@ func (db *DB) Shutdown(ctx context.Context) error {
 		db.indexCheckpoints.Close()
 	}
 
+	if db.handoff != nil {
+		if err := db.handoff.Shutdown(ctx); err != nil {
+			return errors.Wrap(err, "shutdown hinted handoff")
+		}
+		if err := db.hints.Shutdown(ctx); err != nil {
+			return errors.Wrap(err, "shutdown hint store")
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// restoreRewriteBatchSize is the number of objects rewritten before the
+// memtable is flushed, this keeps memory bounded for large shards
+const restoreRewriteBatchSize = 10_000
+
+// RewriteRestoredClass rewrites the shards of a class restored under a different
+// name or referencing classes restored under a different name. indexDir holds the
+// restored shards, class is the class schema as stored in the backup and mapping
+// maps the names of classes in the backup to the names they are restored as.
+//
+// Objects are stored under the new class name. Local beacons and the filterable
+// index of reference properties are redirected to the new names of renamed classes.
+func (db *DB) RewriteRestoredClass(ctx context.Context, indexDir string,
+	class *models.Class, mapping map[string]string,
+) error {
+	entries, err := os.ReadDir(indexDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // nothing restored on this node
+		}
+		return fmt.Errorf("read index dir %s: %w", indexDir, err)
+	}
+
+	name := class.Class
+	if to, ok := mapping[name]; ok {
+		name = to
+	}
+	var refProps []string
+	for _, prop := range class.Properties {
+		if len(prop.DataType) > 0 && schema.IsRefDataType(prop.DataType) {
+			refProps = append(refProps, prop.Name)
+		}
+	}
+
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		shardDir := filepath.Join(indexDir, e.Name())
+		lsmDir := filepath.Join(shardDir, "lsm")
+		if _, err := os.Stat(filepath.Join(lsmDir, helpers.ObjectsBucketLSM)); err != nil {
+			continue // not a shard
+		}
+		logger := db.logger.WithFields(logrus.Fields{
+			"action": "restore_rewrite_class",
+			"class":  name,
+			"shard":  e.Name(),
+		})
+		if err := rewriteRestoredShard(ctx, logger, shardDir, name, refProps, mapping); err != nil {
+			return fmt.Errorf("rewrite shard %s: %w", e.Name(), err)
+		}
+	}
+	return nil
+}
+
+func rewriteRestoredShard(ctx context.Context, logger logrus.FieldLogger, shardDir, class string,
+	refProps []string, mapping map[string]string,
+) (err error) {
+	lsmDir := filepath.Join(shardDir, "lsm")
+	store, err := lsmkv.New(lsmDir, shardDir, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	if err != nil {
+		return fmt.Errorf("init lsmkv store at %s: %w", lsmDir, err)
+	}
+	defer func() {
+		if serr := store.Shutdown(ctx); serr != nil && err == nil {
+			err = fmt.Errorf("shutdown lsmkv store at %s: %w", lsmDir, serr)
+		}
+	}()
+
+	if err := store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithSecondaryIndices(2),
+		lsmkv.WithKeepTombstones(true),
+	); err != nil {
+		return fmt.Errorf("load objects bucket: %w", err)
+	}
+	if err := rewriteRestoredObjects(store.Bucket(helpers.ObjectsBucketLSM), class, mapping); err != nil {
+		return err
+	}
+
+	for _, prop := range refProps {
+		name := helpers.BucketFromPropNameLSM(prop)
+		if _, err := os.Stat(filepath.Join(lsmDir, name)); err != nil {
+			continue // property is not filterable
+		}
+		if err := store.CreateOrLoadBucket(ctx, name,
+			lsmkv.WithStrategy(lsmkv.StrategyRoaringSet)); err != nil {
+			return fmt.Errorf("load bucket of property %s: %w", prop, err)
+		}
+		if err := rewriteRestoredBeacons(store.Bucket(name), mapping); err != nil {
+			return fmt.Errorf("property %s: %w", prop, err)
+		}
+	}
+	return nil
+}
+
+type restoredObject struct {
+	id    []byte
+	data  []byte
+	docID uint64
+}
+
+// rewriteRestoredObjects stores all objects of bucket under class and redirects
+// their beacons according to mapping
+func rewriteRestoredObjects(bucket *lsmkv.Bucket, class string, mapping map[string]string) error {
+	var after []byte
+	for {
+		objs, last, err := restoredObjectsBatch(bucket, after, class, mapping)
+		if err != nil {
+			return err
+		}
+		if last == nil {
+			return nil
+		}
+		for _, obj := range objs {
+			if err := upsertObjectDataLSM(bucket, obj.id, obj.data, obj.docID); err != nil {
+				return fmt.Errorf("put object: %w", err)
+			}
+		}
+		if len(objs) > 0 {
+			if err := bucket.FlushAndSwitch(); err != nil {
+				return fmt.Errorf("flush objects: %w", err)
+			}
+		}
+		after = last
+	}
+}
+
+// restoredObjectsBatch returns the rewritten objects among the next
+// restoreRewriteBatchSize objects following key after and the last key read.
+// The cursor is closed before the objects are written back, since writes
+// must not happen while it holds the flush lock.
+func restoredObjectsBatch(bucket *lsmkv.Bucket, after []byte, class string,
+	mapping map[string]string,
+) ([]restoredObject, []byte, error) {
+	c := bucket.Cursor()
+	defer c.Close()
+
+	k, v := c.First()
+	if after != nil {
+		if k, v = c.Seek(after); bytes.Equal(k, after) {
+			k, v = c.Next()
+		}
+	}
+
+	var objs []restoredObject
+	var last []byte
+	for n := 0; k != nil && n < restoreRewriteBatchSize; n++ {
+		last = append([]byte(nil), k...)
+		obj, err := storobj.FromBinary(v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unmarshal object %x: %w", k, err)
+		}
+		if rewriteRestoredObject(obj, class, mapping) {
+			data, err := obj.MarshalBinary()
+			if err != nil {
+				return nil, nil, fmt.Errorf("marshal object %s: %w", obj.ID(), err)
+			}
+			objs = append(objs, restoredObject{id: last, data: data, docID: obj.DocID})
+		}
+		k, v = c.Next()
+	}
+	return objs, last, nil
+}
+
+// rewriteRestoredObject sets the class of obj and redirects its beacons
+// according to mapping. It returns whether obj was changed.
+func rewriteRestoredObject(obj *storobj.Object, class string, mapping map[string]string) bool {
+	changed := false
+	if obj.Class().String() != class {
+		obj.SetClass(class)
+		changed = true
+	}
+	props, ok := obj.Properties().(map[string]interface{})
+	if !ok {
+		return changed
+	}
+	for _, value := range props {
+		refs, ok := value.(models.MultipleRef)
+		if !ok {
+			continue
+		}
+		for _, ref := range refs {
+			if beacon, ok := mappedBeacon(ref.Beacon, mapping); ok {
+				ref.Beacon = beacon
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+// rewriteRestoredBeacons moves the doc ids indexed under a beacon of a renamed
+// class to the beacon of its new name
+func rewriteRestoredBeacons(bucket *lsmkv.Bucket, mapping map[string]string) error {
+	type rewrite struct {
+		from, to []byte
+		ids      *sroar.Bitmap
+	}
+	var rewrites []rewrite
+
+	c := bucket.CursorRoaringSet()
+	for k, bm := c.First(); k != nil; k, bm = c.Next() {
+		beacon, ok := mappedBeacon(strfmt.URI(k), mapping)
+		if !ok || bm.IsEmpty() {
+			continue
+		}
+		rewrites = append(rewrites, rewrite{
+			from: append([]byte(nil), k...),
+			to:   []byte(beacon),
+			ids:  bm.Clone(),
+		})
+	}
+	c.Close()
+
+	for _, rw := range rewrites {
+		for _, id := range rw.ids.ToArray() {
+			if err := bucket.RoaringSetRemoveOne(rw.from, id); err != nil {
+				return fmt.Errorf("remove %s: %w", rw.from, err)
+			}
+		}
+		if err := bucket.RoaringSetAddBitmap(rw.to, rw.ids); err != nil {
+			return fmt.Errorf("add %s: %w", rw.to, err)
+		}
+	}
+	return nil
+}
+
+// mappedBeacon returns the beacon pointing to the new name of the class
+// referenced by a local beacon, if that class is renamed
+func mappedBeacon(beacon strfmt.URI, mapping map[string]string) (strfmt.URI, bool) {
+	ref, err := crossref.Parse(beacon.String())
+	if err != nil || !ref.Local || ref.Class == "" {
+		return "", false
+	}
+	to, ok := mapping[ref.Class]
+	if !ok {
+		return "", false
+	}
+	return strfmt.URI(crossref.NewLocalhost(to, ref.TargetID).String()), true
+}
@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestRewriteRestoredClass(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		logger, _ = test.NewNullLogger()
+		indexDir  = filepath.Join(t.TempDir(), "article")
+		shardDir  = filepath.Join(indexDir, "shard1")
+		authorID  = strfmt.UUID("7c8183ab-2a09-4d8a-a3e3-f9d8b0b1bc36")
+		otherID   = strfmt.UUID("2bdd4fbc-8b0a-4c5c-a5a0-3d58b6e2fb87")
+		class     = &models.Class{
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "writtenBy", DataType: []string{"Author", "Other"}},
+			},
+		}
+		mapping = map[string]string{"Article": "ArticleCopy", "Author": "AuthorCopy"}
+	)
+	authorBeacon := crossref.NewLocalhost("Author", authorID).String()
+	otherBeacon := crossref.NewLocalhost("Other", otherID).String()
+
+	objects := make([]*storobj.Object, 3)
+	for i := range objects {
+		objects[i] = storobj.FromObject(&models.Object{
+			ID:    strfmt.UUID(uuid.NewString()),
+			Class: "Article",
+			Properties: map[string]interface{}{
+				"title": "article",
+				"writtenBy": models.MultipleRef{
+					{Beacon: strfmt.URI(authorBeacon)},
+					{Beacon: strfmt.URI(otherBeacon)},
+				},
+			},
+		}, []float32{1, 2, 3}, nil)
+		objects[i].DocID = uint64(i)
+	}
+
+	openStore := func() *lsmkv.Store {
+		store, err := lsmkv.New(filepath.Join(shardDir, "lsm"), shardDir, logger, nil,
+			cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop())
+		require.Nil(t, err)
+		require.Nil(t, store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM,
+			lsmkv.WithStrategy(lsmkv.StrategyReplace),
+			lsmkv.WithSecondaryIndices(2),
+			lsmkv.WithKeepTombstones(true)))
+		require.Nil(t, store.CreateOrLoadBucket(ctx, helpers.BucketFromPropNameLSM("writtenBy"),
+			lsmkv.WithStrategy(lsmkv.StrategyRoaringSet)))
+		return store
+	}
+
+	t.Run("store objects of the backup", func(t *testing.T) {
+		store := openStore()
+		objs := store.Bucket(helpers.ObjectsBucketLSM)
+		refs := store.Bucket(helpers.BucketFromPropNameLSM("writtenBy"))
+		for _, obj := range objects {
+			id, err := uuid.MustParse(obj.ID().String()).MarshalBinary()
+			require.Nil(t, err)
+			data, err := obj.MarshalBinary()
+			require.Nil(t, err)
+			require.Nil(t, upsertObjectDataLSM(objs, id, data, obj.DocID))
+			require.Nil(t, refs.RoaringSetAddOne([]byte(authorBeacon), obj.DocID))
+			require.Nil(t, refs.RoaringSetAddOne([]byte(otherBeacon), obj.DocID))
+		}
+		require.Nil(t, store.Shutdown(ctx))
+	})
+
+	t.Run("rewrite class", func(t *testing.T) {
+		db := &DB{logger: logger}
+		require.Nil(t, db.RewriteRestoredClass(ctx, indexDir, class, mapping))
+	})
+
+	t.Run("restored objects use the new names", func(t *testing.T) {
+		store := openStore()
+		defer store.Shutdown(ctx)
+
+		newBeacon := crossref.NewLocalhost("AuthorCopy", authorID).String()
+		objs := store.Bucket(helpers.ObjectsBucketLSM)
+		for _, want := range objects {
+			docID := make([]byte, 8)
+			binary.LittleEndian.PutUint64(docID, want.DocID)
+			data, err := objs.GetBySecondary(helpers.ObjectsBucketLSMDocIDSecondaryIndex, docID)
+			require.Nil(t, err)
+			got, err := storobj.FromBinary(data)
+			require.Nil(t, err)
+
+			assert.Equal(t, want.ID(), got.ID())
+			assert.Equal(t, "ArticleCopy", got.Class().String())
+			assert.Equal(t, want.Vector, got.Vector)
+			props := got.Properties().(map[string]interface{})
+			assert.Equal(t, "article", props["title"])
+			assert.Equal(t, models.MultipleRef{
+				{Beacon: strfmt.URI(newBeacon)},
+				{Beacon: strfmt.URI(otherBeacon)},
+			}, props["writtenBy"])
+		}
+
+		refs := store.Bucket(helpers.BucketFromPropNameLSM("writtenBy"))
+		bm, err := refs.RoaringSetGet([]byte(authorBeacon))
+		require.Nil(t, err)
+		assert.True(t, bm.IsEmpty())
+		bm, err = refs.RoaringSetGet([]byte(newBeacon))
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0, 1, 2}, bm.ToArray())
+		bm, err = refs.RoaringSetGet([]byte(otherBeacon))
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0, 1, 2}, bm.ToArray())
+	})
+
+	t.Run("classes not restored on this node are skipped", func(t *testing.T) {
+		db := &DB{logger: logger}
+		err := db.RewriteRestoredClass(ctx, filepath.Join(t.TempDir(), "missing"), class, mapping)
+		assert.Nil(t, err)
+	})
+}
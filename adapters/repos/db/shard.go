@@ -89,13 +89,17 @@ type ShardLike interface {
 	UpdateAsyncReplication(ctx context.Context, enabled bool) error
 	AddReferencesBatch(ctx context.Context, refs objects.BatchReferences) []error
 	DeleteObjectBatch(ctx context.Context, ids []strfmt.UUID, dryRun bool) objects.BatchSimpleObjects // Delete many objects by id
-	DeleteObject(ctx context.Context, id strfmt.UUID) error                                           // Delete object by id
+	DeleteObject(ctx context.Context, id strfmt.UUID, ifMatch *int64) error                           // Delete object by id, optionally conditional
 	MultiObjectByID(ctx context.Context, query []multi.Identifier) ([]*storobj.Object, error)
 	ObjectDigestsByTokenRange(ctx context.Context, initialToken, finalToken uint64, limit int) (objs []replica.RepairResponse, lastTokenRead uint64, err error)
 	ID() string // Get the shard id
 	drop() error
 	HaltForTransfer(ctx context.Context) error
 	initPropertyBuckets(ctx context.Context, eg *enterrors.ErrorGroupWrapper, props ...*models.Property)
+	dropPropertyData(ctx context.Context, propName string) error
+	updatePropertyIndexes(ctx context.Context, live, target map[string]propertyIndexSettings) error
+	propertyReindexProgress() ([]string, int64)
+	vectorIndexRebuildProgress() ([]string, int64, []string)
 	ListBackupFiles(ctx context.Context, ret *backup.ShardDescriptor) error
 	resumeMaintenanceCycles(ctx context.Context) error
 	SetPropertyLengths(props []inverted.Property) error
@@ -125,13 +129,14 @@ type ShardLike interface {
 	preparePutObject(context.Context, string, *storobj.Object) replica.SimpleResponse
 	preparePutObjects(context.Context, string, []*storobj.Object) replica.SimpleResponse
 	prepareMergeObject(context.Context, string, *objects.MergeDocument) replica.SimpleResponse
-	prepareDeleteObject(context.Context, string, strfmt.UUID) replica.SimpleResponse
+	prepareDeleteObject(context.Context, string, strfmt.UUID, *int64) replica.SimpleResponse
 	prepareDeleteObjects(context.Context, string, []strfmt.UUID, bool) replica.SimpleResponse
 	prepareAddReferences(context.Context, string, []objects.BatchReference) replica.SimpleResponse
 
 	commitReplication(context.Context, string, *shardTransfer) interface{}
 	abortReplication(context.Context, string) replica.SimpleResponse
 	filePutter(context.Context, string) (io.WriteCloser, error)
+	pushToReplicas(context.Context, []string) error
 
 	// TODO tests only
 	Dimensions(ctx context.Context) int // dim(vector)*number vectors
@@ -151,8 +156,9 @@ type ShardLike interface {
 	setFallbackToSearchable(fallback bool)
 	addJobToQueue(job job)
 	uuidFromDocID(docID uint64) (strfmt.UUID, error)
-	batchDeleteObject(ctx context.Context, id strfmt.UUID) error
+	batchDeleteObject(ctx context.Context, id strfmt.UUID) (int64, error)
 	putObjectLSM(object *storobj.Object, idBytes []byte) (objectInsertStatus, error)
+	putObjectUniqueKeyLSM(ctx context.Context, object *storobj.Object, idBytes []byte) (objectInsertStatus, error)
 	mayUpsertObjectHashTree(object *storobj.Object, idBytes []byte, status objectInsertStatus) error
 	mutableMergeObjectLSM(merge objects.MergeDocument, idBytes []byte) (mutableMergeResult, error)
 	batchExtendInvertedIndexItemsLSMNoFrequency(b *lsmkv.Bucket, item inverted.MergeItem) error
@@ -215,6 +221,9 @@ type Shard struct {
 	centralJobQueue chan job // reference to queue used by all shards
 
 	docIdLock []sync.Mutex
+	// serializes writes of objects with the same unique key, see
+	// shard_write_unique_key.go
+	uniqueKeyLock []sync.Mutex
 	// replication
 	replicationMap pendingReplicaTasks
 
@@ -229,6 +238,29 @@ type Shard struct {
 	// being enabled, only searchable bucket exists
 	fallbackToSearchable bool
 
+	// settings of properties the inverted index buckets are currently built
+	// with, see shard_reindex_property.go
+	propertyIndexSettings propertyIndexSettingsMap
+	propertyReindex       atomic.Pointer[propertyReindexJob]
+	// held for reading by writes to the inverted and vector indexes and for
+	// writing while a reindex job processes a batch of objects or replaces
+	// buckets or vector indexes
+	reindexLock sync.RWMutex
+	// held for reading by queries and for writing while a reindex job
+	// replaces buckets or vector indexes. A waiting reindex job blocks new
+	// queries, so queries nested through reference filters run under the
+	// lock of the outer query, see rlockReindexReaders.
+	reindexReadLock sync.RWMutex
+	// serializes starting and stopping reindex jobs
+	reindexJobLock sync.Mutex
+
+	// rebuilt vector indexes and the stores keeping their buckets, see
+	// shard_rebuild_vector_index.go
+	vectorIndexStates     map[string]vectorIndexState
+	vectorIndexStores     map[string]*lsmkv.Store
+	vectorIndexStatesLock sync.Mutex
+	vectorIndexRebuilds   map[string]*vectorIndexRebuildJob
+
 	cycleCallbacks *shardCycleCallbacks
 	bitmapFactory  *roaringset.BitmapFactory
 
@@ -630,6 +662,12 @@ func (s *Shard) UpdateVectorIndexConfig(ctx context.Context, updated schemaConfi
 		return err
 	}
 
+	// changes the index cannot apply itself are applied by rebuilding it in
+	// the background
+	if rebuilding, err := s.mayRebuildVectorIndex("", updated); err != nil || rebuilding {
+		return err
+	}
+
 	err := s.SetStatusReadonly("UpdateVectorIndexConfig")
 	if err != nil {
 		return fmt.Errorf("attempt to mark read-only: %w", err)
@@ -644,13 +682,30 @@ func (s *Shard) UpdateVectorIndexConfigs(ctx context.Context, updated map[string
 	if err := s.isReadOnly(); err != nil {
 		return err
 	}
+
+	// changes the indexes cannot apply themselves are applied by rebuilding
+	// them in the background
+	inPlace := make(map[string]schemaConfig.VectorIndexConfig, len(updated))
+	for targetName, targetCfg := range updated {
+		rebuilding, err := s.mayRebuildVectorIndex(targetName, targetCfg)
+		if err != nil {
+			return fmt.Errorf("vector %q: %w", targetName, err)
+		}
+		if !rebuilding {
+			inPlace[targetName] = targetCfg
+		}
+	}
+	if len(inPlace) == 0 {
+		return nil
+	}
+
 	if err := s.SetStatusReadonly("UpdateVectorIndexConfig"); err != nil {
 		return fmt.Errorf("attempt to mark read-only: %w", err)
 	}
 
 	wg := new(sync.WaitGroup)
 	var err error
-	for targetName, targetCfg := range updated {
+	for targetName, targetCfg := range inPlace {
 		wg.Add(1)
 		if err = s.VectorIndexForName(targetName).UpdateUserConfig(targetCfg, wg.Done); err != nil {
 			break
@@ -20,6 +20,10 @@ import (
 )
 
 func (s *Shard) Aggregate(ctx context.Context, params aggregation.Params, modules *modules.Provider) (*aggregation.Result, error) {
+	// see comment on reindexReadLock in shard.go
+	ctx, unlock := s.rlockReindexReaders(ctx)
+	defer unlock()
+
 	var queue *IndexQueue
 
 	// we only need the index queue for vector search
@@ -33,7 +37,7 @@ func (s *Shard) Aggregate(ctx context.Context, params aggregation.Params, module
 		queue = nil
 	}
 
-	return aggregator.New(s.store, params, s.index.getSchema, s.index.classSearcher,
+	return aggregator.New(s.store, params, liveIndexSchemaGetter{s.index.getSchema, s}, s.index.classSearcher,
 		s.index.stopwords, s.versioner.Version(), queue, s.index.logger, s.GetPropertyLengthTracker(),
 		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory, modules).
 		Do(ctx)
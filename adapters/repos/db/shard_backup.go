@@ -38,6 +38,14 @@ func (s *Shard) HaltForTransfer(ctx context.Context) (err error) {
 	if err = s.store.FlushMemtables(ctx); err != nil {
 		return fmt.Errorf("flush memtables: %w", err)
 	}
+	for targetVector, store := range s.rebuiltVectorIndexStores() {
+		if err = store.PauseCompaction(ctx); err != nil {
+			return fmt.Errorf("pause compaction of vector %q: %w", targetVector, err)
+		}
+		if err = store.FlushMemtables(ctx); err != nil {
+			return fmt.Errorf("flush memtables of vector %q: %w", targetVector, err)
+		}
+	}
 	if err = s.cycleCallbacks.vectorCombinedCallbacksCtrl.Deactivate(ctx); err != nil {
 		return fmt.Errorf("pause vector maintenance: %w", err)
 	}
@@ -68,6 +76,20 @@ func (s *Shard) ListBackupFiles(ctx context.Context, ret *backup.ShardDescriptor
 	if ret.Files, err = s.store.ListFiles(ctx, s.index.Config.RootPath); err != nil {
 		return err
 	}
+	for targetVector, store := range s.rebuiltVectorIndexStores() {
+		files, err := store.ListFiles(ctx, s.index.Config.RootPath)
+		if err != nil {
+			return fmt.Errorf("list files of vector %q: %w", targetVector, err)
+		}
+		ret.Files = append(ret.Files, files...)
+	}
+	if _, err := os.Stat(filepath.Join(s.path(), vectorIndexStateFile)); err == nil {
+		file, err := filepath.Rel(s.index.Config.RootPath, filepath.Join(s.path(), vectorIndexStateFile))
+		if err != nil {
+			return err
+		}
+		ret.Files = append(ret.Files, file)
+	}
 
 	if s.hasTargetVectors() {
 		for targetVector, vectorIndex := range s.vectorIndexes {
@@ -94,6 +116,11 @@ func (s *Shard) resumeMaintenanceCycles(ctx context.Context) error {
 	g.Go(func() error {
 		return s.store.ResumeCompaction(ctx)
 	})
+	for _, store := range s.rebuiltVectorIndexStores() {
+		g.Go(func() error {
+			return store.ResumeCompaction(ctx)
+		})
+	}
 	g.Go(func() error {
 		return s.cycleCallbacks.vectorCombinedCallbacksCtrl.Activate()
 	})
@@ -34,6 +34,11 @@ func (s *Shard) drop() (err error) {
 	s.metrics.baseMetrics.StartUnloadingShard(s.index.Config.ClassName.String())
 	s.replicationMap.clear()
 
+	s.reindexJobLock.Lock()
+	s.stopPropertyReindex()
+	s.stopVectorIndexRebuilds()
+	s.reindexJobLock.Unlock()
+
 	if s.index.Config.TrackVectorDimensions {
 		// tracking vector dimensions goroutine only works when tracking is enabled
 		// that's why we are trying to stop it only in this case
@@ -113,6 +118,10 @@ func (s *Shard) drop() (err error) {
 		}
 	}
 
+	if err = s.shutdownVectorIndexStores(ctx); err != nil {
+		return errors.Wrapf(err, "stop vector index lsmkv stores at %s", s.path())
+	}
+
 	// delete property length tracker
 	err = s.GetPropertyLengthTracker().Drop()
 	if err != nil {
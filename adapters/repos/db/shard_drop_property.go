@@ -0,0 +1,419 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// ShardPropertyDropper removes the inverted buckets, property-specific
+// indexes and property length stats of deleted properties from a shard.
+// Values stored on the objects themselves are not rewritten, they are
+// stripped when objects are read or merged.
+type ShardPropertyDropper struct {
+	logger    logrus.FieldLogger
+	shard     ShardLike
+	propNames []string
+}
+
+func NewShardPropertyDropper(shard ShardLike, logger logrus.FieldLogger,
+	propNames ...string,
+) *ShardPropertyDropper {
+	return &ShardPropertyDropper{
+		logger:    logger,
+		shard:     shard,
+		propNames: propNames,
+	}
+}
+
+func (d *ShardPropertyDropper) Do(ctx context.Context) error {
+	for _, propName := range d.propNames {
+		if err := d.checkContextExpired(ctx, "remaining properties skipped due to context canceled"); err != nil {
+			return err
+		}
+
+		// a property with the same name might have been added again in the
+		// meantime, its buckets must not be touched
+		if d.propertyExists(propName) {
+			d.logger.
+				WithField("action", "drop property").
+				WithField("shard", d.shard.Name()).
+				WithField("property", propName).
+				Warn("property exists in schema, skipping removal of its data")
+			continue
+		}
+
+		if err := d.shard.dropPropertyData(ctx, propName); err != nil {
+			d.logError(err, "failed dropping data of property %q", propName)
+			return errors.Wrapf(err, "failed dropping property '%s' on shard '%s'", propName, d.shard.Name())
+		}
+		d.logger.
+			WithField("action", "drop property").
+			WithField("shard", d.shard.Name()).
+			WithField("property", propName).
+			Debug("dropped property data")
+	}
+	return nil
+}
+
+func (d *ShardPropertyDropper) propertyExists(propName string) bool {
+	idx := d.shard.Index()
+	class := idx.getSchema.ReadOnlyClass(idx.Config.ClassName.String())
+	if class == nil {
+		return false
+	}
+	_, err := schema.GetPropertyByName(class, propName)
+	return err == nil
+}
+
+func (d *ShardPropertyDropper) checkContextExpired(ctx context.Context, msg string) error {
+	if ctx.Err() != nil {
+		d.logError(ctx.Err(), "%v", msg)
+		return errors.Wrapf(ctx.Err(), "%v", msg)
+	}
+	return nil
+}
+
+func (d *ShardPropertyDropper) logError(err error, msg string, args ...interface{}) {
+	d.logger.
+		WithField("action", "drop property").
+		WithField("shard", d.shard.Name()).
+		WithError(err).
+		Errorf(msg, args...)
+}
+
+// dropPropertyInBackground removes the data of a deleted property from all
+// shards of the index. The property is no longer part of the schema, so its
+// data is unreachable and there is no need to block the schema change until
+// it is removed.
+func (i *Index) dropPropertyInBackground(propName string) {
+	if err := i.deletedProperties.add(propName); err != nil {
+		// the data is still removed from the inverted indexes, only stored
+		// values might show up again after a restart
+		i.logger.WithField("action", "drop property").
+			WithField("property", propName).
+			WithError(err).
+			Error("failed to persist deleted property")
+	}
+
+	enterrors.GoWrapper(func() {
+		i.ForEachShard(func(name string, shard ShardLike) error {
+			if err := NewShardPropertyDropper(shard, i.logger, propName).Do(i.closingCtx); err != nil {
+				// the remaining data is never read again and has no effect on
+				// queries, so one failed shard should not stop the others
+				i.logger.WithField("action", "drop property").
+					WithField("shard", name).
+					WithField("property", propName).
+					WithError(err).
+					Error("failed to remove property data")
+			}
+			return nil
+		})
+	}, i.logger)
+}
+
+func (s *Shard) dropPropertyData(ctx context.Context, propName string) error {
+	if err := s.isReadOnly(); err != nil {
+		return err
+	}
+
+	for _, bucketName := range propertyBucketNames(propName) {
+		if err := s.store.DropBucket(ctx, bucketName); err != nil {
+			return fmt.Errorf("drop bucket %q: %w", bucketName, err)
+		}
+		// buckets of properties deleted before the shard was loaded are not
+		// part of the store, but might still be on disk
+		if err := os.RemoveAll(path.Join(s.pathLSM(), bucketName)); err != nil {
+			return fmt.Errorf("remove bucket dir %q: %w", bucketName, err)
+		}
+	}
+
+	s.propertyIndicesLock.Lock()
+	err := s.propertyIndices.Drop(ctx, propName)
+	s.propertyIndicesLock.Unlock()
+	if err != nil {
+		return fmt.Errorf("drop property specific index: %w", err)
+	}
+
+	if err := s.GetPropertyLengthTracker().DropProperty(propName); err != nil {
+		return fmt.Errorf("drop property lengths: %w", err)
+	}
+	return nil
+}
+
+// dropPropertyFiles removes the data of a property from a shard which is
+// not loaded, without loading it
+func dropPropertyFiles(shardDir, propName string, logger logrus.FieldLogger) error {
+	for _, bucketName := range propertyBucketNames(propName) {
+		bucketDir := path.Join(shardDir, "lsm", bucketName)
+		if err := os.RemoveAll(bucketDir); err != nil {
+			return fmt.Errorf("remove bucket dir %q: %w", bucketDir, err)
+		}
+	}
+
+	geoFiles, err := filepath.Glob(filepath.Join(shardDir, geoPropID(propName)+".hnsw.*"))
+	if err != nil {
+		return fmt.Errorf("find geo index files: %w", err)
+	}
+	for _, name := range geoFiles {
+		if err := os.RemoveAll(name); err != nil {
+			return fmt.Errorf("remove geo index file %q: %w", name, err)
+		}
+	}
+
+	plPath := path.Join(shardDir, "proplengths")
+	if _, err := os.Stat(plPath); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("stat property length tracker: %w", err)
+	}
+	tracker, err := inverted.NewJsonShardMetaData(plPath, logger)
+	if err != nil {
+		return fmt.Errorf("load property length tracker: %w", err)
+	}
+	defer tracker.Close()
+	if err := tracker.DropProperty(propName); err != nil {
+		return fmt.Errorf("drop property lengths: %w", err)
+	}
+	return nil
+}
+
+// stripDeletedProps removes values of deleted properties. Deleting a property
+// does not rewrite the objects holding a value for it, these values are
+// dropped whenever such an object is read or merged. If the property was
+// added again, only values of objects last updated before are dropped.
+func (i *Index) stripDeletedProps(objs ...*storobj.Object) {
+	i.deletedProperties.RLock()
+	defer i.deletedProperties.RUnlock()
+
+	if len(i.deletedProperties.names) == 0 {
+		return
+	}
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		props, ok := obj.Properties().(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for name := range props {
+			if i.deletedProperties.stale(name, obj.LastUpdateTimeUnix()) {
+				delete(props, name)
+			}
+		}
+	}
+}
+
+// withoutDeletedProps returns obj if it holds no values of deleted
+// properties, otherwise a copy of obj without these values
+func (i *Index) withoutDeletedProps(obj *storobj.Object) *storobj.Object {
+	i.deletedProperties.RLock()
+	defer i.deletedProperties.RUnlock()
+
+	props, ok := obj.Properties().(map[string]interface{})
+	if !ok {
+		return obj
+	}
+	for name := range props {
+		if i.deletedProperties.stale(name, obj.LastUpdateTimeUnix()) {
+			stripped := obj.DeepCopyDangerous()
+			props := stripped.Properties().(map[string]interface{})
+			for name := range props {
+				if i.deletedProperties.stale(name, obj.LastUpdateTimeUnix()) {
+					delete(props, name)
+				}
+			}
+			return stripped
+		}
+	}
+	return obj
+}
+
+// dropReaddedPropertyData removes the data left behind by deleted properties
+// which are added again, so that none of the values stored before the
+// deletion show up in the inverted indexes of the new property. Values stored
+// on the objects themselves are stripped by stripDeletedProps.
+func (i *Index) dropReaddedPropertyData(ctx context.Context, propNames ...string) error {
+	loaded := map[string]struct{}{}
+	err := i.ForEachShard(func(name string, shard ShardLike) error {
+		loaded[name] = struct{}{}
+		for _, propName := range propNames {
+			if err := shard.dropPropertyData(ctx, propName); err != nil {
+				return fmt.Errorf("shard %q: %w", name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// shards of inactive tenants are not part of the index, only their
+	// files are left
+	entries, err := os.ReadDir(i.path())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("list shards: %w", err)
+	}
+	for _, entry := range entries {
+		if _, ok := loaded[entry.Name()]; ok || !entry.IsDir() {
+			continue
+		}
+		if err := i.dropInactivePropertyFiles(entry.Name(), propNames); err != nil {
+			return fmt.Errorf("shard %q: %w", entry.Name(), err)
+		}
+	}
+	return nil
+}
+
+func (i *Index) dropInactivePropertyFiles(shardName string, propNames []string) error {
+	i.shardCreateLocks.Lock(shardName)
+	defer i.shardCreateLocks.Unlock(shardName)
+
+	for _, propName := range propNames {
+		if err := dropPropertyFiles(shardPath(i.path(), shardName), propName, i.logger); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const deletedPropertiesFile = "deleted_properties.json"
+
+// deletedProperties holds the names of deleted properties whose values might
+// still be stored on objects. Only these are stripped, as a property missing
+// from the schema might as well have been added on another node with the
+// schema change not being applied on this node yet.
+type deletedProperties struct {
+	sync.RWMutex
+	// file the names are persisted to, nothing is persisted if empty
+	filename string
+	// names maps each deleted property to the time (unix ms) it was added
+	// again, or 0 if it is still deleted
+	names map[string]int64
+}
+
+// stale reports whether a value of property name stored on an object last
+// updated at updateTime belongs to a deleted property
+func (d *deletedProperties) stale(name string, updateTime int64) bool {
+	readdedAt, ok := d.names[name]
+	return ok && (readdedAt == 0 || updateTime < readdedAt)
+}
+
+func (d *deletedProperties) load(indexDir string) error {
+	d.Lock()
+	defer d.Unlock()
+
+	d.filename = path.Join(indexDir, deletedPropertiesFile)
+	d.names = map[string]int64{}
+
+	data, err := os.ReadFile(d.filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("read deleted properties: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &d.names); err != nil {
+		return fmt.Errorf("unmarshal deleted properties: %w", err)
+	}
+	return nil
+}
+
+func (d *deletedProperties) add(names ...string) error {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.names == nil {
+		d.names = map[string]int64{}
+	}
+	for _, name := range names {
+		d.names[name] = 0
+	}
+	return d.persist()
+}
+
+// readd records the properties which are added again at the given time.
+// Values stored for the deleted property before that time are still
+// stripped, as they don't belong to the new one. It returns the names of
+// the properties which were deleted.
+func (d *deletedProperties) readd(at int64, names ...string) ([]string, error) {
+	d.Lock()
+	defer d.Unlock()
+
+	var readded []string
+	for _, name := range names {
+		if readdedAt, ok := d.names[name]; ok && readdedAt == 0 {
+			d.names[name] = at
+			readded = append(readded, name)
+		}
+	}
+	if len(readded) == 0 {
+		return nil, nil
+	}
+	return readded, d.persist()
+}
+
+func (d *deletedProperties) persist() error {
+	if d.filename == "" {
+		return nil
+	}
+	if len(d.names) == 0 {
+		if err := os.Remove(d.filename); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove deleted properties: %w", err)
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(d.names)
+	if err != nil {
+		return fmt.Errorf("marshal deleted properties: %w", err)
+	}
+	if err := os.WriteFile(d.filename+".tmp", data, 0o666); err != nil {
+		return fmt.Errorf("write deleted properties: %w", err)
+	}
+	if err := os.Rename(d.filename+".tmp", d.filename); err != nil {
+		return fmt.Errorf("write deleted properties: %w", err)
+	}
+	return nil
+}
+
+// propertyBucketNames lists all inverted buckets a property might have
+func propertyBucketNames(propName string) []string {
+	return []string{
+		helpers.BucketFromPropNameLSM(propName),
+		helpers.BucketSearchableFromPropNameLSM(propName),
+		helpers.BucketRangeableFromPropNameLSM(propName),
+		helpers.BucketFromPropNameLengthLSM(propName),
+		helpers.BucketFromPropNameNullLSM(propName),
+		helpers.BucketFromPropNameMetaCountLSM(propName),
+	}
+}
@@ -0,0 +1,281 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestShard_DropProperty(t *testing.T) {
+	ctx := context.Background()
+	vTrue := true
+	class := &models.Class{
+		Class: "DropPropertyClass",
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			IndexNullState:      true,
+			IndexPropertyLength: true,
+		},
+		Properties: []*models.Property{
+			{
+				Name:            "name",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexFilterable: &vTrue,
+				IndexSearchable: &vTrue,
+			},
+			{
+				Name:            "age",
+				DataType:        schema.DataTypeInt.PropString(),
+				IndexFilterable: &vTrue,
+			},
+		},
+	}
+	shd, idx := testShardWithSettings(t, ctx, class, hnsw.UserConfig{Skip: true}, false, false)
+
+	obj := testObject(class.Class)
+	obj.Object.Properties = map[string]interface{}{"name": "alice", "age": int64(42)}
+	require.Nil(t, shd.PutObject(ctx, obj))
+
+	nameBuckets := []string{
+		helpers.BucketFromPropNameLSM("name"),
+		helpers.BucketSearchableFromPropNameLSM("name"),
+		helpers.BucketFromPropNameLengthLSM("name"),
+		helpers.BucketFromPropNameNullLSM("name"),
+	}
+	for _, bucket := range nameBuckets {
+		require.NotNil(t, shd.Store().Bucket(bucket), bucket)
+	}
+
+	// the property is removed from the schema before its data is dropped
+	class.Properties = class.Properties[1:]
+	require.Nil(t, idx.deletedProperties.add("name"))
+	require.Nil(t, NewShardPropertyDropper(shd, idx.logger, "name").Do(ctx))
+
+	t.Run("buckets and property lengths are dropped", func(t *testing.T) {
+		for _, bucket := range nameBuckets {
+			assert.Nil(t, shd.Store().Bucket(bucket), bucket)
+			assert.NoDirExists(t, path.Join(shardPath(idx.path(), shd.Name()), "lsm", bucket))
+		}
+		assert.NotNil(t, shd.Store().Bucket(helpers.BucketFromPropNameLSM("age")))
+
+		_, count, _, err := shd.GetPropertyLengthTracker().PropertyTally("name")
+		require.Nil(t, err)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("value is stripped on read", func(t *testing.T) {
+		res, err := idx.objectByID(ctx, obj.ID(), nil, additional.Properties{}, nil, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		props := res.Properties().(map[string]interface{})
+		assert.NotContains(t, props, "name")
+		assert.Contains(t, props, "age")
+	})
+
+	t.Run("value is stripped on merge", func(t *testing.T) {
+		err := shd.MergeObject(ctx, objects.MergeDocument{
+			Class:           class.Class,
+			ID:              obj.ID(),
+			PrimitiveSchema: map[string]interface{}{"age": int64(43)},
+			UpdateTime:      obj.LastUpdateTimeUnix() + 1,
+		})
+		require.Nil(t, err)
+
+		stored, err := shd.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, stored)
+		props := stored.Properties().(map[string]interface{})
+		assert.NotContains(t, props, "name")
+		assert.Contains(t, props, "age")
+	})
+
+	t.Run("property re-added in the meantime is kept", func(t *testing.T) {
+		class.Properties = append(class.Properties, &models.Property{
+			Name:            "name",
+			DataType:        schema.DataTypeText.PropString(),
+			Tokenization:    models.PropertyTokenizationWord,
+			IndexFilterable: &vTrue,
+		})
+		require.Nil(t, idx.addProperty(ctx, class.Properties[1]))
+		require.NotNil(t, shd.Store().Bucket(helpers.BucketFromPropNameLSM("name")))
+
+		require.Nil(t, NewShardPropertyDropper(shd, idx.logger, "name").Do(ctx))
+		assert.NotNil(t, shd.Store().Bucket(helpers.BucketFromPropNameLSM("name")))
+	})
+}
+
+func TestShard_ReaddProperty(t *testing.T) {
+	ctx := context.Background()
+	vTrue := true
+	nameProp := func() *models.Property {
+		return &models.Property{
+			Name:            "name",
+			DataType:        schema.DataTypeText.PropString(),
+			Tokenization:    models.PropertyTokenizationField,
+			IndexFilterable: &vTrue,
+		}
+	}
+	class := &models.Class{
+		Class: "ReaddPropertyClass",
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			IndexNullState:      true,
+			IndexPropertyLength: true,
+		},
+		Properties: []*models.Property{
+			nameProp(),
+			{
+				Name:            "age",
+				DataType:        schema.DataTypeInt.PropString(),
+				IndexFilterable: &vTrue,
+			},
+		},
+	}
+	shd, idx := testShardWithSettings(t, ctx, class, hnsw.UserConfig{Skip: true}, false, false)
+
+	old := testObject(class.Class)
+	old.Object.Properties = map[string]interface{}{"name": "alice", "age": int64(42)}
+	old.Object.LastUpdateTimeUnix = time.Now().UnixMilli() - 1000
+	require.Nil(t, shd.PutObject(ctx, old))
+
+	// the property is deleted and added again before its data is dropped
+	class.Properties = class.Properties[1:]
+	require.Nil(t, idx.deletedProperties.add("name"))
+	class.Properties = append(class.Properties, nameProp())
+	require.Nil(t, idx.addProperty(ctx, class.Properties[1]))
+
+	current := testObject(class.Class)
+	current.Object.Properties = map[string]interface{}{"name": "bob", "age": int64(7)}
+	current.Object.LastUpdateTimeUnix = time.Now().UnixMilli() + 1
+	require.Nil(t, shd.PutObject(ctx, current))
+
+	nameOf := func(t *testing.T, obj *storobj.Object) interface{} {
+		res, err := idx.objectByID(ctx, obj.ID(), nil, additional.Properties{}, nil, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		return res.Properties().(map[string]interface{})["name"]
+	}
+
+	t.Run("old values are not part of the new property", func(t *testing.T) {
+		assert.Nil(t, nameOf(t, old))
+		assert.Equal(t, "bob", nameOf(t, current))
+
+		bucket := shd.Store().Bucket(helpers.BucketFromPropNameLSM("name"))
+		require.NotNil(t, bucket)
+		alice, err := bucket.RoaringSetGet([]byte("alice"))
+		require.Nil(t, err)
+		assert.True(t, alice.IsEmpty())
+		bob, err := bucket.RoaringSetGet([]byte("bob"))
+		require.Nil(t, err)
+		assert.False(t, bob.IsEmpty())
+	})
+
+	t.Run("old value is not carried over on merge", func(t *testing.T) {
+		err := shd.MergeObject(ctx, objects.MergeDocument{
+			Class:           class.Class,
+			ID:              old.ID(),
+			PrimitiveSchema: map[string]interface{}{"age": int64(43)},
+			UpdateTime:      time.Now().UnixMilli() + 1,
+		})
+		require.Nil(t, err)
+		assert.Nil(t, nameOf(t, old))
+	})
+}
+
+func TestDropPropertyFiles(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	shardDir := t.TempDir()
+
+	dirs := []string{
+		path.Join(shardDir, "lsm", helpers.BucketFromPropNameLSM("name")),
+		path.Join(shardDir, "lsm", helpers.BucketSearchableFromPropNameLSM("name")),
+		path.Join(shardDir, "lsm", helpers.BucketFromPropNameLSM("name2")),
+		path.Join(shardDir, geoPropID("location")+".hnsw.commitlog.d"),
+		path.Join(shardDir, geoPropID("location2")+".hnsw.commitlog.d"),
+	}
+	for _, dir := range dirs {
+		require.Nil(t, os.MkdirAll(dir, 0o755))
+	}
+
+	tracker, err := inverted.NewJsonShardMetaData(path.Join(shardDir, "proplengths"), logger)
+	require.Nil(t, err)
+	require.Nil(t, tracker.TrackProperty("name", 3))
+	require.Nil(t, tracker.TrackProperty("name2", 5))
+	require.Nil(t, tracker.Close())
+
+	require.Nil(t, dropPropertyFiles(shardDir, "name", logger))
+	require.Nil(t, dropPropertyFiles(shardDir, "location", logger))
+
+	assert.NoDirExists(t, dirs[0])
+	assert.NoDirExists(t, dirs[1])
+	assert.DirExists(t, dirs[2])
+	assert.NoDirExists(t, dirs[3])
+	assert.DirExists(t, dirs[4])
+
+	tracker, err = inverted.NewJsonShardMetaData(path.Join(shardDir, "proplengths"), logger)
+	require.Nil(t, err)
+	defer tracker.Close()
+	_, count, _, err := tracker.PropertyTally("name")
+	require.Nil(t, err)
+	assert.Equal(t, 0, count)
+	_, count, _, err = tracker.PropertyTally("name2")
+	require.Nil(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestDeletedProperties(t *testing.T) {
+	indexDir := t.TempDir()
+
+	var deleted deletedProperties
+	require.Nil(t, deleted.load(indexDir))
+	require.Nil(t, deleted.add("name", "age"))
+	readded, err := deleted.readd(100, "age", "other")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"age"}, readded)
+
+	t.Run("names are persisted", func(t *testing.T) {
+		var loaded deletedProperties
+		require.Nil(t, loaded.load(indexDir))
+		assert.Equal(t, map[string]int64{"name": 0, "age": 100}, loaded.names)
+	})
+
+	t.Run("values before the re-add are stale", func(t *testing.T) {
+		assert.True(t, deleted.stale("name", 200))
+		assert.True(t, deleted.stale("age", 99))
+		assert.False(t, deleted.stale("age", 100))
+		assert.False(t, deleted.stale("other", 0))
+	})
+
+	t.Run("only deleted properties are re-added", func(t *testing.T) {
+		readded, err := deleted.readd(200, "age")
+		require.Nil(t, err)
+		assert.Empty(t, readded)
+		assert.False(t, deleted.stale("age", 150))
+	})
+}
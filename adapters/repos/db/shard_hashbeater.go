@@ -14,6 +14,7 @@ package db
 import (
 	"context"
 	"fmt"
+	"math"
 	"slices"
 	"strings"
 	"time"
@@ -129,6 +130,10 @@ func (s *Shard) initHashBeater() {
 				if propagationErr == nil {
 					logEntry.Info("hashbeat iteration successfully completed")
 
+					if stats.inSync {
+						s.index.syncs.mark(s.name, stats.startedAt)
+					}
+
 					backoffTimer.Reset()
 
 					if objectsPropagated > 0 {
@@ -217,8 +222,11 @@ func (s *Shard) waitUntilObjectPropagationRequired(ctx context.Context) error {
 }
 
 type hashBeatStats struct {
+	startedAt           time.Time
 	diffCalculationTook time.Duration
 	hostStats           []hashBeatHostStats
+	// inSync reports whether the hashtrees of all other replicas matched
+	inSync bool
 }
 
 type hashBeatHostStats struct {
@@ -240,6 +248,7 @@ func (s *Shard) hashBeat() (stats hashBeatStats, err error) {
 	}
 
 	diffCalculationStart := time.Now()
+	stats.startedAt = diffCalculationStart
 
 	replyCh, _, err := s.index.replicator.CollectShardDifferences(s.hashBeaterCtx, s.name, s.hashtree)
 	if err != nil {
@@ -252,14 +261,22 @@ func (s *Shard) hashBeat() (stats hashBeatStats, err error) {
 	var diffCollectionDone bool
 	var diffCollectionErr error
 
+	// hosts without differences reply with ErrNoMoreRanges
+	stats.inSync = true
+
 	for r := range replyCh {
 		if r.Err != nil {
-			if !errors.Is(r.Err, hashtree.ErrNoMoreRanges) && !diffCollectionDone {
-				diffCollectionErr = fmt.Errorf("collecting differences: %w", r.Err)
+			if !errors.Is(r.Err, hashtree.ErrNoMoreRanges) {
+				stats.inSync = false
+				if !diffCollectionDone {
+					diffCollectionErr = fmt.Errorf("collecting differences: %w", r.Err)
+				}
 			}
 			continue
 		}
 
+		stats.inSync = false
+
 		shardDiffReader := r.Value
 		rangeReader := shardDiffReader.RangeReader
 
@@ -457,6 +474,24 @@ func (s *Shard) stepsTowardsShardConsistency(ctx context.Context,
 	return localObjects, remoteObjects, propagations, nil
 }
 
+// pushToReplicas propagates the local objects which are missing or stale on
+// the given hosts. Unlike the hash beater it doesn't rely on hashtrees, so it
+// can be used whether async replication is enabled or not.
+func (s *Shard) pushToReplicas(ctx context.Context, hosts []string) error {
+	for _, host := range hosts {
+		_, _, propagations, err := s.stepsTowardsShardConsistency(ctx, s.name, host, 0, math.MaxUint64, math.MaxInt)
+		if err != nil {
+			return fmt.Errorf("push to %q: %w", host, err)
+		}
+		s.index.logger.WithField("action", "push_to_replica").
+			WithField("shard", s.name).
+			WithField("host", host).
+			WithField("propagations", propagations).
+			Debug("pushed objects to replica")
+	}
+	return nil
+}
+
 func (s *Shard) stopHashBeater() {
 	s.hashBeaterCancelFunc()
 }
@@ -94,6 +94,7 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 	s.initCycleCallbacks()
 
 	s.docIdLock = make([]sync.Mutex, IdLockPoolSize)
+	s.uniqueKeyLock = make([]sync.Mutex, IdLockPoolSize)
 
 	defer s.metrics.ShardStartup(before)
 
@@ -132,6 +133,14 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 
 	s.initDimensionTracking()
 
+	if err := s.resumePropertyReindex(ctx); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: resume property reindex", s.ID())
+	}
+
+	if err := s.resumeVectorIndexRebuilds(); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: resume vector index rebuilds", s.ID())
+	}
+
 	if asyncEnabled() {
 		f := func() {
 			// preload unindexed objects in the background
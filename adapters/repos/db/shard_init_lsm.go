@@ -51,6 +51,12 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 		return fmt.Errorf("init shard %q: %w", s.ID(), err)
 	}
 
+	// the inverted index buckets might have been built with other settings
+	// than the ones in the schema, if a reindex job is not complete yet
+	if err := s.initPropertyReindex(class); err != nil {
+		return fmt.Errorf("init shard %q: %w", s.ID(), err)
+	}
+
 	// Run all other inits in parallel and use a single error group to wait for
 	// all init tasks, the wait statement is at the end of this method. No other
 	// methods should attempt to wait on this error group.
@@ -87,7 +93,7 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 
 	// error group is passed, so properties can be initialized in parallel with
 	// the other initializations going on here.
-	s.initProperties(eg, class)
+	s.initProperties(eg, s.withLiveIndexSettings(class))
 
 	err = eg.Wait()
 	if err != nil {
@@ -128,6 +134,9 @@ func (s *Shard) initLSMStore() error {
 		return fmt.Errorf("init lsmkv store at %s: %w", s.pathLSM(), err)
 	}
 
+	if hook := s.commitLogHook(); hook != nil {
+		store.SetCommitLogHook(hook)
+	}
 	s.store = store
 
 	return nil
@@ -169,6 +178,7 @@ func (s *Shard) initProplenTracker() error {
 	}
 
 	s.propLenTracker = tracker
+	s.archiveState(plPath)
 	return nil
 }
 
@@ -178,6 +188,7 @@ func (s *Shard) initIndexCounterVersionerAndBitmapFactory() error {
 		return fmt.Errorf("init index counter: %w", err)
 	}
 	s.counter = counter
+	s.archiveState(counter.FileName())
 	s.bitmapFactory = roaringset.NewBitmapFactory(s.counter.Get, s.index.logger)
 
 	dataPresent := s.counter.PreviewNext() != 0
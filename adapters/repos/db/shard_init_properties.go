@@ -51,6 +51,8 @@ func (s *Shard) initProperties(eg *enterrors.ErrorGroupWrapper, class *models.Cl
 }
 
 func (s *Shard) initPropertyBuckets(ctx context.Context, eg *enterrors.ErrorGroupWrapper, props ...*models.Property) {
+	s.propertyIndexSettings.setProperties(false, props...)
+
 	for _, prop := range props {
 		if !inverted.HasAnyInvertedIndex(prop) {
 			continue
@@ -14,8 +14,10 @@ package db
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
@@ -31,6 +33,18 @@ import (
 
 func (s *Shard) initVectorIndex(ctx context.Context,
 	targetVector string, vectorIndexUserConfig schemaConfig.VectorIndexConfig,
+) (VectorIndex, error) {
+	rootPath, store, vectorIndexUserConfig, err := s.liveVectorIndex(targetVector, vectorIndexUserConfig)
+	if err != nil {
+		return nil, fmt.Errorf("init shard %q: %w", s.ID(), err)
+	}
+	return s.newVectorIndex(ctx, targetVector, vectorIndexUserConfig, rootPath, store)
+}
+
+// newVectorIndex creates the vector index of the target vector, keeping its
+// files in rootPath and its buckets in store
+func (s *Shard) newVectorIndex(ctx context.Context, targetVector string,
+	vectorIndexUserConfig schemaConfig.VectorIndexConfig, rootPath string, store *lsmkv.Store,
 ) (VectorIndex, error) {
 	var distProv distancer.Provider
 
@@ -77,7 +91,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 
 			vi, err := hnsw.New(hnsw.Config{
 				Logger:               s.index.logger,
-				RootPath:             s.path(),
+				RootPath:             rootPath,
 				ID:                   vecIdxID,
 				ShardName:            s.name,
 				ClassName:            s.index.Config.ClassName.String(),
@@ -86,19 +100,21 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 				TempVectorForIDThunk: hnsw.NewTempVectorForIDThunk(targetVector, s.readVectorByIndexIDIntoSlice),
 				DistanceProvider:     distProv,
 				MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-					return hnsw.NewCommitLogger(s.path(), vecIdxID,
+					return hnsw.NewCommitLogger(rootPath, vecIdxID,
 						s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks,
 						hnsw.WithAllocChecker(s.index.allocChecker),
 						hnsw.WithCommitlogThresholdForCombining(s.index.Config.HNSWMaxLogSize),
 						// consistent with previous logic where the individual limit is 1/5 of the combined limit
 						hnsw.WithCommitlogThreshold(s.index.Config.HNSWMaxLogSize/5),
+						hnsw.WithSnapshotInterval(time.Duration(s.index.Config.HNSWSnapshotIntervalSeconds)*time.Second),
+						hnsw.WithCommitLogHook(s.commitLogHook()),
 					)
 				},
 				AllocChecker:           s.index.allocChecker,
 				WaitForCachePrefill:    s.index.Config.HNSWWaitForCachePrefill,
 				FlatSearchConcurrency:  s.index.Config.HNSWFlatSearchConcurrency,
 				VisitedListPoolMaxSize: s.index.Config.VisitedListPoolMaxSize,
-			}, hnswUserConfig, s.cycleCallbacks.vectorTombstoneCleanupCallbacks, s.store)
+			}, hnswUserConfig, s.cycleCallbacks.vectorTombstoneCleanupCallbacks, store)
 			if err != nil {
 				return nil, errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
 			}
@@ -122,11 +138,11 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 		vi, err := flat.New(flat.Config{
 			ID:               vecIdxID,
 			TargetVector:     targetVector,
-			RootPath:         s.path(),
+			RootPath:         rootPath,
 			Logger:           s.index.logger,
 			DistanceProvider: distProv,
 			AllocChecker:     s.index.allocChecker,
-		}, flatUserConfig, s.store)
+		}, flatUserConfig, store)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init shard %q: flat index", s.ID())
 		}
@@ -151,18 +167,21 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			TargetVector:         targetVector,
 			Logger:               s.index.logger,
 			DistanceProvider:     distProv,
-			RootPath:             s.path(),
+			RootPath:             rootPath,
 			ShardName:            s.name,
 			ClassName:            s.index.Config.ClassName.String(),
 			PrometheusMetrics:    s.promMetrics,
 			VectorForIDThunk:     hnsw.NewVectorForIDThunk(targetVector, s.vectorByIndexID),
 			TempVectorForIDThunk: hnsw.NewTempVectorForIDThunk(targetVector, s.readVectorByIndexIDIntoSlice),
 			MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-				return hnsw.NewCommitLogger(s.path(), vecIdxID,
-					s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks)
+				return hnsw.NewCommitLogger(rootPath, vecIdxID,
+					s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks,
+					hnsw.WithSnapshotInterval(time.Duration(s.index.Config.HNSWSnapshotIntervalSeconds)*time.Second),
+					hnsw.WithCommitLogHook(s.commitLogHook()),
+				)
 			},
 			TombstoneCallbacks: s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
-		}, dynamicUserConfig, s.store)
+		}, dynamicUserConfig, store)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init shard %q: dynamic index", s.ID())
 		}
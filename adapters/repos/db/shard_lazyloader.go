@@ -284,11 +284,11 @@ func (l *LazyLoadShard) DeleteObjectBatch(ctx context.Context, ids []strfmt.UUID
 	return l.shard.DeleteObjectBatch(ctx, ids, dryRun)
 }
 
-func (l *LazyLoadShard) DeleteObject(ctx context.Context, id strfmt.UUID) error {
+func (l *LazyLoadShard) DeleteObject(ctx context.Context, id strfmt.UUID, ifMatch *int64) error {
 	if err := l.Load(ctx); err != nil {
 		return err
 	}
-	return l.shard.DeleteObject(ctx, id)
+	return l.shard.DeleteObject(ctx, id, ifMatch)
 }
 
 func (l *LazyLoadShard) MultiObjectByID(ctx context.Context, query []multi.Identifier) ([]*storobj.Object, error) {
@@ -364,6 +364,61 @@ func (l *LazyLoadShard) initPropertyBuckets(ctx context.Context, eg *enterrors.E
 	l.shard.initPropertyBuckets(ctx, eg, props...)
 }
 
+func (l *LazyLoadShard) dropPropertyData(ctx context.Context, propName string) error {
+	// if not loaded, remove the property's files without loading the shard.
+	// use lock to prevent eventual concurrent dropping and loading
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if !l.loaded {
+		idx := l.shardOpts.index
+		return dropPropertyFiles(shardPath(idx.path(), l.shardOpts.name), propName, idx.logger)
+	}
+
+	return l.shard.dropPropertyData(ctx, propName)
+}
+
+func (l *LazyLoadShard) updatePropertyIndexes(ctx context.Context, live, target map[string]propertyIndexSettings) error {
+	// if not loaded, only record the changed settings. The reindex job starts
+	// once the shard gets loaded
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if !l.loaded {
+		idx := l.shardOpts.index
+		return updatePropertyReindexFile(shardPath(idx.path(), l.shardOpts.name), live, target)
+	}
+
+	return l.shard.updatePropertyIndexes(ctx, live, target)
+}
+
+func (l *LazyLoadShard) propertyReindexProgress() ([]string, int64) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if !l.loaded {
+		idx := l.shardOpts.index
+		state, err := loadPropertyReindexState(shardPath(idx.path(), l.shardOpts.name))
+		if err != nil || state == nil {
+			return nil, 0
+		}
+		return newPropertyReindexJob(*state, nil).properties(), state.Processed
+	}
+
+	return l.shard.propertyReindexProgress()
+}
+
+func (l *LazyLoadShard) vectorIndexRebuildProgress() ([]string, int64, []string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	// rebuilds only run while the shard is loaded
+	if !l.loaded {
+		return nil, 0, nil
+	}
+	return l.shard.vectorIndexRebuildProgress()
+}
+
 func (l *LazyLoadShard) HaltForTransfer(ctx context.Context) error {
 	if err := l.Load(ctx); err != nil {
 		return err
@@ -530,9 +585,9 @@ func (l *LazyLoadShard) prepareMergeObject(ctx context.Context, shardID string,
 	return l.shard.prepareMergeObject(ctx, shardID, object)
 }
 
-func (l *LazyLoadShard) prepareDeleteObject(ctx context.Context, shardID string, id strfmt.UUID) replica.SimpleResponse {
+func (l *LazyLoadShard) prepareDeleteObject(ctx context.Context, shardID string, id strfmt.UUID, ifMatch *int64) replica.SimpleResponse {
 	l.mustLoadCtx(ctx)
-	return l.shard.prepareDeleteObject(ctx, shardID, id)
+	return l.shard.prepareDeleteObject(ctx, shardID, id, ifMatch)
 }
 
 func (l *LazyLoadShard) prepareDeleteObjects(ctx context.Context, shardID string, ids []strfmt.UUID, dryRun bool) replica.SimpleResponse {
@@ -562,6 +617,13 @@ func (l *LazyLoadShard) filePutter(ctx context.Context, shardID string) (io.Writ
 	return l.shard.filePutter(ctx, shardID)
 }
 
+func (l *LazyLoadShard) pushToReplicas(ctx context.Context, hosts []string) error {
+	if err := l.Load(ctx); err != nil {
+		return err
+	}
+	return l.shard.pushToReplicas(ctx, hosts)
+}
+
 func (l *LazyLoadShard) extendDimensionTrackerLSM(dimLength int, docID uint64) error {
 	if err := l.Load(context.Background()); err != nil {
 		return err
@@ -611,9 +673,9 @@ func (l *LazyLoadShard) uuidFromDocID(docID uint64) (strfmt.UUID, error) {
 	return l.shard.uuidFromDocID(docID)
 }
 
-func (l *LazyLoadShard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
+func (l *LazyLoadShard) batchDeleteObject(ctx context.Context, id strfmt.UUID) (int64, error) {
 	if err := l.Load(ctx); err != nil {
-		return err
+		return 0, err
 	}
 	return l.shard.batchDeleteObject(ctx, id)
 }
@@ -623,6 +685,11 @@ func (l *LazyLoadShard) putObjectLSM(object *storobj.Object, idBytes []byte) (ob
 	return l.shard.putObjectLSM(object, idBytes)
 }
 
+func (l *LazyLoadShard) putObjectUniqueKeyLSM(ctx context.Context, object *storobj.Object, idBytes []byte) (objectInsertStatus, error) {
+	l.mustLoad()
+	return l.shard.putObjectUniqueKeyLSM(ctx, object, idBytes)
+}
+
 func (l *LazyLoadShard) mayUpsertObjectHashTree(object *storobj.Object, idBytes []byte, status objectInsertStatus) error {
 	l.mustLoad()
 	return l.shard.mayUpsertObjectHashTree(object, idBytes, status)
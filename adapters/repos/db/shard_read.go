@@ -263,6 +263,10 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 ) ([]*storobj.Object, []float32, error) {
 	var err error
 
+	// see comment on reindexReadLock in shard.go
+	ctx, unlock := s.rlockReindexReaders(ctx)
+	defer unlock()
+
 	// Report slow queries if this method takes longer than expected
 	startTime := time.Now()
 	defer func() {
@@ -298,7 +302,7 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 
 		if filters != nil {
 			objs, err = inverted.NewSearcher(s.index.logger, s.store,
-				s.index.getSchema.ReadOnlyClass, s.propertyIndices,
+				s.readOnlyClass, s.propertyIndices,
 				s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
 				s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit,
 				s.bitmapFactory).
@@ -314,7 +318,7 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 		bm25Config := s.index.getInvertedIndexConfig().BM25
 		logger := s.index.logger.WithFields(logrus.Fields{"class": s.index.Config.ClassName, "shard": s.name})
 		bm25searcher := inverted.NewBM25Searcher(bm25Config, s.store,
-			s.index.getSchema.ReadOnlyClass, s.propertyIndices, s.index.classSearcher,
+			s.readOnlyClass, s.propertyIndices, s.index.classSearcher,
 			s.GetPropertyLengthTracker(), logger, s.versioner.Version())
 		bm25objs, bm25count, err = bm25searcher.BM25F(ctx, filterDocIds, className, limit, *keywordRanking, additional)
 		if err != nil {
@@ -329,7 +333,7 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 			cursor, additional, s.index.Config.ClassName)
 		return objs, nil, err
 	}
-	objs, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
+	objs, err := inverted.NewSearcher(s.index.logger, s.store, s.readOnlyClass,
 		s.propertyIndices, s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
 		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
 		Objects(ctx, limit, filters, sort, additional, s.index.Config.ClassName, properties)
@@ -376,6 +380,10 @@ func (s *Shard) getIndexQueue(targetVector string) (*IndexQueue, error) {
 }
 
 func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float32, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string) ([]*storobj.Object, []float32, error) {
+	// see comment on reindexReadLock in shard.go
+	ctx, unlock := s.rlockReindexReaders(ctx)
+	defer unlock()
+
 	startTime := time.Now()
 
 	defer func() {
@@ -597,7 +605,7 @@ func (s *Shard) sortDocIDsAndDists(ctx context.Context, limit int, sort []filter
 }
 
 func (s *Shard) buildAllowList(ctx context.Context, filters *filters.LocalFilter, addl additional.Properties) (helpers.AllowList, error) {
-	list, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
+	list, err := inverted.NewSearcher(s.index.logger, s.store, s.readOnlyClass,
 		s.propertyIndices, s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
 		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
 		DocIDs(ctx, filters, addl, s.index.Config.ClassName)
@@ -633,57 +641,63 @@ func (s *Shard) uuidFromDocID(docID uint64) (strfmt.UUID, error) {
 	return strfmt.UUID(prop[0]), nil
 }
 
-func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
+// batchDeleteObject deletes the object with the given id and returns the
+// update time of the deleted version, or 0 if there was no such object
+func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) (int64, error) {
+	// see comment on reindexLock in shard_write_put.go::putObjectLSM
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	existing, err := bucket.Get(idBytes)
 	if err != nil {
-		return errors.Wrap(err, "unexpected error on previous lookup")
+		return 0, errors.Wrap(err, "unexpected error on previous lookup")
 	}
 
 	if existing == nil {
 		// nothing to do
-		return nil
+		return 0, nil
 	}
 
 	// we need the doc ID so we can clean up inverted indices currently
 	// pointing to this object
 	docID, updateTime, err := storobj.DocIDAndTimeFromBinary(existing)
 	if err != nil {
-		return errors.Wrap(err, "get existing doc id from object binary")
+		return 0, errors.Wrap(err, "get existing doc id from object binary")
 	}
 
 	err = bucket.Delete(idBytes)
 	if err != nil {
-		return errors.Wrap(err, "delete object from bucket")
+		return 0, errors.Wrap(err, "delete object from bucket")
 	}
 
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
-		return errors.Wrap(err, "delete object from bucket")
+		return 0, errors.Wrap(err, "delete object from bucket")
 	}
 
 	if s.hasTargetVectors() {
 		for targetVector, queue := range s.queues {
 			if err = queue.Delete(docID); err != nil {
-				return fmt.Errorf("delete from vector index queue of vector %q: %w", targetVector, err)
+				return 0, fmt.Errorf("delete from vector index queue of vector %q: %w", targetVector, err)
 			}
 		}
 	} else {
 		if err = s.queue.Delete(docID); err != nil {
-			return errors.Wrap(err, "delete from vector index queue")
+			return 0, errors.Wrap(err, "delete from vector index queue")
 		}
 	}
 
 	if err = s.mayDeleteObjectHashTree(idBytes, updateTime); err != nil {
-		return errors.Wrap(err, "object deletion in hashtree")
+		return 0, errors.Wrap(err, "object deletion in hashtree")
 	}
 
-	return nil
+	return updateTime, nil
 }
 
 func (s *Shard) WasDeleted(ctx context.Context, id strfmt.UUID) (bool, error) {
@@ -0,0 +1,720 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	vectorIndexStateFile   = "vector_indexes.json"
+	vectorIndexesDir       = "vector_indexes"
+	vectorRebuildBatchSize = 1000
+)
+
+// vectorIndexRequiresRebuild returns whether an update of the vector index
+// config can only be applied by rebuilding the index from the stored
+// vectors, because the index type, the distance or a setting the index was
+// built with changed
+func vectorIndexRequiresRebuild(live, target schemaConfig.VectorIndexConfig) bool {
+	if live.IndexType() != target.IndexType() || live.DistanceName() != target.DistanceName() {
+		return true
+	}
+
+	switch live.IndexType() {
+	case vectorindex.VectorIndexTypeHNSW:
+		if hnsw.ValidateUserConfigUpdate(live, target) != nil {
+			return true
+		}
+		// enabling compression is applied to the existing index, disabling
+		// it or switching to another kind of compression is not
+		liveHNSW, ok1 := live.(hnswent.UserConfig)
+		targetHNSW, ok2 := target.(hnswent.UserConfig)
+		return ok1 && ok2 &&
+			(liveHNSW.PQ.Enabled && !targetHNSW.PQ.Enabled ||
+				liveHNSW.BQ.Enabled && !targetHNSW.BQ.Enabled ||
+				liveHNSW.SQ.Enabled && !targetHNSW.SQ.Enabled ||
+				liveHNSW.RQ.Enabled && !targetHNSW.RQ.Enabled)
+	case vectorindex.VectorIndexTypeFLAT:
+		return flat.ValidateUserConfigUpdate(live, target) != nil
+	case vectorindex.VectorIndexTypeDYNAMIC:
+		return dynamic.ValidateUserConfigUpdate(live, target) != nil
+	}
+	return false
+}
+
+// vectorIndexState describes the live vector index of a target vector once
+// it was rebuilt or a rebuild was requested. Vector indexes without a state
+// are kept in the shard directory and the main lsm store.
+type vectorIndexState struct {
+	// Generation of the live index. Its files are kept in the generation
+	// directory, generation 0 is the shard directory.
+	Generation int `json:"generation"`
+	// IndexType and Config of the live index
+	IndexType string          `json:"indexType"`
+	Config    json.RawMessage `json:"config"`
+}
+
+func newVectorIndexState(generation int, cfg schemaConfig.VectorIndexConfig) (vectorIndexState, error) {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return vectorIndexState{}, fmt.Errorf("marshal vector index config: %w", err)
+	}
+	return vectorIndexState{Generation: generation, IndexType: cfg.IndexType(), Config: data}, nil
+}
+
+func (st vectorIndexState) config() (schemaConfig.VectorIndexConfig, error) {
+	var input map[string]interface{}
+	if err := json.Unmarshal(st.Config, &input); err != nil {
+		return nil, fmt.Errorf("unmarshal vector index config: %w", err)
+	}
+	return vectorindex.ParseAndValidateConfig(input, st.IndexType)
+}
+
+func loadVectorIndexStates(shardDir string) (map[string]vectorIndexState, error) {
+	states := map[string]vectorIndexState{}
+
+	data, err := os.ReadFile(path.Join(shardDir, vectorIndexStateFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return states, nil
+		}
+		return nil, fmt.Errorf("read vector index states: %w", err)
+	}
+	if err := json.Unmarshal(data, &states); err != nil {
+		return nil, fmt.Errorf("unmarshal vector index states: %w", err)
+	}
+	return states, nil
+}
+
+func saveVectorIndexStates(shardDir string, states map[string]vectorIndexState) error {
+	data, err := json.Marshal(states)
+	if err != nil {
+		return fmt.Errorf("marshal vector index states: %w", err)
+	}
+
+	// write to a temporary file first, so a crash never leaves a partially
+	// written state behind
+	filename := path.Join(shardDir, vectorIndexStateFile)
+	if err := os.WriteFile(filename+".tmp", data, 0o666); err != nil {
+		return fmt.Errorf("write vector index states: %w", err)
+	}
+	if err := os.Rename(filename+".tmp", filename); err != nil {
+		return fmt.Errorf("write vector index states: %w", err)
+	}
+	return nil
+}
+
+type vectorIndexRebuildJob struct {
+	targetVector string
+	generation   int
+	config       schemaConfig.VectorIndexConfig
+
+	// number of vectors added to the rebuilt index
+	added atomic.Int64
+	// set before done is closed if the rebuild failed
+	err error
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// vectorIndexDir is the directory of a rebuilt vector index. Its buckets are
+// kept in a separate lsm store within the directory, as the bucket names of
+// the index types are not unique within a shard.
+func (s *Shard) vectorIndexDir(targetVector string, generation int) string {
+	if generation == 0 {
+		return s.path()
+	}
+	return filepath.Join(s.path(), vectorIndexesDir, s.vectorIndexID(targetVector), strconv.Itoa(generation))
+}
+
+func (s *Shard) openVectorIndexStore(dir string) (*lsmkv.Store, error) {
+	var metrics *lsmkv.Metrics
+	if s.promMetrics != nil {
+		metrics = lsmkv.NewMetrics(s.promMetrics, string(s.index.Config.ClassName), s.name)
+	}
+
+	store, err := lsmkv.New(filepath.Join(dir, "lsm"), dir, s.index.logger.WithFields(logrus.Fields{
+		"shard": s.name,
+		"index": s.index.ID(),
+		"class": s.index.Config.ClassName,
+	}), metrics,
+		s.cycleCallbacks.compactionCallbacks,
+		s.cycleCallbacks.compactionAuxCallbacks,
+		s.cycleCallbacks.flushCallbacks)
+	if err != nil {
+		return nil, fmt.Errorf("init lsmkv store at %s: %w", dir, err)
+	}
+	if hook := s.commitLogHook(); hook != nil {
+		store.SetCommitLogHook(hook)
+	}
+	return store, nil
+}
+
+// liveVectorIndex returns where the live vector index of the target vector
+// is kept and the config to load it with. That is the given config from the
+// schema, unless the index was built with a config that can only be changed
+// by a rebuild. The rebuild is resumed once the shard is initialized.
+func (s *Shard) liveVectorIndex(targetVector string, cfg schemaConfig.VectorIndexConfig,
+) (string, *lsmkv.Store, schemaConfig.VectorIndexConfig, error) {
+	s.vectorIndexStatesLock.Lock()
+	defer s.vectorIndexStatesLock.Unlock()
+
+	if s.vectorIndexStates == nil {
+		states, err := loadVectorIndexStates(s.path())
+		if err != nil {
+			return "", nil, nil, err
+		}
+		s.vectorIndexStates = states
+		s.vectorIndexStores = map[string]*lsmkv.Store{}
+	}
+
+	state, ok := s.vectorIndexStates[targetVector]
+	if !ok {
+		return s.path(), s.store, cfg, nil
+	}
+
+	live, err := state.config()
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("vector index of %q: %w", targetVector, err)
+	}
+	if !vectorIndexRequiresRebuild(live, cfg) {
+		live = cfg
+	}
+
+	if state.Generation == 0 {
+		return s.path(), s.store, live, nil
+	}
+
+	dir := s.vectorIndexDir(targetVector, state.Generation)
+	store, ok := s.vectorIndexStores[targetVector]
+	if !ok {
+		if store, err = s.openVectorIndexStore(dir); err != nil {
+			return "", nil, nil, err
+		}
+		s.vectorIndexStores[targetVector] = store
+	}
+	return dir, store, live, nil
+}
+
+func (s *Shard) vectorIndexUserConfig(targetVector string) schemaConfig.VectorIndexConfig {
+	s.index.vectorIndexUserConfigLock.Lock()
+	defer s.index.vectorIndexUserConfigLock.Unlock()
+
+	if targetVector == "" {
+		return s.index.vectorIndexUserConfig
+	}
+	return s.index.vectorIndexUserConfigs[targetVector]
+}
+
+// resumeVectorIndexRebuilds restarts the rebuilds of vector indexes still
+// built with a different config than the one in the schema, and removes the
+// leftovers of interrupted rebuilds
+func (s *Shard) resumeVectorIndexRebuilds() error {
+	s.reindexJobLock.Lock()
+	defer s.reindexJobLock.Unlock()
+
+	s.vectorIndexStatesLock.Lock()
+	states := make(map[string]vectorIndexState, len(s.vectorIndexStates))
+	for targetVector, state := range s.vectorIndexStates {
+		states[targetVector] = state
+	}
+	s.vectorIndexStatesLock.Unlock()
+
+	for targetVector, state := range states {
+		if err := s.removeStaleVectorIndexes(targetVector, state.Generation); err != nil {
+			return err
+		}
+
+		live, err := state.config()
+		if err != nil {
+			return fmt.Errorf("vector index of %q: %w", targetVector, err)
+		}
+		target := s.vectorIndexUserConfig(targetVector)
+		if target == nil || !vectorIndexRequiresRebuild(live, target) {
+			continue
+		}
+		s.startVectorIndexRebuild(targetVector, state.Generation+1, target)
+	}
+	return nil
+}
+
+func (s *Shard) removeStaleVectorIndexes(targetVector string, generation int) error {
+	dir := filepath.Join(s.path(), vectorIndexesDir, s.vectorIndexID(targetVector))
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("read vector indexes of %q: %w", targetVector, err)
+	}
+
+	for _, entry := range entries {
+		if entry.Name() == strconv.Itoa(generation) {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(dir, entry.Name())); err != nil {
+			return fmt.Errorf("remove stale vector index of %q: %w", targetVector, err)
+		}
+	}
+	return nil
+}
+
+// mayRebuildVectorIndex starts rebuilding the vector index of the target
+// vector if the updated config can only be applied that way, or if the index
+// is being rebuilt already. It returns whether the index is being rebuilt.
+func (s *Shard) mayRebuildVectorIndex(targetVector string, updated schemaConfig.VectorIndexConfig) (bool, error) {
+	s.reindexJobLock.Lock()
+	defer s.reindexJobLock.Unlock()
+
+	s.vectorIndexStatesLock.Lock()
+	state, ok := s.vectorIndexStates[targetVector]
+	s.vectorIndexStatesLock.Unlock()
+
+	live := s.vectorIndexUserConfig(targetVector)
+	if ok {
+		var err error
+		if live, err = state.config(); err != nil {
+			return false, fmt.Errorf("vector index of %q: %w", targetVector, err)
+		}
+	}
+
+	if job := s.vectorIndexRebuilds[targetVector]; s.vectorIndexRebuilding(targetVector) {
+		if reflect.DeepEqual(job.config, updated) {
+			return true, nil
+		}
+	} else if !vectorIndexRequiresRebuild(live, updated) {
+		return false, nil
+	}
+
+	if !ok {
+		// persist the config the live index was built with, so an interrupted
+		// rebuild is resumed
+		var err error
+		if state, err = newVectorIndexState(0, live); err != nil {
+			return false, err
+		}
+		if err := s.setVectorIndexState(targetVector, state); err != nil {
+			return false, err
+		}
+	}
+
+	s.startVectorIndexRebuild(targetVector, state.Generation+1, updated)
+	return true, nil
+}
+
+func (s *Shard) setVectorIndexState(targetVector string, state vectorIndexState) error {
+	s.vectorIndexStatesLock.Lock()
+	defer s.vectorIndexStatesLock.Unlock()
+
+	states := make(map[string]vectorIndexState, len(s.vectorIndexStates)+1)
+	for name, state := range s.vectorIndexStates {
+		states[name] = state
+	}
+	states[targetVector] = state
+
+	if err := saveVectorIndexStates(s.path(), states); err != nil {
+		return err
+	}
+	s.vectorIndexStates = states
+	return nil
+}
+
+// startVectorIndexRebuild replaces a running rebuild of the vector index
+// of the target vector. Must be called with reindexJobLock held.
+func (s *Shard) startVectorIndexRebuild(targetVector string, generation int,
+	cfg schemaConfig.VectorIndexConfig,
+) {
+	s.stopVectorIndexRebuilds(targetVector)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &vectorIndexRebuildJob{
+		targetVector: targetVector,
+		generation:   generation,
+		config:       cfg,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+	}
+	if s.vectorIndexRebuilds == nil {
+		s.vectorIndexRebuilds = map[string]*vectorIndexRebuildJob{}
+	}
+	s.vectorIndexRebuilds[targetVector] = job
+
+	enterrors.GoWrapper(func() {
+		defer close(job.done)
+
+		if err := s.runVectorIndexRebuild(ctx, job); err != nil && ctx.Err() == nil {
+			// the rebuild is resumed when the shard is loaded the next time
+			job.err = err
+			s.index.logger.
+				WithField("action", "rebuild_vector_index").
+				WithField("shard", s.ID()).
+				WithField("target_vector", targetVector).
+				WithError(err).
+				Error("failed to rebuild vector index")
+		}
+	}, s.index.logger)
+}
+
+// vectorIndexRebuilding returns whether the vector index of the target
+// vector is being rebuilt. Must be called with reindexJobLock held.
+func (s *Shard) vectorIndexRebuilding(targetVector string) bool {
+	job, ok := s.vectorIndexRebuilds[targetVector]
+	if !ok {
+		return false
+	}
+	select {
+	case <-job.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// vectorIndexRebuildProgress returns the target vectors whose index is being
+// rebuilt and the number of vectors added to the rebuilt indexes so far, as
+// well as the target vectors whose rebuild failed. A failed rebuild is
+// retried once the shard is loaded again. The legacy vector is reported as
+// an empty string.
+func (s *Shard) vectorIndexRebuildProgress() (rebuilding []string, added int64, failed []string) {
+	s.reindexJobLock.Lock()
+	defer s.reindexJobLock.Unlock()
+
+	for targetVector, job := range s.vectorIndexRebuilds {
+		if s.vectorIndexRebuilding(targetVector) {
+			rebuilding = append(rebuilding, targetVector)
+			added += job.added.Load()
+		} else if job.err != nil {
+			failed = append(failed, targetVector)
+		}
+	}
+	sort.Strings(rebuilding)
+	sort.Strings(failed)
+	return rebuilding, added, failed
+}
+
+// stopVectorIndexRebuilds stops the running rebuilds of the given target
+// vectors, or of all of them if none are given, and waits for them to
+// return. Must be called with reindexJobLock held.
+func (s *Shard) stopVectorIndexRebuilds(targetVectors ...string) {
+	if len(targetVectors) == 0 {
+		for targetVector := range s.vectorIndexRebuilds {
+			targetVectors = append(targetVectors, targetVector)
+		}
+	}
+
+	for _, targetVector := range targetVectors {
+		job, ok := s.vectorIndexRebuilds[targetVector]
+		if !ok {
+			continue
+		}
+		job.cancel()
+		<-job.done
+		delete(s.vectorIndexRebuilds, targetVector)
+	}
+}
+
+func (s *Shard) runVectorIndexRebuild(ctx context.Context, job *vectorIndexRebuildJob) (err error) {
+	before := time.Now()
+
+	dir := s.vectorIndexDir(job.targetVector, job.generation)
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("remove previous rebuild: %w", err)
+	}
+	store, err := s.openVectorIndexStore(dir)
+	if err != nil {
+		return err
+	}
+	vectorIndex, err := s.newVectorIndex(ctx, job.targetVector, job.config, dir, store)
+	if err != nil {
+		store.Shutdown(ctx)
+		return err
+	}
+
+	swapped := false
+	defer func() {
+		if swapped {
+			return
+		}
+		vectorIndex.Shutdown(context.Background())
+		store.Shutdown(context.Background())
+		os.RemoveAll(dir)
+	}()
+
+	// add the stored vectors, then the ones written meanwhile, so that only
+	// few are left to be added while writes are blocked
+	upTo, err := s.addMissingVectors(ctx, job, vectorIndex, 0)
+	if err != nil {
+		return fmt.Errorf("add vectors: %w", err)
+	}
+	if err := s.removeDeletedVectors(ctx, vectorIndex, upTo); err != nil {
+		return fmt.Errorf("remove deleted vectors: %w", err)
+	}
+	if upTo, err = s.addMissingVectors(ctx, job, vectorIndex, upTo); err != nil {
+		return fmt.Errorf("add vectors: %w", err)
+	}
+
+	old, oldStore, oldGeneration, err := s.swapVectorIndex(ctx, job, vectorIndex, store, upTo)
+	if err != nil {
+		return fmt.Errorf("replace vector index: %w", err)
+	}
+	swapped = true
+
+	// objects deleted after the vectors were last checked may still be
+	// present in the rebuilt index
+	if err := s.removeDeletedVectors(context.Background(), vectorIndex, s.Counter().Get()); err != nil {
+		return fmt.Errorf("remove deleted vectors: %w", err)
+	}
+	if err := s.dropReplacedVectorIndex(context.Background(), job.targetVector, old, oldStore, oldGeneration); err != nil {
+		return fmt.Errorf("drop replaced vector index: %w", err)
+	}
+
+	s.index.logger.
+		WithField("action", "rebuild_vector_index").
+		WithField("shard", s.ID()).
+		WithField("target_vector", job.targetVector).
+		WithField("index_type", job.config.IndexType()).
+		WithField("took", time.Since(before)).
+		Info("finished rebuilding vector index")
+	return nil
+}
+
+// addMissingVectors adds the vectors of objects with a doc id of at least
+// fromID that are not yet present in the index. It returns the doc id up to
+// which objects were added.
+func (s *Shard) addMissingVectors(ctx context.Context, job *vectorIndexRebuildJob,
+	vectorIndex VectorIndex, fromID uint64,
+) (uint64, error) {
+	upTo := s.Counter().Get()
+
+	ids := make([]uint64, 0, vectorRebuildBatchSize)
+	vectors := make([][]float32, 0, vectorRebuildBatchSize)
+	flush := func() error {
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := vectorIndex.AddBatch(ctx, ids, vectors); err != nil {
+			return err
+		}
+		job.added.Add(int64(len(ids)))
+		ids, vectors = ids[:0], vectors[:0]
+		return nil
+	}
+
+	err := s.iterateOnLSMVectors(ctx, fromID, job.targetVector, func(id uint64, vector []float32) error {
+		if id >= upTo {
+			upTo = id + 1
+		}
+		if len(vector) == 0 || vectorIndex.ContainsNode(id) {
+			return nil
+		}
+
+		ids = append(ids, id)
+		vectors = append(vectors, vector)
+		if len(ids) < vectorRebuildBatchSize {
+			return nil
+		}
+		return flush()
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := flush(); err != nil {
+		return 0, err
+	}
+	return upTo, vectorIndex.Flush()
+}
+
+// removeDeletedVectors removes the vectors with a doc id lower than upTo
+// whose objects no longer exist
+func (s *Shard) removeDeletedVectors(ctx context.Context, vectorIndex VectorIndex, upTo uint64) error {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+
+	var deleted []uint64
+	var err error
+	buf := make([]byte, 8)
+	vectorIndex.Iterate(func(id uint64) bool {
+		if id >= upTo {
+			return true
+		}
+		if err = ctx.Err(); err != nil {
+			return false
+		}
+
+		binary.LittleEndian.PutUint64(buf, id)
+		var obj []byte
+		if obj, err = bucket.GetBySecondary(0, buf); err != nil {
+			return false
+		}
+		if obj == nil {
+			deleted = append(deleted, id)
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(deleted) == 0 {
+		return nil
+	}
+	if err := vectorIndex.Delete(deleted...); err != nil {
+		return err
+	}
+	return vectorIndex.Flush()
+}
+
+// swapVectorIndex replaces the live vector index with the rebuilt one, while
+// neither queries nor writes are running. It returns the replaced index.
+func (s *Shard) swapVectorIndex(ctx context.Context, job *vectorIndexRebuildJob,
+	vectorIndex VectorIndex, store *lsmkv.Store, fromID uint64,
+) (VectorIndex, *lsmkv.Store, int, error) {
+	if err := s.lockReindexReaders(ctx); err != nil {
+		return nil, nil, 0, err
+	}
+	defer s.reindexReadLock.Unlock()
+
+	s.reindexLock.Lock()
+	defer s.reindexLock.Unlock()
+
+	if _, err := s.addMissingVectors(ctx, job, vectorIndex, fromID); err != nil {
+		return nil, nil, 0, fmt.Errorf("add vectors: %w", err)
+	}
+
+	queue, err := s.getIndexQueue(job.targetVector)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	state, err := newVectorIndexState(job.generation, job.config)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	s.vectorIndexStatesLock.Lock()
+	oldGeneration := s.vectorIndexStates[job.targetVector].Generation
+	s.vectorIndexStatesLock.Unlock()
+	if err := s.setVectorIndexState(job.targetVector, state); err != nil {
+		return nil, nil, 0, err
+	}
+
+	if err := queue.replaceIndex(vectorIndex); err != nil {
+		return nil, nil, 0, err
+	}
+
+	var old VectorIndex
+	if s.hasTargetVectors() {
+		// the map is replaced rather than modified, as it is read without
+		// holding any lock
+		vectorIndexes := make(map[string]VectorIndex, len(s.vectorIndexes))
+		for targetVector, vectorIndex := range s.vectorIndexes {
+			vectorIndexes[targetVector] = vectorIndex
+		}
+		old = vectorIndexes[job.targetVector]
+		vectorIndexes[job.targetVector] = vectorIndex
+		s.vectorIndexes = vectorIndexes
+	} else {
+		old = s.vectorIndex
+		s.vectorIndex = vectorIndex
+	}
+
+	s.vectorIndexStatesLock.Lock()
+	oldStore := s.vectorIndexStores[job.targetVector]
+	if s.vectorIndexStores == nil {
+		s.vectorIndexStores = map[string]*lsmkv.Store{}
+	}
+	s.vectorIndexStores[job.targetVector] = store
+	s.vectorIndexStatesLock.Unlock()
+
+	return old, oldStore, oldGeneration, nil
+}
+
+// dropReplacedVectorIndex removes the files of a replaced vector index
+func (s *Shard) dropReplacedVectorIndex(ctx context.Context, targetVector string,
+	vectorIndex VectorIndex, store *lsmkv.Store, generation int,
+) error {
+	if err := vectorIndex.Drop(ctx); err != nil {
+		return err
+	}
+
+	if generation > 0 {
+		if err := store.Shutdown(ctx); err != nil {
+			return err
+		}
+		return os.RemoveAll(s.vectorIndexDir(targetVector, generation))
+	}
+
+	// the buckets of flat indexes and compressed hnsw indexes are kept in
+	// the main store. The compressed vectors of hnsw indexes are shared
+	// between all target vectors.
+	buckets := []string{helpers.VectorsBucketLSM, helpers.VectorsCompressedBucketLSM}
+	if targetVector != "" {
+		buckets = []string{
+			fmt.Sprintf("%s_%s", helpers.VectorsBucketLSM, targetVector),
+			fmt.Sprintf("%s_%s", helpers.VectorsCompressedBucketLSM, targetVector),
+		}
+	}
+	for _, bucket := range buckets {
+		if err := s.store.DropBucket(ctx, bucket); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rebuiltVectorIndexStores returns the stores of rebuilt vector indexes by
+// target vector
+func (s *Shard) rebuiltVectorIndexStores() map[string]*lsmkv.Store {
+	s.vectorIndexStatesLock.Lock()
+	defer s.vectorIndexStatesLock.Unlock()
+
+	stores := make(map[string]*lsmkv.Store, len(s.vectorIndexStores))
+	for targetVector, store := range s.vectorIndexStores {
+		stores[targetVector] = store
+	}
+	return stores
+}
+
+// shutdownVectorIndexStores shuts down the stores of rebuilt vector indexes
+func (s *Shard) shutdownVectorIndexStores(ctx context.Context) error {
+	s.vectorIndexStatesLock.Lock()
+	defer s.vectorIndexStatesLock.Unlock()
+
+	for targetVector, store := range s.vectorIndexStores {
+		if err := store.Shutdown(ctx); err != nil {
+			return fmt.Errorf("vector index of %q: %w", targetVector, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_RebuildVectorIndex(t *testing.T) {
+	ctx := context.Background()
+	class := &models.Class{Class: "RebuildVectorIndexClass"}
+	shardLike, idx := testShardWithSettings(t, ctx, class, hnsw.NewDefaultUserConfig(), false, false,
+		func(i *Index) { i.Config.DisableLazyLoadShards = true })
+	shd := shardLike.(*Shard)
+
+	objs := createRandomObjects(rand.New(rand.NewSource(7)), class.Class, 200, 8)
+	for _, err := range shd.PutObjectBatch(ctx, objs) {
+		require.Nil(t, err)
+	}
+
+	flatCfg := flatent.NewDefaultUserConfig()
+	flatCfg.Distance = common.DistanceL2Squared
+
+	waitForRebuild := func(t *testing.T) {
+		assert.Eventually(t, func() bool {
+			shd.reindexJobLock.Lock()
+			defer shd.reindexJobLock.Unlock()
+			return !shd.vectorIndexRebuilding("")
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	t.Run("hnsw is replaced by flat with a different distance", func(t *testing.T) {
+		old := shd.VectorIndex()
+		require.Nil(t, shd.UpdateVectorIndexConfig(ctx, flatCfg))
+		waitForRebuild(t)
+
+		vectorIndex := shd.VectorIndex()
+		require.NotEqual(t, old, vectorIndex)
+		assert.Equal(t, common.DistanceL2Squared, vectorIndex.DistancerProvider().Type())
+		for _, obj := range objs {
+			assert.True(t, vectorIndex.ContainsNode(obj.DocID))
+		}
+
+		ids, dists, err := vectorIndex.SearchByVector(ctx, objs[0].Vector, 1, nil)
+		require.Nil(t, err)
+		require.Len(t, ids, 1)
+		assert.Equal(t, objs[0].DocID, ids[0])
+		assert.Zero(t, dists[0])
+
+		states, err := loadVectorIndexStates(shd.path())
+		require.Nil(t, err)
+		assert.Equal(t, 1, states[""].Generation)
+		assert.Equal(t, flatCfg.IndexType(), states[""].IndexType)
+		assert.DirExists(t, shd.vectorIndexDir("", 1))
+		assert.NoDirExists(t, filepath.Join(shd.path(), "main.hnsw.commitlog.d"))
+
+		rebuilding, _, failed := shd.vectorIndexRebuildProgress()
+		assert.Empty(t, rebuilding)
+		assert.Empty(t, failed)
+		shd.reindexJobLock.Lock()
+		assert.Equal(t, int64(len(objs)), shd.vectorIndexRebuilds[""].added.Load())
+		shd.reindexJobLock.Unlock()
+	})
+
+	t.Run("writes afterwards use the rebuilt index", func(t *testing.T) {
+		obj := createRandomObjects(rand.New(rand.NewSource(8)), class.Class, 1, 8)[0]
+		require.Nil(t, shd.PutObject(ctx, obj))
+		assert.True(t, shd.VectorIndex().ContainsNode(obj.DocID))
+
+		require.Nil(t, shd.DeleteObject(ctx, objs[1].ID(), nil))
+		assert.False(t, shd.VectorIndex().ContainsNode(objs[1].DocID))
+	})
+
+	t.Run("mutable changes are applied in place", func(t *testing.T) {
+		vectorIndex := shd.VectorIndex()
+		updated := flatCfg
+		updated.VectorCacheMaxObjects = flatCfg.VectorCacheMaxObjects + 1
+		require.Nil(t, shd.UpdateVectorIndexConfig(ctx, updated))
+
+		shd.reindexJobLock.Lock()
+		assert.False(t, shd.vectorIndexRebuilding(""))
+		shd.reindexJobLock.Unlock()
+		assert.Equal(t, vectorIndex, shd.VectorIndex())
+	})
+
+	restart := func(t *testing.T) {
+		require.Nil(t, shd.Shutdown(ctx))
+		reloaded, err := idx.initShard(ctx, shd.name, class, nil, true)
+		require.Nil(t, err)
+		idx.shards.Store(shd.name, reloaded)
+		shd = reloaded.(*Shard)
+	}
+
+	t.Run("rebuilt index is loaded after a restart", func(t *testing.T) {
+		idx.vectorIndexUserConfig = flatCfg
+		restart(t)
+
+		shd.reindexJobLock.Lock()
+		assert.False(t, shd.vectorIndexRebuilding(""))
+		shd.reindexJobLock.Unlock()
+		assert.Equal(t, common.DistanceL2Squared, shd.VectorIndex().DistancerProvider().Type())
+		assert.True(t, shd.VectorIndex().ContainsNode(objs[0].DocID))
+	})
+
+	t.Run("rebuilding again replaces the previous generation", func(t *testing.T) {
+		require.Nil(t, shd.UpdateVectorIndexConfig(ctx, hnsw.NewDefaultUserConfig()))
+		waitForRebuild(t)
+
+		assert.Equal(t, "cosine-dot", shd.VectorIndex().DistancerProvider().Type())
+		assert.True(t, shd.VectorIndex().ContainsNode(objs[0].DocID))
+		assert.False(t, shd.VectorIndex().ContainsNode(objs[1].DocID))
+		assert.NoDirExists(t, shd.vectorIndexDir("", 1))
+		assert.DirExists(t, shd.vectorIndexDir("", 2))
+	})
+
+	t.Run("interrupted rebuild is resumed after a restart", func(t *testing.T) {
+		// the schema was changed, but the live index was not rebuilt yet
+		idx.vectorIndexUserConfig = flatCfg
+		restart(t)
+		waitForRebuild(t)
+
+		assert.Equal(t, common.DistanceL2Squared, shd.VectorIndex().DistancerProvider().Type())
+		assert.True(t, shd.VectorIndex().ContainsNode(objs[0].DocID))
+		assert.NoDirExists(t, shd.vectorIndexDir("", 2))
+		assert.DirExists(t, shd.vectorIndexDir("", 3))
+	})
+
+	require.Nil(t, idx.drop())
+	require.Nil(t, os.RemoveAll(idx.Config.RootPath))
+}
+
+func TestShard_RebuildVectorIndex_WithAsyncIndexing(t *testing.T) {
+	t.Setenv("ASYNC_INDEXING", "true")
+	t.Setenv("ASYNC_STALE_TIMEOUT", "200ms")
+
+	ctx := context.Background()
+	class := &models.Class{Class: "RebuildVectorIndexClass"}
+	shardLike, idx := testShardWithSettings(t, ctx, class, hnsw.NewDefaultUserConfig(), false, true,
+		func(i *Index) { i.Config.DisableLazyLoadShards = true })
+	shd := shardLike.(*Shard)
+
+	objs := createRandomObjects(rand.New(rand.NewSource(7)), class.Class, 500, 8)
+	for _, err := range shd.PutObjectBatch(ctx, objs) {
+		require.Nil(t, err)
+	}
+
+	require.Nil(t, shd.UpdateVectorIndexConfig(ctx, flatent.NewDefaultUserConfig()))
+	assert.Eventually(t, func() bool {
+		shd.reindexJobLock.Lock()
+		defer shd.reindexJobLock.Unlock()
+		return !shd.vectorIndexRebuilding("")
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// vectors pushed to the queue before the swap were added by the rebuild
+	for _, obj := range objs {
+		assert.True(t, shd.VectorIndex().ContainsNode(obj.DocID))
+	}
+
+	// vectors pushed afterwards are indexed by the rebuilt index
+	obj := createRandomObjects(rand.New(rand.NewSource(8)), class.Class, 1, 8)[0]
+	require.Nil(t, shd.PutObject(ctx, obj))
+	assert.Eventually(t, func() bool {
+		return shd.VectorIndex().ContainsNode(obj.DocID)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Nil(t, idx.drop())
+	require.Nil(t, os.RemoveAll(idx.Config.RootPath))
+}
+
+func TestVectorIndexRequiresRebuild(t *testing.T) {
+	hnswCfg := hnsw.NewDefaultUserConfig()
+
+	efChanged := hnswCfg
+	efChanged.EF = hnswCfg.EF + 1
+
+	efConstructionChanged := hnswCfg
+	efConstructionChanged.EFConstruction = hnswCfg.EFConstruction + 1
+
+	distanceChanged := hnswCfg
+	distanceChanged.Distance = common.DistanceDot
+
+	compressed := hnswCfg
+	compressed.BQ.Enabled = true
+
+	tests := []struct {
+		name          string
+		live, target  schemaConfig.VectorIndexConfig
+		expectRebuild bool
+	}{
+		{name: "unchanged", live: hnswCfg, target: hnswCfg},
+		{name: "mutable setting", live: hnswCfg, target: efChanged},
+		{name: "immutable setting", live: hnswCfg, target: efConstructionChanged, expectRebuild: true},
+		{name: "distance", live: hnswCfg, target: distanceChanged, expectRebuild: true},
+		{name: "index type", live: hnswCfg, target: flatent.NewDefaultUserConfig(), expectRebuild: true},
+		{name: "compression enabled", live: hnswCfg, target: compressed},
+		{name: "compression disabled", live: compressed, target: hnswCfg, expectRebuild: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectRebuild, vectorIndexRequiresRebuild(tt.live, tt.target))
+		})
+	}
+}
+
+func TestVectorIndexState_Config(t *testing.T) {
+	cfg := flatent.NewDefaultUserConfig()
+	cfg.Distance = common.DistanceDot
+	cfg.BQ.Enabled = true
+
+	state, err := newVectorIndexState(3, cfg)
+	require.Nil(t, err)
+
+	parsed, err := state.config()
+	require.Nil(t, err)
+	assert.Equal(t, cfg, parsed)
+
+	dir := t.TempDir()
+	require.Nil(t, saveVectorIndexStates(dir, map[string]vectorIndexState{"": state}))
+	states, err := loadVectorIndexStates(dir)
+	require.Nil(t, err)
+	assert.Equal(t, 3, states[""].Generation)
+}
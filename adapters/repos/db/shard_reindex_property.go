@@ -0,0 +1,1206 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+const (
+	propertyReindexStateFile = "property_reindex.json"
+	// writes to the shard are blocked while a batch of objects is added to
+	// the rebuilt buckets, so batches are kept small
+	propertyReindexBatchSize = 100
+	// the progress of a job is persisted every that many batches
+	propertyReindexCheckpointInterval = 100
+)
+
+// propertyIndexSettings are the settings of a property which determine the
+// contents of its inverted index buckets
+type propertyIndexSettings struct {
+	Tokenization      string `json:"tokenization,omitempty"`
+	IndexFilterable   *bool  `json:"indexFilterable,omitempty"`
+	IndexSearchable   *bool  `json:"indexSearchable,omitempty"`
+	IndexRangeFilters *bool  `json:"indexRangeFilters,omitempty"`
+}
+
+func propertyIndexSettingsOf(prop *models.Property) propertyIndexSettings {
+	return propertyIndexSettings{
+		Tokenization:      prop.Tokenization,
+		IndexFilterable:   prop.IndexFilterable,
+		IndexSearchable:   prop.IndexSearchable,
+		IndexRangeFilters: prop.IndexRangeFilters,
+	}
+}
+
+func (p propertyIndexSettings) equal(other propertyIndexSettings) bool {
+	return p.Tokenization == other.Tokenization &&
+		boolPtrEqual(p.IndexFilterable, other.IndexFilterable) &&
+		boolPtrEqual(p.IndexSearchable, other.IndexSearchable) &&
+		boolPtrEqual(p.IndexRangeFilters, other.IndexRangeFilters)
+}
+
+// applyTo returns a copy of the property using these settings
+func (p propertyIndexSettings) applyTo(prop *models.Property) *models.Property {
+	cp := *prop
+	cp.Tokenization = p.Tokenization
+	cp.IndexFilterable = p.IndexFilterable
+	cp.IndexSearchable = p.IndexSearchable
+	cp.IndexRangeFilters = p.IndexRangeFilters
+	return &cp
+}
+
+func boolPtrEqual(a, b *bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// propertyIndexSettingsMap holds the index settings of properties by name.
+// Reads are lock-free, as the settings are needed for every object written.
+type propertyIndexSettingsMap struct {
+	sync.Mutex
+	settings atomic.Pointer[map[string]propertyIndexSettings]
+}
+
+func (m *propertyIndexSettingsMap) get(propName string) (propertyIndexSettings, bool) {
+	settings := m.settings.Load()
+	if settings == nil {
+		return propertyIndexSettings{}, false
+	}
+	s, ok := (*settings)[propName]
+	return s, ok
+}
+
+// set stores the settings of the given properties. Unless overwrite is set,
+// properties which are already known keep their settings.
+func (m *propertyIndexSettingsMap) set(settings map[string]propertyIndexSettings, overwrite bool) {
+	m.Lock()
+	defer m.Unlock()
+
+	next := map[string]propertyIndexSettings{}
+	if current := m.settings.Load(); current != nil {
+		for name, s := range *current {
+			next[name] = s
+		}
+	}
+	for name, s := range settings {
+		if _, ok := next[name]; ok && !overwrite {
+			continue
+		}
+		next[name] = s
+	}
+	m.settings.Store(&next)
+}
+
+func (m *propertyIndexSettingsMap) setProperties(overwrite bool, props ...*models.Property) {
+	settings := make(map[string]propertyIndexSettings, len(props))
+	for _, prop := range props {
+		settings[prop.Name] = propertyIndexSettingsOf(prop)
+	}
+	m.set(settings, overwrite)
+}
+
+type reindexAction int
+
+const (
+	reindexKeep reindexAction = iota
+	reindexRebuild
+	reindexDrop
+)
+
+// propertyReindexPlan describes what happens to each of the inverted index
+// buckets of a property when its settings change
+type propertyReindexPlan struct {
+	dataType      schema.DataType
+	filterable    reindexAction
+	searchable    reindexAction
+	rangeable     reindexAction
+	nullAndLength reindexAction
+}
+
+func newPropertyReindexPlan(prop *models.Property, live, target propertyIndexSettings) propertyReindexPlan {
+	from, to := live.applyTo(prop), target.applyTo(prop)
+	retokenized := from.Tokenization != to.Tokenization
+
+	action := func(had, has, rebuild bool) reindexAction {
+		switch {
+		case has && (!had || rebuild):
+			return reindexRebuild
+		case had && !has:
+			return reindexDrop
+		default:
+			return reindexKeep
+		}
+	}
+
+	dt, _ := schema.AsPrimitive(prop.DataType)
+	return propertyReindexPlan{
+		dataType:   dt,
+		filterable: action(inverted.HasFilterableIndex(from), inverted.HasFilterableIndex(to), retokenized),
+		searchable: action(inverted.HasSearchableIndex(from), inverted.HasSearchableIndex(to), retokenized),
+		rangeable:  action(inverted.HasRangeableIndex(from), inverted.HasRangeableIndex(to), false),
+		// null state and length entries do not depend on the settings, they
+		// are only written for properties having any inverted index though
+		nullAndLength: action(inverted.HasAnyInvertedIndex(from), inverted.HasAnyInvertedIndex(to), false),
+	}
+}
+
+// buckets returns the names of the current buckets of the property along
+// with the action applied to them
+func (p propertyReindexPlan) buckets(propName string, cfg schema.InvertedIndexConfig) map[string]reindexAction {
+	buckets := map[string]reindexAction{
+		helpers.BucketFromPropNameLSM(propName):           p.filterable,
+		helpers.BucketSearchableFromPropNameLSM(propName): p.searchable,
+		helpers.BucketRangeableFromPropNameLSM(propName):  p.rangeable,
+	}
+	if cfg.IndexNullState {
+		buckets[helpers.BucketFromPropNameNullLSM(propName)] = p.nullAndLength
+	}
+	if cfg.IndexPropertyLength && isPropertyForLength(p.dataType) {
+		buckets[helpers.BucketFromPropNameLengthLSM(propName)] = p.nullAndLength
+	}
+	return buckets
+}
+
+// reindexLengthsName is the name the property lengths of a rebuilt
+// searchable bucket are tracked under. It is not a valid property name, so
+// it can not collide with any.
+func reindexLengthsName(propName string) string {
+	return propName + "~reindex"
+}
+
+// propertyReindexState is the persisted state of a reindex job, which allows
+// resuming it after a restart
+type propertyReindexState struct {
+	// Live holds the settings the current buckets were built with
+	Live map[string]propertyIndexSettings `json:"live"`
+	// Target holds the settings the buckets are rebuilt with
+	Target map[string]propertyIndexSettings `json:"target"`
+	// LastKey is the key of the last object added to the rebuilt buckets
+	LastKey   []byte `json:"lastKey,omitempty"`
+	Processed int64  `json:"processed"`
+}
+
+// merge adds changed settings to the state. Properties already being
+// reindexed keep their live settings, properties changed back to their live
+// settings are no longer reindexed. The rebuilt buckets are started over.
+func (st propertyReindexState) merge(live, target map[string]propertyIndexSettings) propertyReindexState {
+	next := propertyReindexState{
+		Live:   map[string]propertyIndexSettings{},
+		Target: map[string]propertyIndexSettings{},
+	}
+	for name, settings := range st.Target {
+		next.Live[name] = st.Live[name]
+		next.Target[name] = settings
+	}
+	for name, settings := range target {
+		l, ok := next.Live[name]
+		if !ok {
+			l = live[name]
+		}
+		if l.equal(settings) {
+			delete(next.Live, name)
+			delete(next.Target, name)
+			continue
+		}
+		next.Live[name] = l
+		next.Target[name] = settings
+	}
+	return next
+}
+
+func loadPropertyReindexState(shardDir string) (*propertyReindexState, error) {
+	data, err := os.ReadFile(path.Join(shardDir, propertyReindexStateFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read property reindex state: %w", err)
+	}
+
+	var state propertyReindexState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("unmarshal property reindex state: %w", err)
+	}
+	return &state, nil
+}
+
+func savePropertyReindexState(shardDir string, state propertyReindexState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("marshal property reindex state: %w", err)
+	}
+
+	// write to a temporary file first, so a crash never leaves a partially
+	// written state behind
+	filename := path.Join(shardDir, propertyReindexStateFile)
+	if err := os.WriteFile(filename+".tmp", data, 0o666); err != nil {
+		return fmt.Errorf("write property reindex state: %w", err)
+	}
+	if err := os.Rename(filename+".tmp", filename); err != nil {
+		return fmt.Errorf("write property reindex state: %w", err)
+	}
+	return nil
+}
+
+func removePropertyReindexState(shardDir string) error {
+	err := os.Remove(path.Join(shardDir, propertyReindexStateFile))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove property reindex state: %w", err)
+	}
+	return nil
+}
+
+// updatePropertyReindexFile adds changed settings to the reindex state of a
+// shard which is not loaded, without loading it. The job starts once the
+// shard is loaded.
+func updatePropertyReindexFile(shardDir string, live, target map[string]propertyIndexSettings) error {
+	state, err := loadPropertyReindexState(shardDir)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		state = &propertyReindexState{}
+	}
+
+	next := state.merge(live, target)
+	if len(next.Target) == 0 {
+		return removePropertyReindexState(shardDir)
+	}
+	return savePropertyReindexState(shardDir, next)
+}
+
+// propertyReindexJob rebuilds the inverted index buckets of properties whose
+// tokenization or index flags changed. The buckets are rebuilt next to the
+// current ones, which keep serving queries until the job visited all objects
+// of the shard and atomically replaces them. Writes to objects already
+// visited by the job are applied to both, the current and the rebuilt
+// buckets.
+type propertyReindexJob struct {
+	live   map[string]propertyIndexSettings
+	target map[string]propertyIndexSettings
+	plans  map[string]propertyReindexPlan
+
+	// position is the key of the last object added to the rebuilt buckets,
+	// complete is set once all objects were added. Both are guarded by
+	// Shard.reindexLock
+	position []byte
+	complete bool
+
+	processed atomic.Int64
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+func newPropertyReindexJob(state propertyReindexState, class *models.Class) *propertyReindexJob {
+	job := &propertyReindexJob{
+		live:     state.Live,
+		target:   state.Target,
+		plans:    map[string]propertyReindexPlan{},
+		position: state.LastKey,
+	}
+	job.processed.Store(state.Processed)
+
+	if class != nil {
+		for _, prop := range class.Properties {
+			target, ok := state.Target[prop.Name]
+			if !ok {
+				continue
+			}
+			job.plans[prop.Name] = newPropertyReindexPlan(prop, state.Live[prop.Name], target)
+		}
+	}
+	return job
+}
+
+// covers returns whether the object with the given key was already added to
+// the rebuilt buckets
+func (j *propertyReindexJob) covers(key []byte) bool {
+	return j.complete || (j.position != nil && bytes.Compare(key, j.position) <= 0)
+}
+
+func (j *propertyReindexJob) properties() []string {
+	names := make([]string, 0, len(j.target))
+	for name := range j.target {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (j *propertyReindexJob) state() propertyReindexState {
+	return propertyReindexState{
+		Live:      j.live,
+		Target:    j.target,
+		LastKey:   j.position,
+		Processed: j.processed.Load(),
+	}
+}
+
+// updatePropertyIndexes starts rebuilding the inverted indexes of properties
+// whose tokenization or index flags were changed. Shards keep using the
+// previous settings until their rebuilt indexes are complete.
+func (i *Index) updatePropertyIndexes(ctx context.Context, props []*models.Property) error {
+	live := map[string]propertyIndexSettings{}
+	target := map[string]propertyIndexSettings{}
+	for _, prop := range props {
+		prev, ok := i.propertyIndexSettings.get(prop.Name)
+		next := propertyIndexSettingsOf(prop)
+		if !ok || prev.equal(next) || !schemaUC.IsPropertyReindexable(prop) {
+			continue
+		}
+		live[prop.Name] = prev
+		target[prop.Name] = next
+	}
+	if len(target) == 0 {
+		return nil
+	}
+
+	err := i.ForEachShard(func(name string, shard ShardLike) error {
+		if err := shard.updatePropertyIndexes(ctx, live, target); err != nil {
+			return fmt.Errorf("shard %q: %w", name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	i.propertyIndexSettings.set(target, true)
+	return nil
+}
+
+// readOnlyClass returns the class with the property settings the inverted
+// index buckets of the shard are currently built with, which differ from the
+// schema while the buckets are rebuilt after a settings change
+func (s *Shard) readOnlyClass(className string) *models.Class {
+	class := s.index.getSchema.ReadOnlyClass(className)
+	if class == nil || className != s.index.Config.ClassName.String() {
+		return class
+	}
+	return s.withLiveIndexSettings(class)
+}
+
+// liveIndexSchemaGetter resolves classes like [Shard.readOnlyClass], for
+// components which query the shard through a schema getter
+type liveIndexSchemaGetter struct {
+	schemaUC.SchemaGetter
+	shard *Shard
+}
+
+func (g liveIndexSchemaGetter) ReadOnlyClass(className string) *models.Class {
+	return g.shard.readOnlyClass(className)
+}
+
+func (s *Shard) withLiveIndexSettings(class *models.Class) *models.Class {
+	var props []*models.Property
+	for i, prop := range class.Properties {
+		live, ok := s.propertyIndexSettings.get(prop.Name)
+		if !ok || live.equal(propertyIndexSettingsOf(prop)) {
+			continue
+		}
+		if props == nil {
+			props = make([]*models.Property, len(class.Properties))
+			copy(props, class.Properties)
+		}
+		props[i] = live.applyTo(prop)
+	}
+	if props == nil {
+		return class
+	}
+
+	cp := *class
+	cp.Properties = props
+	return &cp
+}
+
+// reindexClass returns the class with the target settings of the properties
+// reindexed by the job, other properties are left out
+func (s *Shard) reindexClass(job *propertyReindexJob) (*models.Class, error) {
+	className := s.index.Config.ClassName.String()
+	class := s.index.getSchema.ReadOnlyClass(className)
+	if class == nil {
+		return nil, fmt.Errorf("could not find class %s in schema", className)
+	}
+
+	props := make([]*models.Property, 0, len(job.plans))
+	for _, prop := range class.Properties {
+		if _, ok := job.plans[prop.Name]; ok {
+			props = append(props, job.target[prop.Name].applyTo(prop))
+		}
+	}
+
+	cp := *class
+	cp.Properties = props
+	return &cp, nil
+}
+
+// initPropertyReindex loads the settings the inverted index buckets of the
+// shard were built with and the state of an interrupted reindex job
+func (s *Shard) initPropertyReindex(class *models.Class) error {
+	if class != nil {
+		s.propertyIndexSettings.setProperties(true, class.Properties...)
+	}
+
+	state, err := loadPropertyReindexState(s.path())
+	if err != nil || state == nil {
+		return err
+	}
+
+	s.propertyIndexSettings.set(state.Live, true)
+	s.propertyReindex.Store(newPropertyReindexJob(*state, class))
+	return nil
+}
+
+// resumePropertyReindex continues the reindex job loaded at startup
+func (s *Shard) resumePropertyReindex(ctx context.Context) error {
+	s.reindexJobLock.Lock()
+	defer s.reindexJobLock.Unlock()
+
+	job := s.propertyReindex.Load()
+	if job == nil {
+		return nil
+	}
+
+	if job.position == nil {
+		if err := s.dropReindexBuckets(ctx, job.properties()...); err != nil {
+			return err
+		}
+	}
+	if err := s.createReindexBuckets(ctx, job); err != nil {
+		return err
+	}
+
+	s.startPropertyReindex(job)
+	return nil
+}
+
+func (s *Shard) updatePropertyIndexes(ctx context.Context, live, target map[string]propertyIndexSettings) error {
+	s.reindexJobLock.Lock()
+	defer s.reindexJobLock.Unlock()
+
+	prev := s.propertyReindex.Load()
+	s.stopPropertyReindex()
+
+	state := propertyReindexState{}
+	if prev != nil {
+		state = prev.state()
+	}
+	// the current buckets might have been built with settings more recent
+	// than the ones known to the index
+	shardLive := make(map[string]propertyIndexSettings, len(live))
+	for name, settings := range live {
+		if l, ok := s.propertyIndexSettings.get(name); ok {
+			settings = l
+		}
+		shardLive[name] = settings
+	}
+	state = state.merge(shardLive, target)
+
+	var job *propertyReindexJob
+	if len(state.Target) > 0 {
+		job = newPropertyReindexJob(state, s.index.getSchema.ReadOnlyClass(s.index.Config.ClassName.String()))
+	}
+
+	// writes must neither use the buckets of the previous job while they are
+	// dropped nor the ones of the new job before they are created
+	s.reindexLock.Lock()
+	defer s.reindexLock.Unlock()
+
+	s.propertyReindex.Store(job)
+	if prev != nil {
+		if err := s.dropReindexBuckets(ctx, prev.properties()...); err != nil {
+			return err
+		}
+	}
+	if job == nil {
+		return removePropertyReindexState(s.path())
+	}
+
+	if err := s.dropReindexBuckets(ctx, job.properties()...); err != nil {
+		return err
+	}
+	if err := s.createReindexBuckets(ctx, job); err != nil {
+		return err
+	}
+	if err := savePropertyReindexState(s.path(), job.state()); err != nil {
+		return err
+	}
+
+	s.startPropertyReindex(job)
+	return nil
+}
+
+// startPropertyReindex runs the job in the background. Must be called with
+// reindexJobLock held.
+func (s *Shard) startPropertyReindex(job *propertyReindexJob) {
+	ctx, cancel := context.WithCancel(context.Background())
+	job.cancel = cancel
+	job.done = make(chan struct{})
+
+	enterrors.GoWrapper(func() {
+		defer close(job.done)
+
+		if err := s.runPropertyReindex(ctx, job); err != nil && ctx.Err() == nil {
+			// the job is resumed when the shard is loaded the next time
+			s.index.logger.
+				WithField("action", "reindex_properties").
+				WithField("shard", s.ID()).
+				WithField("properties", job.properties()).
+				WithError(err).
+				Error("failed to reindex properties")
+		}
+	}, s.index.logger)
+}
+
+// stopPropertyReindex stops the running job and waits for it to return. Must
+// be called with reindexJobLock held.
+func (s *Shard) stopPropertyReindex() {
+	job := s.propertyReindex.Load()
+	if job == nil || job.cancel == nil {
+		return
+	}
+	job.cancel()
+	<-job.done
+}
+
+func (s *Shard) runPropertyReindex(ctx context.Context, job *propertyReindexJob) error {
+	before := time.Now()
+	props := job.properties()
+
+	for batch := 1; ; batch++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		complete, err := s.reindexPropertiesBatch(job)
+		if err != nil {
+			return err
+		}
+		if complete {
+			break
+		}
+
+		if batch%propertyReindexCheckpointInterval == 0 {
+			if err := s.checkpointPropertyReindex(job); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := s.swapReindexedProperties(ctx, job); err != nil {
+		return fmt.Errorf("replace buckets: %w", err)
+	}
+
+	s.index.logger.
+		WithField("action", "reindex_properties").
+		WithField("shard", s.ID()).
+		WithField("properties", props).
+		WithField("objects", job.processed.Load()).
+		WithField("took", time.Since(before)).
+		Info("finished reindexing properties")
+	return nil
+}
+
+// reindexPropertiesBatch adds the next batch of objects to the rebuilt
+// buckets. Writes are blocked meanwhile, so no object changes between being
+// read and being marked as visited.
+func (s *Shard) reindexPropertiesBatch(job *propertyReindexJob) (bool, error) {
+	s.reindexLock.Lock()
+	defer s.reindexLock.Unlock()
+
+	class, err := s.reindexClass(job)
+	if err != nil {
+		return false, err
+	}
+
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	var k, v []byte
+	if job.position == nil {
+		k, v = cursor.First()
+	} else {
+		k, v = cursor.Seek(job.position)
+		if bytes.Equal(k, job.position) {
+			k, v = cursor.Next()
+		}
+	}
+
+	for i := 0; i < propertyReindexBatchSize && k != nil; i++ {
+		object, err := storobj.FromBinary(v)
+		if err != nil {
+			return false, fmt.Errorf("unmarshal object: %w", err)
+		}
+
+		props, nilProps, err := s.analyzeObject(class, object)
+		if err != nil {
+			return false, fmt.Errorf("analyze object %s: %w", object.ID(), err)
+		}
+		if err := s.trackReindexLengths(job, props, false); err != nil {
+			return false, fmt.Errorf("track property lengths: %w", err)
+		}
+		if err := s.extendReindexBucketsLSM(job, props, nilProps, object.DocID); err != nil {
+			return false, fmt.Errorf("object %s: %w", object.ID(), err)
+		}
+
+		job.position = bytes.Clone(k)
+		job.processed.Add(1)
+		k, v = cursor.Next()
+	}
+
+	if k == nil {
+		job.complete = true
+	}
+	return job.complete, nil
+}
+
+func (s *Shard) checkpointPropertyReindex(job *propertyReindexJob) error {
+	// everything up to the persisted position has to be recoverable from the
+	// rebuilt buckets
+	for propName, plan := range job.plans {
+		for bucketName, action := range plan.buckets(propName, s.index.invertedIndexConfig) {
+			if action != reindexRebuild {
+				continue
+			}
+			if bucket := s.store.Bucket(helpers.TempBucketFromBucketName(bucketName)); bucket != nil {
+				if err := bucket.WriteWAL(); err != nil {
+					return fmt.Errorf("flush WAL of bucket %q: %w", bucket.GetDir(), err)
+				}
+			}
+		}
+	}
+	return savePropertyReindexState(s.path(), job.state())
+}
+
+// reindexReadLockKey marks the context of a query which holds the
+// reindexReadLock of the shard
+type reindexReadLockKey struct {
+	shard *Shard
+}
+
+// rlockReindexReaders holds the reindexReadLock for a query. Queries can be
+// nested through reference filters, a nested query on the same shard runs
+// under the lock of the outer one, as taking it again would deadlock with a
+// waiting reindex job.
+func (s *Shard) rlockReindexReaders(ctx context.Context) (context.Context, func()) {
+	key := reindexReadLockKey{shard: s}
+	if ctx.Value(key) != nil {
+		return ctx, func() {}
+	}
+	s.reindexReadLock.RLock()
+	return context.WithValue(ctx, key, struct{}{}), s.reindexReadLock.RUnlock
+}
+
+// lockReindexReaders waits until no query uses the inverted index buckets.
+// New queries are blocked while waiting, so the reindex job is not starved
+// by a steady stream of them.
+func (s *Shard) lockReindexReaders(ctx context.Context) error {
+	locked := make(chan struct{})
+	enterrors.GoWrapper(func() {
+		s.reindexReadLock.Lock()
+		close(locked)
+	}, s.index.logger)
+
+	select {
+	case <-locked:
+		return nil
+	case <-ctx.Done():
+		// the pending lock can't be abandoned, it is released once acquired
+		enterrors.GoWrapper(func() {
+			<-locked
+			s.reindexReadLock.Unlock()
+		}, s.index.logger)
+		return ctx.Err()
+	}
+}
+
+// swapReindexedProperties replaces the current buckets of the properties
+// with the rebuilt ones, while neither queries nor writes are running
+func (s *Shard) swapReindexedProperties(ctx context.Context, job *propertyReindexJob) error {
+	if err := s.lockReindexReaders(ctx); err != nil {
+		return err
+	}
+	defer s.reindexReadLock.Unlock()
+
+	s.reindexLock.Lock()
+	defer s.reindexLock.Unlock()
+
+	class := s.index.getSchema.ReadOnlyClass(s.index.Config.ClassName.String())
+	for _, propName := range job.properties() {
+		// the property might have been deleted in the meantime, in which case
+		// only its rebuilt buckets are dropped
+		if class != nil {
+			if _, err := schema.GetPropertyByName(class, propName); err == nil {
+				if err := s.swapReindexedProperty(ctx, propName, job.plans[propName]); err != nil {
+					return err
+				}
+				s.propertyIndexSettings.set(map[string]propertyIndexSettings{propName: job.target[propName]}, true)
+			}
+		}
+		if err := s.dropReindexBuckets(ctx, propName); err != nil {
+			return err
+		}
+
+		// persist each replaced property, so the job does not start over for
+		// it if interrupted
+		delete(job.plans, propName)
+		delete(job.live, propName)
+		delete(job.target, propName)
+		if len(job.target) > 0 {
+			if err := savePropertyReindexState(s.path(), job.state()); err != nil {
+				return err
+			}
+		}
+	}
+
+	s.propertyReindex.Store(nil)
+	return removePropertyReindexState(s.path())
+}
+
+func (s *Shard) swapReindexedProperty(ctx context.Context, propName string, plan propertyReindexPlan) error {
+	for bucketName, action := range plan.buckets(propName, s.index.invertedIndexConfig) {
+		switch action {
+		case reindexRebuild:
+			var err error
+			tempName := helpers.TempBucketFromBucketName(bucketName)
+			if s.store.Bucket(bucketName) != nil {
+				err = s.store.ReplaceBuckets(ctx, bucketName, tempName)
+			} else {
+				err = s.store.RenameBucket(ctx, tempName, bucketName)
+			}
+			if err != nil {
+				return fmt.Errorf("replace bucket %q: %w", bucketName, err)
+			}
+		case reindexDrop:
+			if err := s.store.DropBucket(ctx, bucketName); err != nil {
+				return fmt.Errorf("drop bucket %q: %w", bucketName, err)
+			}
+		default:
+		}
+	}
+
+	switch plan.searchable {
+	case reindexRebuild:
+		if err := s.GetPropertyLengthTracker().ReplaceProperty(propName, reindexLengthsName(propName)); err != nil {
+			return fmt.Errorf("replace property lengths: %w", err)
+		}
+	case reindexDrop:
+		if err := s.GetPropertyLengthTracker().DropProperty(propName); err != nil {
+			return fmt.Errorf("drop property lengths: %w", err)
+		}
+	default:
+	}
+	return nil
+}
+
+// createReindexBuckets creates or loads the buckets the job rebuilds the
+// inverted indexes into, using the options of the buckets they replace, see
+// [Shard.createPropertyValueIndex]
+func (s *Shard) createReindexBuckets(ctx context.Context, job *propertyReindexJob) error {
+	if err := s.isReadOnly(); err != nil {
+		return err
+	}
+
+	for propName, plan := range job.plans {
+		for bucketName, action := range plan.buckets(propName, s.index.invertedIndexConfig) {
+			if action != reindexRebuild {
+				continue
+			}
+
+			bucketOpts := []lsmkv.BucketOption{
+				s.memtableDirtyConfig(),
+				s.dynamicMemtableSizing(),
+				lsmkv.WithPread(s.index.Config.AvoidMMap),
+				lsmkv.WithAllocChecker(s.index.allocChecker),
+				lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
+				s.segmentCleanupConfig(),
+			}
+			switch bucketName {
+			case helpers.BucketSearchableFromPropNameLSM(propName):
+				bucketOpts = append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyMapCollection))
+				if s.versioner.Version() < 2 {
+					bucketOpts = append(bucketOpts, lsmkv.WithLegacyMapSorting())
+				}
+			case helpers.BucketRangeableFromPropNameLSM(propName):
+				bucketOpts = append(bucketOpts,
+					lsmkv.WithStrategy(lsmkv.StrategyRoaringSetRange),
+					lsmkv.WithUseBloomFilter(false),
+					lsmkv.WithCalcCountNetAdditions(false),
+				)
+			default:
+				bucketOpts = append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyRoaringSet))
+			}
+
+			if err := s.store.CreateOrLoadBucket(ctx,
+				helpers.TempBucketFromBucketName(bucketName), bucketOpts...,
+			); err != nil {
+				return fmt.Errorf("create bucket for rebuilding %q: %w", bucketName, err)
+			}
+		}
+	}
+	return nil
+}
+
+// dropReindexBuckets removes the rebuilt buckets and property lengths of the
+// given properties, including leftovers of an interrupted job which are only
+// present on disk
+func (s *Shard) dropReindexBuckets(ctx context.Context, propNames ...string) error {
+	for _, propName := range propNames {
+		for _, bucketName := range propertyBucketNames(propName) {
+			tempName := helpers.TempBucketFromBucketName(bucketName)
+			if err := s.store.DropBucket(ctx, tempName); err != nil {
+				return fmt.Errorf("drop bucket %q: %w", tempName, err)
+			}
+			if err := os.RemoveAll(path.Join(s.pathLSM(), tempName)); err != nil {
+				return fmt.Errorf("remove bucket dir %q: %w", tempName, err)
+			}
+		}
+
+		if err := s.GetPropertyLengthTracker().DropProperty(reindexLengthsName(propName)); err != nil {
+			return fmt.Errorf("drop property lengths: %w", err)
+		}
+	}
+	return nil
+}
+
+// updateReindexBucketsLSM applies a changed object to the rebuilt buckets of
+// a running job, if the job already visited it. Objects not visited yet are
+// added by the job itself.
+func (s *Shard) updateReindexBucketsLSM(object *storobj.Object,
+	status objectInsertStatus, prevObject *storobj.Object,
+) error {
+	job := s.propertyReindex.Load()
+	if job == nil {
+		return nil
+	}
+	idBytes, err := parseBytesUUID(object.ID())
+	if err != nil {
+		return err
+	}
+	if !job.covers(idBytes) {
+		return nil
+	}
+
+	class, err := s.reindexClass(job)
+	if err != nil {
+		return err
+	}
+
+	props, nilProps, err := s.analyzeObject(class, object)
+	if err != nil {
+		return errors.Wrap(err, "analyze next object")
+	}
+
+	var prevProps []inverted.Property
+	var prevNilProps []inverted.NilProperty
+	if prevObject != nil {
+		prevProps, prevNilProps, err = s.analyzeObject(class, prevObject)
+		if err != nil {
+			return fmt.Errorf("analyze previous object: %w", err)
+		}
+	}
+
+	if status.docIDChanged || status.docIDPreserved {
+		if err := s.trackReindexLengths(job, prevProps, true); err != nil {
+			return fmt.Errorf("subtract prop lengths: %w", err)
+		}
+	}
+	if err := s.trackReindexLengths(job, props, false); err != nil {
+		return fmt.Errorf("store field length values for props: %w", err)
+	}
+
+	var propsToAdd, propsToDel []inverted.Property
+	var nilPropsToAdd, nilPropsToDel []inverted.NilProperty
+	if status.docIDPreserved {
+		delta := inverted.Delta(prevProps, props)
+		propsToAdd, propsToDel = delta.ToAdd, delta.ToDelete
+		deltaNil := inverted.DeltaNil(prevNilProps, nilProps)
+		nilPropsToAdd, nilPropsToDel = deltaNil.ToAdd, deltaNil.ToDelete
+	} else {
+		propsToAdd, propsToDel = inverted.DedupItems(props), inverted.DedupItems(prevProps)
+		nilPropsToAdd, nilPropsToDel = nilProps, prevNilProps
+	}
+
+	if prevObject != nil {
+		if err := s.deleteFromReindexBucketsLSM(job, propsToDel, nilPropsToDel, status.oldDocID); err != nil {
+			return fmt.Errorf("delete inverted indices props: %w", err)
+		}
+	}
+	if err := s.extendReindexBucketsLSM(job, propsToAdd, nilPropsToAdd, status.docID); err != nil {
+		return fmt.Errorf("put inverted indices props: %w", err)
+	}
+	return nil
+}
+
+// cleanupReindexBucketsOnDelete removes a deleted object from the rebuilt
+// buckets of a running job, if the job already visited it
+func (s *Shard) cleanupReindexBucketsOnDelete(previous *storobj.Object, docID uint64) error {
+	job := s.propertyReindex.Load()
+	if job == nil {
+		return nil
+	}
+	idBytes, err := parseBytesUUID(previous.ID())
+	if err != nil {
+		return err
+	}
+	if !job.covers(idBytes) {
+		return nil
+	}
+
+	class, err := s.reindexClass(job)
+	if err != nil {
+		return err
+	}
+
+	props, nilProps, err := s.analyzeObject(class, previous)
+	if err != nil {
+		return fmt.Errorf("analyze previous object: %w", err)
+	}
+	if err := s.trackReindexLengths(job, props, true); err != nil {
+		return fmt.Errorf("subtract prop lengths: %w", err)
+	}
+	return s.deleteFromReindexBucketsLSM(job, props, nilProps, docID)
+}
+
+func (s *Shard) trackReindexLengths(job *propertyReindexJob, props []inverted.Property, subtract bool) error {
+	for _, prop := range props {
+		if plan, ok := job.plans[prop.Name]; !ok || plan.searchable != reindexRebuild || !prop.HasSearchableIndex {
+			continue
+		}
+
+		var err error
+		if subtract {
+			err = s.GetPropertyLengthTracker().UnTrackProperty(reindexLengthsName(prop.Name), float32(len(prop.Items)))
+		} else {
+			err = s.GetPropertyLengthTracker().TrackProperty(reindexLengthsName(prop.Name), float32(len(prop.Items)))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Shard) reindexBucket(bucketName string) (*lsmkv.Bucket, error) {
+	tempName := helpers.TempBucketFromBucketName(bucketName)
+	bucket := s.store.Bucket(tempName)
+	if bucket == nil {
+		return nil, fmt.Errorf("no bucket %q found", tempName)
+	}
+	return bucket, nil
+}
+
+// extendReindexBucketsLSM mirrors [Shard.extendInvertedIndicesLSM] for the
+// rebuilt buckets of the job
+func (s *Shard) extendReindexBucketsLSM(job *propertyReindexJob, props []inverted.Property,
+	nilProps []inverted.NilProperty, docID uint64,
+) error {
+	for _, prop := range props {
+		plan, ok := job.plans[prop.Name]
+		if !ok {
+			continue
+		}
+
+		if plan.filterable == reindexRebuild && prop.HasFilterableIndex {
+			bucket, err := s.reindexBucket(helpers.BucketFromPropNameLSM(prop.Name))
+			if err != nil {
+				return err
+			}
+			for _, item := range prop.Items {
+				if err := s.addToPropertySetBucket(bucket, docID, item.Data); err != nil {
+					return errors.Wrapf(err, "failed adding to prop '%s' value bucket", prop.Name)
+				}
+			}
+		}
+
+		if plan.searchable == reindexRebuild && prop.HasSearchableIndex {
+			bucket, err := s.reindexBucket(helpers.BucketSearchableFromPropNameLSM(prop.Name))
+			if err != nil {
+				return err
+			}
+			propLen := float32(len(prop.Items))
+			for _, item := range prop.Items {
+				pair := s.pairPropertyWithFrequency(docID, item.TermFrequency, propLen)
+				if err := s.addToPropertyMapBucket(bucket, pair, item.Data); err != nil {
+					return errors.Wrapf(err, "failed adding to prop '%s' value bucket", prop.Name)
+				}
+			}
+		}
+
+		if plan.rangeable == reindexRebuild && prop.HasRangeableIndex {
+			bucket, err := s.reindexBucket(helpers.BucketRangeableFromPropNameLSM(prop.Name))
+			if err != nil {
+				return err
+			}
+			for _, item := range prop.Items {
+				if err := s.addToPropertyRangeBucket(bucket, docID, item.Data); err != nil {
+					return errors.Wrapf(err, "failed adding to prop '%s' value bucket", prop.Name)
+				}
+			}
+		}
+
+		if plan.nullAndLength == reindexRebuild {
+			if err := s.updateReindexNullAndLength(prop.Name, docID, prop.Length, prop.Length >= 0, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, nilProp := range nilProps {
+		plan, ok := job.plans[nilProp.Name]
+		if !ok || plan.nullAndLength != reindexRebuild {
+			continue
+		}
+		if err := s.updateReindexNullAndLength(nilProp.Name, docID, 0, nilProp.AddToPropertyLength, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteFromReindexBucketsLSM mirrors [Shard.deleteFromInvertedIndicesLSM]
+// for the rebuilt buckets of the job
+func (s *Shard) deleteFromReindexBucketsLSM(job *propertyReindexJob, props []inverted.Property,
+	nilProps []inverted.NilProperty, docID uint64,
+) error {
+	for _, prop := range props {
+		plan, ok := job.plans[prop.Name]
+		if !ok {
+			continue
+		}
+
+		if plan.filterable == reindexRebuild && prop.HasFilterableIndex {
+			bucket, err := s.reindexBucket(helpers.BucketFromPropNameLSM(prop.Name))
+			if err != nil {
+				return err
+			}
+			for _, item := range prop.Items {
+				if err := s.deleteFromPropertySetBucket(bucket, docID, item.Data); err != nil {
+					return errors.Wrapf(err, "delete item '%s' from index", string(item.Data))
+				}
+			}
+		}
+
+		if plan.searchable == reindexRebuild && prop.HasSearchableIndex {
+			bucket, err := s.reindexBucket(helpers.BucketSearchableFromPropNameLSM(prop.Name))
+			if err != nil {
+				return err
+			}
+			for _, item := range prop.Items {
+				if err := s.deleteInvertedIndexItemWithFrequencyLSM(bucket, item, docID); err != nil {
+					return errors.Wrapf(err, "delete item '%s' from index", string(item.Data))
+				}
+			}
+		}
+
+		if plan.rangeable == reindexRebuild && prop.HasRangeableIndex {
+			bucket, err := s.reindexBucket(helpers.BucketRangeableFromPropNameLSM(prop.Name))
+			if err != nil {
+				return err
+			}
+			for _, item := range prop.Items {
+				if err := s.deleteFromPropertyRangeBucket(bucket, docID, item.Data); err != nil {
+					return errors.Wrapf(err, "delete item '%s' from index", string(item.Data))
+				}
+			}
+		}
+
+		if plan.nullAndLength == reindexRebuild {
+			if err := s.updateReindexNullAndLength(prop.Name, docID, prop.Length, prop.Length >= 0, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, nilProp := range nilProps {
+		plan, ok := job.plans[nilProp.Name]
+		if !ok || plan.nullAndLength != reindexRebuild {
+			continue
+		}
+		if err := s.updateReindexNullAndLength(nilProp.Name, docID, 0, nilProp.AddToPropertyLength, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Shard) updateReindexNullAndLength(propName string, docID uint64,
+	length int, withLength, remove bool,
+) error {
+	update := func(bucketName string, key []byte) error {
+		bucket, err := s.reindexBucket(bucketName)
+		if err != nil {
+			return err
+		}
+		if remove {
+			return s.deleteFromPropertySetBucket(bucket, docID, key)
+		}
+		return s.addToPropertySetBucket(bucket, docID, key)
+	}
+
+	if s.index.invertedIndexConfig.IndexPropertyLength && withLength {
+		key, err := bucketKeyPropertyLength(length)
+		if err != nil {
+			return errors.Wrapf(err, "failed creating key for prop '%s' length", propName)
+		}
+		if err := update(helpers.BucketFromPropNameLengthLSM(propName), key); err != nil {
+			return errors.Wrapf(err, "failed updating prop '%s' length bucket", propName)
+		}
+	}
+
+	if s.index.invertedIndexConfig.IndexNullState {
+		key, err := bucketKeyPropertyNull(length == 0)
+		if err != nil {
+			return errors.Wrapf(err, "failed creating key for prop '%s' null", propName)
+		}
+		if err := update(helpers.BucketFromPropNameNullLSM(propName), key); err != nil {
+			return errors.Wrapf(err, "failed updating prop '%s' null bucket", propName)
+		}
+	}
+	return nil
+}
+
+// propertyReindexProgress returns the properties being reindexed and the
+// number of objects processed so far
+func (s *Shard) propertyReindexProgress() ([]string, int64) {
+	// the properties of the job change while its buckets are replaced
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
+	job := s.propertyReindex.Load()
+	if job == nil {
+		return nil, 0
+	}
+	return job.properties(), job.processed.Load()
+}
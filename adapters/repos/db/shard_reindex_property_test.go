@@ -0,0 +1,222 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_UpdatePropertyIndexes(t *testing.T) {
+	ctx := context.Background()
+	vTrue, vFalse := true, false
+	class := &models.Class{
+		Class: "ReindexPropertyClass",
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			IndexNullState:      true,
+			IndexPropertyLength: true,
+		},
+		Properties: []*models.Property{
+			{
+				Name:            "name",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexFilterable: &vTrue,
+				IndexSearchable: &vTrue,
+			},
+			{
+				Name:            "age",
+				DataType:        schema.DataTypeInt.PropString(),
+				IndexFilterable: &vTrue,
+			},
+		},
+	}
+	shardLike, idx := testShardWithSettings(t, ctx, class, hnsw.UserConfig{Skip: true}, false, false,
+		func(i *Index) { i.Config.DisableLazyLoadShards = true })
+	shd := shardLike.(*Shard)
+	idx.propertyIndexSettings.setProperties(true, class.Properties...)
+
+	names := []string{"Hello World", "hello there", "Goodbye World"}
+	for i, name := range names {
+		obj := testObject(class.Class)
+		obj.Object.Properties = map[string]interface{}{"name": name, "age": int64(i)}
+		require.Nil(t, shd.PutObject(ctx, obj))
+	}
+
+	docIDs := func(bucketName string, key string) []uint64 {
+		bucket := shd.Store().Bucket(bucketName)
+		require.NotNil(t, bucket, bucketName)
+		bm, err := bucket.RoaringSetGet([]byte(key))
+		require.Nil(t, err)
+		return bm.ToArray()
+	}
+	waitForReindex := func(t *testing.T) {
+		assert.Eventually(t, func() bool {
+			props, _ := shd.propertyReindexProgress()
+			return len(props) == 0
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	require.Len(t, docIDs(helpers.BucketFromPropNameLSM("name"), "hello"), 2)
+
+	t.Run("tokenization change is applied in the background", func(t *testing.T) {
+		class.Properties[0] = &models.Property{
+			Name:            "name",
+			DataType:        schema.DataTypeText.PropString(),
+			Tokenization:    models.PropertyTokenizationField,
+			IndexFilterable: &vTrue,
+			IndexSearchable: &vTrue,
+		}
+		require.Nil(t, idx.updatePropertyIndexes(ctx, class.Properties))
+		waitForReindex(t)
+
+		assert.Empty(t, docIDs(helpers.BucketFromPropNameLSM("name"), "hello"))
+		assert.Len(t, docIDs(helpers.BucketFromPropNameLSM("name"), "Hello World"), 1)
+		assert.Nil(t, shd.Store().Bucket(helpers.TempBucketFromBucketName(helpers.BucketFromPropNameLSM("name"))))
+
+		live := shd.readOnlyClass(class.Class)
+		assert.Equal(t, models.PropertyTokenizationField, live.Properties[0].Tokenization)
+
+		_, count, _, err := shd.GetPropertyLengthTracker().PropertyTally("name")
+		require.Nil(t, err)
+		assert.Equal(t, len(names), count)
+		_, count, _, err = shd.GetPropertyLengthTracker().PropertyTally(reindexLengthsName("name"))
+		require.Nil(t, err)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("objects written afterwards use the new settings", func(t *testing.T) {
+		obj := testObject(class.Class)
+		obj.Object.Properties = map[string]interface{}{"name": "Hello World", "age": int64(10)}
+		require.Nil(t, shd.PutObject(ctx, obj))
+
+		assert.Len(t, docIDs(helpers.BucketFromPropNameLSM("name"), "Hello World"), 2)
+		assert.Empty(t, docIDs(helpers.BucketFromPropNameLSM("name"), "hello"))
+	})
+
+	t.Run("disabled index is dropped", func(t *testing.T) {
+		class.Properties[0] = &models.Property{
+			Name:            "name",
+			DataType:        schema.DataTypeText.PropString(),
+			Tokenization:    models.PropertyTokenizationField,
+			IndexFilterable: &vTrue,
+			IndexSearchable: &vFalse,
+		}
+		require.Nil(t, idx.updatePropertyIndexes(ctx, class.Properties))
+		waitForReindex(t)
+
+		assert.Nil(t, shd.Store().Bucket(helpers.BucketSearchableFromPropNameLSM("name")))
+		assert.NotNil(t, shd.Store().Bucket(helpers.BucketFromPropNameLSM("name")))
+	})
+
+	t.Run("enabled index is built", func(t *testing.T) {
+		class.Properties[1] = &models.Property{
+			Name:              "age",
+			DataType:          schema.DataTypeInt.PropString(),
+			IndexFilterable:   &vTrue,
+			IndexRangeFilters: &vTrue,
+		}
+		require.Nil(t, idx.updatePropertyIndexes(ctx, class.Properties))
+		waitForReindex(t)
+
+		assert.NotNil(t, shd.Store().Bucket(helpers.BucketRangeableFromPropNameLSM("age")))
+	})
+}
+
+func TestPropertyReindexState_Merge(t *testing.T) {
+	word := propertyIndexSettings{Tokenization: models.PropertyTokenizationWord}
+	field := propertyIndexSettings{Tokenization: models.PropertyTokenizationField}
+	lower := propertyIndexSettings{Tokenization: models.PropertyTokenizationLowercase}
+
+	state := propertyReindexState{
+		Live:      map[string]propertyIndexSettings{"name": word},
+		Target:    map[string]propertyIndexSettings{"name": field},
+		LastKey:   []byte("key"),
+		Processed: 10,
+	}
+
+	t.Run("live settings of properties being reindexed are kept", func(t *testing.T) {
+		next := state.merge(
+			map[string]propertyIndexSettings{"name": field, "title": word},
+			map[string]propertyIndexSettings{"name": lower, "title": field},
+		)
+		assert.Equal(t, map[string]propertyIndexSettings{"name": word, "title": word}, next.Live)
+		assert.Equal(t, map[string]propertyIndexSettings{"name": lower, "title": field}, next.Target)
+		assert.Nil(t, next.LastKey)
+		assert.Zero(t, next.Processed)
+	})
+
+	t.Run("properties changed back are no longer reindexed", func(t *testing.T) {
+		next := state.merge(
+			map[string]propertyIndexSettings{"name": field},
+			map[string]propertyIndexSettings{"name": word},
+		)
+		assert.Empty(t, next.Target)
+	})
+}
+
+func TestShard_LockReindexReaders(t *testing.T) {
+	ctx := context.Background()
+	shd, _ := testShard(t, ctx, "LockReindexReadersClass",
+		func(i *Index) { i.Config.DisableLazyLoadShards = true })
+	s := shd.(*Shard)
+
+	t.Run("waiting job blocks new queries but not nested ones", func(t *testing.T) {
+		outer, unlock := s.rlockReindexReaders(ctx)
+
+		locked := make(chan error)
+		go func() { locked <- s.lockReindexReaders(ctx) }()
+		// give the job time to wait for the lock
+		time.Sleep(50 * time.Millisecond)
+
+		_, unlockNested := s.rlockReindexReaders(outer)
+		unlockNested()
+
+		queried := make(chan struct{})
+		go func() {
+			_, unlock := s.rlockReindexReaders(ctx)
+			unlock()
+			close(queried)
+		}()
+		select {
+		case <-queried:
+			t.Fatal("new query ran while the reindex job was waiting")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		unlock()
+		require.Nil(t, <-locked)
+		s.reindexReadLock.Unlock()
+		<-queried
+	})
+
+	t.Run("cancelled wait releases the lock", func(t *testing.T) {
+		_, unlock := s.rlockReindexReaders(ctx)
+		cancelCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, s.lockReindexReaders(cancelCtx), context.DeadlineExceeded)
+		unlock()
+
+		require.Nil(t, s.lockReindexReaders(ctx))
+		s.reindexReadLock.Unlock()
+	})
+}
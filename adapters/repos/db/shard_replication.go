@@ -16,9 +16,11 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -26,18 +28,58 @@ import (
 
 type replicaTask func(context.Context) interface{}
 
+// conditionalReservationTimeout bounds how long a prepared conditional
+// write blocks other conditional writes of the same object, in case the
+// coordinator never commits or aborts it
+const conditionalReservationTimeout = 30 * time.Second
+
 type pendingReplicaTasks struct {
 	sync.Mutex
 	Tasks map[string]replicaTask
+
+	// reserved maps objects with a prepared conditional write to the
+	// request which prepared it
+	reserved map[strfmt.UUID]reservation
+}
+
+type reservation struct {
+	requestID string
+	createdAt time.Time
 }
 
 func (p *pendingReplicaTasks) clear() {
 	p.Lock()
 	// TODO: can we postpone deletion until all pending replications are done
 	p.Tasks = nil
+	p.reserved = nil
 	p.Unlock()
 }
 
+// reserve marks id as written by the conditional request requestID. It
+// returns false if another request holds an unexpired reservation.
+func (p *pendingReplicaTasks) reserve(id strfmt.UUID, requestID string) bool {
+	p.Lock()
+	defer p.Unlock()
+	if r, ok := p.reserved[id]; ok && r.requestID != requestID &&
+		time.Since(r.createdAt) < conditionalReservationTimeout {
+		return false
+	}
+	if p.reserved == nil {
+		p.reserved = make(map[strfmt.UUID]reservation)
+	}
+	p.reserved[id] = reservation{requestID: requestID, createdAt: time.Now()}
+	return true
+}
+
+// release removes the reservation of id if it is held by requestID
+func (p *pendingReplicaTasks) release(id strfmt.UUID, requestID string) {
+	p.Lock()
+	defer p.Unlock()
+	if r, ok := p.reserved[id]; ok && r.requestID == requestID {
+		delete(p.reserved, id)
+	}
+}
+
 func (p *pendingReplicaTasks) get(requestID string) (replicaTask, bool) {
 	p.Lock()
 	defer p.Unlock()
@@ -54,6 +96,11 @@ func (p *pendingReplicaTasks) set(requestID string, task replicaTask) {
 func (p *pendingReplicaTasks) delete(requestID string) {
 	p.Lock()
 	delete(p.Tasks, requestID)
+	for id, r := range p.reserved {
+		if r.requestID == requestID {
+			delete(p.reserved, id)
+		}
+	}
 	p.Unlock()
 }
 
@@ -81,12 +128,17 @@ func (s *Shard) preparePutObject(ctx context.Context, requestID string, object *
 			Code: replica.StatusPreconditionFailed, Msg: err.Error(),
 		}}}
 	}
+	if rerr := s.prepareCondition(requestID, object.ID(), uuid, object.IfMatch); rerr != nil {
+		return replica.SimpleResponse{Errors: []replica.Error{*rerr}}
+	}
 	task := func(ctx context.Context) interface{} {
 		resp := replica.SimpleResponse{}
 		if err := s.putOne(ctx, uuid, object); err != nil {
 			resp.Errors = []replica.Error{
-				{Code: replica.StatusConflict, Msg: err.Error()},
+				{Code: writeErrorCode(err), Msg: err.Error()},
 			}
+		} else {
+			s.index.watermarks.mark(s.name, object.LastUpdateTimeUnix())
 		}
 		return resp
 	}
@@ -101,6 +153,9 @@ func (s *Shard) prepareMergeObject(ctx context.Context, requestID string, doc *o
 			{Code: replica.StatusPreconditionFailed, Msg: err.Error()},
 		}}
 	}
+	if rerr := s.prepareCondition(requestID, doc.ID, uuid, doc.IfMatch); rerr != nil {
+		return replica.SimpleResponse{Errors: []replica.Error{*rerr}}
+	}
 	task := func(ctx context.Context) interface{} {
 		resp := replica.SimpleResponse{}
 		if err := s.merge(ctx, uuid, *doc); err != nil {
@@ -108,11 +163,13 @@ func (s *Shard) prepareMergeObject(ctx context.Context, requestID string, doc *o
 			if errors.Is(err, errObjectNotFound) {
 				code = replica.StatusObjectNotFound
 			} else {
-				code = replica.StatusConflict
+				code = writeErrorCode(err)
 			}
 			resp.Errors = []replica.Error{
 				{Code: code, Msg: err.Error()},
 			}
+		} else {
+			s.index.watermarks.mark(s.name, doc.UpdateTime)
 		}
 		return resp
 	}
@@ -120,7 +177,7 @@ func (s *Shard) prepareMergeObject(ctx context.Context, requestID string, doc *o
 	return replica.SimpleResponse{}
 }
 
-func (s *Shard) prepareDeleteObject(ctx context.Context, requestID string, uuid strfmt.UUID) replica.SimpleResponse {
+func (s *Shard) prepareDeleteObject(ctx context.Context, requestID string, uuid strfmt.UUID, ifMatch *int64) replica.SimpleResponse {
 	bucket, obj, idBytes, docID, updateTime, err := s.canDeleteOne(ctx, uuid)
 	if err != nil {
 		return replica.SimpleResponse{
@@ -129,12 +186,27 @@ func (s *Shard) prepareDeleteObject(ctx context.Context, requestID string, uuid
 			},
 		}
 	}
+	if rerr := s.prepareCondition(requestID, uuid, idBytes, ifMatch); rerr != nil {
+		return replica.SimpleResponse{Errors: []replica.Error{*rerr}}
+	}
 	task := func(ctx context.Context) interface{} {
 		resp := replica.SimpleResponse{}
-		if err := s.deleteOne(ctx, bucket, obj, idBytes, docID, updateTime); err != nil {
+		deletedUpdateTime := updateTime
+		if ifMatch != nil {
+			// the object might have changed since it was prepared, the
+			// precondition is checked again atomically with the deletion
+			err = s.DeleteObject(ctx, uuid, ifMatch)
+			deletedUpdateTime = *ifMatch
+		} else {
+			err = s.deleteOne(ctx, bucket, obj, idBytes, docID, updateTime)
+		}
+		if err != nil {
 			resp.Errors = []replica.Error{
-				{Code: replica.StatusConflict, Msg: err.Error()},
+				{Code: writeErrorCode(err), Msg: err.Error()},
 			}
+		} else {
+			resp.DeletedUpdateTime = deletedUpdateTime
+			s.markDeletion(deletedUpdateTime)
 		}
 		return resp
 	}
@@ -148,7 +220,9 @@ func (s *Shard) preparePutObjects(ctx context.Context, requestID string, objects
 		resp := replica.SimpleResponse{Errors: make([]replica.Error, len(rawErrs))}
 		for i, err := range rawErrs {
 			if err != nil {
-				resp.Errors[i] = replica.Error{Code: replica.StatusConflict, Msg: err.Error()}
+				resp.Errors[i] = replica.Error{Code: writeErrorCode(err), Msg: err.Error()}
+			} else {
+				s.index.watermarks.mark(s.name, objects[i].LastUpdateTimeUnix())
 			}
 		}
 		return resp
@@ -159,9 +233,11 @@ func (s *Shard) preparePutObjects(ctx context.Context, requestID string, objects
 
 func (s *Shard) prepareDeleteObjects(ctx context.Context, requestID string, uuids []strfmt.UUID, dryRun bool) replica.SimpleResponse {
 	task := func(ctx context.Context) interface{} {
-		result := newDeleteObjectsBatcher(s).Delete(ctx, uuids, dryRun)
+		batcher := newDeleteObjectsBatcher(s)
+		result := batcher.Delete(ctx, uuids, dryRun)
 		resp := replica.DeleteBatchResponse{
-			Batch: make([]replica.UUID2Error, len(result)),
+			Batch:             make([]replica.UUID2Error, len(result)),
+			DeletedUpdateTime: batcher.deletedUpdateTime,
 		}
 
 		for i, r := range result {
@@ -171,6 +247,9 @@ func (s *Shard) prepareDeleteObjects(ctx context.Context, requestID string, uuid
 			}
 			resp.Batch[i] = entry
 		}
+		if !dryRun {
+			s.markDeletion(batcher.deletedUpdateTime)
+		}
 		return resp
 	}
 	s.replicationMap.set(requestID, task)
@@ -184,6 +263,8 @@ func (s *Shard) prepareAddReferences(ctx context.Context, requestID string, refs
 		for i, err := range rawErrs {
 			if err != nil {
 				resp.Errors[i] = replica.Error{Code: replica.StatusConflict, Msg: err.Error()}
+			} else {
+				s.index.watermarks.mark(s.name, refs[i].UpdateTime)
 			}
 		}
 		return resp
@@ -192,6 +273,55 @@ func (s *Shard) prepareAddReferences(ctx context.Context, requestID string, refs
 	return replica.SimpleResponse{}
 }
 
+// markDeletion advances the applied watermark past a deleted version with
+// deletedUpdateTime, which is what consistency tokens record for deletions
+func (s *Shard) markDeletion(deletedUpdateTime int64) {
+	if deletedUpdateTime > 0 {
+		s.index.watermarks.mark(s.name, deletedUpdateTime+1)
+	}
+}
+
+// prepareCondition checks the precondition of a conditional write against
+// the local copy of the object and reserves the object for requestID, so
+// that two concurrent conditional writes cannot both be prepared. The
+// precondition is checked again when the write is applied.
+func (s *Shard) prepareCondition(requestID string, id strfmt.UUID, idBytes []byte,
+	ifMatch *int64,
+) *replica.Error {
+	if ifMatch == nil {
+		return nil
+	}
+	if !s.replicationMap.reserve(id, requestID) {
+		return &replica.Error{
+			Code: replica.StatusConditionFailed,
+			Msg:  fmt.Sprintf("concurrent conditional write of object %s", id),
+		}
+	}
+	existing, err := s.store.Bucket(helpers.ObjectsBucketLSM).Get(idBytes)
+	if err == nil {
+		var updateTime int64
+		if existing != nil {
+			_, updateTime, err = storobj.DocIDAndTimeFromBinary(existing)
+		}
+		if err == nil {
+			err = checkIfMatch(ifMatch, existing != nil, updateTime)
+		}
+	}
+	if err != nil {
+		s.replicationMap.release(id, requestID)
+		return &replica.Error{Code: writeErrorCode(err), Msg: err.Error()}
+	}
+	return nil
+}
+
+// writeErrorCode maps an error of an applied write to a replica status code
+func writeErrorCode(err error) replica.StatusCode {
+	if errors.As(err, &objects.ErrPreconditionFailed{}) {
+		return replica.StatusConditionFailed
+	}
+	return replica.StatusConflict
+}
+
 func parseBytesUUID(id strfmt.UUID) ([]byte, error) {
 	uuid, err := uuid.Parse(string(id))
 	if err != nil {
@@ -48,6 +48,12 @@ func (s *Shard) Shutdown(ctx context.Context) (err error) {
 		return
 	}
 
+	// unfinished reindex jobs are resumed when the shard is loaded again
+	s.reindexJobLock.Lock()
+	s.stopPropertyReindex()
+	s.stopVectorIndexRebuilds()
+	s.reindexJobLock.Unlock()
+
 	ec := errorcompounder.New()
 
 	err = s.GetPropertyLengthTracker().Close()
@@ -117,6 +123,9 @@ func (s *Shard) Shutdown(ctx context.Context) (err error) {
 		}
 	}
 
+	err = s.shutdownVectorIndexStores(ctx)
+	ec.AddWrap(err, "stop vector index lsmkv stores")
+
 	if s.store != nil {
 		// store would be nil if loading the objects bucket failed, as we would
 		// only return the store on success from s.initLSMStore()
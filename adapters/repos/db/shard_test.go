@@ -25,6 +25,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -37,6 +38,7 @@ import (
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/replica"
 )
 
 func TestShard_UpdateStatus(t *testing.T) {
@@ -229,6 +231,62 @@ func TestShard_InvalidVectorBatches(t *testing.T) {
 	require.Nil(t, idx.drop())
 }
 
+func TestShard_ReplicatedDeleteUpdateTime(t *testing.T) {
+	ctx := testCtx()
+	shd, idx := testShard(t, ctx, "TestClass")
+	defer func() {
+		require.Nil(t, idx.drop())
+		require.Nil(t, os.RemoveAll(idx.Config.RootPath))
+	}()
+
+	objs := createRandomObjects(getRandomSeed(), "TestClass", 3, 4)
+	for i, updateTime := range []int64{10, 30, 20} {
+		objs[i].Object.LastUpdateTimeUnix = updateTime
+	}
+	for _, err := range shd.PutObjectBatch(ctx, objs) {
+		require.Nil(t, err)
+	}
+
+	t.Run("batch", func(t *testing.T) {
+		ids := []strfmt.UUID{objs[0].ID(), objs[1].ID(), strfmt.UUID(uuid.NewString())}
+		require.Empty(t, shd.prepareDeleteObjects(ctx, "batch", ids, false).Errors)
+		resp := shd.commitReplication(ctx, "batch", &shardTransfer{}).(replica.DeleteBatchResponse)
+		require.Nil(t, resp.FirstError())
+		assert.Equal(t, int64(30), resp.DeletedUpdateTime)
+	})
+
+	t.Run("single", func(t *testing.T) {
+		require.Empty(t, shd.prepareDeleteObject(ctx, "single", objs[2].ID(), nil).Errors)
+		resp := shd.commitReplication(ctx, "single", &shardTransfer{}).(replica.SimpleResponse)
+		require.Nil(t, resp.FirstError())
+		assert.Equal(t, int64(20), resp.DeletedUpdateTime)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		require.Empty(t, shd.prepareDeleteObject(ctx, "missing", objs[2].ID(), nil).Errors)
+		resp := shd.commitReplication(ctx, "missing", &shardTransfer{}).(replica.SimpleResponse)
+		require.Nil(t, resp.FirstError())
+		assert.Zero(t, resp.DeletedUpdateTime)
+	})
+}
+
+func TestIndex_CommitReplicationKeepsLastSync(t *testing.T) {
+	ctx := testCtx()
+	shd, idx := testShard(t, ctx, "TestClass")
+	defer func() {
+		require.Nil(t, idx.drop())
+		require.Nil(t, os.RemoveAll(idx.Config.RootPath))
+	}()
+
+	obj := testObject("TestClass")
+	require.Empty(t, idx.ReplicateObject(ctx, shd.Name(), "put", obj).Errors)
+	resp := idx.CommitReplication(shd.Name(), "put").(replica.SimpleResponse)
+	require.Nil(t, resp.FirstError())
+
+	// an acknowledged write doesn't mean the replica hasn't missed earlier ones
+	assert.True(t, idx.LastSync(shd.Name()).IsZero())
+}
+
 func TestShard_DebugResetVectorIndex(t *testing.T) {
 	t.Setenv("ASYNC_INDEXING", "true")
 	t.Setenv("ASYNC_STALE_TIMEOUT", "200ms")
@@ -42,6 +42,8 @@ type deleteObjectsBatcher struct {
 	sync.Mutex
 	shard   ShardLike
 	objects objects.BatchSimpleObjects
+	// latest update time of the deleted objects
+	deletedUpdateTime int64
 }
 
 func newDeleteObjectsBatcher(shard ShardLike) *deleteObjectsBatcher {
@@ -99,7 +101,12 @@ func (b *deleteObjectsBatcher) deleteObjectOfBatchInLSM(ctx context.Context, uui
 	before := time.Now()
 	defer b.shard.Metrics().BatchDelete(before, "shard_delete_individual_total")
 	if !dryRun {
-		err := b.shard.batchDeleteObject(ctx, uuid)
+		updateTime, err := b.shard.batchDeleteObject(ctx, uuid)
+		if err == nil {
+			b.Lock()
+			b.deletedUpdateTime = max(b.deletedUpdateTime, updateTime)
+			b.Unlock()
+		}
 		return objects.BatchSimpleObject{UUID: uuid, Err: err}
 	}
 
@@ -146,7 +153,7 @@ func (b *deleteObjectsBatcher) setErrorAtIndex(err error, index int) {
 }
 
 func (s *Shard) findDocIDs(ctx context.Context, filters *filters.LocalFilter) ([]uint64, error) {
-	allowList, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
+	allowList, err := inverted.NewSearcher(s.index.logger, s.store, s.readOnlyClass,
 		nil, s.index.classSearcher, s.index.stopwords, s.versioner.version, s.isFallbackToSearchable,
 		s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
 		DocIDs(ctx, filters, additional.Properties{}, s.index.Config.ClassName)
@@ -157,6 +164,10 @@ func (s *Shard) findDocIDs(ctx context.Context, filters *filters.LocalFilter) ([
 }
 
 func (s *Shard) FindUUIDs(ctx context.Context, filters *filters.LocalFilter) ([]strfmt.UUID, error) {
+	// see comment on reindexReadLock in shard.go
+	ctx, unlock := s.rlockReindexReaders(ctx)
+	defer unlock()
+
 	docs, err := s.findDocIDs(ctx, filters)
 	if err != nil {
 		return nil, err
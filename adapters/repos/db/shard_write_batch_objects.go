@@ -205,7 +205,7 @@ func (ob *objectsBatcher) storeObjectOfBatchInLSM(ctx context.Context,
 		return err
 	}
 
-	status, err := ob.shard.putObjectLSM(object, idBytes)
+	status, err := ob.shard.putObjectUniqueKeyLSM(ctx, object, idBytes)
 	if err != nil {
 		return err
 	}
@@ -24,20 +24,58 @@ import (
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
-func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID) error {
+func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID, ifMatch *int64) error {
 	if err := s.isReadOnly(); err != nil {
 		return err
 	}
 
+	// see comment on reindexLock in shard_write_put.go::putObjectLSM
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
 	if err != nil {
 		return err
 	}
 
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-	existing, err := bucket.Get([]byte(idBytes))
-	if err != nil {
-		return fmt.Errorf("unexpected error on previous lookup: %w", err)
+
+	// see comment in shard_write_put.go::putObjectLSM
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+
+	var existing []byte
+	var docID uint64
+	var updateTime int64
+	if err := func() error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		existing, err = bucket.Get([]byte(idBytes))
+		if err != nil {
+			return fmt.Errorf("unexpected error on previous lookup: %w", err)
+		}
+
+		if existing == nil {
+			return checkIfMatch(ifMatch, false, 0)
+		}
+
+		// we need the doc ID so we can clean up inverted indices currently
+		// pointing to this object
+		docID, updateTime, err = storobj.DocIDAndTimeFromBinary(existing)
+		if err != nil {
+			return fmt.Errorf("get existing doc id from object binary: %w", err)
+		}
+
+		if err := checkIfMatch(ifMatch, true, updateTime); err != nil {
+			return err
+		}
+
+		if err := bucket.Delete(idBytes); err != nil {
+			return fmt.Errorf("delete object from bucket: %w", err)
+		}
+		return nil
+	}(); err != nil {
+		return err
 	}
 
 	if existing == nil {
@@ -45,18 +83,6 @@ func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID) error {
 		return nil
 	}
 
-	// we need the doc ID so we can clean up inverted indices currently
-	// pointing to this object
-	docID, updateTime, err := storobj.DocIDAndTimeFromBinary(existing)
-	if err != nil {
-		return fmt.Errorf("get existing doc id from object binary: %w", err)
-	}
-
-	err = bucket.Delete(idBytes)
-	if err != nil {
-		return fmt.Errorf("delete object from bucket: %w", err)
-	}
-
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
@@ -121,6 +147,10 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	if obj == nil || bucket == nil {
 		return nil
 	}
+
+	// see comment on reindexLock in shard_write_put.go::putObjectLSM
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
 	err := bucket.Delete(idBytes)
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
@@ -182,6 +212,10 @@ func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) erro
 		return fmt.Errorf("put inverted indices props: %w", err)
 	}
 
+	if err = s.cleanupReindexBucketsOnDelete(previousObject, docID); err != nil {
+		return fmt.Errorf("delete from rebuilt inverted indices: %w", err)
+	}
+
 	if s.index.Config.TrackVectorDimensions {
 		if s.hasTargetVectors() {
 			for vecName, vec := range previousObject.Vectors {
@@ -16,6 +16,7 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -30,11 +31,17 @@ func isPropertyForLength(dt schema.DataType) bool {
 }
 
 func (s *Shard) AnalyzeObject(object *storobj.Object) ([]inverted.Property, []inverted.NilProperty, error) {
-	c := s.index.getSchema.ReadOnlyClass(object.Class().String())
+	c := s.readOnlyClass(object.Class().String())
 	if c == nil {
 		return nil, nil, fmt.Errorf("could not find class %s in schema", object.Class().String())
 	}
 
+	return s.analyzeObject(c, object)
+}
+
+// analyzeObject analyzes the properties of the object according to the given
+// class, which allows analyzing them with settings different from the schema
+func (s *Shard) analyzeObject(c *models.Class, object *storobj.Object) ([]inverted.Property, []inverted.NilProperty, error) {
 	var schemaMap map[string]interface{}
 
 	if object.Properties() == nil {
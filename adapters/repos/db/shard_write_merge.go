@@ -62,7 +62,12 @@ func (s *Shard) MergeObject(ctx context.Context, merge objects.MergeDocument) er
 }
 
 func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocument) error {
+	unlock, err := s.lockUniqueKeyOfMerge(ctx, idBytes, doc)
+	if err != nil {
+		return err
+	}
 	obj, status, err := s.mergeObjectInStorage(doc, idBytes)
+	unlock()
 	if err != nil {
 		return err
 	}
@@ -73,16 +78,8 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 		return nil
 	}
 
-	if s.hasTargetVectors() {
-		for targetVector, vector := range obj.Vectors {
-			if err := s.updateVectorIndexForName(ctx, vector, status, targetVector); err != nil {
-				return errors.Wrapf(err, "update vector index for target vector %s", targetVector)
-			}
-		}
-	} else {
-		if err := s.updateVectorIndex(ctx, obj.Vector, status); err != nil {
-			return errors.Wrap(err, "update vector index")
-		}
+	if err := s.updateVectorIndexesOfObject(ctx, obj, status); err != nil {
+		return err
 	}
 
 	if err := s.updatePropertySpecificIndices(ctx, obj, status); err != nil {
@@ -108,6 +105,10 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 	var prevObj, obj *storobj.Object
 	var status objectInsertStatus
 
+	// see comment on reindexLock in shard_write_put.go::putObjectLSM
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
 	// see comment in shard_write_put.go::putObjectLSM
 	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
 
@@ -123,9 +124,16 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 		}
 
 		if prevObj == nil {
+			if err := checkIfMatch(merge.IfMatch, false, 0); err != nil {
+				return err
+			}
 			return errObjectNotFound
 		}
 
+		if err := checkIfMatch(merge.IfMatch, true, prevObj.LastUpdateTimeUnix()); err != nil {
+			return err
+		}
+
 		obj, _, err = s.mergeObjectData(prevObj, merge)
 		if err != nil {
 			return errors.Wrap(err, "merge object data")
@@ -146,7 +154,7 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 			return errors.Wrapf(err, "marshal object %s to binary", obj.ID())
 		}
 
-		if err := s.upsertObjectDataLSM(bucket, idBytes, objBytes, status.docID); err != nil {
+		if err := upsertObjectDataLSM(bucket, idBytes, objBytes, status.docID); err != nil {
 			return errors.Wrap(err, "upsert object data")
 		}
 
@@ -225,7 +233,7 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 		return out, errors.Wrapf(err, "marshal object %s to binary", obj.ID())
 	}
 
-	if err := s.upsertObjectDataLSM(bucket, idBytes, objBytes, status.docID); err != nil {
+	if err := upsertObjectDataLSM(bucket, idBytes, objBytes, status.docID); err != nil {
 		return out, errors.Wrap(err, "upsert object data")
 	}
 
@@ -253,7 +261,10 @@ func (s *Shard) mergeObjectData(prevObj *storobj.Object,
 		prevObj.SetID(merge.ID)
 	}
 
-	return mergeProps(prevObj, merge), prevObj, nil
+	// values of deleted properties are not carried over to the new version
+	next := mergeProps(s.index.withoutDeletedProps(prevObj), merge)
+	s.index.stripDeletedProps(next)
+	return next, prevObj, nil
 }
 
 func mergeProps(previous *storobj.Object,
@@ -0,0 +1,39 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// checkIfMatch enforces the optional precondition of a conditional write.
+// It must be called while holding the docIdLock of the object, so that the
+// check and the subsequent write are atomic. A nil ifMatch always passes,
+// 0 requires the object to not exist, any other value has to match the
+// last update time of the stored object.
+func checkIfMatch(ifMatch *int64, exists bool, updateTime int64) error {
+	switch {
+	case ifMatch == nil:
+		return nil
+	case !exists && *ifMatch != 0:
+		return objects.NewErrPreconditionFailed(
+			"precondition failed: expected version %d, but object does not exist", *ifMatch)
+	case exists && *ifMatch == 0:
+		return objects.NewErrPreconditionFailed(
+			"precondition failed: expected object to not exist, but found version %d", updateTime)
+	case exists && *ifMatch != updateTime:
+		return objects.NewErrPreconditionFailed(
+			"precondition failed: expected version %d, but found version %d", *ifMatch, updateTime)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestShard_ConditionalWrites(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+	defer func() {
+		require.Nil(t, idx.drop())
+		require.Nil(t, os.RemoveAll(idx.Config.RootPath))
+	}()
+
+	ptr := func(v int64) *int64 { return &v }
+	requirePreconditionFailed := func(t *testing.T, err error) {
+		require.NotNil(t, err)
+		assert.True(t, errors.As(err, &objects.ErrPreconditionFailed{}), err.Error())
+	}
+
+	obj := testObject(className)
+	obj.Object.CreationTimeUnix = 1000
+	obj.Object.LastUpdateTimeUnix = 1000
+
+	t.Run("create with version other than 0 fails", func(t *testing.T) {
+		obj.IfMatch = ptr(1000)
+		requirePreconditionFailed(t, shd.PutObject(ctx, obj))
+	})
+
+	t.Run("create if absent succeeds", func(t *testing.T) {
+		obj.IfMatch = ptr(0)
+		require.Nil(t, shd.PutObject(ctx, obj))
+	})
+
+	t.Run("create if absent fails once the object exists", func(t *testing.T) {
+		obj.IfMatch = ptr(0)
+		requirePreconditionFailed(t, shd.PutObject(ctx, obj))
+	})
+
+	t.Run("update with stale version fails", func(t *testing.T) {
+		obj.IfMatch = ptr(999)
+		obj.Object.LastUpdateTimeUnix = 2000
+		requirePreconditionFailed(t, shd.PutObject(ctx, obj))
+	})
+
+	t.Run("update with current version succeeds", func(t *testing.T) {
+		obj.IfMatch = ptr(1000)
+		obj.Object.LastUpdateTimeUnix = 2000
+		require.Nil(t, shd.PutObject(ctx, obj))
+	})
+
+	t.Run("merge with stale version fails", func(t *testing.T) {
+		err := shd.MergeObject(ctx, objects.MergeDocument{
+			Class:      className,
+			ID:         obj.ID(),
+			UpdateTime: 3000,
+			IfMatch:    ptr(1000),
+		})
+		requirePreconditionFailed(t, err)
+	})
+
+	t.Run("merge with current version succeeds", func(t *testing.T) {
+		err := shd.MergeObject(ctx, objects.MergeDocument{
+			Class:      className,
+			ID:         obj.ID(),
+			UpdateTime: 3000,
+			Vector:     []float32{4, 5, 6},
+			IfMatch:    ptr(2000),
+		})
+		require.Nil(t, err)
+
+		found, err := shd.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, int64(3000), found.LastUpdateTimeUnix())
+	})
+
+	t.Run("delete with stale version fails", func(t *testing.T) {
+		requirePreconditionFailed(t, shd.DeleteObject(ctx, obj.ID(), ptr(2000)))
+
+		found, err := shd.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, found)
+	})
+
+	t.Run("delete with current version succeeds", func(t *testing.T) {
+		require.Nil(t, shd.DeleteObject(ctx, obj.ID(), ptr(3000)))
+
+		found, err := shd.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		require.Nil(t, found)
+	})
+
+	t.Run("delete of a missing object with a version fails", func(t *testing.T) {
+		requirePreconditionFailed(t, shd.DeleteObject(ctx, obj.ID(), ptr(3000)))
+	})
+}
@@ -43,7 +43,7 @@ func (s *Shard) PutObject(ctx context.Context, object *storobj.Object) error {
 }
 
 func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object) error {
-	status, err := s.putObjectLSM(object, uuid)
+	status, err := s.putObjectUniqueKeyLSM(ctx, object, uuid)
 	if err != nil {
 		return errors.Wrap(err, "store object in LSM store")
 	}
@@ -54,16 +54,8 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 		return nil
 	}
 
-	if s.hasTargetVectors() {
-		for targetVector, vector := range object.Vectors {
-			if err := s.updateVectorIndexForName(ctx, vector, status, targetVector); err != nil {
-				return errors.Wrapf(err, "update vector index for target vector %s", targetVector)
-			}
-		}
-	} else {
-		if err := s.updateVectorIndex(ctx, object.Vector, status); err != nil {
-			return errors.Wrap(err, "update vector index")
-		}
+	if err := s.updateVectorIndexesOfObject(ctx, object, status); err != nil {
+		return err
 	}
 
 	if err := s.updatePropertySpecificIndices(ctx, object, status); err != nil {
@@ -85,6 +77,29 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 	return nil
 }
 
+// updateVectorIndexesOfObject updates the vector indexes after the object
+// was written to the objects bucket
+func (s *Shard) updateVectorIndexesOfObject(ctx context.Context, object *storobj.Object,
+	status objectInsertStatus,
+) error {
+	// see comment on reindexLock in shard.go
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
+	if s.hasTargetVectors() {
+		for targetVector, vector := range object.Vectors {
+			if err := s.updateVectorIndexForName(ctx, vector, status, targetVector); err != nil {
+				return errors.Wrapf(err, "update vector index for target vector %s", targetVector)
+			}
+		}
+	} else {
+		if err := s.updateVectorIndex(ctx, object.Vector, status); err != nil {
+			return errors.Wrap(err, "update vector index")
+		}
+	}
+	return nil
+}
+
 // as the name implies this method only performs the insertions, but completely
 // ignores any deletes. It thus assumes that the caller has already taken care
 // of all the deletes in another way
@@ -104,6 +119,10 @@ func (s *Shard) updateVectorIndexIgnoreDelete(ctx context.Context, vector []floa
 		return nil
 	}
 
+	// see comment on reindexLock in shard.go
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
 	if err := s.vectorIndex.Add(ctx, status.docID, vector); err != nil {
 		return errors.Wrapf(err, "insert doc id %d to vector index", status.docID)
 	}
@@ -130,6 +149,10 @@ func (s *Shard) updateVectorIndexesIgnoreDelete(ctx context.Context,
 		return nil
 	}
 
+	// see comment on reindexLock in shard.go
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
 	for targetVector, vector := range vectors {
 		if vectorIndex := s.VectorIndexForName(targetVector); vectorIndex != nil {
 			if err := vectorIndex.Add(ctx, status.docID, vector); err != nil {
@@ -219,6 +242,12 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 	before := time.Now()
 	defer s.metrics.PutObject(before)
 
+	// a reindex job must not process the object while it is written, as the
+	// change would be applied to the rebuilt inverted index buckets either
+	// twice or not at all
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
 	if s.hasTargetVectors() {
 		if len(obj.Vectors) > 0 {
 			for targetVector, vector := range obj.Vectors {
@@ -260,6 +289,15 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 			return err
 		}
 
+		if prevObj != nil {
+			err = checkIfMatch(obj.IfMatch, true, prevObj.LastUpdateTimeUnix())
+		} else {
+			err = checkIfMatch(obj.IfMatch, false, 0)
+		}
+		if err != nil {
+			return err
+		}
+
 		status, err = s.determineInsertStatus(prevObj, obj)
 		if err != nil {
 			return err
@@ -277,7 +315,7 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 		}
 
 		before = time.Now()
-		if err := s.upsertObjectDataLSM(bucket, idBytes, objBinary, status.docID); err != nil {
+		if err := upsertObjectDataLSM(bucket, idBytes, objBinary, status.docID); err != nil {
 			return errors.Wrap(err, "upsert object data")
 		}
 		s.metrics.PutObjectUpsertObject(before)
@@ -428,7 +466,7 @@ func (s *Shard) determineMutableInsertStatus(previous, next *storobj.Object) (ob
 	return out, nil
 }
 
-func (s *Shard) upsertObjectDataLSM(bucket *lsmkv.Bucket, id []byte, data []byte,
+func upsertObjectDataLSM(bucket *lsmkv.Bucket, id []byte, data []byte,
 	docID uint64,
 ) error {
 	keyBuf := bytes.NewBuffer(nil)
@@ -542,6 +580,10 @@ func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 		}
 	}
 
+	if err := s.updateReindexBucketsLSM(object, status, prevObject); err != nil {
+		return fmt.Errorf("update rebuilt inverted indices: %w", err)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// putObjectUniqueKeyLSM is putObjectLSM for objects which may belong to a
+// class with a unique key. It rejects the object if another object of the
+// shard already has the same key.
+func (s *Shard) putObjectUniqueKeyLSM(ctx context.Context, object *storobj.Object,
+	idBytes []byte,
+) (objectInsertStatus, error) {
+	unlock, err := s.lockUniqueKey(ctx, object.ID(), object.Properties())
+	if err != nil {
+		return objectInsertStatus{}, err
+	}
+	defer unlock()
+
+	return s.putObjectLSM(object, idBytes)
+}
+
+// lockUniqueKeyOfMerge locks the unique key the object would have after the
+// merge, if the merge changes any of the properties of the key
+func (s *Shard) lockUniqueKeyOfMerge(ctx context.Context, idBytes []byte,
+	merge objects.MergeDocument,
+) (func(), error) {
+	class := s.index.getSchema.ReadOnlyClass(s.index.Config.ClassName.String())
+	if !schema.HasUniqueKey(class) {
+		return func() {}, nil
+	}
+
+	changesKey := false
+	for _, name := range class.UniqueKey {
+		if _, ok := merge.PrimitiveSchema[name]; ok {
+			changesKey = true
+			break
+		}
+	}
+	if !changesKey {
+		return func() {}, nil
+	}
+
+	prev, err := fetchObject(s.store.Bucket(helpers.ObjectsBucketLSM), idBytes)
+	if err != nil || prev == nil {
+		// a missing object is reported by the merge itself
+		return func() {}, err
+	}
+
+	props := map[string]interface{}{}
+	if prevProps, ok := prev.Properties().(map[string]interface{}); ok {
+		for name, value := range prevProps {
+			props[name] = value
+		}
+	}
+	for name, value := range merge.PrimitiveSchema {
+		props[name] = value
+	}
+	return s.lockUniqueKey(ctx, merge.ID, props)
+}
+
+// lockUniqueKey locks the unique key of an object with the given properties
+// and checks that no other object of the shard has the same key. The key
+// stays locked until the returned func is called, so that no other object
+// with the same key can be written in the meantime. Nothing is locked if
+// the class has no unique key.
+func (s *Shard) lockUniqueKey(ctx context.Context, id strfmt.UUID,
+	properties interface{},
+) (func(), error) {
+	class := s.index.getSchema.ReadOnlyClass(s.index.Config.ClassName.String())
+	if !schema.HasUniqueKey(class) {
+		return func() {}, nil
+	}
+
+	values, err := schema.UniqueKeyValues(class, properties)
+	if err != nil {
+		return nil, err
+	}
+	key := schema.UniqueKeyString(values)
+
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	lock := &s.uniqueKeyLock[h.Sum32()%IdLockPoolSize]
+	lock.Lock()
+
+	owner, err := s.uniqueKeyOwner(ctx, class, values, key)
+	if err != nil {
+		lock.Unlock()
+		return nil, errors.Wrap(err, "check unique key")
+	}
+	if owner != "" && owner != id {
+		lock.Unlock()
+		return nil, fmt.Errorf("unique key %s is already used by object %s", key, owner)
+	}
+	return lock.Unlock, nil
+}
+
+// uniqueKeyOwner returns the id of an object of the shard with the given
+// key, if any
+func (s *Shard) uniqueKeyOwner(ctx context.Context, class *models.Class,
+	values []interface{}, key string,
+) (strfmt.UUID, error) {
+	clause := uniqueKeyClause(class, values)
+	allowList, err := s.buildAllowList(ctx, &filters.LocalFilter{Root: &clause}, additional.Properties{})
+	if err != nil {
+		return "", err
+	}
+	if allowList.IsEmpty() {
+		return "", nil
+	}
+
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	candidates, err := storobj.ObjectsByDocID(bucket, allowList.Slice(), additional.Properties{}, nil, s.index.logger)
+	if err != nil {
+		return "", err
+	}
+	owners := matchUniqueKeys(class, candidates, map[string]struct{}{key: {}})
+	return owners[key], nil
+}
@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// TenantUsage returns the resource usage of a tenant. The stats are taken
+// from the local shard if this node holds a replica of the tenant, otherwise
+// the first reachable replica is asked.
+func (db *DB) TenantUsage(ctx context.Context, className, tenant string) (*models.TenantUsage, error) {
+	replicas, err := db.schemaGetter.ShardReplicas(className, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("tenant %q: %w", tenant, err)
+	}
+	if len(replicas) == 0 {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("tenant %q not found", tenant))
+	}
+
+	if slices.Contains(replicas, db.schemaGetter.NodeName()) {
+		return db.localTenantUsage(ctx, className, tenant)
+	}
+
+	var lastErr error
+	for _, node := range replicas {
+		usage, err := db.remoteNode.GetTenantUsage(ctx, node, className, tenant)
+		if err == nil {
+			return usage, nil
+		}
+		lastErr = fmt.Errorf("node %q: %w", node, err)
+	}
+	return nil, lastErr
+}
+
+// IncomingGetTenantUsage returns the usage of a tenant held by this node
+func (db *DB) IncomingGetTenantUsage(ctx context.Context, className, tenant string) (*models.TenantUsage, error) {
+	return db.localTenantUsage(ctx, className, tenant)
+}
+
+func (db *DB) localTenantUsage(ctx context.Context, className, tenant string) (*models.TenantUsage, error) {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+
+	usage := &models.TenantUsage{Name: tenant}
+	shard, release, err := idx.GetShard(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// shards of inactive tenants are not initialized, they only take up space
+	// on disk. Neither those nor lazily loaded shards are loaded just to
+	// collect their usage, their object count is read from the segment
+	// metadata instead. Vectors are only counted by loaded vector indexes.
+	if lazy, ok := shard.(*LazyLoadShard); shard == nil || (ok && !lazy.isLoaded()) {
+		count, err := lsmkv.CountNetAdditionsFromDisk(
+			path.Join(shardPath(idx.path(), tenant), "lsm", helpers.ObjectsBucketLSM))
+		if err != nil {
+			return nil, fmt.Errorf("object count of shard %q: %w", tenant, err)
+		}
+		usage.ObjectCount = int64(count)
+	} else {
+		usage.ObjectCount = int64(shard.ObjectCountAsync())
+		for _, vectorIndex := range shard.VectorIndexes() {
+			usage.VectorCount += int64(vectorIndex.AlreadyIndexed())
+		}
+	}
+
+	size, err := dirSize(shardPath(idx.path(), tenant))
+	if err != nil {
+		return nil, fmt.Errorf("disk usage of shard %q: %w", tenant, err)
+	}
+	usage.DiskUsageBytes = size
+
+	return usage, nil
+}
+
+// dirSize sums up the size of all regular files below dir. A missing
+// directory has a size of 0.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// files may be removed concurrently, e.g. by compactions
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
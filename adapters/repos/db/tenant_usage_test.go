@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirSize(t *testing.T) {
+	t.Run("missing directory", func(t *testing.T) {
+		size, err := dirSize(filepath.Join(t.TempDir(), "missing"))
+		require.Nil(t, err)
+		assert.Equal(t, int64(0), size)
+	})
+
+	t.Run("nested files", func(t *testing.T) {
+		dir := t.TempDir()
+		require.Nil(t, os.MkdirAll(filepath.Join(dir, "lsm", "objects"), 0o755))
+		require.Nil(t, os.WriteFile(filepath.Join(dir, "main.hnsw.commitlog"), make([]byte, 100), 0o644))
+		require.Nil(t, os.WriteFile(filepath.Join(dir, "lsm", "objects", "segment.db"), make([]byte, 250), 0o644))
+
+		size, err := dirSize(dir)
+		require.Nil(t, err)
+		assert.Equal(t, int64(350), size)
+	})
+}
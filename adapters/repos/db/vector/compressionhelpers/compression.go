@@ -34,6 +34,15 @@ type CompressorDistancer interface {
 	DistanceToFloat(vec []float32) (float32, error)
 }
 
+// ErrorBoundedDistancer is implemented by the distancers of compressors whose
+// distance estimates may come with a bound on their error
+type ErrorBoundedDistancer interface {
+	// LowerBoundToNode returns a lower bound of the exact distance to the
+	// node. It fails if the compressor does not bound the error of its
+	// estimates.
+	LowerBoundToNode(id uint64) (float32, error)
+}
+
 type ReturnDistancerFn func()
 
 type CommitLogger interface {
@@ -345,6 +354,28 @@ func NewBQCompressor(
 	return bqVectorsCompressor, nil
 }
 
+func NewRQCompressor(
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	quantizer := NewRotationalQuantizer(distance)
+	rqVectorsCompressor := &quantizedVectorsCompressor[byte]{
+		quantizer:       quantizer,
+		compressedStore: store,
+		storeId:         binary.BigEndian.PutUint64,
+		loadId:          binary.BigEndian.Uint64,
+		logger:          logger,
+	}
+	rqVectorsCompressor.initCompressedStore()
+	rqVectorsCompressor.cache = cache.NewShardedByteLockCache(
+		rqVectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, logger,
+		0, allocChecker)
+	return rqVectorsCompressor, nil
+}
+
 func NewHNSWSQCompressor(
 	distance distancer.Provider,
 	vectorCacheMaxObjects int,
@@ -413,6 +444,24 @@ func (distancer *quantizedCompressorDistancer[T]) DistanceToNode(id uint64) (flo
 	return distancer.distancer.Distance(compressedVector)
 }
 
+func (distancer *quantizedCompressorDistancer[T]) LowerBoundToNode(id uint64) (float32, error) {
+	bounded, ok := distancer.distancer.(interface {
+		LowerBound(x []T) (float32, error)
+	})
+	if !ok {
+		return 0, errors.New("distance estimates of the compressor are not error bounded")
+	}
+	compressedVector, err := distancer.compressor.cache.Get(context.Background(), id)
+	if err != nil {
+		return 0, err
+	}
+	if len(compressedVector) == 0 {
+		return 0, fmt.Errorf(
+			"got a nil or zero-length vector at docID %d", id)
+	}
+	return bounded.LowerBound(compressedVector)
+}
+
 func (distancer *quantizedCompressorDistancer[T]) DistanceToFloat(vector []float32) (float32, error) {
 	return distancer.distancer.DistanceToFloat(vector)
 }
@@ -0,0 +1,374 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+const (
+	// rqSeed determines the random rotation. The rotation is never persisted
+	// but re-created from the seed, so changing it would invalidate all
+	// existing RQ-compressed vectors.
+	rqSeed = 0x5eed
+	// rqRounds is the number of sign-flip + Hadamard rounds making up the
+	// rotation. Three rounds are enough to spread the energy of a vector evenly
+	// across all dimensions.
+	rqRounds = 3
+	rqCodes  = 255.0
+	// each code is followed by lower (float32), step (float32), the sum of all
+	// codes (uint32) and the squared norm of the original vector (float32)
+	rqMetadataSize = 16
+)
+
+// RotationalQuantizer is a training-free quantizer. Vectors are first
+// rotated using a seeded, pseudo-random orthogonal transformation (random sign
+// flips followed by a normalized Walsh-Hadamard transform) and then encoded
+// using 8 bits per dimension with a per-vector range. The rotation spreads
+// outliers across all dimensions, so that the per-vector range is tight and
+// the absolute error of each coordinate is bounded by half a quantization
+// step.
+type RotationalQuantizer struct {
+	distancer        distancer.Provider
+	dimensions       int
+	outputDimensions int
+	signs            [rqRounds][]float32
+	initOnce         sync.Once
+	initialized      atomic.Bool
+}
+
+func NewRotationalQuantizer(distance distancer.Provider) *RotationalQuantizer {
+	return &RotationalQuantizer{
+		distancer: distance,
+	}
+}
+
+// init creates the rotation for the dimensionality of the first vector which
+// is encoded. As the rotation is fully determined by the seed and the
+// dimensions, it does not need any data and can be set up lazily. Vectors of
+// any other dimensionality can't be encoded with the same rotation.
+func (rq *RotationalQuantizer) init(dimensions int) error {
+	rq.initOnce.Do(func() {
+		rq.dimensions = dimensions
+		rq.outputDimensions = rqOutputDimensions(dimensions)
+		rq.signs = rqSigns(rq.outputDimensions)
+		rq.initialized.Store(true)
+	})
+	return rq.checkDimensions(dimensions)
+}
+
+func (rq *RotationalQuantizer) checkDimensions(dimensions int) error {
+	if dimensions != rq.dimensions {
+		return errors.Errorf("vector has %d dimensions, but RQ was initialized with %d dimensions",
+			dimensions, rq.dimensions)
+	}
+	return nil
+}
+
+func rqOutputDimensions(dimensions int) int {
+	outputDimensions := 1
+	for outputDimensions < dimensions {
+		outputDimensions <<= 1
+	}
+	return outputDimensions
+}
+
+func rqSigns(outputDimensions int) [rqRounds][]float32 {
+	var signs [rqRounds][]float32
+	rng := rand.New(rand.NewSource(rqSeed))
+	for r := range signs {
+		signs[r] = make([]float32, outputDimensions)
+		for i := range signs[r] {
+			if rng.Intn(2) == 0 {
+				signs[r][i] = -1
+			} else {
+				signs[r][i] = 1
+			}
+		}
+	}
+	return signs
+}
+
+func rotate(vec []float32, signs [rqRounds][]float32) []float32 {
+	outputDimensions := len(signs[0])
+	out := make([]float32, outputDimensions)
+	copy(out, vec)
+	scale := float32(1 / math.Sqrt(float64(outputDimensions)))
+	for r := range signs {
+		for i := range out {
+			out[i] *= signs[r][i]
+		}
+		fastWalshHadamard(out)
+		for i := range out {
+			out[i] *= scale
+		}
+	}
+	return out
+}
+
+// rotateQuery rotates a query vector. Queries don't fix the dimensionality
+// of the quantizer: before the first vector is encoded, e.g. right after a
+// restart, the rotation is created for the query alone.
+func (rq *RotationalQuantizer) rotateQuery(vec []float32) ([]float32, error) {
+	if !rq.initialized.Load() {
+		return rotate(vec, rqSigns(rqOutputDimensions(len(vec)))), nil
+	}
+	if err := rq.checkDimensions(len(vec)); err != nil {
+		return nil, err
+	}
+	return rotate(vec, rq.signs), nil
+}
+
+// fastWalshHadamard applies the unnormalized Walsh-Hadamard transform in
+// place. The length of x must be a power of two.
+func fastWalshHadamard(x []float32) {
+	for h := 1; h < len(x); h <<= 1 {
+		for i := 0; i < len(x); i += h << 1 {
+			for j := i; j < i+h; j++ {
+				a, b := x[j], x[j+h]
+				x[j] = a + b
+				x[j+h] = a - b
+			}
+		}
+	}
+}
+
+// Encode returns the code of vec, or nil if vec does not have the dimensions
+// of the previously encoded vectors. Callers are expected to reject such
+// vectors before, see EncodeWithError.
+func (rq *RotationalQuantizer) Encode(vec []float32) []byte {
+	code, _ := rq.EncodeWithError(vec)
+	return code
+}
+
+// EncodeWithError is like Encode, but returns an error if vec does not have
+// the dimensions of the previously encoded vectors.
+func (rq *RotationalQuantizer) EncodeWithError(vec []float32) ([]byte, error) {
+	if err := rq.init(len(vec)); err != nil {
+		return nil, err
+	}
+	rotated := rotate(vec, rq.signs)
+	lower, upper := rotated[0], rotated[0]
+	var norm2 float32
+	for _, x := range rotated {
+		if x < lower {
+			lower = x
+		}
+		if x > upper {
+			upper = x
+		}
+		norm2 += x * x
+	}
+	step := (upper - lower) / rqCodes
+
+	var sum uint32
+	code := make([]byte, len(rotated)+rqMetadataSize)
+	for i, x := range rotated {
+		if step > 0 {
+			code[i] = byte(math.Min(rqCodes, math.Round(float64((x-lower)/step))))
+		}
+		sum += uint32(code[i])
+	}
+	meta := code[len(rotated):]
+	binary.LittleEndian.PutUint32(meta, math.Float32bits(lower))
+	binary.LittleEndian.PutUint32(meta[4:], math.Float32bits(step))
+	binary.LittleEndian.PutUint32(meta[8:], sum)
+	binary.LittleEndian.PutUint32(meta[12:], math.Float32bits(norm2))
+	return code, nil
+}
+
+type rqCode []byte
+
+func (c rqCode) codes() []byte {
+	return c[:len(c)-rqMetadataSize]
+}
+
+func (c rqCode) lower() float32 {
+	return math.Float32frombits(binary.LittleEndian.Uint32(c[len(c)-16:]))
+}
+
+func (c rqCode) step() float32 {
+	return math.Float32frombits(binary.LittleEndian.Uint32(c[len(c)-12:]))
+}
+
+func (c rqCode) sum() float32 {
+	return float32(binary.LittleEndian.Uint32(c[len(c)-8:]))
+}
+
+func (c rqCode) norm2() float32 {
+	return math.Float32frombits(binary.LittleEndian.Uint32(c[len(c)-4:]))
+}
+
+func (rq *RotationalQuantizer) distanceFromDot(dot, norm2x, norm2y float32) (float32, error) {
+	switch rq.distancer.Type() {
+	case "l2-squared":
+		return norm2x + norm2y - 2*dot, nil
+	case "dot":
+		return -dot, nil
+	case "cosine-dot":
+		return 1 - dot, nil
+	}
+	return 0, errors.Errorf("Distance not supported yet %s", rq.distancer)
+}
+
+func (rq *RotationalQuantizer) DistanceBetweenCompressedVectors(x, y []byte) (float32, error) {
+	if len(x) != len(y) {
+		return 0, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(x), len(y))
+	}
+	if len(x) < rqMetadataSize {
+		return 0, errors.Errorf("invalid RQ code of length %d", len(x))
+	}
+	cx, cy := rqCode(x), rqCode(y)
+	dims := float32(len(x) - rqMetadataSize)
+	lx, ly := cx.lower(), cy.lower()
+	sx, sy := cx.step(), cy.step()
+	dot := dims*lx*ly + lx*sy*cy.sum() + ly*sx*cx.sum() +
+		sx*sy*float32(dotByteImpl(cx.codes(), cy.codes()))
+	return rq.distanceFromDot(dot, cx.norm2(), cy.norm2())
+}
+
+// RQDistancer estimates distances between an uncompressed query and
+// compressed vectors. Only the stored vectors are quantized, the rotated query
+// is kept at full precision.
+type RQDistancer struct {
+	x          []float32
+	rq         *RotationalQuantizer
+	rotated    []float32
+	sum        float32
+	absSum     float32
+	norm2      float32
+	compressed []byte
+	err        error
+}
+
+func (rq *RotationalQuantizer) NewDistancer(a []float32) *RQDistancer {
+	d := &RQDistancer{
+		x:  a,
+		rq: rq,
+	}
+	d.rotated, d.err = rq.rotateQuery(a)
+	for _, x := range d.rotated {
+		d.sum += x
+		d.absSum += float32(math.Abs(float64(x)))
+		d.norm2 += x * x
+	}
+	return d
+}
+
+func (d *RQDistancer) Distance(x []byte) (float32, error) {
+	if d.err != nil {
+		return 0, d.err
+	}
+	if d.compressed != nil {
+		return d.rq.DistanceBetweenCompressedVectors(d.compressed, x)
+	}
+	if len(x) != len(d.rotated)+rqMetadataSize {
+		return 0, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(x)-rqMetadataSize, len(d.rotated))
+	}
+	code := rqCode(x)
+	var dotCodes float32
+	for i, c := range code.codes() {
+		dotCodes += d.rotated[i] * float32(c)
+	}
+	dot := code.lower()*d.sum + code.step()*dotCodes
+	return d.rq.distanceFromDot(dot, d.norm2, code.norm2())
+}
+
+// ErrorBound returns the maximum absolute error of Distance(x) compared to
+// the exact distance between the query and the original vector. Each rotated
+// coordinate is off by at most half a quantization step, so the error of the
+// inner product is bounded by step/2 * sum(|q_i|).
+func (d *RQDistancer) ErrorBound(x []byte) float32 {
+	bound := rqCode(x).step() / 2 * d.absSum
+	if d.rq.distancer.Type() == "l2-squared" {
+		return 2 * bound
+	}
+	return bound
+}
+
+// LowerBound returns a lower bound of the exact distance between the query
+// and the original vector of x. Candidates can be ruled out during rescoring
+// once their lower bound exceeds the exact distance of the k-th match.
+func (d *RQDistancer) LowerBound(x []byte) (float32, error) {
+	if d.compressed != nil {
+		return 0, errors.New("error bound requires an uncompressed query")
+	}
+	dist, err := d.Distance(x)
+	if err != nil {
+		return 0, err
+	}
+	return dist - d.ErrorBound(x), nil
+}
+
+func (d *RQDistancer) DistanceToFloat(x []float32) (float32, error) {
+	if len(d.x) > 0 {
+		return d.rq.distancer.SingleDist(d.x, x)
+	}
+	xComp, err := d.rq.EncodeWithError(x)
+	if err != nil {
+		return 0, err
+	}
+	return d.rq.DistanceBetweenCompressedVectors(d.compressed, xComp)
+}
+
+func (rq *RotationalQuantizer) NewQuantizerDistancer(a []float32) quantizerDistancer[byte] {
+	return rq.NewDistancer(a)
+}
+
+func (rq *RotationalQuantizer) NewCompressedQuantizerDistancer(a []byte) quantizerDistancer[byte] {
+	return &RQDistancer{
+		x:          nil,
+		rq:         rq,
+		compressed: a,
+	}
+}
+
+func (rq *RotationalQuantizer) ReturnQuantizerDistancer(distancer quantizerDistancer[byte]) {}
+
+func (rq *RotationalQuantizer) CompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (rq *RotationalQuantizer) FromCompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (rq *RotationalQuantizer) FromCompressedBytesWithSubsliceBuffer(compressed []byte, buffer *[]byte) []byte {
+	if len(*buffer) < len(compressed) {
+		*buffer = make([]byte, len(compressed)*1000)
+	}
+
+	// take from end so we can address the start of the buffer
+	out := (*buffer)[len(*buffer)-len(compressed):]
+	copy(out, compressed)
+	*buffer = (*buffer)[:len(*buffer)-len(compressed)]
+
+	return out
+}
+
+// PersistCompression is a no-op. Unlike PQ and SQ there is no trained state
+// which would need to be restored from the commit log on startup: just like
+// for BQ, the compressor is created from the (persisted) index config. The
+// rotation is re-created from the constant seed and the dimensions of the
+// first vector encoded, and the range and norm each code was encoded with are
+// stored with the code itself.
+func (rq *RotationalQuantizer) PersistCompression(logger CommitLogger) {
+}
@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	testinghelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+)
+
+func Test_RQEncodeIsDeterministic(t *testing.T) {
+	vec := []float32{0.5, 1, 0, 2, -1}
+	rq1 := compressionhelpers.NewRotationalQuantizer(distancer.NewL2SquaredProvider())
+	rq2 := compressionhelpers.NewRotationalQuantizer(distancer.NewL2SquaredProvider())
+
+	code := rq1.Encode(vec)
+	// 5 dimensions are padded to 8 after the rotation, plus 16 bytes of
+	// metadata
+	assert.Len(t, code, 8+16)
+	assert.Equal(t, code, rq2.Encode(vec))
+}
+
+func Test_RQDistanceWithinErrorBound(t *testing.T) {
+	distancers := []distancer.Provider{
+		distancer.NewL2SquaredProvider(),
+		distancer.NewCosineDistanceProvider(),
+		distancer.NewDotProductProvider(),
+	}
+	vectors, queries := testinghelpers.RandomVecs(100, 10, 100)
+	testinghelpers.Normalize(vectors)
+	testinghelpers.Normalize(queries)
+
+	for _, provider := range distancers {
+		t.Run(provider.Type(), func(t *testing.T) {
+			rq := compressionhelpers.NewRotationalQuantizer(provider)
+			codes := make([][]byte, len(vectors))
+			for i := range vectors {
+				codes[i] = rq.Encode(vectors[i])
+			}
+
+			for _, query := range queries {
+				d := rq.NewDistancer(query)
+				queryCode := rq.Encode(query)
+				for i := range vectors {
+					expected, err := provider.SingleDist(query, vectors[i])
+					require.Nil(t, err)
+
+					dist, err := d.Distance(codes[i])
+					require.Nil(t, err)
+					assert.LessOrEqual(t, math.Abs(float64(expected-dist)), float64(d.ErrorBound(codes[i]))+1e-5)
+					assert.InDelta(t, expected, dist, 0.01)
+
+					lowerBound, err := d.LowerBound(codes[i])
+					require.Nil(t, err)
+					assert.LessOrEqual(t, lowerBound, expected+1e-5)
+
+					dist, err = rq.DistanceBetweenCompressedVectors(queryCode, codes[i])
+					require.Nil(t, err)
+					assert.InDelta(t, expected, dist, 0.02)
+				}
+			}
+		})
+	}
+}
+
+func Test_RQDistanceUnsupported(t *testing.T) {
+	rq := compressionhelpers.NewRotationalQuantizer(distancer.NewManhattanProvider())
+	x := rq.Encode([]float32{1, 2, 3, 4})
+	y := rq.Encode([]float32{4, 3, 2, 1})
+	_, err := rq.DistanceBetweenCompressedVectors(x, y)
+	assert.NotNil(t, err)
+}
+
+func Test_RQDistanceMismatchedLengths(t *testing.T) {
+	rq := compressionhelpers.NewRotationalQuantizer(distancer.NewL2SquaredProvider())
+	x := rq.Encode([]float32{1, 2, 3, 4})
+	_, err := rq.DistanceBetweenCompressedVectors(x, x[:len(x)-1])
+	assert.NotNil(t, err)
+}
+
+func Test_RQMismatchedDimensions(t *testing.T) {
+	rq := compressionhelpers.NewRotationalQuantizer(distancer.NewL2SquaredProvider())
+	x, err := rq.EncodeWithError([]float32{1, 2, 3, 4})
+	require.Nil(t, err)
+
+	// the rotation for 4 dimensions would silently truncate or pad vectors of
+	// other dimensions, even those with the same padded size
+	for _, vec := range [][]float32{{1, 2, 3}, {1, 2, 3, 4, 5}} {
+		_, err = rq.EncodeWithError(vec)
+		assert.NotNil(t, err)
+		assert.Nil(t, rq.Encode(vec))
+
+		_, err = rq.NewDistancer(vec).Distance(x)
+		assert.NotNil(t, err)
+	}
+}
+
+func Test_RQQueryBeforeEncode(t *testing.T) {
+	rq := compressionhelpers.NewRotationalQuantizer(distancer.NewL2SquaredProvider())
+	x := compressionhelpers.NewRotationalQuantizer(distancer.NewL2SquaredProvider()).
+		Encode([]float32{1, 2, 3, 4})
+
+	// a query does not fix the dimensions, e.g. right after a restart
+	_, err := rq.NewDistancer([]float32{1, 2, 3}).Distance(x)
+	require.Nil(t, err)
+	_, err = rq.EncodeWithError([]float32{1, 2, 3, 4})
+	require.Nil(t, err)
+
+	distancer := rq.NewDistancer([]float32{1, 2, 3, 4})
+	d, err := distancer.Distance(x)
+	require.Nil(t, err)
+	assert.InDelta(t, 0, d, float64(distancer.ErrorBound(x)))
+}
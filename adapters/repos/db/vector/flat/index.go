@@ -47,6 +47,7 @@ const (
 	compressionBQ   = "bq"
 	compressionPQ   = "pq"
 	compressionSQ   = "sq"
+	compressionRQ   = "rq"
 	compressionNone = "none"
 )
 
@@ -62,6 +63,7 @@ type flat struct {
 	trackDimensionsOnce sync.Once
 	rescore             int64
 	bq                  compressionhelpers.BinaryQuantizer
+	rq                  *compressionhelpers.RotationalQuantizer
 
 	pqResults *common.PqMaxPool
 	pool      *pools
@@ -103,6 +105,10 @@ func New(cfg Config, uc flatent.UserConfig, store *lsmkv.Store) (*flat, error) {
 		return nil, fmt.Errorf("init flat index buckets: %w", err)
 	}
 
+	if index.isRQ() {
+		index.rq = compressionhelpers.NewRotationalQuantizer(index.distancerProvider)
+	}
+
 	if uc.BQ.Enabled && uc.BQ.Cache {
 		index.bqCache = cache.NewShardedUInt64LockCache(
 			index.getBQVector, uc.VectorCacheMaxObjects, cfg.Logger, 0, cfg.AllocChecker)
@@ -142,6 +148,10 @@ func extractCompression(uc flatent.UserConfig) string {
 		return compressionSQ
 	}
 
+	if uc.RQ.Enabled {
+		return compressionRQ
+	}
+
 	return compressionNone
 }
 
@@ -154,6 +164,8 @@ func extractCompressionRescore(uc flatent.UserConfig) int64 {
 		return int64(uc.BQ.RescoreLimit)
 	case compressionSQ:
 		return int64(uc.SQ.RescoreLimit)
+	case compressionRQ:
+		return int64(uc.RQ.RescoreLimit)
 	default:
 		return 0
 	}
@@ -177,6 +189,10 @@ func (index *flat) isBQ() bool {
 	return index.compression == compressionBQ
 }
 
+func (index *flat) isRQ() bool {
+	return index.compression == compressionRQ
+}
+
 func (index *flat) isBQCached() bool {
 	return index.bqCache != nil
 }
@@ -222,7 +238,7 @@ func (index *flat) initBuckets(ctx context.Context) error {
 	); err != nil {
 		return fmt.Errorf("Create or load flat vectors bucket: %w", err)
 	}
-	if index.isBQ() {
+	if index.isBQ() || index.isRQ() {
 		if err := index.store.CreateOrLoadBucket(ctx, index.getCompressedBucketName(),
 			lsmkv.WithForceCompation(forceCompaction),
 			lsmkv.WithUseBloomFilter(false),
@@ -319,6 +335,13 @@ func (index *flat) Add(ctx context.Context, id uint64, vector []float32) error {
 		return errors.Errorf("insert called with a vector of the wrong size")
 	}
 	vector = index.normalized(vector)
+	var vectorRQ []byte
+	if index.isRQ() {
+		var err error
+		if vectorRQ, err = index.rq.EncodeWithError(vector); err != nil {
+			return err
+		}
+	}
 	slice := make([]byte, len(vector)*4)
 	index.storeVector(id, byteSliceFromFloat32Slice(vector, slice))
 
@@ -331,6 +354,10 @@ func (index *flat) Add(ctx context.Context, id uint64, vector []float32) error {
 		slice = make([]byte, len(vectorBQ)*8)
 		index.storeCompressedVector(id, byteSliceFromUint64Slice(vectorBQ, slice))
 	}
+
+	if index.isRQ() {
+		index.storeCompressedVector(id, vectorRQ)
+	}
 	newCount := atomic.LoadUint64(&index.count)
 	atomic.StoreUint64(&index.count, newCount+1)
 	return nil
@@ -348,7 +375,7 @@ func (index *flat) Delete(ids ...uint64) error {
 			return err
 		}
 
-		if index.isBQ() {
+		if index.isBQ() || index.isRQ() {
 			if err := index.store.Bucket(index.getCompressedBucketName()).Delete(idBytes); err != nil {
 				return err
 			}
@@ -371,6 +398,8 @@ func (index *flat) SearchByVector(ctx context.Context, vector []float32, k int,
 	switch index.compression {
 	case compressionBQ:
 		return index.searchByVectorBQ(ctx, vector, k, allow)
+	case compressionRQ:
+		return index.searchByVectorRQ(ctx, vector, k, allow)
 	case compressionPQ:
 		// use uncompressed for now
 		fallthrough
@@ -429,6 +458,98 @@ func (index *flat) searchByVectorBQ(ctx context.Context, vector []float32, k int
 		}
 	}
 
+	if err := index.rescoreHeap(heap, k, vector); err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists := index.extractHeap(heap)
+	return ids, dists, nil
+}
+
+func (index *flat) searchByVectorRQ(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	// TODO: pass context into inner methods, so it can be checked more granuarly
+	rescore := index.searchTimeRescore(k)
+	heap := index.pqResults.GetMax(rescore)
+	defer index.pqResults.Put(heap)
+
+	vector = index.normalized(vector)
+	distancer := index.rq.NewDistancer(vector)
+
+	// candidates are selected by the lower bounds of their distances, so that
+	// rescoring can skip those which can't be among the top k
+	if err := index.findTopVectors(heap, allow, rescore,
+		index.store.Bucket(index.getCompressedBucketName()).Cursor,
+		distancer.LowerBound,
+	); err != nil {
+		return nil, nil, err
+	}
+
+	if err := index.rescoreHeapWithinErrorBound(heap, k, vector); err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists := index.extractHeap(heap)
+	return ids, dists, nil
+}
+
+// rescoreHeapWithinErrorBound is rescoreHeap for candidates whose distances
+// in the heap are lower bounds of their exact distances. Candidates are
+// rescored in the order of their lower bounds, until the lower bound of the
+// next candidate exceeds the exact distance of the k-th match.
+func (index *flat) rescoreHeapWithinErrorBound(heap *priorityqueue.Queue[any], k int, vector []float32) error {
+	distanceCalc := index.createDistanceCalc(vector)
+	candidates := make([]priorityqueue.Item[any], heap.Len())
+	for i := len(candidates) - 1; i >= 0; i-- {
+		candidates[i] = heap.Pop()
+	}
+
+	// candidates are rescored concurrently in rounds, the bound is checked
+	// before each round
+	distances := make([]float32, index.concurrentCacheReads)
+	found := make([]bool, index.concurrentCacheReads)
+	for start := 0; start < len(candidates); start += index.concurrentCacheReads {
+		if heap.Len() >= k && candidates[start].Dist > heap.Top().Dist {
+			break
+		}
+
+		round := candidates[start:min(start+index.concurrentCacheReads, len(candidates))]
+		eg := enterrors.NewErrorGroupWrapper(index.logger)
+		for j := range round {
+			j := j
+			eg.Go(func() error {
+				found[j] = false
+				candidateAsBytes, err := index.vectorById(round[j].ID)
+				if err != nil {
+					return err
+				}
+				if len(candidateAsBytes) == 0 {
+					return nil
+				}
+				distances[j], err = distanceCalc(candidateAsBytes)
+				if err != nil {
+					return err
+				}
+				found[j] = true
+				return nil
+			})
+		}
+		if err := eg.Wait(); err != nil {
+			return err
+		}
+
+		for j := range round {
+			if found[j] {
+				index.insertToHeap(heap, k, round[j].ID, distances[j])
+			}
+		}
+	}
+
+	return nil
+}
+
+// rescoreHeap replaces the candidates in the heap, which were selected using
+// compressed vectors, with the top k based on the uncompressed vectors
+func (index *flat) rescoreHeap(heap *priorityqueue.Queue[any], k int, vector []float32) error {
 	distanceCalc := index.createDistanceCalc(vector)
 	idsSlice := index.pool.uint64SlicePool.Get(heap.Len())
 	defer index.pool.uint64SlicePool.Put(idsSlice)
@@ -466,15 +587,14 @@ func (index *flat) searchByVectorBQ(ctx context.Context, vector []float32, k int
 	}
 
 	if err := eg.Wait(); err != nil {
-		return nil, nil, err
+		return err
 	}
 
 	for i, id := range idsSlice.slice {
 		index.insertToHeap(heap, k, id, distancesUncompressedVectors[i])
 	}
 
-	ids, dists := index.extractHeap(heap)
-	return ids, dists, nil
+	return nil
 }
 
 func (index *flat) createDistanceCalcBQ(vectorBQ []uint64) distanceCalc {
@@ -935,6 +1055,10 @@ func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) e
 			name:     "bq",
 			accessor: func(c flatent.UserConfig) interface{} { return c.BQ.Enabled },
 		},
+		{
+			name:     "rq",
+			accessor: func(c flatent.UserConfig) interface{} { return c.RQ.Enabled },
+		},
 		// as of v1.25.2, updating the BQ cache setting is now possible.
 		// Note that the change does not take effect until the tenant is
 		// reloaded, either from a complete restart or from
@@ -72,6 +72,9 @@ func run(ctx context.Context, dirName string, logger *logrus.Logger, compression
 	bq := flatent.CompressionUserConfig{
 		Enabled: false,
 	}
+	rq := flatent.CompressionUserConfig{
+		Enabled: false,
+	}
 	switch compression {
 	case compressionPQ:
 		pq.Enabled = true
@@ -81,6 +84,9 @@ func run(ctx context.Context, dirName string, logger *logrus.Logger, compression
 		bq.Enabled = true
 		bq.RescoreLimit = 100 * k
 		bq.Cache = vectorCache
+	case compressionRQ:
+		rq.Enabled = true
+		rq.RescoreLimit = 100 * k
 	}
 	index, err := New(Config{
 		ID:               runId,
@@ -89,6 +95,7 @@ func run(ctx context.Context, dirName string, logger *logrus.Logger, compression
 	}, flatent.UserConfig{
 		PQ: pq,
 		BQ: bq,
+		RQ: rq,
 	}, store)
 	if err != nil {
 		return 0, 0, err
@@ -185,17 +192,20 @@ func Test_NoRaceFlatIndex(t *testing.T) {
 	}
 
 	extraVectorsForDelete, _ := testinghelpers.RandomVecs(5_000, 0, dimensions)
-	for _, compression := range []string{compressionNone, compressionBQ} {
+	for _, compression := range []string{compressionNone, compressionBQ, compressionRQ} {
 		t.Run("compression: "+compression, func(t *testing.T) {
 			for _, cache := range []bool{false, true} {
 				t.Run("cache: "+strconv.FormatBool(cache), func(t *testing.T) {
-					if compression == compressionNone && cache == true {
+					if compression != compressionBQ && cache == true {
 						return
 					}
 					targetRecall := float32(0.99)
 					if compression == compressionBQ {
 						targetRecall = 0.8
 					}
+					if compression == compressionRQ {
+						targetRecall = 0.95
+					}
 					t.Run("recall", func(t *testing.T) {
 						recall, latency, err := run(ctx, dirName, logger, compression, cache, vectors, queries, k, truths, nil, nil, distancer, 0)
 						require.Nil(t, err)
@@ -217,7 +227,7 @@ func Test_NoRaceFlatIndex(t *testing.T) {
 			}
 		})
 	}
-	for _, compression := range []string{compressionNone, compressionBQ} {
+	for _, compression := range []string{compressionNone, compressionBQ, compressionRQ} {
 		t.Run("compression: "+compression, func(t *testing.T) {
 			for _, cache := range []bool{false, true} {
 				t.Run("cache: "+strconv.FormatBool(cache), func(t *testing.T) {
@@ -235,6 +245,9 @@ func Test_NoRaceFlatIndex(t *testing.T) {
 					if compression == compressionBQ {
 						targetRecall = 0.8
 					}
+					if compression == compressionRQ {
+						targetRecall = 0.95
+					}
 
 					t.Run("recall on filtered", func(t *testing.T) {
 						recall, latency, err := run(ctx, dirName, logger, compression, cache, vectors, queries, k, truths, nil, allowIds, distancer, 0)
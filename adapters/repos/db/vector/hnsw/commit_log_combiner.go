@@ -14,6 +14,7 @@ package hnsw
 import (
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -47,7 +48,12 @@ func (c *CommitLogCombiner) Do() (bool, error) {
 			return executed, errors.Wrap(err, "obtain files names")
 		}
 
-		ok, err := c.combineFirstMatch(fileNames)
+		_, snapshotTs, err := getLatestSnapshot(c.rootPath, c.id)
+		if err != nil {
+			return executed, errors.Wrap(err, "obtain latest snapshot")
+		}
+
+		ok, err := c.combineFirstMatch(fileNames, snapshotTs)
 		if err != nil {
 			return executed, err
 		}
@@ -62,7 +68,9 @@ func (c *CommitLogCombiner) Do() (bool, error) {
 	return executed, nil
 }
 
-func (c *CommitLogCombiner) combineFirstMatch(fileNames []string) (bool, error) {
+func (c *CommitLogCombiner) combineFirstMatch(fileNames []string,
+	snapshotTs int64,
+) (bool, error) {
 	for i, fileName := range fileNames {
 		if !strings.HasSuffix(fileName, ".condensed") {
 			// not an already condensed file, so no candidate for combining
@@ -80,6 +88,18 @@ func (c *CommitLogCombiner) combineFirstMatch(fileNames []string) (bool, error)
 			continue
 		}
 
+		straddles, err := straddlesSnapshot(fileName, fileNames[i+1], snapshotTs)
+		if err != nil {
+			return false, err
+		}
+
+		if straddles {
+			// the first file is contained in the snapshot, the second one isn't.
+			// The combined file would be named after the first, so its newer
+			// contents would be skipped on startup
+			continue
+		}
+
 		currentStat, err := os.Stat(fileName)
 		if err != nil {
 			return false, errors.Wrapf(err, "stat file %q", fileName)
@@ -110,6 +130,24 @@ func (c *CommitLogCombiner) combineFirstMatch(fileNames []string) (bool, error)
 	return false, nil
 }
 
+func straddlesSnapshot(first, second string, snapshotTs int64) (bool, error) {
+	if snapshotTs == 0 {
+		return false, nil
+	}
+
+	firstTs, err := asTimeStamp(filepath.Base(first))
+	if err != nil {
+		return false, errors.Wrapf(err, "parse commit log name %q", first)
+	}
+
+	secondTs, err := asTimeStamp(filepath.Base(second))
+	if err != nil {
+		return false, errors.Wrapf(err, "parse commit log name %q", second)
+	}
+
+	return firstTs <= snapshotTs && secondTs > snapshotTs, nil
+}
+
 func (c *CommitLogCombiner) combine(first, second string) error {
 	// all names are based on the first file, so that once file1 + file2 are
 	// combined it is as if file2 had never existed and file 1 was just always
@@ -53,6 +53,7 @@ func NewCommitLogger(rootPath, name string, logger logrus.FieldLogger,
 		// both can be overwritten using functional options
 		maxSizeIndividual: defaultCommitLogSize / 5,
 		maxSizeCombining:  defaultCommitLogSize,
+		lastSnapshot:      time.Now(),
 	}
 
 	for _, o := range opts {
@@ -72,6 +73,9 @@ func NewCommitLogger(rootPath, name string, logger logrus.FieldLogger,
 		return strings.Join(elems, "/")
 	}
 	l.commitLogger = commitlog.NewLoggerWithFile(fd)
+	if l.createdHook != nil {
+		l.createdHook(fd.Name())
+	}
 	l.switchLogsCallbackCtrl = maintenanceCallbacks.Register(id("switch_logs"), l.startSwitchLogs)
 	l.condenseLogsCallbackCtrl = maintenanceCallbacks.Register(id("condense_logs"), l.startCombineAndCondenseLogs)
 
@@ -260,6 +264,14 @@ type hnswCommitLogger struct {
 	condenseLogsCallbackCtrl cyclemanager.CycleCallbackCtrl
 
 	allocChecker memwatch.AllocChecker
+
+	// snapshotInterval controls how often a snapshot of the graph is written,
+	// 0 disables snapshots
+	snapshotInterval time.Duration
+	lastSnapshot     time.Time
+
+	// optional, called with the path of every newly opened commit log file
+	createdHook func(path string)
 }
 
 type HnswCommitType uint8 // 256 options, plenty of room for future extensions
@@ -444,7 +456,14 @@ func (l *hnswCommitLogger) startCombineAndCondenseLogs(shouldAbort cyclemanager.
 			WithField("action", "hnsw_commit_log_condensing").
 			Error("hnsw commit log maintenance (condensing) failed")
 	}
-	return executed1 || executed2
+
+	executed3, err := l.startSnapshot()
+	if err != nil {
+		l.logger.WithError(err).
+			WithField("action", "hnsw_snapshot").
+			Error("hnsw commit log maintenance (snapshot) failed")
+	}
+	return executed1 || executed2 || executed3
 }
 
 func (l *hnswCommitLogger) SwitchCommitLogs(force bool) error {
@@ -500,6 +519,9 @@ func (l *hnswCommitLogger) switchCommitLogs(force bool) (bool, error) {
 	}
 
 	l.commitLogger = commitlog.NewLoggerWithFile(fd)
+	if l.createdHook != nil {
+		l.createdHook(fd.Name())
+	}
 
 	return true, nil
 }
@@ -582,6 +604,11 @@ func (l *hnswCommitLogger) Drop(ctx context.Context) error {
 			return errors.Wrap(err, "delete commit files directory")
 		}
 	}
+
+	// remove snapshot directory if exists
+	if err := os.RemoveAll(snapshotDirectory(l.rootPath, l.id)); err != nil {
+		return errors.Wrap(err, "delete snapshot directory")
+	}
 	return nil
 }
 
@@ -11,7 +11,11 @@
 
 package hnsw
 
-import "github.com/weaviate/weaviate/usecases/memwatch"
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
 
 type CommitlogOption func(l *hnswCommitLogger) error
 
@@ -42,3 +46,19 @@ func WithCondensor(condensor Condensor) CommitlogOption {
 		return nil
 	}
 }
+
+func WithSnapshotInterval(interval time.Duration) CommitlogOption {
+	return func(l *hnswCommitLogger) error {
+		l.snapshotInterval = interval
+		return nil
+	}
+}
+
+// WithCommitLogHook registers a function which is called with the path of
+// every commit log file opened for writing.
+func WithCommitLogHook(hook func(path string)) CommitlogOption {
+	return func(l *hnswCommitLogger) error {
+		l.createdHook = hook
+		return nil
+	}
+}
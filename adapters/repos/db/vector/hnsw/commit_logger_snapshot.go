@@ -0,0 +1,579 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
+)
+
+// A snapshot is a point-in-time image of the graph which is equivalent to
+// replaying all commit logs up to and including the one with the timestamp
+// that the snapshot is named after. On startup, the most recent valid
+// snapshot is loaded and only the newer commit logs need to be replayed.
+//
+// Layout (all integers little endian):
+//
+//	version     u8
+//	entrypoint  u64
+//	level       u16
+//	compressed  u8 (followed by an AddPQ or AddSQ commit log record if set)
+//	tombstones  u64 count, followed by u64 ids
+//	nodes       u64 length of node slice, u64 count of non-nil nodes, then
+//	            per node: u64 id, u16 level, u16 number of connection levels,
+//	            per level: u32 count, followed by u64 ids
+//	checksum    u32 crc32 (castagnoli) of everything above
+const snapshotVersion uint8 = 1
+
+var snapshotCrcTable = crc32.MakeTable(crc32.Castagnoli)
+
+func snapshotDirectory(rootPath, name string) string {
+	return fmt.Sprintf("%s/%s.hnsw.snapshot.d", rootPath, name)
+}
+
+func snapshotFileName(rootPath, name string, ts int64) string {
+	return fmt.Sprintf("%s/%d.snapshot", snapshotDirectory(rootPath, name), ts)
+}
+
+// getLatestSnapshot returns the path and timestamp of the most recent
+// snapshot. If no snapshot exists, the path is empty.
+func getLatestSnapshot(rootPath, name string) (string, int64, error) {
+	dir := snapshotDirectory(rootPath, name)
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", 0, nil
+		}
+		return "", 0, errors.Wrap(err, "browse snapshot directory")
+	}
+
+	latest, latestTs := "", int64(0)
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".snapshot") {
+			continue
+		}
+
+		ts, err := strconv.ParseInt(strings.TrimSuffix(file.Name(), ".snapshot"), 10, 64)
+		if err != nil {
+			return "", 0, errors.Wrapf(err, "parse snapshot name %q", file.Name())
+		}
+
+		if latest == "" || ts > latestTs {
+			latest, latestTs = filepath.Join(dir, file.Name()), ts
+		}
+	}
+
+	return latest, latestTs, nil
+}
+
+// commitLogsAfter returns the commit logs which are not yet contained in a
+// snapshot with the given timestamp. fileNames must be sorted.
+func commitLogsAfter(fileNames []string, ts int64) ([]string, error) {
+	for i, fileName := range fileNames {
+		fileTs, err := asTimeStamp(filepath.Base(fileName))
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse commit log name %q", fileName)
+		}
+
+		if fileTs > ts {
+			return fileNames[i:], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func writeSnapshot(fileName string, state *DeserializationResult) error {
+	tmpName := fileName + ".tmp"
+	fd, err := os.Create(tmpName)
+	if err != nil {
+		return errors.Wrap(err, "create snapshot file")
+	}
+
+	if err := encodeSnapshot(fd, state); err != nil {
+		fd.Close()
+		os.Remove(tmpName)
+		return err
+	}
+
+	if err := fd.Sync(); err != nil {
+		fd.Close()
+		os.Remove(tmpName)
+		return errors.Wrap(err, "fsync snapshot file")
+	}
+
+	if err := fd.Close(); err != nil {
+		os.Remove(tmpName)
+		return errors.Wrap(err, "close snapshot file")
+	}
+
+	if err := os.Rename(tmpName, fileName); err != nil {
+		return errors.Wrap(err, "rename snapshot file")
+	}
+
+	return nil
+}
+
+func encodeSnapshot(w io.Writer, state *DeserializationResult) error {
+	crc := crc32.New(snapshotCrcTable)
+	buffered := bufio.NewWriterSize(io.MultiWriter(w, crc), 256*1024)
+	sw := &snapshotWriter{w: buffered}
+
+	sw.writeByte(snapshotVersion)
+	sw.writeUint64(state.Entrypoint)
+	sw.writeUint16(state.Level)
+
+	if state.Compressed {
+		sw.writeByte(1)
+		switch {
+		case state.CompressionPQData != nil:
+			sw.write(pqCompressionRecord(*state.CompressionPQData))
+		case state.CompressionSQData != nil:
+			sw.write(sqCompressionRecord(*state.CompressionSQData))
+		default:
+			return errors.Errorf("compression is enabled, but no compression data is present")
+		}
+	} else {
+		sw.writeByte(0)
+	}
+
+	sw.writeUint64(uint64(len(state.Tombstones)))
+	for id := range state.Tombstones {
+		sw.writeUint64(id)
+	}
+
+	nonNil := uint64(0)
+	for _, node := range state.Nodes {
+		if node != nil {
+			nonNil++
+		}
+	}
+
+	sw.writeUint64(uint64(len(state.Nodes)))
+	sw.writeUint64(nonNil)
+	for _, node := range state.Nodes {
+		if node == nil {
+			continue
+		}
+
+		sw.writeUint64(node.id)
+		sw.writeUint16(uint16(node.level))
+		sw.writeUint16(uint16(len(node.connections)))
+		for _, conns := range node.connections {
+			sw.writeUint32(uint32(len(conns)))
+			for _, conn := range conns {
+				sw.writeUint64(conn)
+			}
+		}
+	}
+
+	// bufio.Writer keeps the first error, so it is enough to check on flush
+	if err := buffered.Flush(); err != nil {
+		return errors.Wrap(err, "write snapshot")
+	}
+
+	var checksum [4]byte
+	binary.LittleEndian.PutUint32(checksum[:], crc.Sum32())
+	if _, err := w.Write(checksum[:]); err != nil {
+		return errors.Wrap(err, "write snapshot checksum")
+	}
+
+	return nil
+}
+
+type snapshotWriter struct {
+	w   *bufio.Writer
+	buf [8]byte
+}
+
+func (sw *snapshotWriter) write(b []byte) {
+	sw.w.Write(b)
+}
+
+func (sw *snapshotWriter) writeByte(b byte) {
+	sw.w.WriteByte(b)
+}
+
+func (sw *snapshotWriter) writeUint16(v uint16) {
+	binary.LittleEndian.PutUint16(sw.buf[:2], v)
+	sw.w.Write(sw.buf[:2])
+}
+
+func (sw *snapshotWriter) writeUint32(v uint32) {
+	binary.LittleEndian.PutUint32(sw.buf[:4], v)
+	sw.w.Write(sw.buf[:4])
+}
+
+func (sw *snapshotWriter) writeUint64(v uint64) {
+	binary.LittleEndian.PutUint64(sw.buf[:8], v)
+	sw.w.Write(sw.buf[:8])
+}
+
+func readSnapshot(fileName string, logger logrus.FieldLogger) (*DeserializationResult, error) {
+	fd, err := os.Open(fileName)
+	if err != nil {
+		return nil, errors.Wrap(err, "open snapshot file")
+	}
+	defer fd.Close()
+
+	return decodeSnapshot(bufio.NewReaderSize(fd, 256*1024), logger)
+}
+
+func decodeSnapshot(r io.Reader, logger logrus.FieldLogger) (*DeserializationResult, error) {
+	crc := crc32.New(snapshotCrcTable)
+	sr := &snapshotReader{r: io.TeeReader(r, crc)}
+
+	version, err := sr.readByte()
+	if err != nil {
+		return nil, errors.Wrap(err, "read version")
+	}
+	if version != snapshotVersion {
+		return nil, errors.Errorf("unsupported snapshot version %d", version)
+	}
+
+	res := &DeserializationResult{
+		NodesDeleted:      make(map[uint64]struct{}),
+		Tombstones:        make(map[uint64]struct{}),
+		TombstonesDeleted: make(map[uint64]struct{}),
+		LinksReplaced:     make(map[uint64]map[uint16]struct{}),
+	}
+
+	if res.Entrypoint, err = sr.readUint64(); err != nil {
+		return nil, errors.Wrap(err, "read entrypoint")
+	}
+	if res.Level, err = sr.readUint16(); err != nil {
+		return nil, errors.Wrap(err, "read level")
+	}
+
+	compressed, err := sr.readByte()
+	if err != nil {
+		return nil, errors.Wrap(err, "read compression flag")
+	}
+	if compressed == 1 {
+		d := NewDeserializer(logger)
+		ct, err := d.ReadCommitType(sr.r)
+		if err != nil {
+			return nil, errors.Wrap(err, "read compression type")
+		}
+		switch ct {
+		case AddPQ:
+			_, err = d.ReadPQ(sr.r, res)
+		case AddSQ:
+			err = d.ReadSQ(sr.r, res)
+		default:
+			err = errors.Errorf("unexpected compression type %s", ct)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "read compression data")
+		}
+	}
+
+	tombstones, err := sr.readUint64()
+	if err != nil {
+		return nil, errors.Wrap(err, "read tombstone count")
+	}
+	for i := uint64(0); i < tombstones; i++ {
+		id, err := sr.readUint64()
+		if err != nil {
+			return nil, errors.Wrap(err, "read tombstone")
+		}
+		res.Tombstones[id] = struct{}{}
+	}
+
+	length, err := sr.readUint64()
+	if err != nil {
+		return nil, errors.Wrap(err, "read node length")
+	}
+	count, err := sr.readUint64()
+	if err != nil {
+		return nil, errors.Wrap(err, "read node count")
+	}
+	if count > length {
+		return nil, errors.Errorf("node count %d exceeds length %d", count, length)
+	}
+
+	res.Nodes = make([]*vertex, max(length, uint64(cache.InitialSize)))
+	for i := uint64(0); i < count; i++ {
+		node, err := sr.readNode()
+		if err != nil {
+			return nil, errors.Wrapf(err, "read node %d of %d", i, count)
+		}
+		if node.id >= length {
+			return nil, errors.Errorf("node id %d out of range", node.id)
+		}
+		res.Nodes[node.id] = node
+	}
+
+	expected := crc.Sum32()
+	var checksum [4]byte
+	if _, err := io.ReadFull(r, checksum[:]); err != nil {
+		return nil, errors.Wrap(err, "read checksum")
+	}
+	if actual := binary.LittleEndian.Uint32(checksum[:]); actual != expected {
+		return nil, errors.Errorf("checksum mismatch: expected %d, got %d", expected, actual)
+	}
+
+	return res, nil
+}
+
+type snapshotReader struct {
+	r   io.Reader
+	buf []byte
+}
+
+func (sr *snapshotReader) read(n int) ([]byte, error) {
+	if cap(sr.buf) < n {
+		sr.buf = make([]byte, n)
+	}
+	sr.buf = sr.buf[:n]
+	if _, err := io.ReadFull(sr.r, sr.buf); err != nil {
+		return nil, err
+	}
+	return sr.buf, nil
+}
+
+func (sr *snapshotReader) readByte() (byte, error) {
+	b, err := sr.read(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (sr *snapshotReader) readUint16() (uint16, error) {
+	b, err := sr.read(2)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint16(b), nil
+}
+
+func (sr *snapshotReader) readUint32() (uint32, error) {
+	b, err := sr.read(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+func (sr *snapshotReader) readUint64() (uint64, error) {
+	b, err := sr.read(8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+func (sr *snapshotReader) readNode() (*vertex, error) {
+	id, err := sr.readUint64()
+	if err != nil {
+		return nil, err
+	}
+	level, err := sr.readUint16()
+	if err != nil {
+		return nil, err
+	}
+	levels, err := sr.readUint16()
+	if err != nil {
+		return nil, err
+	}
+
+	node := &vertex{id: id, level: int(level), connections: make([][]uint64, levels)}
+	for l := range node.connections {
+		n, err := sr.readUint32()
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			continue
+		}
+		b, err := sr.read(int(n) * 8)
+		if err != nil {
+			return nil, err
+		}
+		conns := make([]uint64, n)
+		for i := range conns {
+			conns[i] = binary.LittleEndian.Uint64(b[i*8:])
+		}
+		node.connections[l] = conns
+	}
+
+	return node, nil
+}
+
+// replayCommitLog applies a single commit log on top of the given state
+func replayCommitLog(fileName string, state *DeserializationResult,
+	logger logrus.FieldLogger,
+) (*DeserializationResult, error) {
+	fd, err := os.Open(fileName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open commit log %q for reading", fileName)
+	}
+	defer fd.Close()
+
+	state, _, err = NewDeserializer(logger).Do(bufio.NewReaderSize(fd, 256*1024), state, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "deserialize commit log %q", fileName)
+	}
+
+	return state, nil
+}
+
+func (l *hnswCommitLogger) startSnapshot() (bool, error) {
+	if l.snapshotInterval <= 0 || time.Since(l.lastSnapshot) < l.snapshotInterval {
+		return false, nil
+	}
+
+	// set regardless of the outcome, so that a failing snapshot is not retried
+	// on every maintenance cycle
+	defer func() { l.lastSnapshot = time.Now() }()
+	return l.createSnapshot()
+}
+
+// createSnapshot builds a new snapshot from the previous one (if any) and all
+// commit logs that are no longer written to. Older snapshots are removed once
+// the new one is in place.
+func (l *hnswCommitLogger) createSnapshot() (bool, error) {
+	files, err := getCommitFileNames(l.rootPath, l.id)
+	if err != nil {
+		return false, err
+	}
+
+	if len(files) <= 1 {
+		// the last file is still in use, so there is nothing to snapshot yet
+		return false, nil
+	}
+
+	candidates := files[:len(files)-1]
+
+	if err := os.MkdirAll(snapshotDirectory(l.rootPath, l.id), os.ModePerm); err != nil {
+		return false, errors.Wrap(err, "create snapshot directory")
+	}
+
+	previous, previousTs, err := getLatestSnapshot(l.rootPath, l.id)
+	if err != nil {
+		return false, err
+	}
+
+	toReplay, err := commitLogsAfter(candidates, previousTs)
+	if err != nil {
+		return false, err
+	}
+
+	if len(toReplay) == 0 {
+		return false, nil
+	}
+
+	ts, err := asTimeStamp(filepath.Base(toReplay[len(toReplay)-1]))
+	if err != nil {
+		return false, err
+	}
+
+	if l.allocChecker != nil {
+		// same estimate as for condensing: about 1B of memory for every byte on
+		// disk
+		size := int64(0)
+		for _, fileName := range append([]string{previous}, toReplay...) {
+			if fileName == "" {
+				continue
+			}
+			s, err := os.Stat(fileName)
+			if err != nil {
+				return false, fmt.Errorf("stat file %q: %w", fileName, err)
+			}
+			size += s.Size()
+		}
+
+		if err := l.allocChecker.CheckAlloc(size); err != nil {
+			l.logger.WithFields(logrus.Fields{
+				"action": "hnsw_snapshot",
+				"event":  "snapshot_skipped_oom",
+				"size":   size,
+			}).WithError(err).
+				Warnf("skipping hnsw snapshot due to memory pressure")
+			return false, nil
+		}
+	}
+
+	before := time.Now()
+
+	var state *DeserializationResult
+	if previous != "" {
+		state, err = readSnapshot(previous, l.logger)
+		if err != nil {
+			l.logger.WithFields(logrus.Fields{
+				"action": "hnsw_snapshot",
+				"path":   previous,
+			}).WithError(err).
+				Warn("previous snapshot is invalid, rebuilding from all commit logs")
+			state, toReplay = nil, candidates
+		}
+	}
+
+	for _, fileName := range toReplay {
+		state, err = replayCommitLog(fileName, state, l.logger)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	fileName := snapshotFileName(l.rootPath, l.id, ts)
+	if err := writeSnapshot(fileName, state); err != nil {
+		return false, errors.Wrapf(err, "write snapshot %q", fileName)
+	}
+
+	if err := removeSnapshotsExcept(l.rootPath, l.id, fileName); err != nil {
+		return true, err
+	}
+
+	l.logger.WithFields(logrus.Fields{
+		"action":   "hnsw_snapshot",
+		"id":       l.id,
+		"path":     fileName,
+		"took":     time.Since(before),
+		"replayed": len(toReplay),
+	}).Info("successfully created hnsw snapshot")
+
+	return true, nil
+}
+
+func removeSnapshotsExcept(rootPath, name, keep string) error {
+	dir := snapshotDirectory(rootPath, name)
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return errors.Wrap(err, "browse snapshot directory")
+	}
+
+	for _, file := range files {
+		if file.Name() == filepath.Base(keep) {
+			continue
+		}
+		path := filepath.Join(dir, file.Name())
+		if err := os.Remove(path); err != nil {
+			return errors.Wrapf(err, "remove outdated snapshot %q", path)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,241 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/commitlog"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestSnapshot_EncodeDecode(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	state := &DeserializationResult{
+		Nodes: []*vertex{
+			{id: 0, level: 1, connections: [][]uint64{{1, 3}, {3}}},
+			{id: 1, level: 0, connections: [][]uint64{{0}}},
+			nil,
+			{id: 3, level: 1, connections: [][]uint64{{0, 1}, nil}},
+		},
+		Entrypoint: 3,
+		Level:      1,
+		Tombstones: map[uint64]struct{}{1: {}},
+		Compressed: true,
+		CompressionSQData: &compressionhelpers.SQData{
+			A:          0.5,
+			B:          1.5,
+			Dimensions: 32,
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	require.Nil(t, encodeSnapshot(buf, state))
+	encoded := buf.Bytes()
+
+	t.Run("decode", func(t *testing.T) {
+		res, err := decodeSnapshot(bytes.NewReader(encoded), logger)
+		require.Nil(t, err)
+
+		assert.Equal(t, state.Entrypoint, res.Entrypoint)
+		assert.Equal(t, state.Level, res.Level)
+		assert.Equal(t, state.Tombstones, res.Tombstones)
+		assert.True(t, res.Compressed)
+		assert.Equal(t, state.CompressionSQData, res.CompressionSQData)
+		assertEqualNodes(t, state.Nodes, res.Nodes)
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		corrupted := append([]byte{}, encoded...)
+		corrupted[len(corrupted)/2] ^= 0xff
+		_, err := decodeSnapshot(bytes.NewReader(corrupted), logger)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		_, err := decodeSnapshot(bytes.NewReader(encoded[:len(encoded)-3]), logger)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestSnapshot_CreateAndRestore(t *testing.T) {
+	rootPath := t.TempDir()
+	id := "snapshot"
+	logger, _ := test.NewNullLogger()
+
+	writeTestCommitLog(t, rootPath, id, "1000", func(l *commitlog.Logger) {
+		for i := uint64(0); i < 4; i++ {
+			l.AddNode(i, 1)
+		}
+		l.ReplaceLinksAtLevel(0, 0, []uint64{1, 2, 3})
+		l.ReplaceLinksAtLevel(1, 0, []uint64{0, 2})
+		l.ReplaceLinksAtLevel(0, 1, []uint64{3})
+		l.SetEntryPointWithMaxLayer(0, 1)
+		l.AddSQCompression(compressionhelpers.SQData{A: 1, B: 2, Dimensions: 8})
+	})
+	writeTestCommitLog(t, rootPath, id, "1001", func(l *commitlog.Logger) {
+		l.AddLinkAtLevel(2, 0, 0)
+		l.AddTombstone(2)
+	})
+	writeTestCommitLog(t, rootPath, id, "1002", func(l *commitlog.Logger) {
+		l.DeleteNode(2)
+		l.RemoveTombstone(2)
+		l.AddNode(4, 0)
+		l.ReplaceLinksAtLevel(4, 0, []uint64{0, 1})
+	})
+
+	cl, err := NewCommitLogger(rootPath, id, logger, cyclemanager.NewCallbackGroupNoop(),
+		WithSnapshotInterval(time.Hour))
+	require.Nil(t, err)
+
+	t.Run("snapshot skips the active log", func(t *testing.T) {
+		executed, err := cl.createSnapshot()
+		require.Nil(t, err)
+		assert.True(t, executed)
+
+		path, ts, err := getLatestSnapshot(rootPath, id)
+		require.Nil(t, err)
+		assert.Equal(t, int64(1001), ts)
+		assert.Equal(t, "1001.snapshot", filepath.Base(path))
+	})
+
+	t.Run("snapshot is a no-op without new logs", func(t *testing.T) {
+		executed, err := cl.createSnapshot()
+		require.Nil(t, err)
+		assert.False(t, executed)
+	})
+
+	require.Nil(t, cl.Shutdown(context.Background()))
+
+	writeTestCommitLog(t, rootPath, id, "1003", func(l *commitlog.Logger) {
+		l.AddLinkAtLevel(4, 0, 3)
+	})
+
+	t.Run("snapshot builds on the previous one", func(t *testing.T) {
+		executed, err := cl.createSnapshot()
+		require.Nil(t, err)
+		assert.True(t, executed)
+
+		_, ts, err := getLatestSnapshot(rootPath, id)
+		require.Nil(t, err)
+		assert.Equal(t, int64(1002), ts)
+
+		files, err := os.ReadDir(snapshotDirectory(rootPath, id))
+		require.Nil(t, err)
+		assert.Len(t, files, 1)
+	})
+
+	fileNames, err := getCommitFileNames(rootPath, id)
+	require.Nil(t, err)
+
+	var expected *DeserializationResult
+	for _, fileName := range fileNames {
+		expected, err = replayCommitLog(fileName, expected, logger)
+		require.Nil(t, err)
+	}
+
+	t.Run("restore from snapshot matches a full replay", func(t *testing.T) {
+		h := &hnsw{rootPath: rootPath, id: id, logger: logger}
+		state, remaining := h.restoreFromSnapshot(fileNames)
+		require.NotNil(t, state)
+		require.Len(t, remaining, 1)
+
+		state, err = replayCommitLog(remaining[0], state, logger)
+		require.Nil(t, err)
+
+		assert.Equal(t, expected.Entrypoint, state.Entrypoint)
+		assert.Equal(t, expected.Level, state.Level)
+		assert.Equal(t, expected.Tombstones, state.Tombstones)
+		assert.Equal(t, expected.CompressionSQData, state.CompressionSQData)
+		assertEqualNodes(t, expected.Nodes, state.Nodes)
+	})
+
+	t.Run("corrupt snapshot falls back to commit logs", func(t *testing.T) {
+		path, _, err := getLatestSnapshot(rootPath, id)
+		require.Nil(t, err)
+		require.Nil(t, os.Truncate(path, 10))
+
+		h := &hnsw{rootPath: rootPath, id: id, logger: logger}
+		state, remaining := h.restoreFromSnapshot(fileNames)
+		assert.Nil(t, state)
+		assert.Equal(t, fileNames, remaining)
+	})
+}
+
+func TestSnapshot_CombinerRespectsSnapshotBoundary(t *testing.T) {
+	rootPath := t.TempDir()
+	id := "combine"
+	logger, _ := test.NewNullLogger()
+
+	writeTestCommitLog(t, rootPath, id, "1000.condensed", func(l *commitlog.Logger) {
+		l.AddNode(0, 0)
+	})
+	writeTestCommitLog(t, rootPath, id, "1001.condensed", func(l *commitlog.Logger) {
+		l.AddNode(1, 0)
+	})
+
+	fileNames, err := getCommitFileNames(rootPath, id)
+	require.Nil(t, err)
+
+	c := NewCommitLogCombiner(rootPath, id, 1024*1024, logger)
+
+	ok, err := c.combineFirstMatch(fileNames, 1000)
+	require.Nil(t, err)
+	assert.False(t, ok, "files on both sides of the snapshot must not be combined")
+
+	ok, err = c.combineFirstMatch(fileNames, 1001)
+	require.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func writeTestCommitLog(t *testing.T, rootPath, id, name string,
+	write func(l *commitlog.Logger),
+) {
+	require.Nil(t, os.MkdirAll(commitLogDirectory(rootPath, id), os.ModePerm))
+	fd, err := os.Create(commitLogFileName(rootPath, id, name))
+	require.Nil(t, err)
+
+	l := commitlog.NewLoggerWithFile(fd)
+	write(l)
+	require.Nil(t, l.Flush())
+	require.Nil(t, l.Close())
+}
+
+func assertEqualNodes(t *testing.T, expected, actual []*vertex) {
+	for i := 0; i < max(len(expected), len(actual)); i++ {
+		var e, a *vertex
+		if i < len(expected) {
+			e = expected[i]
+		}
+		if i < len(actual) {
+			a = actual[i]
+		}
+
+		if e == nil || a == nil {
+			assert.Equal(t, e == nil, a == nil, "node %d", i)
+			continue
+		}
+
+		assert.Equal(t, e.id, a.id)
+		assert.Equal(t, e.level, a.level, "level of node %d", i)
+		assert.Equal(t, e.connections, a.connections, "connections of node %d", i)
+	}
+}
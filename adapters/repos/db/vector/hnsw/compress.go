@@ -23,7 +23,7 @@ import (
 )
 
 func (h *hnsw) compress(cfg ent.UserConfig) error {
-	if !cfg.PQ.Enabled && !cfg.BQ.Enabled && !cfg.SQ.Enabled {
+	if !cfg.PQ.Enabled && !cfg.BQ.Enabled && !cfg.SQ.Enabled && !cfg.RQ.Enabled {
 		return nil
 	}
 
@@ -94,6 +94,13 @@ func (h *hnsw) compress(cfg ent.UserConfig) error {
 			}
 		}
 		h.compressor.PersistCompression(h.commitLog)
+	} else if cfg.RQ.Enabled {
+		var err error
+		h.compressor, err = compressionhelpers.NewRQCompressor(
+			h.distancerProvider, 1e12, h.logger, h.store, h.allocChecker)
+		if err != nil {
+			return err
+		}
 	} else {
 		var err error
 		h.compressor, err = compressionhelpers.NewBQCompressor(
@@ -16,6 +16,7 @@ package hnsw_test
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"os"
 	"sync"
 	"testing"
@@ -24,6 +25,8 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
@@ -143,3 +146,101 @@ func Test_NoRaceCompressionRecall(t *testing.T) {
 		wg.Wait()
 	}
 }
+
+// Test_NoRaceAcornFilteredRecall checks the recall of the ACORN filter
+// strategy on restrictive filters. ACORN seeds its entrypoints from the allow
+// list, ids of the allow list which are not part of the graph, e.g. because
+// the object store is ahead of the index, must not be used as entrypoints.
+func Test_NoRaceAcornFilteredRecall(t *testing.T) {
+	ctx := context.Background()
+
+	efConstruction := 64
+	ef := 64
+	maxNeighbors := 32
+	dimensions := 32
+	vectorsSize := 10000
+	queriesSize := 200
+	k := 10
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(vectorsSize, queriesSize, dimensions)
+	logger, _ := test.NewNullLogger()
+	distanceProvider := distancer.NewL2SquaredProvider()
+
+	uc := ent.UserConfig{
+		MaxConnections:        maxNeighbors,
+		EFConstruction:        efConstruction,
+		EF:                    ef,
+		VectorCacheMaxObjects: 10e12,
+		// always search the graph, no matter how restrictive the filter is
+		FlatSearchCutoff: 0,
+		FilterStrategy:   ent.FilterStrategyAcorn,
+	}
+	index, err := hnsw.New(hnsw.Config{
+		RootPath:              t.TempDir(),
+		ID:                    "filteredrecallbenchmark",
+		MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+		ClassName:             "clasFilteredRecallBenchmark",
+		ShardName:             "shardFilteredRecallBenchmark",
+		DistanceProvider:      distanceProvider,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			if int(id) >= len(vectors) {
+				return nil, storobj.NewErrNotFoundf(id, "out of range")
+			}
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+			copy(container.Slice, vectors[int(id)])
+			return container.Slice, nil
+		},
+	}, uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+
+	// the first ids are never added to the graph, but are part of every allow
+	// list. As allow lists are iterated in order, they are the first candidate
+	// entrypoints and need to be skipped. Only the first ef ids of the allow
+	// list are considered as entrypoints, so fewer of them are missing.
+	missing := ef / 4
+	compressionhelpers.Concurrently(logger, uint64(vectorsSize-missing), func(i uint64) {
+		id := i + uint64(missing)
+		index.Add(ctx, id, vectors[id])
+	})
+
+	// every object belongs to exactly one of the "tenants" sharing the
+	// collection, filtering on a tenant makes for an uncorrelated filter of the
+	// given selectivity
+	r := rand.New(rand.NewSource(42))
+	filter := func(selectivity float32) ([][]float32, helpers.AllowList) {
+		allowed := make([][]float32, vectorsSize)
+		allowList := helpers.NewAllowList()
+		for i := 0; i < missing; i++ {
+			allowList.Insert(uint64(i))
+		}
+		for i := missing; i < vectorsSize; i++ {
+			if r.Float32() < selectivity {
+				allowed[i] = vectors[i]
+				allowList.Insert(uint64(i))
+			}
+		}
+		return allowed, allowList
+	}
+
+	recall := func(allowed [][]float32, allowList helpers.AllowList) float32 {
+		var relevant uint64
+		var retrieved int
+		for _, query := range queries {
+			truth, _ := testinghelpers.BruteForce(logger, allowed, query, k, distanceWrapper(distanceProvider))
+			results, _, err := index.SearchByVector(ctx, query, k, allowList)
+			require.Nil(t, err)
+			retrieved += len(truth)
+			relevant += testinghelpers.MatchesInLists(truth, results)
+		}
+		return float32(relevant) / float32(retrieved)
+	}
+
+	for _, selectivity := range []float32{0.01, 0.05, 0.1} {
+		t.Run(fmt.Sprintf("selectivity %.2f", selectivity), func(t *testing.T) {
+			acornRecall := recall(filter(selectivity))
+			t.Logf("selectivity %.2f: acorn recall %f", selectivity, acornRecall)
+			assert.Greater(t, acornRecall, float32(0.9))
+		})
+	}
+}
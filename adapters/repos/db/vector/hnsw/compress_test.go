@@ -88,3 +88,56 @@ func Test_NoRaceCompressReturnsErrorWhenNotEnoughData(t *testing.T) {
 	err := index.compress(uc)
 	assert.NotNil(t, err)
 }
+
+func Test_NoRaceRQCompressesFromFirstInsert(t *testing.T) {
+	dimensions := 64
+	vectors, queries := testinghelpers.RandomVecs(1000, 10, dimensions)
+	distancer := distancer.NewL2SquaredProvider()
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+
+	uc := ent.NewDefaultUserConfig()
+	uc.EF = 64
+	uc.RQ = ent.RQConfig{
+		Enabled:      true,
+		RescoreLimit: 20,
+	}
+
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "rq",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			if int(id) >= len(vectors) {
+				return nil, storobj.NewErrNotFoundf(id, "out of range")
+			}
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+			copy(container.Slice, vectors[int(id)])
+			return container.Slice, nil
+		},
+	}, uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	assert.Nil(t, err)
+	defer index.Shutdown(context.Background())
+
+	assert.True(t, index.Compressed())
+	assert.Nil(t, compressionhelpers.ConcurrentlyWithError(logger, uint64(len(vectors)), func(id uint64) error {
+		return index.Add(ctx, id, vectors[id])
+	}))
+
+	k := 10
+	var matches uint64
+	for _, query := range queries {
+		truth, _ := testinghelpers.BruteForce(logger, vectors, query, k, func(x, y []float32) float32 {
+			dist, _ := distancer.SingleDist(x, y)
+			return dist
+		})
+		results, _, err := index.SearchByVector(ctx, query, k, nil)
+		assert.Nil(t, err)
+		matches += testinghelpers.MatchesInLists(truth, results)
+	}
+	recall := float32(matches) / float32(k*len(queries))
+	assert.Greater(t, recall, float32(0.9))
+}
@@ -287,6 +287,18 @@ func (c *MemoryCondensor) RemoveTombstone(nodeid uint64) error {
 }
 
 func (c *MemoryCondensor) AddPQCompression(data compressionhelpers.PQData) error {
+	_, err := c.newLog.Write(pqCompressionRecord(data))
+	return err
+}
+
+func (c *MemoryCondensor) AddSQCompression(data compressionhelpers.SQData) error {
+	_, err := c.newLog.Write(sqCompressionRecord(data))
+	return err
+}
+
+// pqCompressionRecord encodes the PQ data in the commit log format, including
+// the leading commit type
+func pqCompressionRecord(data compressionhelpers.PQData) []byte {
 	toWrite := make([]byte, 10)
 	toWrite[0] = byte(AddPQ)
 	binary.LittleEndian.PutUint16(toWrite[1:3], data.Dimensions)
@@ -303,18 +315,18 @@ func (c *MemoryCondensor) AddPQCompression(data compressionhelpers.PQData) error
 	for _, encoder := range data.Encoders {
 		toWrite = append(toWrite, encoder.ExposeDataForRestore()...)
 	}
-	_, err := c.newLog.Write(toWrite)
-	return err
+	return toWrite
 }
 
-func (c *MemoryCondensor) AddSQCompression(data compressionhelpers.SQData) error {
+// sqCompressionRecord encodes the SQ data in the commit log format, including
+// the leading commit type
+func sqCompressionRecord(data compressionhelpers.SQData) []byte {
 	toWrite := make([]byte, 11)
 	toWrite[0] = byte(AddSQ)
 	binary.LittleEndian.PutUint32(toWrite[1:], math.Float32bits(data.A))
 	binary.LittleEndian.PutUint32(toWrite[5:], math.Float32bits(data.B))
 	binary.LittleEndian.PutUint16(toWrite[9:], data.Dimensions)
-	_, err := c.newLog.Write(toWrite)
-	return err
+	return toWrite
 }
 
 func NewMemoryCondensor(logger logrus.FieldLogger) *MemoryCondensor {
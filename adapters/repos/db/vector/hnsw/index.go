@@ -165,6 +165,7 @@ type hnsw struct {
 	pqConfig   ent.PQConfig
 	bqConfig   ent.BQConfig
 	sqConfig   ent.SQConfig
+	rqConfig   ent.RQConfig
 	// rescoring compressed vectors is disk-bound. On cold starts, we cannot
 	// rescore sequentially, as that would take very long. This setting allows us
 	// to define the rescoring concurrency.
@@ -287,6 +288,7 @@ func New(cfg Config, uc ent.UserConfig,
 		pqConfig:             uc.PQ,
 		bqConfig:             uc.BQ,
 		sqConfig:             uc.SQ,
+		rqConfig:             uc.RQ,
 		rescoreConcurrency:   2 * runtime.GOMAXPROCS(0), // our default for IO-bound activties
 		shardedNodeLocks:     common.NewDefaultShardedRWLocks(),
 
@@ -309,6 +311,21 @@ func New(cfg Config, uc ent.UserConfig,
 		index.cache = nil
 	}
 
+	if uc.RQ.Enabled {
+		// RQ does not need to be trained, so just like BQ we can compress
+		// right from the start
+		var err error
+		index.compressor, err = compressionhelpers.NewRQCompressor(
+			index.distancerProvider, uc.VectorCacheMaxObjects, cfg.Logger, store,
+			cfg.AllocChecker)
+		if err != nil {
+			return nil, err
+		}
+		index.compressed.Store(true)
+		index.cache.Drop()
+		index.cache = nil
+	}
+
 	if err := index.init(cfg); err != nil {
 		return nil, errors.Wrapf(err, "init index %q", index.id)
 	}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -254,6 +255,7 @@ func (h *hnsw) searchLayerByVectorWithDistancer(ctx context.Context,
 		if err := ctx.Err(); err != nil {
 			h.pools.visitedListsLock.RLock()
 			h.pools.visitedLists.Return(visited)
+			h.pools.visitedLists.Return(visitedExp)
 			h.pools.visitedListsLock.RUnlock()
 
 			helpers.AnnotateSlowQueryLog(ctx, "context_error", "knn_search_layer")
@@ -778,15 +780,23 @@ func (h *hnsw) knnSearchByVector(ctx context.Context, searchVec []float32, k int
 			size = ef - 1
 		}
 		it := allowList.Iterator()
-		i := 0
-		seeds := make([]uint64, size)
-		for idx, ok := it.Next(); ok && i < size; idx, ok = it.Next() {
-			seeds[i] = idx
-			i++
-		}
-		for _, entryPoint := range seeds {
-			entryPointDistance, _ := h.distToNode(compressorDistancer, entryPoint, searchVec)
+		seeds := 0
+		// the allow list may contain ids which are not (or no longer) part of
+		// the graph, those can't be used as entrypoints and are replaced by
+		// the next ids of the allow list. At most ef ids are looked at, so
+		// that a large allow list does not make for a long walk.
+		visited := 0
+		for entryPoint, ok := it.Next(); ok && seeds < size && visited < ef; entryPoint, ok = it.Next() {
+			visited++
+			if h.nodeByID(entryPoint) == nil {
+				continue
+			}
+			entryPointDistance, err := h.distToNode(compressorDistancer, entryPoint, searchVec)
+			if err != nil {
+				continue
+			}
 			eps.Insert(entryPoint, entryPointDistance)
+			seeds++
 		}
 	}
 	res, err := h.searchLayerByVectorWithDistancer(ctx, searchVec, eps, ef, 0, allowList, compressorDistancer)
@@ -857,6 +867,11 @@ func (h *hnsw) rescore(ctx context.Context, res *priorityqueue.Queue[any], k int
 			res.Pop()
 		}
 	}
+	if h.rqConfig.Enabled {
+		if bounded, ok := compressorDistancer.(compressionhelpers.ErrorBoundedDistancer); ok {
+			return h.rescoreWithinErrorBound(ctx, res, k, bounded, compressorDistancer)
+		}
+	}
 	ids := make([]uint64, res.Len())
 	i := len(ids) - 1
 	for res.Len() > 0 {
@@ -909,6 +924,80 @@ func (h *hnsw) rescore(ctx context.Context, res *priorityqueue.Queue[any], k int
 	return nil
 }
 
+// rescoreWithinErrorBound rescores the candidates in the order of the lower
+// bounds of their distances, rather than by their estimated distances. As the
+// exact distance of a candidate can't be below its lower bound, rescoring
+// stops as soon as the lower bound of the next candidate exceeds the exact
+// distance of the k-th match. No more than RescoreLimit candidates are
+// rescored.
+func (h *hnsw) rescoreWithinErrorBound(ctx context.Context, res *priorityqueue.Queue[any], k int,
+	bounded compressionhelpers.ErrorBoundedDistancer, compressorDistancer compressionhelpers.CompressorDistancer,
+) error {
+	type candidate struct {
+		id         uint64
+		lowerBound float32
+	}
+	candidates := make([]candidate, 0, res.Len())
+	for res.Len() > 0 {
+		id := res.Pop().ID
+		lowerBound, err := bounded.LowerBoundToNode(id)
+		if err != nil {
+			// without a bound the candidate can't be ruled out
+			lowerBound = float32(math.Inf(-1))
+		}
+		candidates = append(candidates, candidate{id: id, lowerBound: lowerBound})
+	}
+	res.Reset()
+
+	sort.Slice(candidates, func(a, b int) bool {
+		return candidates[a].lowerBound < candidates[b].lowerBound
+	})
+	if limit := h.rqConfig.RescoreLimit; limit >= k && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	// candidates are rescored concurrently in rounds, the bound is checked
+	// before each round
+	dists := make([]float32, h.rescoreConcurrency)
+	errs := make([]error, h.rescoreConcurrency)
+	for start := 0; start < len(candidates); start += h.rescoreConcurrency {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("rescore: %w", err)
+		}
+		if res.Len() >= k && candidates[start].lowerBound > res.Top().Dist {
+			break
+		}
+
+		round := candidates[start:min(start+h.rescoreConcurrency, len(candidates))]
+		wg := sync.WaitGroup{}
+		for j := range round {
+			j := j
+			wg.Add(1)
+			enterrors.GoWrapper(func() {
+				defer wg.Done()
+				dists[j], errs[j] = h.distanceFromBytesToFloatNode(compressorDistancer, round[j].id)
+			}, h.logger)
+		}
+		wg.Wait()
+
+		for j := range round {
+			if errs[j] != nil {
+				h.logger.
+					WithField("action", "rescore").
+					WithError(errs[j]).
+					Warnf("could not rescore node %d", round[j].id)
+				continue
+			}
+			res.Insert(round[j].id, dists[j])
+			if res.Len() > k {
+				res.Pop()
+			}
+		}
+	}
+
+	return nil
+}
+
 func newSearchByDistParams(maxLimit int64) *searchByDistParams {
 	initialOffset := 0
 	initialLimit := DefaultSearchByDistInitialLimit
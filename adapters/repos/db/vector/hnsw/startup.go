@@ -52,6 +52,47 @@ func (h *hnsw) init(cfg Config) error {
 	return nil
 }
 
+// restoreFromSnapshot loads the most recent snapshot, if present, and returns
+// it along with the commit logs that still need to be replayed on top of it.
+// An unreadable snapshot is not fatal, the commit logs are the source of truth.
+func (h *hnsw) restoreFromSnapshot(fileNames []string) (*DeserializationResult, []string) {
+	path, ts, err := getLatestSnapshot(h.rootPath, h.id)
+	if err != nil {
+		h.logger.WithField("action", "hnsw_load_snapshot").
+			WithError(err).
+			Warn("failed to find hnsw snapshot, falling back to commit logs")
+		return nil, fileNames
+	}
+
+	if path == "" {
+		return nil, fileNames
+	}
+
+	remaining, err := commitLogsAfter(fileNames, ts)
+	if err != nil {
+		h.logger.WithField("action", "hnsw_load_snapshot").
+			WithError(err).
+			Warn("failed to match hnsw snapshot with commit logs, falling back to commit logs")
+		return nil, fileNames
+	}
+
+	state, err := readSnapshot(path, h.logger)
+	if err != nil {
+		h.logger.WithField("action", "hnsw_load_snapshot").
+			WithField("path", path).
+			WithError(err).
+			Warn("invalid hnsw snapshot, falling back to commit logs")
+		return nil, fileNames
+	}
+
+	h.logger.WithField("action", "hnsw_load_snapshot").
+		WithField("path", path).
+		WithField("remaining_commit_logs", len(remaining)).
+		Debug("loaded hnsw snapshot")
+
+	return state, remaining
+}
+
 // if a commit log is already present it will be read into memory, if not we
 // start with an empty model
 func (h *hnsw) restoreFromDisk() error {
@@ -73,7 +114,7 @@ func (h *hnsw) restoreFromDisk() error {
 		return errors.Wrap(err, "corrupted commit log fixer")
 	}
 
-	var state *DeserializationResult
+	state, fileNames := h.restoreFromSnapshot(fileNames)
 	for i, fileName := range fileNames {
 		beforeIndividual := time.Now()
 
@@ -28,6 +28,211 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientServi
 	return &Client{transport: transport, formats: formats}
 }
 
+/*
+BackupsSchedulesCreate creates a backup schedule
+
+Create a schedule which periodically creates backups of a set of collections. <br/><br/>Schedules are stored in the cluster and executed by the current leader. Backups which are no longer kept by the retention rules of the schedule are deleted from the backend.
+*/
+func (a *Client) BackupsSchedulesCreate(params *BackupsSchedulesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesCreateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsSchedulesCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "backups.schedules.create",
+		Method:             "POST",
+		PathPattern:        "/backups/schedules",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsSchedulesCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsSchedulesCreateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.schedules.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BackupsSchedulesDelete deletes a backup schedule
+
+Delete a backup schedule. The backups it created are kept in the backend.
+*/
+func (a *Client) BackupsSchedulesDelete(params *BackupsSchedulesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesDeleteNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsSchedulesDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "backups.schedules.delete",
+		Method:             "DELETE",
+		PathPattern:        "/backups/schedules/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsSchedulesDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsSchedulesDeleteNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.schedules.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BackupsSchedulesGet gets a backup schedule
+
+Returns a backup schedule, the backups it created and when it runs next.
+*/
+func (a *Client) BackupsSchedulesGet(params *BackupsSchedulesGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsSchedulesGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "backups.schedules.get",
+		Method:             "GET",
+		PathPattern:        "/backups/schedules/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsSchedulesGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsSchedulesGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.schedules.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BackupsSchedulesList lists backup schedules
+
+Returns all backup schedules, the backups they created and when they run next.
+*/
+func (a *Client) BackupsSchedulesList(params *BackupsSchedulesListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsSchedulesListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "backups.schedules.list",
+		Method:             "GET",
+		PathPattern:        "/backups/schedules",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsSchedulesListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsSchedulesListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.schedules.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BackupsSchedulesUpdate updates a backup schedule
+
+Replace the definition of a backup schedule. The new retention rules also apply to the backups the schedule created before.
+*/
+func (a *Client) BackupsSchedulesUpdate(params *BackupsSchedulesUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsSchedulesUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "backups.schedules.update",
+		Method:             "PUT",
+		PathPattern:        "/backups/schedules/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsSchedulesUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsSchedulesUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.schedules.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 Client for backups API
 */
@@ -53,6 +258,16 @@ type ClientService interface {
 
 	BackupsRestoreStatus(params *BackupsRestoreStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsRestoreStatusOK, error)
 
+	BackupsSchedulesCreate(params *BackupsSchedulesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesCreateOK, error)
+
+	BackupsSchedulesDelete(params *BackupsSchedulesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesDeleteNoContent, error)
+
+	BackupsSchedulesGet(params *BackupsSchedulesGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesGetOK, error)
+
+	BackupsSchedulesList(params *BackupsSchedulesListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesListOK, error)
+
+	BackupsSchedulesUpdate(params *BackupsSchedulesUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesUpdateOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
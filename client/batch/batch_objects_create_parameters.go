@@ -25,6 +25,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewBatchObjectsCreateParams creates a new BatchObjectsCreateParams object,
@@ -75,12 +76,24 @@ type BatchObjectsCreateParams struct {
 	// Body.
 	Body BatchObjectsCreateBody
 
+	/* Conditional.
+
+	   If true, each object is only written if its lastUpdateTimeUnix matches the stored object. Objects with lastUpdateTimeUnix unset or 0 are only written if they do not exist yet.
+	*/
+	Conditional *bool
+
 	/* ConsistencyLevel.
 
 	   Determines how many replicas must acknowledge a request before it is considered successful
 	*/
 	ConsistencyLevel *string
 
+	/* XWeaviateConsistencyToken.
+
+	   Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.
+	*/
+	XWeaviateConsistencyToken *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -145,6 +158,17 @@ func (o *BatchObjectsCreateParams) SetBody(body BatchObjectsCreateBody) {
 	o.Body = body
 }
 
+// WithConditional adds the conditional to the batch objects create params
+func (o *BatchObjectsCreateParams) WithConditional(conditional *bool) *BatchObjectsCreateParams {
+	o.SetConditional(conditional)
+	return o
+}
+
+// SetConditional adds the conditional to the batch objects create params
+func (o *BatchObjectsCreateParams) SetConditional(conditional *bool) {
+	o.Conditional = conditional
+}
+
 // WithConsistencyLevel adds the consistencyLevel to the batch objects create params
 func (o *BatchObjectsCreateParams) WithConsistencyLevel(consistencyLevel *string) *BatchObjectsCreateParams {
 	o.SetConsistencyLevel(consistencyLevel)
@@ -156,6 +180,17 @@ func (o *BatchObjectsCreateParams) SetConsistencyLevel(consistencyLevel *string)
 	o.ConsistencyLevel = consistencyLevel
 }
 
+// WithXWeaviateConsistencyToken adds the xWeaviateConsistencyToken to the batch objects create params
+func (o *BatchObjectsCreateParams) WithXWeaviateConsistencyToken(xWeaviateConsistencyToken *string) *BatchObjectsCreateParams {
+	o.SetXWeaviateConsistencyToken(xWeaviateConsistencyToken)
+	return o
+}
+
+// SetXWeaviateConsistencyToken adds the xWeaviateConsistencyToken to the batch objects create params
+func (o *BatchObjectsCreateParams) SetXWeaviateConsistencyToken(xWeaviateConsistencyToken *string) {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *BatchObjectsCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -167,6 +202,23 @@ func (o *BatchObjectsCreateParams) WriteToRequest(r runtime.ClientRequest, reg s
 		return err
 	}
 
+	if o.Conditional != nil {
+
+		// query param conditional
+		var qrConditional bool
+
+		if o.Conditional != nil {
+			qrConditional = *o.Conditional
+		}
+		qConditional := swag.FormatBool(qrConditional)
+		if qConditional != "" {
+
+			if err := r.SetQueryParam("conditional", qConditional); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.ConsistencyLevel != nil {
 
 		// query param consistency_level
@@ -184,6 +236,14 @@ func (o *BatchObjectsCreateParams) WriteToRequest(r runtime.ClientRequest, reg s
 		}
 	}
 
+	if o.XWeaviateConsistencyToken != nil {
+
+		// header param X-Weaviate-Consistency-Token
+		if err := r.SetHeaderParam("X-Weaviate-Consistency-Token", *o.XWeaviateConsistencyToken); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
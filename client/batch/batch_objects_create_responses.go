@@ -92,6 +92,11 @@ BatchObjectsCreateOK describes a response with status code 200, with default hea
 Request succeeded, see response body to get detailed information about each batched item.
 */
 type BatchObjectsCreateOK struct {
+
+	/* Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes.
+	 */
+	XWeaviateConsistencyToken string
+
 	Payload []*models.ObjectsGetResponse
 }
 
@@ -139,6 +144,13 @@ func (o *BatchObjectsCreateOK) GetPayload() []*models.ObjectsGetResponse {
 
 func (o *BatchObjectsCreateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Weaviate-Consistency-Token
+	hdrXWeaviateConsistencyToken := response.GetHeader("X-Weaviate-Consistency-Token")
+
+	if hdrXWeaviateConsistencyToken != "" {
+		o.XWeaviateConsistencyToken = hdrXWeaviateConsistencyToken
+	}
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
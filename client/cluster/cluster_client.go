@@ -41,11 +41,60 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	ClusterGetRaft(params *ClusterGetRaftParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterGetRaftOK, error)
+
 	ClusterGetStatistics(params *ClusterGetStatisticsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterGetStatisticsOK, error)
 
+	ClusterRaftPromotePeer(params *ClusterRaftPromotePeerParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterRaftPromotePeerNoContent, error)
+
+	ClusterRaftRemovePeer(params *ClusterRaftRemovePeerParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterRaftRemovePeerNoContent, error)
+
+	ClusterRaftSnapshot(params *ClusterRaftSnapshotParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterRaftSnapshotOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+ClusterGetRaft sees the raft state of the cluster
+
+Returns the leader, term, log indexes, the members of the latest Raft configuration and the latest snapshot as seen by the node serving the request.
+*/
+func (a *Client) ClusterGetRaft(params *ClusterGetRaftParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterGetRaftOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewClusterGetRaftParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "cluster.get.raft",
+		Method:             "GET",
+		PathPattern:        "/cluster/raft",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ClusterGetRaftReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ClusterGetRaftOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for cluster.get.raft: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ClusterGetStatistics sees raft cluster statistics
 
@@ -87,6 +136,129 @@ func (a *Client) ClusterGetStatistics(params *ClusterGetStatisticsParams, authIn
 	panic(msg)
 }
 
+/*
+ClusterRaftPromotePeer promotes a non voter to a voter
+
+Turns a non-voting member of the Raft configuration into a voter. The request is forwarded to the leader.
+*/
+func (a *Client) ClusterRaftPromotePeer(params *ClusterRaftPromotePeerParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterRaftPromotePeerNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewClusterRaftPromotePeerParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "cluster.raft.promote.peer",
+		Method:             "POST",
+		PathPattern:        "/cluster/raft/peers/{id}/promote",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ClusterRaftPromotePeerReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ClusterRaftPromotePeerNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for cluster.raft.promote.peer: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ClusterRaftRemovePeer removes a peer from the raft configuration
+
+Removes a peer, typically a node that is permanently gone, from the Raft configuration. The request is forwarded to the leader. The current leader can not be removed, and neither can voters whose removal would leave too few voters for a quorum.
+*/
+func (a *Client) ClusterRaftRemovePeer(params *ClusterRaftRemovePeerParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterRaftRemovePeerNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewClusterRaftRemovePeerParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "cluster.raft.remove.peer",
+		Method:             "DELETE",
+		PathPattern:        "/cluster/raft/peers/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ClusterRaftRemovePeerReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ClusterRaftRemovePeerNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for cluster.raft.remove.peer: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ClusterRaftSnapshot forces a raft snapshot
+
+Forces the node serving the request to take a Raft snapshot of its state, which also truncates its log. Returns the latest snapshot if nothing new was applied since the last one.
+*/
+func (a *Client) ClusterRaftSnapshot(params *ClusterRaftSnapshotParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterRaftSnapshotOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewClusterRaftSnapshotParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "cluster.raft.snapshot",
+		Method:             "POST",
+		PathPattern:        "/cluster/raft/snapshot",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ClusterRaftSnapshotReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ClusterRaftSnapshotOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for cluster.raft.snapshot: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -25,6 +25,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewObjectsClassDeleteParams creates a new ObjectsClassDeleteParams object,
@@ -89,12 +90,26 @@ type ObjectsClassDeleteParams struct {
 	*/
 	ID strfmt.UUID
 
+	/* IfMatch.
+
+	   Only apply the write if the stored object was last updated at exactly this unix time in milliseconds (its lastUpdateTimeUnix). 0 requires that the object does not exist.
+
+	   Format: int64
+	*/
+	IfMatch *int64
+
 	/* Tenant.
 
 	   Specifies the tenant in a request targeting a multi-tenant class
 	*/
 	Tenant *string
 
+	/* XWeaviateConsistencyToken.
+
+	   Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.
+	*/
+	XWeaviateConsistencyToken *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -181,6 +196,17 @@ func (o *ObjectsClassDeleteParams) SetID(id strfmt.UUID) {
 	o.ID = id
 }
 
+// WithIfMatch adds the ifMatch to the objects class delete params
+func (o *ObjectsClassDeleteParams) WithIfMatch(ifMatch *int64) *ObjectsClassDeleteParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the objects class delete params
+func (o *ObjectsClassDeleteParams) SetIfMatch(ifMatch *int64) {
+	o.IfMatch = ifMatch
+}
+
 // WithTenant adds the tenant to the objects class delete params
 func (o *ObjectsClassDeleteParams) WithTenant(tenant *string) *ObjectsClassDeleteParams {
 	o.SetTenant(tenant)
@@ -192,6 +218,17 @@ func (o *ObjectsClassDeleteParams) SetTenant(tenant *string) {
 	o.Tenant = tenant
 }
 
+// WithXWeaviateConsistencyToken adds the xWeaviateConsistencyToken to the objects class delete params
+func (o *ObjectsClassDeleteParams) WithXWeaviateConsistencyToken(xWeaviateConsistencyToken *string) *ObjectsClassDeleteParams {
+	o.SetXWeaviateConsistencyToken(xWeaviateConsistencyToken)
+	return o
+}
+
+// SetXWeaviateConsistencyToken adds the xWeaviateConsistencyToken to the objects class delete params
+func (o *ObjectsClassDeleteParams) SetXWeaviateConsistencyToken(xWeaviateConsistencyToken *string) {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ObjectsClassDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -227,6 +264,14 @@ func (o *ObjectsClassDeleteParams) WriteToRequest(r runtime.ClientRequest, reg s
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", swag.FormatInt64(*o.IfMatch)); err != nil {
+			return err
+		}
+	}
+
 	if o.Tenant != nil {
 
 		// query param tenant
@@ -244,6 +289,14 @@ func (o *ObjectsClassDeleteParams) WriteToRequest(r runtime.ClientRequest, reg s
 		}
 	}
 
+	if o.XWeaviateConsistencyToken != nil {
+
+		// header param X-Weaviate-Consistency-Token
+		if err := r.SetHeaderParam("X-Weaviate-Consistency-Token", *o.XWeaviateConsistencyToken); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -107,6 +107,12 @@ type ObjectsClassGetParams struct {
 	*/
 	Tenant *string
 
+	/* XWeaviateConsistencyToken.
+
+	   Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.
+	*/
+	XWeaviateConsistencyToken *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -226,6 +232,17 @@ func (o *ObjectsClassGetParams) SetTenant(tenant *string) {
 	o.Tenant = tenant
 }
 
+// WithXWeaviateConsistencyToken adds the xWeaviateConsistencyToken to the objects class get params
+func (o *ObjectsClassGetParams) WithXWeaviateConsistencyToken(xWeaviateConsistencyToken *string) *ObjectsClassGetParams {
+	o.SetXWeaviateConsistencyToken(xWeaviateConsistencyToken)
+	return o
+}
+
+// SetXWeaviateConsistencyToken adds the xWeaviateConsistencyToken to the objects class get params
+func (o *ObjectsClassGetParams) SetXWeaviateConsistencyToken(xWeaviateConsistencyToken *string) {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ObjectsClassGetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -312,6 +329,14 @@ func (o *ObjectsClassGetParams) WriteToRequest(r runtime.ClientRequest, reg strf
 		}
 	}
 
+	if o.XWeaviateConsistencyToken != nil {
+
+		// header param X-Weaviate-Consistency-Token
+		if err := r.SetHeaderParam("X-Weaviate-Consistency-Token", *o.XWeaviateConsistencyToken); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
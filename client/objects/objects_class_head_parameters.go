@@ -98,6 +98,12 @@ type ObjectsClassHeadParams struct {
 	*/
 	Tenant *string
 
+	/* XWeaviateConsistencyToken.
+
+	   Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.
+	*/
+	XWeaviateConsistencyToken *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -195,6 +201,17 @@ func (o *ObjectsClassHeadParams) SetTenant(tenant *string) {
 	o.Tenant = tenant
 }
 
+// WithXWeaviateConsistencyToken adds the xWeaviateConsistencyToken to the objects class head params
+func (o *ObjectsClassHeadParams) WithXWeaviateConsistencyToken(xWeaviateConsistencyToken *string) *ObjectsClassHeadParams {
+	o.SetXWeaviateConsistencyToken(xWeaviateConsistencyToken)
+	return o
+}
+
+// SetXWeaviateConsistencyToken adds the xWeaviateConsistencyToken to the objects class head params
+func (o *ObjectsClassHeadParams) SetXWeaviateConsistencyToken(xWeaviateConsistencyToken *string) {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ObjectsClassHeadParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -247,6 +264,14 @@ func (o *ObjectsClassHeadParams) WriteToRequest(r runtime.ClientRequest, reg str
 		}
 	}
 
+	if o.XWeaviateConsistencyToken != nil {
+
+		// header param X-Weaviate-Consistency-Token
+		if err := r.SetHeaderParam("X-Weaviate-Consistency-Token", *o.XWeaviateConsistencyToken); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -25,6 +25,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -100,6 +101,20 @@ type ObjectsClassPatchParams struct {
 	*/
 	ID strfmt.UUID
 
+	/* IfMatch.
+
+	   Only apply the write if the stored object was last updated at exactly this unix time in milliseconds (its lastUpdateTimeUnix). 0 requires that the object does not exist.
+
+	   Format: int64
+	*/
+	IfMatch *int64
+
+	/* XWeaviateConsistencyToken.
+
+	   Consistency token returned by a previous write. Reads and writes reflect at least the writes recorded in it.
+	*/
+	XWeaviateConsistencyToken *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -197,6 +212,28 @@ func (o *ObjectsClassPatchParams) SetID(id strfmt.UUID) {
 	o.ID = id
 }
 
+// WithIfMatch adds the ifMatch to the objects class patch params
+func (o *ObjectsClassPatchParams) WithIfMatch(ifMatch *int64) *ObjectsClassPatchParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the objects class patch params
+func (o *ObjectsClassPatchParams) SetIfMatch(ifMatch *int64) {
+	o.IfMatch = ifMatch
+}
+
+// WithXWeaviateConsistencyToken adds the xWeaviateConsistencyToken to the objects class patch params
+func (o *ObjectsClassPatchParams) WithXWeaviateConsistencyToken(xWeaviateConsistencyToken *string) *ObjectsClassPatchParams {
+	o.SetXWeaviateConsistencyToken(xWeaviateConsistencyToken)
+	return o
+}
+
+// SetXWeaviateConsistencyToken adds the xWeaviateConsistencyToken to the objects class patch params
+func (o *ObjectsClassPatchParams) SetXWeaviateConsistencyToken(xWeaviateConsistencyToken *string) {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ObjectsClassPatchParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -237,6 +274,22 @@ func (o *ObjectsClassPatchParams) WriteToRequest(r runtime.ClientRequest, reg st
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", swag.FormatInt64(*o.IfMatch)); err != nil {
+			return err
+		}
+	}
+
+	if o.XWeaviateConsistencyToken != nil {
+
+		// header param X-Weaviate-Consistency-Token
+		if err := r.SetHeaderParam("X-Weaviate-Consistency-Token", *o.XWeaviateConsistencyToken); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -64,6 +64,12 @@ func (o *ObjectsClassPatchReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewObjectsClassPatchPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewObjectsClassPatchUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -92,6 +98,9 @@ ObjectsClassPatchNoContent describes a response with status code 204, with defau
 Successfully applied. No content provided.
 */
 type ObjectsClassPatchNoContent struct {
+	/* Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes.
+	 */
+	XWeaviateConsistencyToken string
 }
 
 // IsSuccess returns true when this objects class patch no content response has a 2xx status code
@@ -134,6 +143,13 @@ func (o *ObjectsClassPatchNoContent) String() string {
 
 func (o *ObjectsClassPatchNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Weaviate-Consistency-Token
+	hdrXWeaviateConsistencyToken := response.GetHeader("X-Weaviate-Consistency-Token")
+
+	if hdrXWeaviateConsistencyToken != "" {
+		o.XWeaviateConsistencyToken = hdrXWeaviateConsistencyToken
+	}
+
 	return nil
 }
 
@@ -385,6 +401,74 @@ func (o *ObjectsClassPatchNotFound) readResponse(response runtime.ClientResponse
 	return nil
 }
 
+// NewObjectsClassPatchPreconditionFailed creates a ObjectsClassPatchPreconditionFailed with default headers values
+func NewObjectsClassPatchPreconditionFailed() *ObjectsClassPatchPreconditionFailed {
+	return &ObjectsClassPatchPreconditionFailed{}
+}
+
+/*
+ObjectsClassPatchPreconditionFailed describes a response with status code 412, with default header values.
+
+The precondition given in the If-Match header is not met, the object has been modified or deleted in the meantime.
+*/
+type ObjectsClassPatchPreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects class patch precondition failed response has a 2xx status code
+func (o *ObjectsClassPatchPreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects class patch precondition failed response has a 3xx status code
+func (o *ObjectsClassPatchPreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects class patch precondition failed response has a 4xx status code
+func (o *ObjectsClassPatchPreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects class patch precondition failed response has a 5xx status code
+func (o *ObjectsClassPatchPreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects class patch precondition failed response a status code equal to that given
+func (o *ObjectsClassPatchPreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the objects class patch precondition failed response
+func (o *ObjectsClassPatchPreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *ObjectsClassPatchPreconditionFailed) Error() string {
+	return fmt.Sprintf("[PATCH /objects/{className}/{id}][%d] objectsClassPatchPreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsClassPatchPreconditionFailed) String() string {
+	return fmt.Sprintf("[PATCH /objects/{className}/{id}][%d] objectsClassPatchPreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsClassPatchPreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassPatchPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassPatchUnprocessableEntity creates a ObjectsClassPatchUnprocessableEntity with default headers values
 func NewObjectsClassPatchUnprocessableEntity() *ObjectsClassPatchUnprocessableEntity {
 	return &ObjectsClassPatchUnprocessableEntity{}
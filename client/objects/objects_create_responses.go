@@ -86,6 +86,11 @@ ObjectsCreateOK describes a response with status code 200, with default header v
 Object created.
 */
 type ObjectsCreateOK struct {
+
+	/* Opaque token recording the writes of this request. Pass it with subsequent requests to read your own writes.
+	 */
+	XWeaviateConsistencyToken string
+
 	Payload *models.Object
 }
 
@@ -133,6 +138,13 @@ func (o *ObjectsCreateOK) GetPayload() *models.Object {
 
 func (o *ObjectsCreateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Weaviate-Consistency-Token
+	hdrXWeaviateConsistencyToken := response.GetHeader("X-Weaviate-Consistency-Token")
+
+	if hdrXWeaviateConsistencyToken != "" {
+		o.XWeaviateConsistencyToken = hdrXWeaviateConsistencyToken
+	}
+
 	o.Payload = new(models.Object)
 
 	// response payload
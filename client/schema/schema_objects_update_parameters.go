@@ -25,6 +25,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -80,6 +81,12 @@ type SchemaObjectsUpdateParams struct {
 	// ObjectClass.
 	ObjectClass *models.Class
 
+	/* RebuildVectorIndex.
+
+	   If true, changes of the vector index config which can only be applied by rebuilding the vector index, such as a different index type, distance or compression, are applied by rebuilding it in the background. Such changes are rejected otherwise.
+	*/
+	RebuildVectorIndex *bool
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -155,6 +162,17 @@ func (o *SchemaObjectsUpdateParams) SetObjectClass(objectClass *models.Class) {
 	o.ObjectClass = objectClass
 }
 
+// WithRebuildVectorIndex adds the rebuildVectorIndex to the schema objects update params
+func (o *SchemaObjectsUpdateParams) WithRebuildVectorIndex(rebuildVectorIndex *bool) *SchemaObjectsUpdateParams {
+	o.SetRebuildVectorIndex(rebuildVectorIndex)
+	return o
+}
+
+// SetRebuildVectorIndex adds the rebuildVectorIndex to the schema objects update params
+func (o *SchemaObjectsUpdateParams) SetRebuildVectorIndex(rebuildVectorIndex *bool) {
+	o.RebuildVectorIndex = rebuildVectorIndex
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *SchemaObjectsUpdateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -173,6 +191,23 @@ func (o *SchemaObjectsUpdateParams) WriteToRequest(r runtime.ClientRequest, reg
 		}
 	}
 
+	if o.RebuildVectorIndex != nil {
+
+		// query param rebuildVectorIndex
+		var qrRebuildVectorIndex bool
+
+		if o.RebuildVectorIndex != nil {
+			qrRebuildVectorIndex = *o.RebuildVectorIndex
+		}
+		qRebuildVectorIndex := swag.FormatBool(qrRebuildVectorIndex)
+		if qRebuildVectorIndex != "" {
+
+			if err := r.SetQueryParam("rebuildVectorIndex", qRebuildVectorIndex); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -12,6 +12,7 @@
 package api
 
 import (
+	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/versioned"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -31,10 +32,43 @@ type AddPropertyRequest struct {
 	Properties []*models.Property
 }
 
+type DeletePropertyRequest struct {
+	Name string
+}
+
 type DeleteClassRequest struct {
 	Name string
 }
 
+type CreateAliasRequest struct {
+	Alias string
+	Class string
+}
+
+type ReplaceAliasRequest struct {
+	Alias string
+	Class string
+}
+
+type DeleteAliasRequest struct {
+	Alias string
+}
+
+type PutBackupScheduleRequest struct {
+	Schedule backup.Schedule
+	// Create fails if the schedule exists, otherwise it must exist
+	Create bool
+}
+
+type DeleteBackupScheduleRequest struct {
+	Name string
+}
+
+type RecordBackupScheduleRunRequest struct {
+	Name string
+	Run  backup.ScheduleRun
+}
+
 type UpdateShardStatusRequest struct {
 	Class, Shard, Status string
 	SchemaVersion        uint64
@@ -22,6 +22,7 @@ import (
 	cmd "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -107,6 +108,125 @@ func (s *Raft) AddProperty(ctx context.Context, class string, props ...*models.P
 	return s.Execute(ctx, command)
 }
 
+func (s *Raft) DeleteProperty(ctx context.Context, class, name string) (uint64, error) {
+	if class == "" || name == "" {
+		return 0, fmt.Errorf("empty property or empty class name : %w", schema.ErrBadRequest)
+	}
+	req := cmd.DeletePropertyRequest{Name: name}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_DELETE_PROPERTY,
+		Class:      class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
+func (s *Raft) CreateAlias(ctx context.Context, alias, class string) (uint64, error) {
+	if alias == "" || class == "" {
+		return 0, fmt.Errorf("empty alias or empty class name : %w", schema.ErrBadRequest)
+	}
+	req := cmd.CreateAliasRequest{Alias: alias, Class: class}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_CREATE_ALIAS,
+		Class:      class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
+// ReplaceAlias points an existing alias to another class in a single step
+func (s *Raft) ReplaceAlias(ctx context.Context, alias, class string) (uint64, error) {
+	if alias == "" || class == "" {
+		return 0, fmt.Errorf("empty alias or empty class name : %w", schema.ErrBadRequest)
+	}
+	req := cmd.ReplaceAliasRequest{Alias: alias, Class: class}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_REPLACE_ALIAS,
+		Class:      class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
+func (s *Raft) DeleteAlias(ctx context.Context, alias string) (uint64, error) {
+	if alias == "" {
+		return 0, fmt.Errorf("empty alias : %w", schema.ErrBadRequest)
+	}
+	req := cmd.DeleteAliasRequest{Alias: alias}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_DELETE_ALIAS,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
+// PutBackupSchedule creates the schedule if create is true, otherwise it
+// replaces an existing schedule with the same name
+func (s *Raft) PutBackupSchedule(ctx context.Context, sched backup.Schedule, create bool) (uint64, error) {
+	if sched.Name == "" {
+		return 0, fmt.Errorf("empty backup schedule name : %w", schema.ErrBadRequest)
+	}
+	req := cmd.PutBackupScheduleRequest{Schedule: sched, Create: create}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_PUT_BACKUP_SCHEDULE,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
+func (s *Raft) DeleteBackupSchedule(ctx context.Context, name string) (uint64, error) {
+	if name == "" {
+		return 0, fmt.Errorf("empty backup schedule name : %w", schema.ErrBadRequest)
+	}
+	req := cmd.DeleteBackupScheduleRequest{Name: name}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_DELETE_BACKUP_SCHEDULE,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
+// RecordBackupScheduleRun adds or updates a run of the schedule called name
+func (s *Raft) RecordBackupScheduleRun(ctx context.Context, name string, run backup.ScheduleRun) (uint64, error) {
+	if name == "" || run.BackupID == "" {
+		return 0, fmt.Errorf("empty backup schedule name or backup id : %w", schema.ErrBadRequest)
+	}
+	req := cmd.RecordBackupScheduleRunRequest{Name: name, Run: run}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_RECORD_BACKUP_SCHEDULE_RUN,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
 func (s *Raft) UpdateShardStatus(ctx context.Context, class, shard, status string) (uint64, error) {
 	if class == "" || shard == "" {
 		return 0, fmt.Errorf("empty class or shard : %w", schema.ErrBadRequest)
@@ -203,6 +323,10 @@ func (s *Raft) Execute(ctx context.Context, req *cmd.ApplyRequest) (uint64, erro
 		))
 	defer t.ObserveDuration()
 
+	if req.User == "" {
+		req.User = types.UserFromContext(ctx)
+	}
+
 	var schemaVersion uint64
 	err := backoff.Retry(func() error {
 		var err error
@@ -13,10 +13,14 @@ package cluster
 
 import (
 	"context"
+	"fmt"
 	"slices"
 
+	"github.com/hashicorp/raft"
 	"github.com/sirupsen/logrus"
 	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/models"
 )
 
 // LeaderWithID is used to return the current leader address and ID of the cluster.
@@ -26,6 +30,11 @@ func (s *Raft) LeaderWithID() (string, string) {
 	return string(addr), string(id)
 }
 
+// IsLeader returns true if this node is the current leader of the cluster
+func (s *Raft) IsLeader() bool {
+	return s.store.IsLeader()
+}
+
 // StorageCandidates return the nodes in the raft configuration or memberlist storage nodes
 // based on the current configuration of the cluster if it does have  MetadataVoterOnly nodes.
 func (s *Raft) StorageCandidates() []string {
@@ -99,3 +108,75 @@ func (s *Raft) Stats() map[string]any {
 	s.log.Debug("membership.stats")
 	return s.store.Stats()
 }
+
+// RaftStatus returns the raft state of the cluster as seen by this node.
+func (s *Raft) RaftStatus() (*models.RaftStatus, error) {
+	return s.store.RaftStatus()
+}
+
+// RemovePeer removes the given peer from the raft configuration. Unlike Remove,
+// it first checks the latest configuration so that unknown peers are reported,
+// the voters left can still form a quorum and the current leader is never
+// removed from under the cluster.
+func (s *Raft) RemovePeer(ctx context.Context, id string) error {
+	servers, err := s.store.Peers()
+	if err != nil {
+		return err
+	}
+	if err := checkPeerRemoval(servers, id); err != nil {
+		return err
+	}
+	if _, leaderID := s.store.LeaderWithID(); string(leaderID) == id {
+		return fmt.Errorf("%w: %q", types.ErrRemoveLeader, id)
+	}
+	return s.Remove(ctx, id)
+}
+
+// checkPeerRemoval checks that the peer is part of the configuration and that
+// the voters left after removing it are at least a quorum of the current
+// voters. Non-voters can always be removed.
+func checkPeerRemoval(servers []raft.Server, id string) error {
+	var peer *raft.Server
+	voters := 0
+	for i := range servers {
+		if string(servers[i].ID) == id {
+			peer = &servers[i]
+		}
+		if servers[i].Suffrage == raft.Voter {
+			voters++
+		}
+	}
+	if peer == nil {
+		return fmt.Errorf("%w: %q", types.ErrPeerNotFound, id)
+	}
+	if peer.Suffrage != raft.Voter {
+		return nil
+	}
+	if voters <= 1 {
+		return fmt.Errorf("%w: %q", types.ErrRemoveLastVoter, id)
+	}
+	if quorum := voters/2 + 1; voters-1 < quorum {
+		return fmt.Errorf("%w: %d of %d voters would be left", types.ErrRemoveQuorum, voters-1, voters)
+	}
+	return nil
+}
+
+// PromotePeer turns the given non-voter into a voter, keeping its address.
+// The change is forwarded to the leader if this node isn't the leader.
+func (s *Raft) PromotePeer(ctx context.Context, id string) error {
+	s.log.WithField("id", id).Debug("membership.promote")
+	peer, err := s.store.Peer(id)
+	if err != nil {
+		return err
+	}
+	if peer.Suffrage == raft.Voter {
+		return fmt.Errorf("%w: %q", types.ErrPeerAlreadyVoter, id)
+	}
+	return s.Join(ctx, id, string(peer.Address), true)
+}
+
+// TakeSnapshot forces a raft snapshot of this node's state.
+func (s *Raft) TakeSnapshot() (*models.RaftSnapshot, error) {
+	s.log.Debug("membership.snapshot")
+	return s.store.TakeSnapshot()
+}
@@ -43,6 +43,7 @@ func TestRaftEndpoints(t *testing.T) {
 	m.indexer.On("UpdateClass", Anything).Return(nil)
 	m.indexer.On("DeleteClass", Anything).Return(nil)
 	m.indexer.On("AddProperty", Anything, Anything).Return(nil)
+	m.indexer.On("DeleteProperty", Anything, Anything).Return(nil)
 	m.indexer.On("UpdateShardStatus", Anything).Return(nil)
 	m.indexer.On("AddTenants", Anything, Anything).Return(nil)
 	m.indexer.On("UpdateTenants", Anything, Anything).Return(nil)
@@ -226,6 +227,16 @@ func TestRaftEndpoints(t *testing.T) {
 	info.Properties = 1
 	assert.Equal(t, info, schemaReader.ClassInfo("C"))
 
+	// DeleteProperty
+	_, err = srv.DeleteProperty(ctx, "C", "")
+	assert.ErrorIs(t, err, schema.ErrBadRequest)
+	_, err = srv.AddProperty(ctx, "C", &models.Property{Name: "P2"})
+	assert.Nil(t, err)
+	version, err = srv.DeleteProperty(ctx, "C", "P2")
+	assert.Nil(t, err)
+	info.ClassVersion = version
+	assert.Equal(t, info, schemaReader.ClassInfo("C"))
+
 	// UpdateStatus
 	_, err = srv.UpdateShardStatus(ctx, "", "A", "ACTIVE")
 	assert.ErrorIs(t, err, schema.ErrBadRequest)
@@ -484,3 +495,114 @@ func TestRaftQueryDataVersion(t *testing.T) {
 		DataVersion:    &one,
 	}}, getTenantsAll2)
 }
+
+func TestRaftMembershipOperations(t *testing.T) {
+	ctx := context.Background()
+	m := NewMockStore(t, "Node-1", utils.MustGetFreeTCPPort())
+	addr := fmt.Sprintf("%s:%d", m.cfg.Host, m.cfg.RaftPort)
+	m.indexer.On("Open", Anything).Return(nil)
+	m.indexer.On("Close", Anything).Return(nil)
+	m.indexer.On("AddClass", Anything).Return(nil)
+	m.indexer.On("TriggerSchemaUpdateCallbacks").Return()
+	m.parser.On("ParseClass", mock.Anything).Return(nil)
+
+	srv := NewRaft(mocks.NewMockNodeSelector(), m.store, nil)
+
+	// NotOpen
+	_, err := srv.RaftStatus()
+	assert.ErrorIs(t, err, types.ErrNotOpen)
+	_, err = srv.TakeSnapshot()
+	assert.ErrorIs(t, err, types.ErrNotOpen)
+
+	defer srv.Close(ctx)
+	assert.Nil(t, srv.Open(ctx, m.indexer))
+	assert.Nil(t, srv.store.Notify(m.cfg.NodeID, addr))
+	assert.Nil(t, srv.WaitUntilDBRestored(ctx, time.Second*1, make(chan struct{})))
+	assert.True(t, tryNTimesWithWait(10, time.Millisecond*200, srv.Ready))
+	tryNTimesWithWait(20, time.Millisecond*100, srv.store.IsLeader)
+	assert.True(t, srv.store.IsLeader())
+
+	_, err = srv.AddClass(ctx, &models.Class{Class: "C"}, &sharding.State{})
+	assert.Nil(t, err)
+
+	// Status
+	status, err := srv.RaftStatus()
+	assert.Nil(t, err)
+	assert.Equal(t, "Node-1", status.NodeID)
+	assert.Equal(t, "Leader", status.State)
+	assert.Equal(t, "Node-1", status.LeaderID)
+	assert.GreaterOrEqual(t, status.Term, uint64(1))
+	assert.GreaterOrEqual(t, status.LastIndex, status.CommitIndex)
+	assert.Nil(t, status.Snapshot)
+	assert.Equal(t, []*models.RaftPeer{{
+		ID:       "Node-1",
+		Address:  addr,
+		Suffrage: models.RaftPeerSuffrageVOTER,
+		Leader:   true,
+	}}, status.Peers)
+
+	// Snapshot
+	snapshot, err := srv.TakeSnapshot()
+	assert.Nil(t, err)
+	assert.Equal(t, status.LastIndex, snapshot.Index)
+	again, err := srv.TakeSnapshot()
+	assert.Nil(t, err)
+	assert.Equal(t, snapshot.Index, again.Index)
+	status, err = srv.RaftStatus()
+	assert.Nil(t, err)
+	assert.Equal(t, again, status.Snapshot)
+
+	// Promote and remove with safety checks
+	assert.ErrorIs(t, srv.PromotePeer(ctx, "Node-1"), types.ErrPeerAlreadyVoter)
+	assert.ErrorIs(t, srv.PromotePeer(ctx, "Node-X"), types.ErrPeerNotFound)
+	assert.ErrorIs(t, srv.RemovePeer(ctx, "Node-1"), types.ErrRemoveLastVoter)
+	assert.ErrorIs(t, srv.RemovePeer(ctx, "Node-X"), types.ErrPeerNotFound)
+
+	assert.Nil(t, srv.Join(ctx, "Node-2", "127.0.0.1:1", false))
+	status, err = srv.RaftStatus()
+	assert.Nil(t, err)
+	assert.Len(t, status.Peers, 2)
+	assert.Equal(t, models.RaftPeerSuffrageNONVOTER, status.Peers[1].Suffrage)
+	assert.Nil(t, srv.RemovePeer(ctx, "Node-2"))
+	status, err = srv.RaftStatus()
+	assert.Nil(t, err)
+	assert.Len(t, status.Peers, 1)
+}
+
+func TestCheckPeerRemoval(t *testing.T) {
+	servers := func(voters, nonVoters int) []raft.Server {
+		var out []raft.Server
+		for i := 0; i < voters; i++ {
+			out = append(out, raft.Server{ID: raft.ServerID(fmt.Sprintf("voter-%d", i)), Suffrage: raft.Voter})
+		}
+		for i := 0; i < nonVoters; i++ {
+			out = append(out, raft.Server{ID: raft.ServerID(fmt.Sprintf("nonvoter-%d", i)), Suffrage: raft.Nonvoter})
+		}
+		return out
+	}
+
+	tests := []struct {
+		name    string
+		servers []raft.Server
+		id      string
+		err     error
+	}{
+		{name: "unknown peer", servers: servers(3, 0), id: "voter-9", err: types.ErrPeerNotFound},
+		{name: "last voter", servers: servers(1, 1), id: "voter-0", err: types.ErrRemoveLastVoter},
+		{name: "voter of two", servers: servers(2, 0), id: "voter-1", err: types.ErrRemoveQuorum},
+		{name: "voter of three", servers: servers(3, 0), id: "voter-2"},
+		{name: "voter of four", servers: servers(4, 0), id: "voter-3"},
+		{name: "non-voters don't count", servers: servers(2, 3), id: "voter-1", err: types.ErrRemoveQuorum},
+		{name: "non-voter", servers: servers(1, 1), id: "nonvoter-0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkPeerRemoval(tt.servers, tt.id)
+			if tt.err == nil {
+				assert.Nil(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
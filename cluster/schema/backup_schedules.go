@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"errors"
+	"slices"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+// maxBackupScheduleRuns bounds the runs kept per schedule. Runs of backups
+// which still exist are never dropped since retention depends on them.
+const maxBackupScheduleRuns = 100
+
+var (
+	ErrBackupScheduleExists   = errors.New("backup schedule already exists")
+	ErrBackupScheduleNotFound = errors.New("backup schedule not found")
+)
+
+// BackupSchedule returns a copy of the schedule called name and its runs
+func (s *schema) BackupSchedule(name string) (backup.ScheduleState, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	st, ok := s.BackupSchedules[name]
+	if !ok {
+		return backup.ScheduleState{}, false
+	}
+	return copyScheduleState(st), true
+}
+
+// ListBackupSchedules returns a copy of all schedules ordered by name
+func (s *schema) ListBackupSchedules() []backup.ScheduleState {
+	s.RLock()
+	defer s.RUnlock()
+	res := make([]backup.ScheduleState, 0, len(s.BackupSchedules))
+	for _, st := range s.BackupSchedules {
+		res = append(res, copyScheduleState(st))
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Schedule.Name < res[j].Schedule.Name })
+	return res
+}
+
+// putBackupSchedule creates a schedule if create is true and replaces an
+// existing one otherwise. The runs of a replaced schedule are kept so that
+// its retention rules still apply to them.
+func (s *schema) putBackupSchedule(sched backup.Schedule, create bool) error {
+	s.Lock()
+	defer s.Unlock()
+	st, ok := s.BackupSchedules[sched.Name]
+	switch {
+	case create && ok:
+		return ErrBackupScheduleExists
+	case !create && !ok:
+		return ErrBackupScheduleNotFound
+	case ok:
+		st.Schedule = sched
+	default:
+		s.BackupSchedules[sched.Name] = &backup.ScheduleState{Schedule: sched}
+	}
+	return nil
+}
+
+// deleteBackupSchedule removes the schedule, the backups it created are left
+// in the backend
+func (s *schema) deleteBackupSchedule(name string) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.BackupSchedules, name)
+}
+
+// recordBackupScheduleRun adds a run or updates the run with the same backup id
+func (s *schema) recordBackupScheduleRun(name string, run backup.ScheduleRun) error {
+	s.Lock()
+	defer s.Unlock()
+	st, ok := s.BackupSchedules[name]
+	if !ok {
+		return ErrBackupScheduleNotFound
+	}
+	i := slices.IndexFunc(st.Runs, func(r backup.ScheduleRun) bool { return r.BackupID == run.BackupID })
+	if i >= 0 {
+		st.Runs[i] = run
+	} else {
+		st.Runs = append(st.Runs, run)
+		sort.SliceStable(st.Runs, func(i, j int) bool { return st.Runs[i].StartedAt.Before(st.Runs[j].StartedAt) })
+	}
+
+	// drop the oldest runs whose backups are gone
+	for n := len(st.Runs) - maxBackupScheduleRuns; n > 0; n-- {
+		i := slices.IndexFunc(st.Runs, func(r backup.ScheduleRun) bool { return !r.Live() })
+		if i < 0 {
+			break
+		}
+		st.Runs = slices.Delete(st.Runs, i, i+1)
+	}
+	return nil
+}
+
+func copyScheduleState(st *backup.ScheduleState) backup.ScheduleState {
+	res := backup.ScheduleState{Schedule: st.Schedule, Runs: slices.Clone(st.Runs)}
+	res.Schedule.Include = slices.Clone(st.Schedule.Include)
+	res.Schedule.Exclude = slices.Clone(st.Schedule.Exclude)
+	return res
+}
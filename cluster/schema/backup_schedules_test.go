@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/usecases/fakes"
+)
+
+func TestBackupSchedules(t *testing.T) {
+	var (
+		sc    = NewSchema("N1", fakes.NewMockSchemaExecutor())
+		start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		sched = backup.Schedule{Name: "nightly", Cron: "0 2 * * *", Backend: "s3"}
+	)
+
+	require.Nil(t, sc.putBackupSchedule(sched, true))
+	assert.ErrorIs(t, sc.putBackupSchedule(sched, true), ErrBackupScheduleExists)
+	assert.ErrorIs(t, sc.putBackupSchedule(backup.Schedule{Name: "other"}, false), ErrBackupScheduleNotFound)
+	assert.ErrorIs(t, sc.recordBackupScheduleRun("other", backup.ScheduleRun{BackupID: "b"}), ErrBackupScheduleNotFound)
+
+	run := backup.ScheduleRun{BackupID: "nightly-1", StartedAt: start, Status: backup.Started}
+	require.Nil(t, sc.recordBackupScheduleRun("nightly", run))
+	run.Status = backup.Success
+	require.Nil(t, sc.recordBackupScheduleRun("nightly", run))
+
+	// updating the schedule keeps its runs
+	sched.Retention.KeepLast = 3
+	require.Nil(t, sc.putBackupSchedule(sched, false))
+	st, ok := sc.BackupSchedule("nightly")
+	require.True(t, ok)
+	assert.Equal(t, 3, st.Schedule.Retention.KeepLast)
+	require.Len(t, st.Runs, 1)
+	assert.Equal(t, backup.Success, st.Runs[0].Status)
+
+	// returned states are copies
+	st.Runs[0].Status = backup.Failed
+	st, _ = sc.BackupSchedule("nightly")
+	assert.Equal(t, backup.Success, st.Runs[0].Status)
+
+	t.Run("snapshot", func(t *testing.T) {
+		parser := fakes.NewMockParser()
+		parser.On("ParseClass", mock.Anything).Return(nil)
+
+		sink := &MockSnapshotSink{}
+		require.Nil(t, sc.Persist(sink))
+		sc2 := NewSchema("N1", fakes.NewMockSchemaExecutor())
+		require.Nil(t, sc2.Restore(sink, parser))
+		assert.Equal(t, sc.ListBackupSchedules(), sc2.ListBackupSchedules())
+	})
+
+	sc.deleteBackupSchedule("nightly")
+	assert.Empty(t, sc.ListBackupSchedules())
+}
+
+func TestBackupScheduleRunsTruncation(t *testing.T) {
+	sc := NewSchema("N1", fakes.NewMockSchemaExecutor())
+	require.Nil(t, sc.putBackupSchedule(backup.Schedule{Name: "s"}, true))
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < maxBackupScheduleRuns+10; i++ {
+		run := backup.ScheduleRun{
+			BackupID:  fmt.Sprintf("s-%d", i),
+			StartedAt: start.Add(time.Duration(i) * time.Hour),
+			Status:    backup.Success,
+		}
+		if i%2 == 0 {
+			run.DeletedAt = run.StartedAt
+		}
+		require.Nil(t, sc.recordBackupScheduleRun("s", run))
+	}
+
+	st, _ := sc.BackupSchedule("s")
+	require.Len(t, st.Runs, maxBackupScheduleRuns)
+	// the oldest deleted backups were dropped, existing ones are all kept
+	assert.Equal(t, "s-1", st.Runs[0].BackupID)
+	for i := 1; i < len(st.Runs); i++ {
+		assert.True(t, st.Runs[i-1].StartedAt.Before(st.Runs[i].StartedAt))
+	}
+}
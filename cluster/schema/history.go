@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// maxHistoryEntries bounds the number of changes kept in the schema history.
+// Older changes are folded into the base state of the history, which keeps the
+// schema reconstructable for every version still in the history.
+const maxHistoryEntries = 1000
+
+// ErrHistoryTruncated is returned when the schema is requested at a version
+// older than the oldest version kept in the history
+var ErrHistoryTruncated = errors.New("schema history does not reach back to this version")
+
+// HistoryEntry describes a single change of a class definition
+type HistoryEntry struct {
+	// Version is the raft index of the change
+	Version uint64 `json:"version"`
+	// Time is when the change was appended to the raft log by the leader
+	Time time.Time `json:"time"`
+	// User is the user who issued the change, empty if unknown
+	User string `json:"user,omitempty"`
+	// Type is the command type without its "TYPE_" prefix, e.g. "UPDATE_CLASS"
+	Type  string `json:"type"`
+	Class string `json:"class"`
+	// State is the class definition after the change, nil if it was deleted
+	State *models.Class `json:"state,omitempty"`
+}
+
+// history records class definition changes. It is part of the raft state and
+// therefore identical on all nodes and persisted with every snapshot.
+type history struct {
+	// BaseVersion is the version as of which Base holds the class definitions.
+	// The schema can't be reconstructed for versions before it.
+	BaseVersion uint64                   `json:"base_version"`
+	Base        map[string]*models.Class `json:"base,omitempty"`
+	Entries     []HistoryEntry           `json:"entries,omitempty"`
+}
+
+// historyTypes are the commands which change class definitions
+var historyTypes = map[command.ApplyRequest_Type]bool{
+	command.ApplyRequest_TYPE_ADD_CLASS:       true,
+	command.ApplyRequest_TYPE_RESTORE_CLASS:   true,
+	command.ApplyRequest_TYPE_UPDATE_CLASS:    true,
+	command.ApplyRequest_TYPE_DELETE_CLASS:    true,
+	command.ApplyRequest_TYPE_ADD_PROPERTY:    true,
+	command.ApplyRequest_TYPE_DELETE_PROPERTY: true,
+}
+
+// newHistory returns a history whose base is the given state of classes
+func newHistory(classes map[string]*metaClass) history {
+	h := history{Base: make(map[string]*models.Class, len(classes))}
+	for name, meta := range classes {
+		h.Base[name] = copyClass(&meta.Class)
+		h.BaseVersion = max(h.BaseVersion, meta.ClassVersion)
+	}
+	return h
+}
+
+// record appends e, entries already contained in the history are skipped as
+// they might be re-applied when the raft log is replayed
+func (h *history) record(e HistoryEntry) {
+	if n := len(h.Entries); e.Version <= h.BaseVersion || (n > 0 && e.Version <= h.Entries[n-1].Version) {
+		return
+	}
+	h.Entries = append(h.Entries, e)
+	if len(h.Entries) <= maxHistoryEntries {
+		return
+	}
+
+	oldest := h.Entries[0]
+	if h.Base == nil {
+		h.Base = make(map[string]*models.Class)
+	}
+	if oldest.State == nil {
+		delete(h.Base, oldest.Class)
+	} else {
+		h.Base[oldest.Class] = oldest.State
+	}
+	h.BaseVersion = oldest.Version
+	h.Entries[0] = HistoryEntry{}
+	h.Entries = h.Entries[1:]
+}
+
+// list returns the entries of class, or all entries if class is empty, in the
+// order they were applied
+func (h *history) list(class string) []HistoryEntry {
+	res := make([]HistoryEntry, 0, len(h.Entries))
+	for _, e := range h.Entries {
+		if class == "" || e.Class == class {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+// at returns all class definitions as they were after the change with the given
+// version had been applied
+func (h *history) at(version uint64) (models.Schema, error) {
+	if version < h.BaseVersion {
+		return models.Schema{}, fmt.Errorf("%w: version %d, oldest version %d",
+			ErrHistoryTruncated, version, h.BaseVersion)
+	}
+
+	classes := make(map[string]*models.Class, len(h.Base))
+	for name, cls := range h.Base {
+		classes[name] = cls
+	}
+	for _, e := range h.Entries {
+		if e.Version > version {
+			break
+		}
+		if e.State == nil {
+			delete(classes, e.Class)
+		} else {
+			classes[e.Class] = e.State
+		}
+	}
+
+	res := models.Schema{Classes: make([]*models.Class, 0, len(classes))}
+	for _, cls := range classes {
+		res.Classes = append(res.Classes, copyClass(cls))
+	}
+	sort.Slice(res.Classes, func(i, j int) bool {
+		return res.Classes[i].Class < res.Classes[j].Class
+	})
+	return res, nil
+}
+
+// copyClass returns a deep copy of cls. Class definitions are modified in place
+// by later changes, hence the history can't share them with the schema.
+func copyClass(cls *models.Class) *models.Class {
+	var cp models.Class
+	if b, err := json.Marshal(cls); err != nil || json.Unmarshal(b, &cp) != nil {
+		cp = *cls
+	}
+	return &cp
+}
+
+// recordChange adds the change cmd made to the class definitions to the history
+func (s *schema) recordChange(cmd *command.ApplyRequest, at time.Time) {
+	if !historyTypes[cmd.Type] {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+
+	e := HistoryEntry{
+		Version: cmd.Version,
+		Time:    at,
+		User:    cmd.User,
+		Type:    strings.TrimPrefix(cmd.Type.String(), "TYPE_"),
+		Class:   cmd.Class,
+	}
+	if meta := s.Classes[cmd.Class]; meta != nil {
+		meta.RLock()
+		e.State = copyClass(&meta.Class)
+		meta.RUnlock()
+	}
+	s.history.record(e)
+}
+
+// History returns the recorded changes of class, or of all classes if class is
+// empty, and the oldest version the schema can be reconstructed for
+func (s *schema) History(class string) ([]HistoryEntry, uint64) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.history.list(class), s.history.BaseVersion
+}
+
+// SchemaAt returns the class definitions as of the given version
+func (s *schema) SchemaAt(version uint64) (models.Schema, error) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.history.at(version)
+}
@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/fakes"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestSchemaHistory(t *testing.T) {
+	var (
+		sc  = NewSchema("N1", fakes.NewMockSchemaExecutor())
+		now = time.Now()
+		ss  = &sharding.State{Physical: map[string]sharding.Physical{}}
+		cmd = func(t command.ApplyRequest_Type, class string, v uint64) *command.ApplyRequest {
+			return &command.ApplyRequest{Type: t, Class: class, Version: v, User: "alice"}
+		}
+	)
+
+	require.Nil(t, sc.addClass(&models.Class{Class: "C"}, ss, 1))
+	sc.recordChange(cmd(command.ApplyRequest_TYPE_ADD_CLASS, "C", 1), now)
+	require.Nil(t, sc.addProperty("C", 2, &models.Property{Name: "p", DataType: []string{"text"}}))
+	sc.recordChange(cmd(command.ApplyRequest_TYPE_ADD_PROPERTY, "C", 2), now)
+	require.Nil(t, sc.addClass(&models.Class{Class: "D"}, ss, 3))
+	sc.recordChange(cmd(command.ApplyRequest_TYPE_ADD_CLASS, "D", 3), now)
+	// tenant changes are not part of the history
+	sc.recordChange(cmd(command.ApplyRequest_TYPE_ADD_TENANT, "D", 4), now)
+	sc.deleteClass("C")
+	sc.recordChange(cmd(command.ApplyRequest_TYPE_DELETE_CLASS, "C", 5), now)
+	// replayed entries are skipped
+	sc.recordChange(cmd(command.ApplyRequest_TYPE_ADD_PROPERTY, "C", 2), now)
+
+	entries, oldest := sc.History("")
+	assert.Equal(t, uint64(0), oldest)
+	require.Len(t, entries, 4)
+	assert.Equal(t, HistoryEntry{Version: 1, Time: now, User: "alice", Type: "ADD_CLASS", Class: "C", State: &models.Class{Class: "C"}}, entries[0])
+	assert.Equal(t, "DELETE_CLASS", entries[3].Type)
+	assert.Nil(t, entries[3].State)
+
+	entries, _ = sc.History("C")
+	assert.Len(t, entries, 3)
+
+	classNames := func(s models.Schema) []string {
+		names := make([]string, len(s.Classes))
+		for i, c := range s.Classes {
+			names[i] = c.Class
+		}
+		return names
+	}
+	s, err := sc.SchemaAt(1)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"C"}, classNames(s))
+	assert.Empty(t, s.Classes[0].Properties)
+
+	s, err = sc.SchemaAt(2)
+	require.Nil(t, err)
+	require.Len(t, s.Classes[0].Properties, 1)
+	assert.Equal(t, "p", s.Classes[0].Properties[0].Name)
+
+	s, err = sc.SchemaAt(4)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"C", "D"}, classNames(s))
+
+	s, err = sc.SchemaAt(5)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"D"}, classNames(s))
+
+	t.Run("snapshot", func(t *testing.T) {
+		parser := fakes.NewMockParser()
+		parser.On("ParseClass", mock.Anything).Return(nil)
+
+		sink := &MockSnapshotSink{}
+		require.Nil(t, sc.Persist(sink))
+		sc2 := NewSchema("N1", fakes.NewMockSchemaExecutor())
+		require.Nil(t, sc2.Restore(sink, parser))
+
+		entries2, _ := sc2.History("")
+		require.Len(t, entries2, 4)
+		assert.Equal(t, entries[0].Version, entries2[0].Version)
+		assert.True(t, entries2[0].Time.Equal(now))
+		s, err := sc2.SchemaAt(4)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"C", "D"}, classNames(s))
+	})
+
+	t.Run("snapshot without history", func(t *testing.T) {
+		parser := fakes.NewMockParser()
+		parser.On("ParseClass", mock.Anything).Return(nil)
+
+		buf := &bytes.Buffer{}
+		require.Nil(t, json.NewEncoder(buf).Encode(&snapshot{NodeID: "N1", Classes: sc.Classes}))
+		sc2 := NewSchema("N1", fakes.NewMockSchemaExecutor())
+		require.Nil(t, sc2.Restore(buf, parser))
+
+		entries, oldest := sc2.History("")
+		assert.Empty(t, entries)
+		assert.Equal(t, uint64(3), oldest)
+		_, err := sc2.SchemaAt(2)
+		assert.ErrorIs(t, err, ErrHistoryTruncated)
+		s, err := sc2.SchemaAt(3)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"D"}, classNames(s))
+	})
+}
+
+func TestSchemaHistoryTruncation(t *testing.T) {
+	h := history{}
+	for v := uint64(1); v <= maxHistoryEntries+2; v++ {
+		e := HistoryEntry{Version: v, Class: "C", State: &models.Class{Class: "C", Description: "v"}}
+		if v == 1 {
+			e.Class = "D"
+			e.State = &models.Class{Class: "D"}
+		}
+		h.record(e)
+	}
+
+	assert.Len(t, h.Entries, maxHistoryEntries)
+	assert.Equal(t, uint64(2), h.BaseVersion)
+	assert.Len(t, h.Base, 2)
+
+	_, err := h.at(1)
+	assert.ErrorIs(t, err, ErrHistoryTruncated)
+	s, err := h.at(2)
+	require.Nil(t, err)
+	assert.Len(t, s.Classes, 2)
+}
@@ -17,6 +17,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"reflect"
+	"time"
 
 	"github.com/hashicorp/raft"
 	"github.com/sirupsen/logrus"
@@ -115,6 +117,12 @@ func (s *SchemaManager) PreApplyFilter(req *command.ApplyRequest) error {
 	return nil
 }
 
+// RecordChange adds the class definition change applied by cmd to the schema
+// history. at is the time the change was appended to the raft log.
+func (s *SchemaManager) RecordChange(cmd *command.ApplyRequest, at time.Time) {
+	s.schema.recordChange(cmd, at)
+}
+
 func (s *SchemaManager) Load(ctx context.Context, nodeID string) error {
 	if err := s.db.Open(ctx); err != nil {
 		return err
@@ -230,6 +238,7 @@ func (s *SchemaManager) UpdateClass(cmd *command.ApplyRequest, nodeID string, sc
 		meta.Class.ReplicationConfig = u.ReplicationConfig
 		meta.Class.MultiTenancyConfig = u.MultiTenancyConfig
 		meta.Class.Description = u.Description
+		meta.Class.Properties = updatePropertyIndexSettings(meta.Class.Properties, u.Properties)
 		meta.ClassVersion = cmd.Version
 		if req.State != nil {
 			meta.Sharding = *req.State
@@ -248,6 +257,39 @@ func (s *SchemaManager) UpdateClass(cmd *command.ApplyRequest, nodeID string, sc
 	)
 }
 
+// updatePropertyIndexSettings returns the current properties with the
+// tokenization and inverted index flags taken from the updated ones. The
+// parser guarantees that nothing else differs. Changed properties are
+// copied, as the current ones might still be referenced by readers.
+func updatePropertyIndexSettings(current, updated []*models.Property) []*models.Property {
+	if len(current) != len(updated) {
+		return current
+	}
+
+	props := make([]*models.Property, len(current))
+	for i, prop := range current {
+		props[i] = prop
+		next := updated[i]
+		if prop == nil || next == nil || prop.Name != next.Name {
+			continue
+		}
+		if prop.Tokenization == next.Tokenization &&
+			reflect.DeepEqual(prop.IndexFilterable, next.IndexFilterable) &&
+			reflect.DeepEqual(prop.IndexSearchable, next.IndexSearchable) &&
+			reflect.DeepEqual(prop.IndexRangeFilters, next.IndexRangeFilters) {
+			continue
+		}
+
+		cp := *prop
+		cp.Tokenization = next.Tokenization
+		cp.IndexFilterable = next.IndexFilterable
+		cp.IndexSearchable = next.IndexSearchable
+		cp.IndexRangeFilters = next.IndexRangeFilters
+		props[i] = &cp
+	}
+	return props
+}
+
 func (s *SchemaManager) DeleteClass(cmd *command.ApplyRequest, schemaOnly bool, enableSchemaCallback bool) error {
 	var hasFrozen bool
 	tenants, err := s.schema.getTenants(cmd.Class, nil)
@@ -294,6 +336,144 @@ func (s *SchemaManager) AddProperty(cmd *command.ApplyRequest, schemaOnly bool,
 	)
 }
 
+func (s *SchemaManager) DeleteProperty(cmd *command.ApplyRequest, schemaOnly bool, enableSchemaCallback bool) error {
+	req := command.DeletePropertyRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if req.Name == "" {
+		return fmt.Errorf("%w: empty property name", ErrBadRequest)
+	}
+
+	return s.apply(
+		applyOp{
+			op:                   cmd.GetType().String(),
+			updateSchema:         func() error { return s.schema.deleteProperty(cmd.Class, cmd.Version, req.Name) },
+			updateStore:          func() error { return s.db.DeleteProperty(cmd.Class, req) },
+			schemaOnly:           schemaOnly,
+			enableSchemaCallback: enableSchemaCallback,
+		},
+	)
+}
+
+func (s *SchemaManager) CreateAlias(cmd *command.ApplyRequest, enableSchemaCallback bool) error {
+	req := command.CreateAliasRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if req.Alias == "" || req.Class == "" {
+		return fmt.Errorf("%w: empty alias or class", ErrBadRequest)
+	}
+
+	// aliases only live in the schema, there is nothing to apply to the store
+	return s.apply(
+		applyOp{
+			op:                   cmd.GetType().String(),
+			updateSchema:         func() error { return s.schema.createAlias(req.Alias, req.Class) },
+			updateStore:          func() error { return nil },
+			schemaOnly:           true,
+			enableSchemaCallback: enableSchemaCallback,
+		},
+	)
+}
+
+func (s *SchemaManager) ReplaceAlias(cmd *command.ApplyRequest, enableSchemaCallback bool) error {
+	req := command.ReplaceAliasRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if req.Alias == "" || req.Class == "" {
+		return fmt.Errorf("%w: empty alias or class", ErrBadRequest)
+	}
+
+	return s.apply(
+		applyOp{
+			op:                   cmd.GetType().String(),
+			updateSchema:         func() error { return s.schema.replaceAlias(req.Alias, req.Class) },
+			updateStore:          func() error { return nil },
+			schemaOnly:           true,
+			enableSchemaCallback: enableSchemaCallback,
+		},
+	)
+}
+
+func (s *SchemaManager) DeleteAlias(cmd *command.ApplyRequest, enableSchemaCallback bool) error {
+	req := command.DeleteAliasRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if req.Alias == "" {
+		return fmt.Errorf("%w: empty alias", ErrBadRequest)
+	}
+
+	return s.apply(
+		applyOp{
+			op:                   cmd.GetType().String(),
+			updateSchema:         func() error { s.schema.deleteAlias(req.Alias); return nil },
+			updateStore:          func() error { return nil },
+			schemaOnly:           true,
+			enableSchemaCallback: enableSchemaCallback,
+		},
+	)
+}
+
+func (s *SchemaManager) PutBackupSchedule(cmd *command.ApplyRequest) error {
+	req := command.PutBackupScheduleRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if req.Schedule.Name == "" {
+		return fmt.Errorf("%w: empty backup schedule name", ErrBadRequest)
+	}
+
+	return s.apply(
+		applyOp{
+			op:           cmd.GetType().String(),
+			updateSchema: func() error { return s.schema.putBackupSchedule(req.Schedule, req.Create) },
+			updateStore:  func() error { return nil },
+			schemaOnly:   true,
+		},
+	)
+}
+
+func (s *SchemaManager) DeleteBackupSchedule(cmd *command.ApplyRequest) error {
+	req := command.DeleteBackupScheduleRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if req.Name == "" {
+		return fmt.Errorf("%w: empty backup schedule name", ErrBadRequest)
+	}
+
+	return s.apply(
+		applyOp{
+			op:           cmd.GetType().String(),
+			updateSchema: func() error { s.schema.deleteBackupSchedule(req.Name); return nil },
+			updateStore:  func() error { return nil },
+			schemaOnly:   true,
+		},
+	)
+}
+
+func (s *SchemaManager) RecordBackupScheduleRun(cmd *command.ApplyRequest) error {
+	req := command.RecordBackupScheduleRunRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if req.Name == "" || req.Run.BackupID == "" {
+		return fmt.Errorf("%w: empty backup schedule name or backup id", ErrBadRequest)
+	}
+
+	return s.apply(
+		applyOp{
+			op:           cmd.GetType().String(),
+			updateSchema: func() error { return s.schema.recordBackupScheduleRun(req.Name, req.Run) },
+			updateStore:  func() error { return nil },
+			schemaOnly:   true,
+		},
+	)
+}
+
 func (s *SchemaManager) UpdateShardStatus(cmd *command.ApplyRequest, schemaOnly bool) error {
 	req := command.UpdateShardStatusRequest{}
 	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
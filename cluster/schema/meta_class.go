@@ -169,6 +169,25 @@ func (m *metaClass) AddProperty(v uint64, props ...*models.Property) error {
 	return nil
 }
 
+// DeleteProperty removes the property with the given name from the class.
+// Deleting a property which does not exist is a no-op so that the command
+// can be safely re-applied.
+func (m *metaClass) DeleteProperty(v uint64, name string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	props := make([]*models.Property, 0, len(m.Class.Properties))
+	for _, p := range m.Class.Properties {
+		if !strings.EqualFold(p.Name, name) {
+			props = append(props, p)
+		}
+	}
+	// update all at once to prevent race condition with concurrent readers
+	m.Class.Properties = props
+	m.ClassVersion = v
+	return nil
+}
+
 // MergeProps makes sure duplicates are not created by ignoring new props
 // with the same names as old props.
 // If property of nested type is present in both new and old slices,
@@ -233,7 +252,10 @@ func (m *metaClass) AddTenants(nodeID string, req *command.AddTenantsRequest, re
 			// TODO-RAFT: Do we want to silently continue here or raise an error ?
 			continue
 		}
-		p := sharding.Physical{Name: t.Name, Status: t.Status, BelongsToNodes: part}
+		p := sharding.Physical{
+			Name: t.Name, Status: t.Status, BelongsToNodes: part,
+			Quotas: tenantQuotasFromProto(t.Quotas), ConsistencyLevel: t.ConsistencyLevel,
+		}
 		if m.Sharding.Physical == nil {
 			m.Sharding.Physical = make(map[string]sharding.Physical, 128)
 		}
@@ -318,6 +340,18 @@ func (m *metaClass) UpdateTenants(nodeID string, req *command.UpdateTenantsReque
 			continue
 		}
 
+		// quotas are pure metadata and can be changed independently of the activity status
+		if requestTenant.Quotas != nil {
+			schemaTenant = schemaTenant.DeepCopy()
+			schemaTenant.Quotas = tenantQuotasFromProto(requestTenant.Quotas)
+			m.Sharding.Physical[schemaTenant.Name] = schemaTenant
+		}
+		if requestTenant.ConsistencyLevel != "" {
+			schemaTenant = schemaTenant.DeepCopy()
+			schemaTenant.ConsistencyLevel = requestTenant.ConsistencyLevel
+			m.Sharding.Physical[schemaTenant.Name] = schemaTenant
+		}
+
 		// validate status
 		switch schemaTenant.ActivityStatus() {
 		case req.Tenants[i].Status:
@@ -407,6 +441,18 @@ func (m *metaClass) RLockGuard(reader func(*models.Class, *sharding.State) error
 	return reader(&m.Class, &m.Sharding)
 }
 
+// tenantQuotasFromProto converts the raft representation of tenant quotas back to the model
+func tenantQuotasFromProto(q *api.TenantQuotas) *models.TenantQuotas {
+	if q == nil {
+		return nil
+	}
+	return &models.TenantQuotas{
+		MaxObjects:          q.MaxObjects,
+		MaxDiskBytes:        q.MaxDiskBytes,
+		MaxQueriesPerSecond: q.MaxQueriesPerSecond,
+	}
+}
+
 func shardProcessID(name string, action command.TenantProcessRequest_Action) string {
 	return fmt.Sprintf("%s-%s", name, action)
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/cluster/utils"
+	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -53,6 +54,40 @@ func (rs SchemaReader) ClassEqual(name string) string {
 	return rs.schema.ClassEqual(name)
 }
 
+// ResolveAlias returns the class the alias points to and "" if there is no such alias
+func (rs SchemaReader) ResolveAlias(alias string) string {
+	return rs.schema.ResolveAlias(alias)
+}
+
+// ListAliases returns all aliases mapped to their class, optionally limited to
+// the aliases of a single class
+func (rs SchemaReader) ListAliases(class string) map[string]string {
+	return rs.schema.ListAliases(class)
+}
+
+// BackupSchedule returns the backup schedule called name together with its
+// runs, and false if there is no such schedule
+func (rs SchemaReader) BackupSchedule(name string) (backup.ScheduleState, bool) {
+	return rs.schema.BackupSchedule(name)
+}
+
+// ListBackupSchedules returns all backup schedules ordered by name
+func (rs SchemaReader) ListBackupSchedules() []backup.ScheduleState {
+	return rs.schema.ListBackupSchedules()
+}
+
+// History returns the recorded class definition changes in the order they were
+// applied, optionally limited to a single class, and the oldest version the
+// schema can be reconstructed for
+func (rs SchemaReader) History(class string) ([]HistoryEntry, uint64) {
+	return rs.schema.History(class)
+}
+
+// SchemaAt returns the class definitions as of the given version
+func (rs SchemaReader) SchemaAt(version uint64) (models.Schema, error) {
+	return rs.schema.SchemaAt(version)
+}
+
 func (rs SchemaReader) MultiTenancy(class string) models.MultiTenancyConfig {
 	t := prometheus.NewTimer(monitoring.GetMetrics().SchemaReadsLocal.WithLabelValues("MultiTenancy"))
 	defer t.ObserveDuration()
@@ -19,6 +19,7 @@ import (
 
 	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	entSchema "github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/versioned"
@@ -30,6 +31,8 @@ var (
 	ErrClassExists   = errors.New("class already exists")
 	ErrClassNotFound = errors.New("class not found")
 	ErrShardNotFound = errors.New("shard not found")
+	ErrAliasExists   = errors.New("alias already exists")
+	ErrAliasNotFound = errors.New("alias not found")
 )
 
 type ClassInfo struct {
@@ -50,8 +53,14 @@ type schema struct {
 	nodeID      string
 	shardReader shardReader
 	sync.RWMutex
-	Classes               map[string]*metaClass
+	Classes map[string]*metaClass
+	// Aliases maps alternative names to the class they currently point to
+	Aliases map[string]string
+	// BackupSchedules maps schedule names to the schedule and its runs
+	BackupSchedules       map[string]*backup.ScheduleState
 	classTenantDataEvents chan metadata.ClassTenant
+	// history records changes of class definitions
+	history history
 }
 
 func (s *schema) ClassInfo(class string) ClassInfo {
@@ -214,9 +223,11 @@ type shardReader interface {
 
 func NewSchema(nodeID string, shardReader shardReader) *schema {
 	return &schema{
-		nodeID:      nodeID,
-		Classes:     make(map[string]*metaClass, 128),
-		shardReader: shardReader,
+		nodeID:          nodeID,
+		Classes:         make(map[string]*metaClass, 128),
+		Aliases:         make(map[string]string),
+		BackupSchedules: make(map[string]*backup.ScheduleState),
+		shardReader:     shardReader,
 	}
 }
 
@@ -226,6 +237,8 @@ func NewSchemaWithTenantEvents(nodeID string, shardReader shardReader,
 	return &schema{
 		nodeID:                nodeID,
 		Classes:               make(map[string]*metaClass, 128),
+		Aliases:               make(map[string]string),
+		BackupSchedules:       make(map[string]*backup.ScheduleState),
 		shardReader:           shardReader,
 		classTenantDataEvents: classTenantDataEvents,
 	}
@@ -258,6 +271,9 @@ func (s *schema) addClass(cls *models.Class, ss *sharding.State, v uint64) error
 	if exists {
 		return ErrClassExists
 	}
+	if alias := s.aliasEqual(cls.Class); alias != "" {
+		return fmt.Errorf("%w: %q is an alias", ErrClassExists, alias)
+	}
 
 	s.Classes[cls.Class] = &metaClass{
 		Class: *cls, Sharding: *ss, ClassVersion: v, ShardVersion: v,
@@ -306,6 +322,12 @@ func (s *schema) deleteClass(name string) {
 	s.Lock()
 	defer s.Unlock()
 	delete(s.Classes, name)
+	// aliases must not outlive the class they point to
+	for alias, class := range s.Aliases {
+		if class == name {
+			delete(s.Aliases, alias)
+		}
+	}
 }
 
 func (s *schema) addProperty(class string, v uint64, props ...*models.Property) error {
@@ -319,6 +341,91 @@ func (s *schema) addProperty(class string, v uint64, props ...*models.Property)
 	return meta.AddProperty(v, props...)
 }
 
+func (s *schema) deleteProperty(class string, v uint64, name string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	meta := s.Classes[class]
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	return meta.DeleteProperty(v, name)
+}
+
+// ResolveAlias returns the class the alias points to and "" if there is no
+// such alias
+func (s *schema) ResolveAlias(alias string) string {
+	s.RLock()
+	defer s.RUnlock()
+	return s.Aliases[alias]
+}
+
+// ListAliases returns a copy of all aliases mapped to their class. If class is
+// not empty only the aliases pointing to this class are returned.
+func (s *schema) ListAliases(class string) map[string]string {
+	s.RLock()
+	defer s.RUnlock()
+	res := make(map[string]string, len(s.Aliases))
+	for alias, target := range s.Aliases {
+		if class == "" || class == target {
+			res[alias] = target
+		}
+	}
+	return res
+}
+
+func (s *schema) createAlias(alias, class string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if _, ok := s.Classes[class]; !ok {
+		return ErrClassNotFound
+	}
+	if other := s.aliasEqual(alias); other != "" {
+		return fmt.Errorf("%w: found similar alias %q", ErrAliasExists, other)
+	}
+	for name := range s.Classes {
+		if strings.EqualFold(name, alias) {
+			return fmt.Errorf("%w: %q is a class", ErrAliasExists, name)
+		}
+	}
+	s.Aliases[alias] = class
+	return nil
+}
+
+// replaceAlias points an existing alias to another class. Readers observe
+// either the old or the new class, never a missing alias.
+func (s *schema) replaceAlias(alias, class string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if _, ok := s.Aliases[alias]; !ok {
+		return ErrAliasNotFound
+	}
+	if _, ok := s.Classes[class]; !ok {
+		return ErrClassNotFound
+	}
+	s.Aliases[alias] = class
+	return nil
+}
+
+func (s *schema) deleteAlias(alias string) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.Aliases, alias)
+}
+
+// aliasEqual returns the name of an existing alias with a similar name, and
+// "" otherwise. The caller must hold the lock.
+func (s *schema) aliasEqual(name string) string {
+	for alias := range s.Aliases {
+		if strings.EqualFold(alias, name) {
+			return alias
+		}
+	}
+	return ""
+}
+
 func (s *schema) addTenants(class string, v uint64, req *command.AddTenantsRequest) error {
 	req.Tenants = removeNilTenants(req.Tenants)
 
@@ -371,6 +478,8 @@ func (s *schema) getTenants(class string, tenants []string) ([]*models.TenantRes
 				copy(cpy, physical.BelongsToNodes)
 
 				res[i] = MakeTenantWithDataVersion(tenant, entSchema.ActivityStatus(physical.Status), cpy, physical.DataVersion)
+				res[i].Quotas = physical.TenantQuotas()
+				res[i].ConsistencyLevel = physical.ConsistencyLevel
 
 				// Increment our result iterator
 				i++
@@ -382,7 +491,10 @@ func (s *schema) getTenants(class string, tenants []string) ([]*models.TenantRes
 					// Ensure we copy the belongs to nodes array to avoid it being modified
 					cpy := make([]string, len(physical.BelongsToNodes))
 					copy(cpy, physical.BelongsToNodes)
-					res = append(res, MakeTenantWithDataVersion(tenant, entSchema.ActivityStatus(physical.Status), cpy, physical.DataVersion))
+					t := MakeTenantWithDataVersion(tenant, entSchema.ActivityStatus(physical.Status), cpy, physical.DataVersion)
+					t.Quotas = physical.TenantQuotas()
+					t.ConsistencyLevel = physical.ConsistencyLevel
+					res = append(res, t)
 				}
 			}
 		}
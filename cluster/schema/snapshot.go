@@ -18,12 +18,17 @@ import (
 
 	"github.com/hashicorp/raft"
 	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/backup"
 )
 
 type snapshot struct {
 	NodeID     string                `json:"node_id"`
 	SnapshotID string                `json:"snapshot_id"`
 	Classes    map[string]*metaClass `json:"classes"`
+	Aliases    map[string]string     `json:"aliases,omitempty"`
+	History    *history              `json:"history,omitempty"`
+
+	BackupSchedules map[string]*backup.ScheduleState `json:"backup_schedules,omitempty"`
 }
 
 func (s *schema) Restore(r io.Reader, parser Parser) error {
@@ -41,6 +46,20 @@ func (s *schema) Restore(r io.Reader, parser Parser) error {
 	s.Lock()
 	defer s.Unlock()
 	s.Classes = snap.Classes
+	s.Aliases = snap.Aliases
+	if s.Aliases == nil {
+		s.Aliases = make(map[string]string)
+	}
+	s.BackupSchedules = snap.BackupSchedules
+	if s.BackupSchedules == nil {
+		s.BackupSchedules = make(map[string]*backup.ScheduleState)
+	}
+	if snap.History != nil {
+		s.history = *snap.History
+	} else {
+		// snapshots taken before the history was introduced
+		s.history = newHistory(snap.Classes)
+	}
 
 	return nil
 }
@@ -56,6 +75,10 @@ func (s *schema) Persist(sink raft.SnapshotSink) (err error) {
 		NodeID:     s.nodeID,
 		SnapshotID: sink.ID(),
 		Classes:    s.Classes,
+		Aliases:    s.Aliases,
+		History:    &s.history,
+
+		BackupSchedules: s.BackupSchedules,
 	}
 	if err := json.NewEncoder(sink).Encode(&snap); err != nil {
 		return fmt.Errorf("encode: %w", err)
@@ -33,6 +33,7 @@ type Indexer interface {
 	UpdateClass(api.UpdateClassRequest) error
 	DeleteClass(className string, hasFrozen bool) error
 	AddProperty(class string, req api.AddPropertyRequest) error
+	DeleteProperty(class string, req api.DeletePropertyRequest) error
 	AddTenants(class string, req *api.AddTenantsRequest) error
 	UpdateTenants(class string, req *api.UpdateTenantsRequest) error
 	DeleteTenants(class string, req *api.DeleteTenantsRequest) error
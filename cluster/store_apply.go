@@ -163,6 +163,41 @@ func (st *Store) Apply(l *raft.Log) interface{} {
 			ret.Error = st.schemaManager.AddProperty(&cmd, schemaOnly, !catchingUp)
 		}
 
+	case api.ApplyRequest_TYPE_DELETE_PROPERTY:
+		f = func() {
+			ret.Error = st.schemaManager.DeleteProperty(&cmd, schemaOnly, !catchingUp)
+		}
+
+	case api.ApplyRequest_TYPE_CREATE_ALIAS:
+		f = func() {
+			ret.Error = st.schemaManager.CreateAlias(&cmd, !catchingUp)
+		}
+
+	case api.ApplyRequest_TYPE_REPLACE_ALIAS:
+		f = func() {
+			ret.Error = st.schemaManager.ReplaceAlias(&cmd, !catchingUp)
+		}
+
+	case api.ApplyRequest_TYPE_DELETE_ALIAS:
+		f = func() {
+			ret.Error = st.schemaManager.DeleteAlias(&cmd, !catchingUp)
+		}
+
+	case api.ApplyRequest_TYPE_PUT_BACKUP_SCHEDULE:
+		f = func() {
+			ret.Error = st.schemaManager.PutBackupSchedule(&cmd)
+		}
+
+	case api.ApplyRequest_TYPE_DELETE_BACKUP_SCHEDULE:
+		f = func() {
+			ret.Error = st.schemaManager.DeleteBackupSchedule(&cmd)
+		}
+
+	case api.ApplyRequest_TYPE_RECORD_BACKUP_SCHEDULE_RUN:
+		f = func() {
+			ret.Error = st.schemaManager.RecordBackupScheduleRun(&cmd)
+		}
+
 	case api.ApplyRequest_TYPE_UPDATE_SHARD_STATUS:
 		f = func() {
 			ret.Error = st.schemaManager.UpdateShardStatus(&cmd, schemaOnly)
@@ -216,5 +251,9 @@ func (st *Store) Apply(l *raft.Log) interface{} {
 	enterrors.GoWrapper(g, st.log)
 	wg.Wait()
 
+	if ret.Error == nil {
+		st.schemaManager.RecordChange(&cmd, l.AppendedAt)
+	}
+
 	return ret
 }
@@ -13,10 +13,13 @@ package cluster
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/raft"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/models"
 )
 
 // Join adds the given peer to the cluster.
@@ -49,6 +52,122 @@ func (st *Store) Remove(id string) error {
 	return st.assertFuture(st.raft.RemoveServer(raft.ServerID(id), 0, 0))
 }
 
+// Peers returns the members of the latest raft configuration.
+func (st *Store) Peers() ([]raft.Server, error) {
+	if !st.open.Load() {
+		return nil, types.ErrNotOpen
+	}
+	cf := st.raft.GetConfiguration()
+	if err := cf.Error(); err != nil {
+		return nil, fmt.Errorf("get raft configuration: %w", err)
+	}
+	return cf.Configuration().Servers, nil
+}
+
+// Peer returns the member of the latest raft configuration with the given ID.
+// It returns ErrPeerNotFound if there is no such member.
+func (st *Store) Peer(id string) (raft.Server, error) {
+	servers, err := st.Peers()
+	if err != nil {
+		return raft.Server{}, err
+	}
+	for _, server := range servers {
+		if string(server.ID) == id {
+			return server, nil
+		}
+	}
+	return raft.Server{}, fmt.Errorf("%w: %q", types.ErrPeerNotFound, id)
+}
+
+// RaftStatus returns the raft state of this node, the members of the latest
+// raft configuration and the latest snapshot stored locally.
+func (st *Store) RaftStatus() (*models.RaftStatus, error) {
+	if !st.open.Load() {
+		return nil, types.ErrNotOpen
+	}
+	cf := st.raft.GetConfiguration()
+	if err := cf.Error(); err != nil {
+		return nil, fmt.Errorf("get raft configuration: %w", err)
+	}
+
+	stats := st.raft.Stats()
+	leaderAddr, leaderID := st.raft.LeaderWithID()
+	status := &models.RaftStatus{
+		NodeID:        st.cfg.NodeID,
+		State:         st.raft.State().String(),
+		LeaderAddress: string(leaderAddr),
+		LeaderID:      string(leaderID),
+		Term:          parseRaftStat(stats["term"]),
+		LastIndex:     st.raft.LastIndex(),
+		CommitIndex:   parseRaftStat(stats["commit_index"]),
+		AppliedIndex:  st.raft.AppliedIndex(),
+		Peers:         make([]*models.RaftPeer, 0, len(cf.Configuration().Servers)),
+	}
+	for _, server := range cf.Configuration().Servers {
+		status.Peers = append(status.Peers, &models.RaftPeer{
+			ID:       string(server.ID),
+			Address:  string(server.Address),
+			Suffrage: suffrage(server.Suffrage),
+			Leader:   server.ID == leaderID,
+		})
+	}
+
+	snapshot, err := st.latestSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	status.Snapshot = snapshot
+	return status, nil
+}
+
+// TakeSnapshot forces raft to snapshot the state of this node and returns the
+// metadata of the resulting snapshot. If nothing was applied since the last
+// snapshot, the metadata of the existing snapshot is returned instead.
+func (st *Store) TakeSnapshot() (*models.RaftSnapshot, error) {
+	if !st.open.Load() {
+		return nil, types.ErrNotOpen
+	}
+	if err := st.raft.Snapshot().Error(); err != nil && !errors.Is(err, raft.ErrNothingNewToSnapshot) {
+		return nil, fmt.Errorf("take snapshot: %w", err)
+	}
+	return st.latestSnapshot()
+}
+
+// latestSnapshot returns the metadata of the latest local snapshot or nil if
+// there is none yet.
+func (st *Store) latestSnapshot() (*models.RaftSnapshot, error) {
+	ls, err := st.snapshotStore.List()
+	if err != nil {
+		return nil, fmt.Errorf("list snapshots: %w", err)
+	}
+	if len(ls) == 0 {
+		return nil, nil
+	}
+	return &models.RaftSnapshot{
+		ID:    ls[0].ID,
+		Index: ls[0].Index,
+		Term:  ls[0].Term,
+		Size:  ls[0].Size,
+	}, nil
+}
+
+func suffrage(s raft.ServerSuffrage) string {
+	switch s {
+	case raft.Voter:
+		return models.RaftPeerSuffrageVOTER
+	case raft.Nonvoter:
+		return models.RaftPeerSuffrageNONVOTER
+	default:
+		return models.RaftPeerSuffrageSTAGING
+	}
+}
+
+// parseRaftStat parses a numeric value of raft.Stats, which are all reported as strings.
+func parseRaftStat(v string) uint64 {
+	n, _ := strconv.ParseUint(v, 10, 64)
+	return n
+}
+
 // Notify signals this Store that a node is ready for bootstrapping at the specified address.
 // Bootstrapping will be initiated once the number of known nodes reaches the expected level,
 // which includes this node.
@@ -341,6 +341,157 @@ func TestStoreApply(t *testing.T) {
 				return nil
 			},
 		},
+		{
+			name: "DeleteProperty/Empty",
+			req: raft.Log{
+				Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_DELETE_PROPERTY,
+					cmd.DeletePropertyRequest{}, nil),
+			},
+			resp:     Response{Error: schema.ErrBadRequest},
+			doBefore: doFirst,
+		},
+		{
+			name: "DeleteProperty/ClassNotFound",
+			req: raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_DELETE_PROPERTY,
+				cmd.DeletePropertyRequest{Name: "P1"}, nil)},
+			resp:     Response{Error: schema.ErrSchema},
+			doBefore: doFirst,
+		},
+		{
+			name: "DeleteProperty/Success",
+			req: raft.Log{
+				Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_DELETE_PROPERTY,
+					cmd.DeletePropertyRequest{Name: "P1"}, nil),
+			},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				doFirst(m)
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}, nil),
+				})
+				m.indexer.On("AddProperty", mock.Anything, mock.Anything).Return(nil)
+				m.indexer.On("TriggerSchemaUpdateCallbacks").Return()
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_PROPERTY,
+						cmd.AddPropertyRequest{Properties: []*models.Property{{Name: "P1"}, {Name: "P2"}}}, nil),
+				})
+				m.indexer.On("DeleteProperty", "C1", cmd.DeletePropertyRequest{Name: "P1"}).Return(nil)
+			},
+			doAfter: func(ms *MockStore) error {
+				class := ms.store.SchemaReader().ReadOnlyClass("C1")
+				if class == nil {
+					return fmt.Errorf("class not found")
+				}
+				if len(class.Properties) != 1 || class.Properties[0].Name != "P2" {
+					return fmt.Errorf("unexpected properties %v", class.Properties)
+				}
+				return nil
+			},
+		},
+		{
+			name: "CreateAlias/Empty",
+			req: raft.Log{
+				Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_CREATE_ALIAS,
+					cmd.CreateAliasRequest{Class: "C1"}, nil),
+			},
+			resp:     Response{Error: schema.ErrBadRequest},
+			doBefore: doFirst,
+		},
+		{
+			name: "CreateAlias/ClassNotFound",
+			req: raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_CREATE_ALIAS,
+				cmd.CreateAliasRequest{Alias: "A1", Class: "C1"}, nil)},
+			resp:     Response{Error: schema.ErrClassNotFound},
+			doBefore: doFirst,
+		},
+		{
+			name: "CreateAlias/NameOfClass",
+			req: raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_CREATE_ALIAS,
+				cmd.CreateAliasRequest{Alias: "c1", Class: "C1"}, nil)},
+			resp: Response{Error: schema.ErrAliasExists},
+			doBefore: func(m *MockStore) {
+				doFirst(m)
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}, nil),
+				})
+			},
+		},
+		{
+			name: "CreateAlias/Success",
+			req: raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_CREATE_ALIAS,
+				cmd.CreateAliasRequest{Alias: "A1", Class: "C1"}, nil)},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				doFirst(m)
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.indexer.On("TriggerSchemaUpdateCallbacks").Return()
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}, nil),
+				})
+			},
+			doAfter: func(ms *MockStore) error {
+				if got := ms.store.SchemaReader().ResolveAlias("A1"); got != "C1" {
+					return fmt.Errorf("alias resolved to %q", got)
+				}
+				return nil
+			},
+		},
+		{
+			name: "ReplaceAlias/AliasNotFound",
+			req: raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_REPLACE_ALIAS,
+				cmd.ReplaceAliasRequest{Alias: "A1", Class: "C1"}, nil)},
+			resp:     Response{Error: schema.ErrAliasNotFound},
+			doBefore: doFirst,
+		},
+		{
+			name: "ReplaceAlias/Success",
+			req: raft.Log{Data: cmdAsBytes("C2", cmd.ApplyRequest_TYPE_REPLACE_ALIAS,
+				cmd.ReplaceAliasRequest{Alias: "A1", Class: "C2"}, nil)},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				doFirst(m)
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.indexer.On("TriggerSchemaUpdateCallbacks").Return()
+				for _, name := range []string{"C1", "C2"} {
+					m.store.Apply(&raft.Log{
+						Data: cmdAsBytes(name, cmd.ApplyRequest_TYPE_ADD_CLASS,
+							cmd.AddClassRequest{Class: &models.Class{Class: name}, State: ss}, nil),
+					})
+				}
+				m.store.Apply(&raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_CREATE_ALIAS,
+					cmd.CreateAliasRequest{Alias: "A1", Class: "C1"}, nil)})
+			},
+			doAfter: func(ms *MockStore) error {
+				if got := ms.store.SchemaReader().ResolveAlias("A1"); got != "C2" {
+					return fmt.Errorf("alias resolved to %q", got)
+				}
+				return nil
+			},
+		},
+		{
+			name: "DeleteAlias/Success",
+			req: raft.Log{Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_DELETE_ALIAS,
+				cmd.DeleteAliasRequest{Alias: "A1"}, nil)},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				doFirst(m)
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.indexer.On("TriggerSchemaUpdateCallbacks").Return()
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}, nil),
+				})
+				m.store.Apply(&raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_CREATE_ALIAS,
+					cmd.CreateAliasRequest{Alias: "A1", Class: "C1"}, nil)})
+			},
+			doAfter: func(ms *MockStore) error {
+				if got := ms.store.SchemaReader().ResolveAlias("A1"); got != "" {
+					return fmt.Errorf("alias still resolves to %q", got)
+				}
+				return nil
+			},
+		},
 		{
 			name: "UpdateShard/Unmarshal",
 			req: raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_UPDATE_SHARD_STATUS,
@@ -551,6 +702,47 @@ func TestStoreApply(t *testing.T) {
 	}
 }
 
+func TestStoreApplyRecordsHistory(t *testing.T) {
+	m := NewMockStore(t, "Node-1", 9091)
+	m.parser.On("ParseClass", mock.Anything).Return(nil)
+	m.indexer.On("AddClass", mock.Anything).Return(nil)
+	m.indexer.On("TriggerSchemaUpdateCallbacks").Return()
+
+	subCmd, err := json.Marshal(cmd.AddClassRequest{
+		Class: &models.Class{Class: "C1"},
+		State: &sharding.State{Physical: map[string]sharding.Physical{}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := gproto.Marshal(&cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_ADD_CLASS,
+		Class:      "C1",
+		SubCommand: subCmd,
+		User:       "alice",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appendedAt := time.Now()
+	if resp := m.store.Apply(&raft.Log{Index: 5, AppendedAt: appendedAt, Data: data}).(Response); resp.Error != nil {
+		t.Fatalf("apply: %v", resp.Error)
+	}
+
+	entries, _ := m.store.SchemaReader().History("")
+	if len(entries) != 1 {
+		t.Fatalf("history entries want: 1 got: %d", len(entries))
+	}
+	e := entries[0]
+	if e.Version != 5 || e.User != "alice" || e.Type != "ADD_CLASS" || !e.Time.Equal(appendedAt) {
+		t.Errorf("unexpected history entry: %+v", e)
+	}
+	if e.State == nil || e.State.Class != "C1" {
+		t.Errorf("history entry must contain the class definition: %+v", e.State)
+	}
+}
+
 type MockStore struct {
 	indexer *fakes.MockSchemaExecutor
 	parser  *fakes.MockParser
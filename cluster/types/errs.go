@@ -22,4 +22,13 @@ var (
 	ErrUnknownCommand = errors.New("unknown command")
 	// ErrDeadlineExceeded represents an error returned when the deadline for waiting for a specific update is exceeded.
 	ErrDeadlineExceeded = errors.New("deadline exceeded for waiting for update")
+	// ErrPeerNotFound is returned when a membership change targets a node
+	// that is not part of the raft configuration.
+	ErrPeerNotFound     = errors.New("peer not found in raft configuration")
+	ErrPeerAlreadyVoter = errors.New("peer is already a voter")
+	ErrRemoveLeader     = errors.New("cannot remove the current leader, transfer leadership first")
+	ErrRemoveLastVoter  = errors.New("cannot remove the last voter")
+	// ErrRemoveQuorum is returned when removing a voter would leave fewer
+	// voters than a quorum of the current configuration.
+	ErrRemoveQuorum = errors.New("cannot remove voter, too few voters would be left for a quorum")
 )
@@ -0,0 +1,32 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package types
+
+import "context"
+
+type userCtxKey struct{}
+
+// ContextWithUser returns a copy of ctx which carries the name of the user on
+// whose behalf schema commands are issued. The name ends up in the schema
+// history of every change applied with that context.
+func ContextWithUser(ctx context.Context, user string) context.Context {
+	if user == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, userCtxKey{}, user)
+}
+
+// UserFromContext returns the user attached by [ContextWithUser] and "" if none
+func UserFromContext(ctx context.Context) string {
+	user, _ := ctx.Value(userCtxKey{}).(string)
+	return user
+}
@@ -12,6 +12,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -106,6 +107,13 @@ func main() {
 			log,
 		)
 
+		// keep cached tenants up to date with the data updates seen by the metadata node
+		subscriber := query.NewMetadataSubscriber(opts.Query.MetadataGRPCAddress, a,
+			query.NewFreshnessMetrics(opts.Monitoring.MetricsNamespace, prometheus.DefaultRegisterer), log)
+		enterrors.GoWrapper(func() {
+			subscriber.Run(context.Background())
+		}, log)
+
 		grpcQuerier := query.NewGRPC(a, schemaInfo, log)
 		listener, err := net.Listen("tcp", opts.Query.GRPCListenAddr)
 		if err != nil {
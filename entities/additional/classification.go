@@ -19,6 +19,14 @@ type Classification struct {
 	Completed        strfmt.DateTime `json:"completed,omitempty"`
 	ID               strfmt.UUID     `json:"id,omitempty"`
 	Scope            []string        `json:"scope"`
+	// Confidence is only set by classifiers which can estimate how likely
+	// each classified value is correct
+	Confidence []ClassificationConfidence `json:"confidence,omitempty"`
+}
+
+type ClassificationConfidence struct {
+	Property   string  `json:"property"`
+	Confidence float64 `json:"confidence"`
 }
 
 type Properties struct {
@@ -11,6 +11,8 @@
 
 package additional
 
+import "github.com/weaviate/weaviate/entities/replication"
+
 // ReplicationProperties are replication-related handles and configurations which
 // allow replication context to pass through different layers of
 // abstraction, usually initiated via client requests
@@ -26,4 +28,14 @@ type ReplicationProperties struct {
 	// NodeName is the node which is expected to
 	// fulfill the request
 	NodeName string
+
+	// ConsistencyToken is optional. Reads reflect at least the writes
+	// recorded in it, and successful writes are added to it.
+	ConsistencyToken *replication.ConsistencyToken
+
+	// IfMatch is an optional precondition for single-object writes. The
+	// write is only applied if the stored object was last updated at
+	// exactly this unix time in ms. 0 requires that it does not exist.
+	// Replicated conditional writes use at least QUORUM.
+	IfMatch *int64
 }
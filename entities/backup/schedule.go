@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import "time"
+
+// Schedule describes a backup which is created periodically by the leader
+type Schedule struct {
+	// Name identifies the schedule, it is also the prefix of the backup ids
+	Name string `json:"name"`
+	// Cron is a standard 5-field cron expression evaluated in UTC
+	Cron    string   `json:"cron"`
+	Backend string   `json:"backend"`
+	Include []string `json:"include,omitempty"`
+	Exclude []string `json:"exclude,omitempty"`
+	Bucket  string   `json:"bucket,omitempty"`
+	Path    string   `json:"path,omitempty"`
+	// Retention decides which of the backups created by the schedule are kept
+	Retention Retention `json:"retention"`
+	// Paused schedules don't create new backups, retention is still applied
+	Paused bool `json:"paused,omitempty"`
+	// UpdatedAt is when the schedule was created or last changed
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Retention rules of a schedule. A successful backup is kept as long as at
+// least one rule selects it, all backups are kept if no rule is set.
+type Retention struct {
+	// KeepLast keeps the n most recent backups
+	KeepLast int `json:"keepLast,omitempty"`
+	// KeepDaily keeps the most recent backup of each of the last n days
+	// on which a backup was created
+	KeepDaily int `json:"keepDaily,omitempty"`
+	// KeepWeekly keeps the most recent backup of each of the last n ISO weeks
+	// in which a backup was created
+	KeepWeekly int `json:"keepWeekly,omitempty"`
+	// KeepMonthly keeps the most recent backup of each of the last n months
+	// in which a backup was created
+	KeepMonthly int `json:"keepMonthly,omitempty"`
+}
+
+// Empty returns true if no rule is set
+func (r Retention) Empty() bool {
+	return r.KeepLast == 0 && r.KeepDaily == 0 && r.KeepWeekly == 0 && r.KeepMonthly == 0
+}
+
+// ScheduleRun is a backup created by a schedule
+type ScheduleRun struct {
+	BackupID    string    `json:"backupId"`
+	StartedAt   time.Time `json:"startedAt"`
+	CompletedAt time.Time `json:"completedAt,omitempty"`
+	Status      Status    `json:"status"`
+	Error       string    `json:"error,omitempty"`
+	// DeletedAt is set once the backup was removed by the retention policy
+	DeletedAt time.Time `json:"deletedAt,omitempty"`
+}
+
+// Finished returns true if the backup is no longer in progress
+func (r *ScheduleRun) Finished() bool {
+	return r.Status == Success || r.Status == Failed || r.Status == Cancelled
+}
+
+// Live returns true if the backup is in progress or exists in the backend
+func (r *ScheduleRun) Live() bool {
+	return r.DeletedAt.IsZero() && (!r.Finished() || r.Status == Success)
+}
+
+// ScheduleState is a schedule together with the backups it created,
+// ordered by start time
+type ScheduleState struct {
+	Schedule Schedule      `json:"schedule"`
+	Runs     []ScheduleRun `json:"runs,omitempty"`
+}
+
+// LastRun returns the most recent run and false if there is none
+func (s *ScheduleState) LastRun() (ScheduleRun, bool) {
+	if len(s.Runs) == 0 {
+		return ScheduleRun{}, false
+	}
+	return s.Runs[len(s.Runs)-1], true
+}
@@ -42,6 +42,12 @@ func Class(c *models.Class) *models.Class {
 		}
 	}
 
+	var uniqueKey []string = nil
+	if c.UniqueKey != nil {
+		uniqueKey = make([]string, len(c.UniqueKey))
+		copy(uniqueKey, c.UniqueKey)
+	}
+
 	return &models.Class{
 		Class:               c.Class,
 		Description:         c.Description,
@@ -53,6 +59,7 @@ func Class(c *models.Class) *models.Class {
 		Vectorizer:          c.Vectorizer,
 		InvertedIndexConfig: InvertedIndexConfig(c.InvertedIndexConfig),
 		Properties:          properties,
+		UniqueKey:           uniqueKey,
 	}
 }
 
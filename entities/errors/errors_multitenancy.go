@@ -16,8 +16,9 @@ import (
 )
 
 var (
-	ErrTenantNotActive = errors.New("tenant not active")
-	ErrTenantNotFound  = errors.New("tenant not found")
+	ErrTenantNotActive     = errors.New("tenant not active")
+	ErrTenantNotFound      = errors.New("tenant not found")
+	ErrTenantQuotaExceeded = errors.New("tenant quota exceeded")
 )
 
 func IsTenantNotFound(err error) bool {
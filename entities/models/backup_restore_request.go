@@ -22,6 +22,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // BackupRestoreRequest Request body for restoring a backup for a set of classes
@@ -29,6 +30,9 @@ import (
 // swagger:model BackupRestoreRequest
 type BackupRestoreRequest struct {
 
+	// Allows restoring classes under a different name, mapping the class name stored in the backup to the new name. Cross-references between restored classes are rewritten accordingly.
+	ClassMapping map[string]string `json:"class_mapping,omitempty"`
+
 	// Custom configuration for the backup restoration process
 	Config *RestoreConfig `json:"config,omitempty"`
 
@@ -40,6 +44,13 @@ type BackupRestoreRequest struct {
 
 	// Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.
 	NodeMapping map[string]string `json:"node_mapping,omitempty"`
+
+	// Replays the commit logs archived after the backup up to this time. Logs are archived in segments, writes are restored up to the last segment archived before this time. Requires commit log archiving to be enabled on all nodes.
+	// Format: date-time
+	PointInTime *strfmt.DateTime `json:"point_in_time,omitempty"`
+
+	// List of tenants to restore. Only applies to multi-tenant classes, all tenants are restored if empty.
+	Tenants []string `json:"tenants"`
 }
 
 // Validate validates this backup restore request
@@ -50,6 +61,10 @@ func (m *BackupRestoreRequest) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePointInTime(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -75,6 +90,18 @@ func (m *BackupRestoreRequest) validateConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *BackupRestoreRequest) validatePointInTime(formats strfmt.Registry) error {
+	if swag.IsZero(m.PointInTime) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("point_in_time", "body", "date-time", m.PointInTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validate this backup restore request based on the context it is used
 func (m *BackupRestoreRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
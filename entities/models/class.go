@@ -55,6 +55,9 @@ type Class struct {
 	// Manage how the index should be sharded and distributed in the cluster
 	ShardingConfig interface{} `json:"shardingConfig,omitempty"`
 
+	// Names of the properties whose combined values identify an object within the collection (a.k.a. 'natural key'). Batch imports resolve an existing object by these values and update it instead of creating a duplicate. Objects imported without an id get an id derived from the key. Text properties of the key must use the tokenization 'field'. Immutable after the collection was created.
+	UniqueKey []string `json:"uniqueKey,omitempty"`
+
 	// Configure named vectors. Either use this field or `vectorizer`, `vectorIndexType`, and `vectorIndexConfig` fields. Available from `v1.24.0`.
 	VectorConfig map[string]VectorConfig `json:"vectorConfig,omitempty"`
 
@@ -43,6 +43,9 @@ type Classification struct {
 	// Example: ["inCountry"]
 	ClassifyProperties []string `json:"classifyProperties"`
 
+	// keep classifying objects of the class which are added or updated after the initial run completed. Only one continuous classification can be active per class, scheduling another one replaces it.
+	Continuous *bool `json:"continuous,omitempty"`
+
 	// error message if status == failed
 	// Example: classify xzy: something went wrong
 	Error string `json:"error,omitempty"`
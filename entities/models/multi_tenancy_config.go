@@ -19,6 +19,7 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -36,15 +37,71 @@ type MultiTenancyConfig struct {
 
 	// Whether or not multi-tenancy is enabled for this class (default: false).
 	Enabled bool `json:"enabled"`
+
+	// Default resource limits for every tenant of this class. Quotas set on a tenant take precedence.
+	TenantQuotas *TenantQuotas `json:"tenantQuotas,omitempty"`
 }
 
 // Validate validates this multi tenancy config
 func (m *MultiTenancyConfig) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTenantQuotas(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *MultiTenancyConfig) validateTenantQuotas(formats strfmt.Registry) error {
+	if swag.IsZero(m.TenantQuotas) { // not required
+		return nil
+	}
+
+	if m.TenantQuotas != nil {
+		if err := m.TenantQuotas.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tenantQuotas")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("tenantQuotas")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
-// ContextValidate validates this multi tenancy config based on context it is used
+// ContextValidate validate this multi tenancy config based on the context it is used
 func (m *MultiTenancyConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateTenantQuotas(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *MultiTenancyConfig) contextValidateTenantQuotas(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TenantQuotas != nil {
+		if err := m.TenantQuotas.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tenantQuotas")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("tenantQuotas")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -34,6 +34,9 @@ type NodeShardStatus struct {
 	// The status of vector compression/quantization.
 	Compressed bool `json:"compressed"`
 
+	// The target vectors whose vector index rebuild failed. The rebuild is retried once the shard is loaded again.
+	FailedVectorIndexRebuilds []string `json:"failedVectorIndexRebuilds"`
+
 	// The load status of the shard.
 	Loaded bool `json:"loaded"`
 
@@ -43,6 +46,18 @@ type NodeShardStatus struct {
 	// The number of objects in shard.
 	ObjectCount int64 `json:"objectCount"`
 
+	// The target vectors whose vector index is being rebuilt in the background. The legacy vector is listed as an empty string.
+	RebuildingVectorIndexes []string `json:"rebuildingVectorIndexes"`
+
+	// The number of vectors added by the ongoing rebuilds of vector indexes.
+	RebuiltVectors int64 `json:"rebuiltVectors"`
+
+	// The number of objects processed by the ongoing reindexing of properties.
+	ReindexedObjects int64 `json:"reindexedObjects"`
+
+	// The properties whose tokenization or inverted index settings are being applied in the background.
+	ReindexingProperties []string `json:"reindexingProperties"`
+
 	// The status of the vector indexing process.
 	VectorIndexingStatus string `json:"vectorIndexingStatus"`
 
@@ -41,6 +41,9 @@ type NodeStatus struct {
 	// The name of the node.
 	Name string `json:"name,omitempty"`
 
+	// Replication factor changes coordinated by the node.
+	ReplicationOperations []*ReplicationScaleOperation `json:"replicationOperations"`
+
 	// The list of the shards with it's statistics.
 	Shards []*NodeShardStatus `json:"shards"`
 
@@ -63,6 +66,10 @@ func (m *NodeStatus) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateReplicationOperations(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateShards(formats); err != nil {
 		res = append(res, err)
 	}
@@ -100,6 +107,32 @@ func (m *NodeStatus) validateBatchStats(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *NodeStatus) validateReplicationOperations(formats strfmt.Registry) error {
+	if swag.IsZero(m.ReplicationOperations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ReplicationOperations); i++ {
+		if swag.IsZero(m.ReplicationOperations[i]) { // not required
+			continue
+		}
+
+		if m.ReplicationOperations[i] != nil {
+			if err := m.ReplicationOperations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("replicationOperations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("replicationOperations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *NodeStatus) validateShards(formats strfmt.Registry) error {
 	if swag.IsZero(m.Shards) { // not required
 		return nil
@@ -201,6 +234,10 @@ func (m *NodeStatus) ContextValidate(ctx context.Context, formats strfmt.Registr
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateReplicationOperations(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateShards(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -231,6 +268,26 @@ func (m *NodeStatus) contextValidateBatchStats(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *NodeStatus) contextValidateReplicationOperations(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ReplicationOperations); i++ {
+
+		if m.ReplicationOperations[i] != nil {
+			if err := m.ReplicationOperations[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("replicationOperations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("replicationOperations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *NodeStatus) contextValidateShards(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Shards); i++ {
@@ -34,28 +34,91 @@ type ReplicationConfig struct {
 	// Enable asynchronous replication (default: false).
 	AsyncEnabled bool `json:"asyncEnabled"`
 
+	// Default consistency level of requests which do not specify one. `BOUNDED_STALENESS` reads are answered by a single replica if it has been in sync within `maxStalenessSeconds` and fall back to `QUORUM` otherwise; writes use `QUORUM`. Defaults to `QUORUM`.
+	// Enum: [ONE QUORUM ALL BOUNDED_STALENESS]
+	ConsistencyLevel string `json:"consistencyLevel,omitempty"`
+
 	// Conflict resolution strategy for deleted objects.
 	// Enum: [NoAutomatedResolution DeleteOnConflict]
 	DeletionStrategy string `json:"deletionStrategy,omitempty"`
 
 	// Number of times a class is replicated (default: 1).
 	Factor int64 `json:"factor,omitempty"`
+
+	// Maximum staleness in seconds of `BOUNDED_STALENESS` reads (default: 10).
+	// Minimum: 0
+	MaxStalenessSeconds int64 `json:"maxStalenessSeconds,omitempty"`
 }
 
 // Validate validates this replication config
 func (m *ReplicationConfig) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateConsistencyLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDeletionStrategy(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateMaxStalenessSeconds(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+var replicationConfigTypeConsistencyLevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["ONE","QUORUM","ALL","BOUNDED_STALENESS"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replicationConfigTypeConsistencyLevelPropEnum = append(replicationConfigTypeConsistencyLevelPropEnum, v)
+	}
+}
+
+const (
+	// ReplicationConfigConsistencyLevelONE captures enum value "ONE"
+	ReplicationConfigConsistencyLevelONE string = "ONE"
+
+	// ReplicationConfigConsistencyLevelQUORUM captures enum value "QUORUM"
+	ReplicationConfigConsistencyLevelQUORUM string = "QUORUM"
+
+	// ReplicationConfigConsistencyLevelALL captures enum value "ALL"
+	ReplicationConfigConsistencyLevelALL string = "ALL"
+
+	// ReplicationConfigConsistencyLevelBOUNDEDSTALENESS captures enum value "BOUNDED_STALENESS"
+	ReplicationConfigConsistencyLevelBOUNDEDSTALENESS string = "BOUNDED_STALENESS"
+)
+
+// prop value enum
+func (m *ReplicationConfig) validateConsistencyLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, replicationConfigTypeConsistencyLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ReplicationConfig) validateConsistencyLevel(formats strfmt.Registry) error {
+	if swag.IsZero(m.ConsistencyLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateConsistencyLevelEnum("consistencyLevel", "body", m.ConsistencyLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var replicationConfigTypeDeletionStrategyPropEnum []interface{}
 
 func init() {
@@ -98,6 +161,18 @@ func (m *ReplicationConfig) validateDeletionStrategy(formats strfmt.Registry) er
 	return nil
 }
 
+func (m *ReplicationConfig) validateMaxStalenessSeconds(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxStalenessSeconds) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("maxStalenessSeconds", "body", m.MaxStalenessSeconds, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validates this replication config based on context it is used
 func (m *ReplicationConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
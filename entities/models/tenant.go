@@ -35,8 +35,15 @@ type Tenant struct {
 	// Enum: [ACTIVE INACTIVE OFFLOADED OFFLOADING ONLOADING HOT COLD FROZEN FREEZING UNFREEZING]
 	ActivityStatus string `json:"activityStatus,omitempty"`
 
+	// Default consistency level of requests to the tenant which do not specify one. Overrides the `consistencyLevel` of the class replication config.
+	// Enum: [ONE QUORUM ALL BOUNDED_STALENESS]
+	ConsistencyLevel string `json:"consistencyLevel,omitempty"`
+
 	// The name of the tenant (required).
 	Name string `json:"name,omitempty"`
+
+	// Resource limits of the tenant. Limits which are not set fall back to the tenant quotas of the class.
+	Quotas *TenantQuotas `json:"quotas,omitempty"`
 }
 
 // Validate validates this tenant
@@ -47,6 +54,14 @@ func (m *Tenant) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateConsistencyLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateQuotas(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -119,8 +134,99 @@ func (m *Tenant) validateActivityStatus(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this tenant based on context it is used
+var tenantTypeConsistencyLevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["ONE","QUORUM","ALL","BOUNDED_STALENESS"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		tenantTypeConsistencyLevelPropEnum = append(tenantTypeConsistencyLevelPropEnum, v)
+	}
+}
+
+const (
+	// TenantConsistencyLevelONE captures enum value "ONE"
+	TenantConsistencyLevelONE string = "ONE"
+
+	// TenantConsistencyLevelQUORUM captures enum value "QUORUM"
+	TenantConsistencyLevelQUORUM string = "QUORUM"
+
+	// TenantConsistencyLevelALL captures enum value "ALL"
+	TenantConsistencyLevelALL string = "ALL"
+
+	// TenantConsistencyLevelBOUNDEDSTALENESS captures enum value "BOUNDED_STALENESS"
+	TenantConsistencyLevelBOUNDEDSTALENESS string = "BOUNDED_STALENESS"
+)
+
+// prop value enum
+func (m *Tenant) validateConsistencyLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, tenantTypeConsistencyLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Tenant) validateConsistencyLevel(formats strfmt.Registry) error {
+	if swag.IsZero(m.ConsistencyLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateConsistencyLevelEnum("consistencyLevel", "body", m.ConsistencyLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Tenant) validateQuotas(formats strfmt.Registry) error {
+	if swag.IsZero(m.Quotas) { // not required
+		return nil
+	}
+
+	if m.Quotas != nil {
+		if err := m.Quotas.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("quotas")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("quotas")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this tenant based on the context it is used
 func (m *Tenant) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateQuotas(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Tenant) contextValidateQuotas(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Quotas != nil {
+		if err := m.Quotas.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("quotas")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("quotas")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
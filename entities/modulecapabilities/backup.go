@@ -48,3 +48,11 @@ type BackupBackend interface {
 	Write(ctx context.Context, backupID, key, overrideBucket, overridePath string, r io.ReadCloser) (int64, error)
 	Read(ctx context.Context, backupID, key, overrideBucket, overridePath string, w io.WriteCloser) (int64, error)
 }
+
+// BackupBackendDeleter is implemented by backends which are able to remove
+// a backup, e.g. to enforce the retention policy of a backup schedule.
+type BackupBackendDeleter interface {
+	// Delete removes all objects belonging to the backup backupID.
+	// Deleting a backup which does not exist is not an error.
+	Delete(ctx context.Context, backupID, overrideBucket, overridePath string) error
+}
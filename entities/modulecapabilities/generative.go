@@ -38,13 +38,21 @@ type GenerateResponse struct {
 	Debug  *GenerateDebugInformation
 }
 
+// GenerateProperties holds the properties of a single object that are passed
+// to the generative client. Text holds text properties, Blob holds base64
+// encoded blob properties (e.g. images) which multimodal models can consume
+type GenerateProperties struct {
+	Text map[string]string
+	Blob map[string]*string
+}
+
 // GenerativeClient defines generative client
 type GenerativeClient interface {
 	GenerateSingleResult(ctx context.Context,
-		textProperties map[string]string, prompt string, requestParams interface{}, debug bool, cfg moduletools.ClassConfig,
+		properties *GenerateProperties, prompt string, requestParams interface{}, debug bool, cfg moduletools.ClassConfig,
 	) (*GenerateResponse, error)
 	GenerateAllResults(ctx context.Context,
-		textProperties []map[string]string, task string, requestParams interface{}, debug bool, cfg moduletools.ClassConfig,
+		properties []*GenerateProperties, task string, requestParams interface{}, debug bool, cfg moduletools.ClassConfig,
 	) (*GenerateResponse, error)
 	Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, requestParams interface{}, debug bool,
 	) (*GenerateResponse, error)
@@ -11,6 +11,8 @@
 
 package replication
 
+import "time"
+
 // GlobalConfig represents system-wide config that may restrict settings of an
 // individual class
 type GlobalConfig struct {
@@ -20,4 +22,20 @@ type GlobalConfig struct {
 	MinimumFactor int `json:"minimum_factor" yaml:"minimum_factor"`
 
 	DeletionStrategy string `json:"deletion_strategy" yaml:"deletion_strategy"`
+
+	// HintedHandoff configures how writes missed by unavailable replicas are
+	// stored and replayed once the replicas are reachable again
+	HintedHandoff HintedHandoffConfig `json:"hinted_handoff" yaml:"hinted_handoff"`
+}
+
+// HintedHandoffConfig limits the hints a node keeps for unavailable replicas
+type HintedHandoffConfig struct {
+	Disabled bool `json:"disabled" yaml:"disabled"`
+	// MaxBytesPerNode is the maximum size of the hints kept for a single
+	// replica. Writes missed beyond it are left to read repair and async
+	// replication.
+	MaxBytesPerNode int64 `json:"max_bytes_per_node" yaml:"max_bytes_per_node"`
+	// MaxAge is the maximum age of a hint. Older hints are dropped instead of
+	// being replayed.
+	MaxAge time.Duration `json:"max_age" yaml:"max_age"`
 }
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// consistencyTokenVersion is the version of the encoding of consistency tokens
+const consistencyTokenVersion = 1
+
+// ConsistencyToken records the update time of the latest write of a client
+// to each shard it has written to. Reads presenting the token are served by
+// replicas which have applied writes at least as recent, so that clients
+// read their own writes without reading at QUORUM.
+//
+// Tokens are opaque to clients, they are passed around in encoded form.
+// A nil token records nothing. Tokens are safe for concurrent use.
+type ConsistencyToken struct {
+	sync.Mutex
+	shards map[string]map[string]int64 // class -> shard -> update time in ms
+}
+
+type consistencyTokenPayload struct {
+	Version int                         `json:"v"`
+	Shards  map[string]map[string]int64 `json:"s,omitempty"`
+}
+
+// NewConsistencyToken creates an empty token
+func NewConsistencyToken() *ConsistencyToken {
+	return &ConsistencyToken{shards: make(map[string]map[string]int64)}
+}
+
+// ParseConsistencyToken decodes a token created by [ConsistencyToken.String].
+// An empty string results in an empty token.
+func ParseConsistencyToken(s string) (*ConsistencyToken, error) {
+	t := NewConsistencyToken()
+	if s == "" {
+		return t, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid consistency token: %w", err)
+	}
+	var p consistencyTokenPayload
+	if err := json.Unmarshal(b, &p); err != nil {
+		return nil, fmt.Errorf("invalid consistency token: %w", err)
+	}
+	if p.Version != consistencyTokenVersion {
+		return nil, fmt.Errorf("invalid consistency token: unsupported version %d", p.Version)
+	}
+	if p.Shards != nil {
+		t.shards = p.Shards
+	}
+	return t, nil
+}
+
+// Add records a write to shard of class at updateTime (unix ms)
+func (t *ConsistencyToken) Add(class, shard string, updateTime int64) {
+	if t == nil {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	shards := t.shards[class]
+	if shards == nil {
+		shards = make(map[string]int64)
+		t.shards[class] = shards
+	}
+	if updateTime > shards[shard] {
+		shards[shard] = updateTime
+	}
+}
+
+// UpdateTime returns the update time (unix ms) of the latest write recorded
+// for shard of class, or 0 if there is none
+func (t *ConsistencyToken) UpdateTime(class, shard string) int64 {
+	if t == nil {
+		return 0
+	}
+	t.Lock()
+	defer t.Unlock()
+	return t.shards[class][shard]
+}
+
+// String encodes the token. An empty token is encoded as an empty string.
+func (t *ConsistencyToken) String() string {
+	if t == nil {
+		return ""
+	}
+	t.Lock()
+	defer t.Unlock()
+	if len(t.shards) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(consistencyTokenPayload{
+		Version: consistencyTokenVersion,
+		Shards:  t.shards,
+	})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsistencyToken(t *testing.T) {
+	t.Run("RoundTrip", func(t *testing.T) {
+		token := NewConsistencyToken()
+		token.Add("C1", "S1", 10)
+		token.Add("C1", "S1", 5)
+		token.Add("C1", "S2", 20)
+		token.Add("C2", "S1", 30)
+
+		parsed, err := ParseConsistencyToken(token.String())
+		require.Nil(t, err)
+		assert.Equal(t, int64(10), parsed.UpdateTime("C1", "S1"))
+		assert.Equal(t, int64(20), parsed.UpdateTime("C1", "S2"))
+		assert.Equal(t, int64(30), parsed.UpdateTime("C2", "S1"))
+		assert.Zero(t, parsed.UpdateTime("C2", "S2"))
+
+		parsed.Add("C3", "S1", 40)
+		assert.Equal(t, int64(40), parsed.UpdateTime("C3", "S1"))
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		assert.Equal(t, "", NewConsistencyToken().String())
+		token, err := ParseConsistencyToken("")
+		require.Nil(t, err)
+		assert.Zero(t, token.UpdateTime("C1", "S1"))
+		token.Add("C1", "S1", 1)
+		assert.NotEmpty(t, token.String())
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		var token *ConsistencyToken
+		token.Add("C1", "S1", 1)
+		assert.Zero(t, token.UpdateTime("C1", "S1"))
+		assert.Equal(t, "", token.String())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, s := range []string{
+			"not base64!",
+			base64.RawURLEncoding.EncodeToString([]byte("{")),
+			base64.RawURLEncoding.EncodeToString([]byte(`{"v":2}`)),
+		} {
+			_, err := ParseConsistencyToken(s)
+			assert.ErrorContains(t, err, "invalid consistency token", s)
+		}
+	})
+}
@@ -39,6 +39,8 @@ type ClassAndProperty struct {
 // Describes the schema that is used in Weaviate.
 type Schema struct {
 	Objects *models.Schema
+	// Aliases maps alias names to the class they point to
+	Aliases map[string]string
 }
 
 func Empty() Schema {
@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// uniqueKeyNamespace is the namespace of the name based (v5) uuids derived
+// from unique keys. It must never change, as existing objects would no
+// longer be found by their key.
+var uniqueKeyNamespace = uuid.MustParse("5b1c2a8e-3f4d-4f6a-9c1e-7d2b8a0e4c13")
+
+// HasUniqueKey returns whether objects of the class are identified by the
+// values of some of their properties
+func HasUniqueKey(class *models.Class) bool {
+	return class != nil && len(class.UniqueKey) > 0
+}
+
+// IsUniqueKeyDataType returns whether a property of the given data type
+// can be part of a unique key
+func IsUniqueKeyDataType(dt DataType) bool {
+	switch dt {
+	case DataTypeText, DataTypeInt, DataTypeNumber, DataTypeBoolean,
+		DataTypeDate, DataTypeUUID:
+		return true
+	default:
+		return false
+	}
+}
+
+// UniqueKeyValues returns the values of the unique key of the class in the
+// given properties, in the order of the key. Values are normalized, so that
+// the same key results in the same values regardless of whether they were
+// sent by a client or read from disk: text and uuids are strings, ints and
+// numbers are float64, dates are time.Time in UTC.
+func UniqueKeyValues(class *models.Class, properties interface{}) ([]interface{}, error) {
+	props, ok := properties.(map[string]interface{})
+	if !ok && properties != nil {
+		return nil, fmt.Errorf("unique key: expected properties to be a map, got %T", properties)
+	}
+
+	values := make([]interface{}, len(class.UniqueKey))
+	for i, name := range class.UniqueKey {
+		prop, err := GetPropertyByName(class, name)
+		if err != nil {
+			return nil, fmt.Errorf("unique key: %w", err)
+		}
+		value, ok := props[name]
+		if !ok || value == nil {
+			return nil, fmt.Errorf("unique key: missing value of property %q", name)
+		}
+		values[i], err = normalizeUniqueKeyValue(DataType(prop.DataType[0]), value)
+		if err != nil {
+			return nil, fmt.Errorf("unique key: property %q: %w", name, err)
+		}
+	}
+	return values, nil
+}
+
+func normalizeUniqueKeyValue(dt DataType, value interface{}) (interface{}, error) {
+	switch dt {
+	case DataTypeText:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+	case DataTypeUUID:
+		var s string
+		switch v := value.(type) {
+		case uuid.UUID:
+			return v.String(), nil
+		case strfmt.UUID:
+			s = v.String()
+		case string:
+			s = v
+		default:
+			return nil, fmt.Errorf("unexpected value of type %T", value)
+		}
+		parsed, err := uuid.Parse(s)
+		if err != nil {
+			return nil, err
+		}
+		return parsed.String(), nil
+	case DataTypeInt, DataTypeNumber:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case int:
+			return float64(v), nil
+		case int64:
+			return float64(v), nil
+		case json.Number:
+			return v.Float64()
+		}
+	case DataTypeBoolean:
+		if v, ok := value.(bool); ok {
+			return v, nil
+		}
+	case DataTypeDate:
+		switch v := value.(type) {
+		case time.Time:
+			return v.UTC(), nil
+		case string:
+			parsed, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return nil, err
+			}
+			return parsed.UTC(), nil
+		}
+	default:
+		return nil, fmt.Errorf("data type %q cannot be part of a unique key", dt)
+	}
+	return nil, fmt.Errorf("unexpected value of type %T for data type %q", value, dt)
+}
+
+// UniqueKeyString returns the canonical representation of normalized
+// unique key values, equal keys have equal representations
+func UniqueKeyString(values []interface{}) string {
+	// normalized values are all json primitives, marshalling can't fail
+	b, _ := json.Marshal(values)
+	return string(b)
+}
+
+// UniqueKeyUUID derives the id of an object from the normalized values of
+// the unique key of its class
+func UniqueKeyUUID(className string, values []interface{}) strfmt.UUID {
+	name := className + ":" + UniqueKeyString(values)
+	return strfmt.UUID(uuid.NewSHA1(uniqueKeyNamespace, []byte(name)).String())
+}
@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestUniqueKey(t *testing.T) {
+	class := &models.Class{
+		Class: "Product",
+		Properties: []*models.Property{
+			{Name: "sku", DataType: DataTypeText.PropString()},
+			{Name: "region", DataType: DataTypeInt.PropString()},
+			{Name: "since", DataType: DataTypeDate.PropString()},
+			{Name: "vendor", DataType: DataTypeUUID.PropString()},
+		},
+		UniqueKey: []string{"sku", "region", "since", "vendor"},
+	}
+	vendor := uuid.MustParse("6e4b1c4e-8c4b-4a8e-9a4b-0c7e2f8a1d3b")
+	since := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("client and stored values result in the same key", func(t *testing.T) {
+		fromClient, err := UniqueKeyValues(class, map[string]interface{}{
+			"sku":    "A-1",
+			"region": json.Number("7"),
+			"since":  since.In(time.FixedZone("CET", 3600)),
+			"vendor": vendor,
+		})
+		require.Nil(t, err)
+		fromDisk, err := UniqueKeyValues(class, map[string]interface{}{
+			"sku":    "A-1",
+			"region": float64(7),
+			"since":  "2024-01-02T03:04:05Z",
+			"vendor": "6E4B1C4E-8C4B-4A8E-9A4B-0C7E2F8A1D3B",
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, UniqueKeyString(fromClient), UniqueKeyString(fromDisk))
+		assert.Equal(t, UniqueKeyUUID(class.Class, fromClient), UniqueKeyUUID(class.Class, fromDisk))
+	})
+
+	t.Run("different keys result in different ids", func(t *testing.T) {
+		props := map[string]interface{}{
+			"sku": "A-1", "region": float64(7), "since": since, "vendor": vendor,
+		}
+		first, err := UniqueKeyValues(class, props)
+		require.Nil(t, err)
+		props["region"] = float64(8)
+		second, err := UniqueKeyValues(class, props)
+		require.Nil(t, err)
+
+		assert.NotEqual(t, UniqueKeyUUID(class.Class, first), UniqueKeyUUID(class.Class, second))
+		_, err = uuid.Parse(UniqueKeyUUID(class.Class, first).String())
+		assert.Nil(t, err)
+	})
+
+	t.Run("missing value", func(t *testing.T) {
+		_, err := UniqueKeyValues(class, map[string]interface{}{
+			"sku": "A-1", "region": float64(7), "since": since,
+		})
+		assert.ErrorContains(t, err, `missing value of property "vendor"`)
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		_, err := UniqueKeyValues(class, map[string]interface{}{
+			"sku": 1.0, "region": float64(7), "since": since, "vendor": vendor,
+		})
+		assert.ErrorContains(t, err, `property "sku"`)
+	})
+}
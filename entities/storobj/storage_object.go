@@ -56,6 +56,11 @@ type Object struct {
 	IsConsistent      bool          `json:"-"`
 	DocID             uint64
 	Vectors           map[string][]float32 `json:"vectors"`
+
+	// IfMatch is an optional write precondition. It is not persisted. If
+	// set, the write only succeeds if the stored object was last updated
+	// at exactly this unix time in ms, 0 meaning it must not exist yet.
+	IfMatch *int64 `json:"-"`
 }
 
 func New(docID uint64) *Object {
@@ -64,6 +64,10 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
 				},
 				FlatUC: flat.UserConfig{
@@ -84,6 +88,10 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: flat.CompressionUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+					},
 				},
 			},
 		},
@@ -122,6 +130,10 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
 				},
 				FlatUC: flat.UserConfig{
@@ -142,6 +154,10 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: flat.CompressionUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+					},
 				},
 			},
 		},
@@ -200,6 +216,10 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 					FilterStrategy: hnsw.FilterStrategyAcorn,
 				},
 				FlatUC: flat.UserConfig{
@@ -220,6 +240,10 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: flat.CompressionUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+					},
 				},
 			},
 		},
@@ -266,6 +290,10 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
 				},
 				FlatUC: flat.UserConfig{
@@ -286,6 +314,10 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: flat.CompressionUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+					},
 				},
 			},
 		},
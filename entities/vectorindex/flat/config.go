@@ -38,6 +38,7 @@ type UserConfig struct {
 	PQ                    CompressionUserConfig `json:"pq"`
 	BQ                    CompressionUserConfig `json:"bq"`
 	SQ                    CompressionUserConfig `json:"sq"`
+	RQ                    CompressionUserConfig `json:"rq"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -62,6 +63,8 @@ func (u *UserConfig) SetDefaults() {
 	u.BQ.RescoreLimit = DefaultCompressionRescore
 	u.SQ.Enabled = DefaultCompressionEnabled
 	u.SQ.RescoreLimit = DefaultCompressionRescore
+	u.RQ.Enabled = DefaultCompressionEnabled
+	u.RQ.RescoreLimit = DefaultCompressionRescore
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -126,8 +129,9 @@ func parseCompression(in map[string]interface{}, uc *UserConfig) error {
 	pqConfigValue, pqOk := in["pq"]
 	bqConfigValue, bqOk := in["bq"]
 	sqConfigValue, sqOk := in["sq"]
+	rqConfigValue, rqOk := in["rq"]
 
-	if !pqOk && !bqOk && !sqOk {
+	if !pqOk && !bqOk && !sqOk && !rqOk {
 		return nil
 	}
 
@@ -152,7 +156,14 @@ func parseCompression(in map[string]interface{}, uc *UserConfig) error {
 		}
 	}
 
-	compressionConfigs := []CompressionUserConfig{uc.PQ, uc.BQ, uc.SQ}
+	if rqOk {
+		err := parseCompressionMap(rqConfigValue, &uc.RQ)
+		if err != nil {
+			return err
+		}
+	}
+
+	compressionConfigs := []CompressionUserConfig{uc.PQ, uc.BQ, uc.SQ, uc.RQ}
 	totalEnabled := 0
 
 	for _, compressionConfig := range compressionConfigs {
@@ -175,6 +186,9 @@ func parseCompression(in map[string]interface{}, uc *UserConfig) error {
 	if uc.SQ.Enabled {
 		return errors.New("SQ is not currently supported for flat indices")
 	}
+	if uc.RQ.Cache {
+		return errors.New("cache is not currently supported for RQ on flat indices")
+	}
 
 	return nil
 }
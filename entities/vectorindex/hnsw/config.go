@@ -57,6 +57,7 @@ type UserConfig struct {
 	PQ                     PQConfig `json:"pq"`
 	BQ                     BQConfig `json:"bq"`
 	SQ                     SQConfig `json:"sq"`
+	RQ                     RQConfig `json:"rq"`
 	FilterStrategy         string   `json:"filterStrategy"`
 }
 
@@ -102,6 +103,10 @@ func (u *UserConfig) SetDefaults() {
 		TrainingLimit: DefaultSQTrainingLimit,
 		RescoreLimit:  DefaultSQRescoreLimit,
 	}
+	u.RQ = RQConfig{
+		Enabled:      DefaultRQEnabled,
+		RescoreLimit: DefaultRQRescoreLimit,
+	}
 	u.FilterStrategy = DefaultFilterStrategy
 }
 
@@ -198,6 +203,10 @@ func ParseAndValidateConfig(input interface{}) (config.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := parseRQMap(asMap, &uc.RQ); err != nil {
+		return uc, err
+	}
+
 	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "filterStrategy", func(v string) {
 		uc.FilterStrategy = v
 	}); err != nil {
@@ -242,6 +251,9 @@ func (u *UserConfig) validate() error {
 	if u.SQ.Enabled {
 		enabled++
 	}
+	if u.RQ.Enabled {
+		enabled++
+	}
 	if enabled > 1 {
 		return fmt.Errorf("invalid hnsw config: more than a single compression methods enabled")
 	}
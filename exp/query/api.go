@@ -17,20 +17,21 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"os"
+	"path"
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
-	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
-	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
-	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/usecases/modulecomponents/text2vecbase"
 	"github.com/weaviate/weaviate/usecases/modules"
 )
@@ -40,6 +41,11 @@ const (
 
 	defaultLSMRoot = "lsm"
 
+	// files at the root of the offloaded tenant, see `db.Shard`
+	propLengthsFile  = "proplengths"
+	shardVersionFile = "version"
+	indexCountFile   = "indexcount"
+
 	maxQueryObjectsLimit = 10
 )
 
@@ -115,12 +121,16 @@ func (a *API) Search(ctx context.Context, req *SearchRequest) (*SearchResponse,
 		return nil, fmt.Errorf("tenant %q belongs to no nodes, impossible to locate in s3: %w", req.Tenant, ErrTenantBelongsToNoNodes)
 	}
 
-	store, localPath, err := a.lsm.Fetch(ctx, belongsToNodes[0], req.Collection, req.Tenant, tenantVersion)
+	store, lsmPath, err := a.lsm.Fetch(ctx, belongsToNodes[0], req.Collection, req.Tenant, tenantVersion)
 	if err != nil {
 		return nil, err
 	}
 	defer store.Shutdown(ctx)
 
+	// lsm data lives in `lsm` dir of the tenant, everything else (e.g: hnsw commit logs,
+	// property lengths) at the root of the tenant
+	tenantPath := path.Dir(lsmPath)
+
 	var resp SearchResponse
 
 	limit := req.Limit
@@ -128,10 +138,27 @@ func (a *API) Search(ctx context.Context, req *SearchRequest) (*SearchResponse,
 		limit = maxQueryObjectsLimit
 	}
 
-	// TODO(kavi): Hanle where we support both nearText && Filters in the query
+	class := req.Class
+	if class == nil {
+		class, err = a.schema.Collection(ctx, req.Collection)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get class info for %q from schema: %w", req.Collection, err)
+		}
+	}
+
+	// filters combined with vector or keyword search restrict the candidates of the search
+	var allow helpers.AllowList
+	if req.Filters != nil && (len(req.NearText) != 0 || req.Keyword != "") {
+		allow, err = a.filterDocIDs(ctx, store, tenantPath, req.Collection, class, req.Tenant, req.Filters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to do filter search: %w", err)
+		}
+	}
+
 	if len(req.NearText) != 0 {
 		// do vector search
-		resObjects, distances, err := a.vectorSearch(ctx, store, localPath, req.NearText, float32(req.Certainty), limit)
+		tc := a.lsm.cachedTenant(req.Collection, req.Tenant, tenantPath)
+		resObjects, distances, err := a.vectorSearch(ctx, store, tc, class, tenantPath, lsmPath, req.NearText, float32(req.Certainty), limit, allow)
 		if err != nil {
 			return nil, err
 		}
@@ -144,13 +171,29 @@ func (a *API) Search(ctx context.Context, req *SearchRequest) (*SearchResponse,
 			resp.Results = append(resp.Results, Result{
 				Obj:       resObjects[i],
 				Certainty: float64(distances[i]),
+				Distance:  distances[i],
+			})
+		}
+		return &resp, nil
+	}
+
+	if req.Keyword != "" {
+		resObjects, scores, err := a.keywordSearch(ctx, store, class, tenantPath, req.Keyword, req.KeywordProperties, limit, allow)
+		if err != nil {
+			return nil, fmt.Errorf("failed to do keyword search: %w", err)
+		}
+
+		for i := 0; i < len(resObjects); i++ {
+			resp.Results = append(resp.Results, Result{
+				Obj:   resObjects[i],
+				Score: scores[i],
 			})
 		}
 		return &resp, nil
 	}
 
 	if req.Filters != nil {
-		resObjs, err := a.propertyFilters(ctx, store, req.Collection, req.Class, req.Tenant, req.Filters, limit)
+		resObjs, err := a.propertyFilters(ctx, store, req.Collection, class, req.Tenant, req.Filters, limit)
 		if err != nil {
 			return nil, fmt.Errorf("failed to do filter search: %w", err)
 		}
@@ -180,6 +223,32 @@ func (a *API) Search(ctx context.Context, req *SearchRequest) (*SearchResponse,
 	return &resp, nil
 }
 
+// Refresh downloads the latest version of the tenant's data if the tenant is
+// cached, so that subsequent searches don't have to wait for the download.
+// It returns the version of the data that is served from now on, or 0 if the
+// tenant is not cached.
+func (a *API) Refresh(ctx context.Context, collection, tenant string) (int64, error) {
+	if !a.lsm.Cached(collection, tenant) {
+		return 0, nil
+	}
+
+	info, belongsToNodes, tenantVersion, err := a.schema.TenantStatus(ctx, collection, tenant)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get tenant status: collection: %q, tenant %q: %w", collection, tenant, err)
+	}
+	if info != TenantOffLoadingStatus {
+		return 0, fmt.Errorf("tenant %q is not offloaded, %w", tenant, ErrInvalidTenant)
+	}
+	if len(belongsToNodes) == 0 {
+		return 0, fmt.Errorf("tenant %q belongs to no nodes, impossible to locate in s3: %w", tenant, ErrTenantBelongsToNoNodes)
+	}
+
+	if err := a.lsm.Prefetch(ctx, belongsToNodes[0], collection, tenant, tenantVersion); err != nil {
+		return 0, err
+	}
+	return tenantVersion, nil
+}
+
 func (a *API) propertyFilters(
 	ctx context.Context,
 	store *lsmkv.Store,
@@ -189,6 +258,59 @@ func (a *API) propertyFilters(
 	filter *filters.LocalFilter,
 	limit int,
 ) ([]*storobj.Object, error) {
+	searcher, props, class, err := a.invertedSearcher(ctx, store, collection, class, tenant, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	objs, err := searcher.Objects(ctx, limit, filter, nil, additional.Properties{}, schema.ClassName(class.Class), props)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for objects:%w", err)
+	}
+
+	return objs, nil
+}
+
+// filterDocIDs returns the doc ids of all the objects matching the filter, to be used as allow list
+// of vector and keyword search.
+func (a *API) filterDocIDs(
+	ctx context.Context,
+	store *lsmkv.Store,
+	tenantPath string,
+	collection string,
+	class *models.Class,
+	tenant string,
+	filter *filters.LocalFilter,
+) (helpers.AllowList, error) {
+	maxDocID, err := readIndexCount(tenantPath)
+	if err != nil {
+		return nil, err
+	}
+	// needed to negate the filters (e.g: NotEqual)
+	bitmapFactory := roaringset.NewBitmapFactory(func() uint64 { return maxDocID }, a.log)
+
+	searcher, _, class, err := a.invertedSearcher(ctx, store, collection, class, tenant, bitmapFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	allow, err := searcher.DocIDs(ctx, filter, additional.Properties{}, schema.ClassName(class.Class))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for doc ids: %w", err)
+	}
+	return allow, nil
+}
+
+// invertedSearcher loads the filterable property buckets and objects bucket of the tenant and returns
+// searcher on top of them along with the property names of the collection.
+func (a *API) invertedSearcher(
+	ctx context.Context,
+	store *lsmkv.Store,
+	collection string,
+	class *models.Class,
+	tenant string,
+	bitmapFactory *roaringset.BitmapFactory,
+) (*inverted.Searcher, []string, *models.Class, error) {
 	// TODO(kavi): make it dynamic
 	fallbackToSearchable := func() bool {
 		return false
@@ -202,7 +324,7 @@ func (a *API) propertyFilters(
 	if class == nil {
 		class, err = a.schema.Collection(ctx, collection)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get class info for %q from schema: %w", collection, err)
+			return nil, nil, nil, fmt.Errorf("failed to get class info for %q from schema: %w", collection, err)
 		}
 	}
 
@@ -211,7 +333,7 @@ func (a *API) propertyFilters(
 	// Made sure all the properties of the class have been loaded for inverted index search
 	for _, prop := range class.Properties {
 		if err := store.CreateOrLoadBucket(ctx, helpers.BucketFromPropNameLSM(prop.Name), opts...); err != nil {
-			return nil, fmt.Errorf("failed to open property lsmkv bucket: %w", err)
+			return nil, nil, nil, fmt.Errorf("failed to open property lsmkv bucket: %w", err)
 		}
 		props = append(props, prop.Name)
 	}
@@ -221,79 +343,127 @@ func (a *API) propertyFilters(
 	}
 
 	// TODO(kavi): Handle cases where we pass `nil` to `propIndices`(for geo-indices) and `classSearcher`
-	searcher := inverted.NewSearcher(a.log, store, getClass, nil, nil, a.stopwords, 0, fallbackToSearchable, tenant, maxQueryObjectsLimit, nil)
+	searcher := inverted.NewSearcher(a.log, store, getClass, nil, nil, a.stopwords, 0, fallbackToSearchable, tenant, maxQueryObjectsLimit, bitmapFactory)
 
-	opts = []lsmkv.BucketOption{
-		lsmkv.WithSecondaryIndices(2),
+	if err := a.loadObjectsBucket(ctx, store); err != nil {
+		return nil, nil, nil, err
 	}
 
-	// TOD(kavi): remove `opts` may be not necessary
-	if err := store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM, opts...); err != nil {
-		return nil, fmt.Errorf("failed to open objects bucket: %w", err)
+	return searcher, props, class, nil
+}
+
+// keywordSearch serves BM25 search over the searchable properties of the tenant.
+// If `properties` is empty, all the searchable properties are searched.
+func (a *API) keywordSearch(
+	ctx context.Context,
+	store *lsmkv.Store,
+	class *models.Class,
+	tenantPath string,
+	query string,
+	properties []string,
+	limit int,
+	allow helpers.AllowList,
+) ([]*storobj.Object, []float32, error) {
+	shardVersion, err := readShardVersion(tenantPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts := []lsmkv.BucketOption{
+		lsmkv.WithStrategy(lsmkv.StrategyMapCollection),
+	}
+	if shardVersion < 2 {
+		opts = append(opts, lsmkv.WithLegacyMapSorting())
+	}
+
+	searchable := make([]string, 0, len(class.Properties))
+	for _, prop := range class.Properties {
+		if !inverted.HasSearchableIndex(prop) {
+			continue
+		}
+		if err := store.CreateOrLoadBucket(ctx, helpers.BucketSearchableFromPropNameLSM(prop.Name), opts...); err != nil {
+			return nil, nil, fmt.Errorf("failed to open searchable property lsmkv bucket: %w", err)
+		}
+		searchable = append(searchable, prop.Name)
+	}
+	if len(properties) == 0 {
+		properties = searchable
 	}
 
-	objs, err := searcher.Objects(ctx, limit, filter, nil, additional.Properties{}, schema.ClassName(collection), props)
+	if err := a.loadObjectsBucket(ctx, store); err != nil {
+		return nil, nil, err
+	}
+
+	propLengths, err := inverted.NewJsonShardMetaData(path.Join(tenantPath, propLengthsFile), a.log)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search for objects:%w", err)
+		return nil, nil, fmt.Errorf("failed to load property lengths: %w", err)
 	}
 
-	return objs, nil
+	getClass := func(className string) *models.Class {
+		return class
+	}
+
+	config := inverted.ConfigFromModel(class.InvertedIndexConfig)
+	searcher := inverted.NewBM25Searcher(config.BM25, store, getClass, nil, nil, propLengths, a.log, shardVersion)
+
+	return searcher.BM25F(ctx, allow, schema.ClassName(class.Class), limit, searchparams.KeywordRanking{
+		Type:       "bm25",
+		Query:      query,
+		Properties: properties,
+	}, additional.Properties{})
 }
 
 func (a *API) vectorSearch(
 	ctx context.Context,
 	store *lsmkv.Store,
-	localPath string,
+	tc *TenantCache,
+	class *models.Class,
+	tenantPath string,
+	lsmPath string,
 	nearText []string,
 	threshold float32,
 	limit int,
+	allow helpers.AllowList,
 ) ([]*storobj.Object, []float32, error) {
 	vectors, err := a.vectorizer.Texts(ctx, nearText, &modules.ClassBasedModuleConfig{})
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to vectorize the nearText query: %w", err)
 	}
 
-	// TODO(kavi): Assuming BQ compression is enabled. Make it generic.
-	bq := flatent.CompressionUserConfig{
-		Enabled: true,
-	}
-
-	// NOTE(kavi): Accept distance provider as dependency?
-	dis := distancer.NewCosineDistanceProvider()
-	index, err := flat.New(flat.Config{
-		ID:               helpers.VectorsCompressedBucketLSM,
-		DistanceProvider: dis,
-		RootPath:         localPath,
-	}, flatent.UserConfig{
-		BQ: bq,
-	}, store)
+	index, release, err := a.tenantVectorIndex(class, store, tc, tenantPath, lsmPath)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to initialize index: %w", err)
+		return nil, nil, err
+	}
+	defer release()
+
+	var (
+		matchedIDs []uint64
+		distances  []float32
+	)
+	if threshold == 0 {
+		matchedIDs, distances, err = index.SearchByVector(ctx, vectors, limit, allow)
+	} else {
+		// `SearchByVectorDistance` takes `limit` only as upper bound of the candidates
+		// to look at, results beyond `limit` are dropped below.
+		certainty := 1 - threshold
+		matchedIDs, distances, err = index.SearchByVectorDistance(ctx, vectors, certainty, int64(limit), allow)
 	}
-	defer index.Shutdown(ctx)
-
-	// TODO(kavi): Here `limit` is not what you expect. It's the maxLimit.
-	// Currently `SearchByVectorDistance` api takes limit via `newSearchByDistParams(maxLimit)` which caller
-	// don't have control too.
-	certainty := 1 - threshold
-	matched_ids, distance, err := index.SearchByVectorDistance(ctx, vectors, certainty, int64(limit), nil)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to search by vector: %w", err)
 	}
-
-	opts := []lsmkv.BucketOption{
-		lsmkv.WithSecondaryIndices(2),
+	if len(matchedIDs) > limit {
+		matchedIDs, distances = matchedIDs[:limit], distances[:limit]
 	}
 
-	if err := store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM, opts...); err != nil {
-		return nil, nil, fmt.Errorf("failed to vectorize query text: %w", err)
+	if err := a.loadObjectsBucket(ctx, store); err != nil {
+		return nil, nil, err
 	}
 
 	bkt := store.Bucket(helpers.ObjectsBucketLSM)
 
 	objs := make([]*storobj.Object, 0)
 
-	for _, id := range matched_ids {
+	for _, id := range matchedIDs {
 		key, err := indexDocIDToLSMKey(id)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to serialize ids returned from flat index: %w", err)
@@ -311,7 +481,56 @@ func (a *API) vectorSearch(
 		objs = append(objs, obj)
 	}
 
-	return objs, distance, nil
+	return objs, distances, nil
+}
+
+func (a *API) loadObjectsBucket(ctx context.Context, store *lsmkv.Store) error {
+	opts := []lsmkv.BucketOption{
+		lsmkv.WithSecondaryIndices(2),
+	}
+
+	if err := store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM, opts...); err != nil {
+		return fmt.Errorf("failed to open objects bucket: %w", err)
+	}
+	return nil
+}
+
+// readShardVersion reads the version of the on-disk format of the offloaded shard.
+// Shards without version file are assumed to be of version 1.
+func readShardVersion(tenantPath string) (uint16, error) {
+	var version uint16 = 1
+	if _, err := readTenantFile(tenantPath, shardVersionFile, &version); err != nil {
+		return 0, fmt.Errorf("failed to read shard version: %w", err)
+	}
+	return version, nil
+}
+
+// readIndexCount reads the next doc id to be assigned in the offloaded shard, i.e. all
+// the doc ids of the shard are below it.
+func readIndexCount(tenantPath string) (uint64, error) {
+	var count uint64
+	if _, err := readTenantFile(tenantPath, indexCountFile, &count); err != nil {
+		return 0, fmt.Errorf("failed to read index count: %w", err)
+	}
+	return count, nil
+}
+
+// readTenantFile reads little endian encoded `data` from the file at the root of the tenant.
+// Returns false, leaving `data` untouched, if the file doesn't exist.
+func readTenantFile(tenantPath, name string, data any) (bool, error) {
+	f, err := os.Open(path.Join(tenantPath, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	if err := binary.Read(f, binary.LittleEndian, data); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 type SearchRequest struct {
@@ -323,6 +542,10 @@ type SearchRequest struct {
 	Certainty float64  // threshold to match with certainty of vectors match
 	Limit     int
 	Filters   *filters.LocalFilter
+
+	Keyword string // keyword (bm25) search
+	// properties to do keyword search on. All searchable properties if empty.
+	KeywordProperties []string
 }
 
 type SearchResponse struct {
@@ -332,6 +555,8 @@ type SearchResponse struct {
 type Result struct {
 	Obj       *storobj.Object
 	Certainty float64
+	Distance  float32 // set on vector search
+	Score     float32 // set on keyword search
 }
 
 func indexDocIDToLSMKey(x uint64) ([]byte, error) {
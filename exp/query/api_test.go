@@ -16,12 +16,14 @@ import (
 	"encoding/json"
 	"io"
 	"os"
+	"path"
 	"sync"
 	"testing"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
@@ -167,6 +169,8 @@ func TestAPI_vectorSearch(t *testing.T) {
 	defer store.Shutdown(ctx)
 
 	api := NewAPI(testSchemaInfo, lsm, vectorize, st, &config, logger)
+	class, err := testSchemaInfo.Collection(ctx, testCollection)
+	require.NoError(t, err)
 
 	nearText := []string{"biology"}
 
@@ -189,19 +193,205 @@ func TestAPI_vectorSearch(t *testing.T) {
 			name:      "vector_match_with_threshold_and_limit",
 			threshold: 0.4,
 			limit:     2,
-			expCount:  7, // limit - not working as expected. See TODO in api.go
+			expCount:  2,
 		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			res, _, err := api.vectorSearch(ctx, store, lsmRoot, nearText, tc.threshold, tc.limit)
+			limit := tc.limit
+			if limit == 0 {
+				limit = maxQueryObjectsLimit
+			}
+			res, _, err := api.vectorSearch(ctx, store, nil, class, path.Dir(lsmRoot), lsmRoot, nearText, tc.threshold, limit, nil)
 			require.NoError(t, err)
 			assert.Equal(t, tc.expCount, len(res))
 		})
 	}
 }
 
+func TestAPI_vectorSearchCachedIndex(t *testing.T) {
+	vectorize := newMockVectorizer(t)
+	offmod := modsloads3.Module{}
+	config := Config{}
+	logger := testLogger()
+	lsm := NewLSMFetcher("", &offmod, logger)
+	ctx := context.Background()
+	st, err := stopwords.NewDetectorFromPreset(stopwords.EnglishPreset)
+	require.NoError(t, err)
+
+	store, err := lsmkv.New(lsmRoot, lsmRoot, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.NoError(t, err)
+	defer store.Shutdown(ctx)
+
+	api := NewAPI(testSchemaInfo, lsm, vectorize, st, &config, logger)
+	class, err := testSchemaInfo.Collection(ctx, testCollection)
+	require.NoError(t, err)
+
+	tc := &TenantCache{Collection: testCollection, TenantID: "test-tenant"}
+	nearText := []string{"biology"}
+
+	res, _, err := api.vectorSearch(ctx, store, tc, class, path.Dir(lsmRoot), lsmRoot, nearText, 0, maxQueryObjectsLimit, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 10, len(res))
+	index := tc.vector
+	require.NotNil(t, index)
+
+	// the index is opened only once for the cached tenant
+	res, _, err = api.vectorSearch(ctx, store, tc, class, path.Dir(lsmRoot), lsmRoot, nearText, 0, maxQueryObjectsLimit, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 10, len(res))
+	assert.True(t, index == tc.vector)
+
+	// evicted tenant closes its index, searches still in flight open their own
+	tc.closeVectorIndex()
+	assert.Nil(t, tc.vector)
+	res, _, err = api.vectorSearch(ctx, store, tc, class, path.Dir(lsmRoot), lsmRoot, nearText, 0, maxQueryObjectsLimit, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 10, len(res))
+	assert.Nil(t, tc.vector)
+}
+
+func TestAPI_keywordSearch(t *testing.T) {
+	vectorize := newMockVectorizer(t)
+	offmod := modsloads3.Module{}
+	config := Config{}
+	logger := testLogger()
+	lsm := NewLSMFetcher("", &offmod, logger)
+	ctx := context.Background()
+	st, err := stopwords.NewDetectorFromPreset(stopwords.EnglishPreset)
+	require.NoError(t, err)
+
+	store, err := lsmkv.New(lsmRoot, lsmRoot, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.NoError(t, err)
+	defer store.Shutdown(ctx)
+
+	api := NewAPI(testSchemaInfo, lsm, vectorize, st, &config, logger)
+	class, err := testSchemaInfo.Collection(ctx, testCollection)
+	require.NoError(t, err)
+
+	animals := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorEqual,
+		On: &filters.Path{
+			Class:    schema.AssertValidClassName("Question"),
+			Property: schema.AssertValidPropertyName("category"),
+		},
+		Value: &filters.Value{
+			Value: "ANIMALS",
+			Type:  schema.DataTypeText,
+		},
+	}}
+
+	cases := []struct {
+		name       string
+		keyword    string
+		properties []string
+		filters    *filters.LocalFilter
+		limit      int
+		expCount   int
+	}{
+		{
+			name:     "all_searchable_properties",
+			keyword:  "ANIMALS",
+			expCount: 4,
+		},
+		{
+			name:       "single_property",
+			keyword:    "Elephant",
+			properties: []string{"answer"},
+			expCount:   1,
+		},
+		{
+			name:       "no_match_in_property",
+			keyword:    "Elephant",
+			properties: []string{"question"},
+			expCount:   0,
+		},
+		{
+			name:     "with_filter",
+			keyword:  "the",
+			filters:  animals,
+			expCount: 4, // 8 objects match `the`, only 4 of them are animals
+		},
+		{
+			name:     "with_limit",
+			keyword:  "the",
+			limit:    2,
+			expCount: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			limit := tc.limit
+			if limit == 0 {
+				limit = maxQueryObjectsLimit
+			}
+
+			var allow helpers.AllowList
+			if tc.filters != nil {
+				allow, err = api.filterDocIDs(ctx, store, path.Dir(lsmRoot), testCollection, class, testTenant, tc.filters)
+				require.NoError(t, err)
+			}
+
+			res, scores, err := api.keywordSearch(ctx, store, class, path.Dir(lsmRoot), tc.keyword, tc.properties, limit, allow)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expCount, len(res))
+			assert.Equal(t, len(res), len(scores))
+		})
+	}
+}
+
+func TestAPI_vectorSearchWithFilters(t *testing.T) {
+	vectorize := newMockVectorizer(t)
+	offmod := modsloads3.Module{}
+	config := Config{}
+	logger := testLogger()
+	lsm := NewLSMFetcher("", &offmod, logger)
+	ctx := context.Background()
+	st, err := stopwords.NewDetectorFromPreset(stopwords.EnglishPreset)
+	require.NoError(t, err)
+
+	store, err := lsmkv.New(lsmRoot, lsmRoot, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.NoError(t, err)
+	defer store.Shutdown(ctx)
+
+	api := NewAPI(testSchemaInfo, lsm, vectorize, st, &config, logger)
+	class, err := testSchemaInfo.Collection(ctx, testCollection)
+	require.NoError(t, err)
+
+	science := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorEqual,
+		On: &filters.Path{
+			Class:    schema.AssertValidClassName("Question"),
+			Property: schema.AssertValidPropertyName("category"),
+		},
+		Value: &filters.Value{
+			Value: "SCIENCE",
+			Type:  schema.DataTypeText,
+		},
+	}}
+
+	allow, err := api.filterDocIDs(ctx, store, path.Dir(lsmRoot), testCollection, class, testTenant, science)
+	require.NoError(t, err)
+
+	res, _, err := api.vectorSearch(ctx, store, nil, class, path.Dir(lsmRoot), lsmRoot, []string{"biology"}, 0, maxQueryObjectsLimit, allow)
+	require.NoError(t, err)
+	require.Len(t, res, 6)
+	for _, obj := range res {
+		assert.Equal(t, "SCIENCE", obj.Object.Properties.(map[string]interface{})["category"])
+	}
+}
+
 type mockSchemaInfo struct {
 	mu         sync.Mutex
 	tenantinfo map[string]*tenantInfo
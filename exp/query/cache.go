@@ -18,6 +18,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"reflect"
 	"sync"
 	"time"
 
@@ -29,6 +30,8 @@ import (
 var (
 	ErrTenantNotFound       = errors.New("cache: tenant not found")
 	ErrTenantDirectoryFound = errors.New("cache: tenant directory not found")
+
+	errTenantEvicted = errors.New("cache: tenant evicted")
 )
 
 const (
@@ -87,6 +90,15 @@ func (d *DiskCache) Tenant(collection, tenantID string) (*TenantCache, error) {
 	return nil, ErrTenantNotFound
 }
 
+// Has returns true if the tenant is cached. Unlike `Tenant`, it doesn't count as an access to the tenant.
+func (d *DiskCache) Has(collection, tenantID string) bool {
+	d.tmu.RLock()
+	defer d.tmu.RUnlock()
+
+	_, exists := d.tenants[d.TenantKey(collection, tenantID)]
+	return exists
+}
+
 // AddTenant is called after having the files in right directory
 // AddTenant checks if file is present on that directory
 // deterministically generated from `collection` & `tenant` & `version` with basePath
@@ -111,18 +123,26 @@ func (d *DiskCache) AddTenant(collection, tenantID string, version int64) error
 		return fmt.Errorf("failed to get local path of the tenant: %w", err)
 	}
 
+	key := d.TenantKey(tc.Collection, tc.TenantID)
+
 	d.emu.Lock()
+	defer d.emu.Unlock()
+
 	c := d.evictList.PushFront(tc)
-	d.emu.Unlock()
 
 	d.tmu.Lock()
-	d.tenants[d.TenantKey(tc.Collection, tc.TenantID)] = c
+	if prev, ok := d.tenants[key]; ok {
+		// replaced by newer version of the tenant
+		d.evictList.Remove(prev)
+		prev.Value.(*TenantCache).closeVectorIndex()
+	}
+	d.tenants[key] = c
 	d.tmu.Unlock()
 
 	// evit if the size is getting filled up
 	// TODO(kavi): Worth doing it on different go routine? But will get complex with correctness
 	if d.usage() > d.maxCap {
-		if err := d.evict(); err != nil {
+		if err := d.evict(c); err != nil {
 			return err
 		}
 	}
@@ -152,22 +172,31 @@ func (d *DiskCache) usage() int64 {
 	return sizeBytes
 }
 
-func (d *DiskCache) evict() error {
+// evict removes the least recently used tenants until the usage is below the
+// max capacity by `evictFraction`. `keep` is never evicted, even if it alone
+// exceeds the capacity.
+// Caller must hold `emu`.
+func (d *DiskCache) evict(keep *list.Element) error {
 	evictSize := int64(float64(d.maxCap) * evictFraction)
 
 	for d.usage() > (d.maxCap - evictSize) {
 		e := d.evictList.Back()
-		if e == nil {
-			// NOTE: Shouldn't happen.
-			// This mean, we still have disk space filling up, but tenant eviction list is empty.
-			panic("cache: max capacity reached. But couldn't find any tenant to evict")
+		if e == nil || e == keep {
+			// nothing else to evict
+			return nil
 		}
 		t := e.Value.(*TenantCache)
+		t.closeVectorIndex()
 		if err := os.RemoveAll(t.AbsolutePath()); err != nil {
 			return err
 		}
+
+		d.tmu.Lock()
 		delete(d.tenants, d.TenantKey(t.Collection, t.TenantID))
+		d.tmu.Unlock()
+
 		d.evictList.Remove(e)
+		d.metrics.Evictions.WithLabelValues(t.Collection, t.TenantID).Inc()
 	}
 	return nil
 }
@@ -183,17 +212,75 @@ type TenantCache struct {
 	LastAccessed time.Time
 
 	basePath string
+
+	// vector index opened on this version of the tenant's data. It is closed
+	// once the tenant is evicted or replaced by a newer version.
+	vectorLock   sync.RWMutex
+	vector       vectorIndex
+	vectorConfig interface{}
+	closeVector  func()
+	evicted      bool
 }
 
 func (tc *TenantCache) AbsolutePath() string {
 	return path.Join(tc.basePath, tc.Collection, tc.TenantID, fmt.Sprintf("%d", tc.Version))
 }
 
+// openedVectorIndex returns the vector index opened on the tenant's data,
+// opening it with `open` if it isn't yet or was opened with another config.
+// `release` must be called once the search is done.
+func (tc *TenantCache) openedVectorIndex(config interface{},
+	open func() (vectorIndex, func(), error),
+) (index vectorIndex, release func(), err error) {
+	for {
+		tc.vectorLock.RLock()
+		if tc.evicted {
+			tc.vectorLock.RUnlock()
+			return nil, nil, errTenantEvicted
+		}
+		if tc.vector != nil && reflect.DeepEqual(tc.vectorConfig, config) {
+			return tc.vector, tc.vectorLock.RUnlock, nil
+		}
+		tc.vectorLock.RUnlock()
+
+		tc.vectorLock.Lock()
+		if !tc.evicted && (tc.vector == nil || !reflect.DeepEqual(tc.vectorConfig, config)) {
+			tc.closeVectorIndexLocked()
+			index, closeIndex, err := open()
+			if err != nil {
+				tc.vectorLock.Unlock()
+				return nil, nil, err
+			}
+			tc.vector, tc.vectorConfig, tc.closeVector = index, config, closeIndex
+		}
+		tc.vectorLock.Unlock()
+	}
+}
+
+// closeVectorIndex closes the vector index opened on the tenant's data once
+// no search uses it anymore
+func (tc *TenantCache) closeVectorIndex() {
+	tc.vectorLock.Lock()
+	defer tc.vectorLock.Unlock()
+
+	tc.evicted = true
+	tc.closeVectorIndexLocked()
+}
+
+func (tc *TenantCache) closeVectorIndexLocked() {
+	if tc.vector == nil {
+		return
+	}
+	tc.closeVector()
+	tc.vector, tc.vectorConfig, tc.closeVector = nil, nil, nil
+}
+
 // CacheMetrics exposes some insights about how cache operations.
 type CacheMetrics struct {
 	// OpsDuration tracks overall duration of each cache operation.
 	OpsDuration *prometheus.HistogramVec
 	CacheMiss   *prometheus.CounterVec
+	Evictions   *prometheus.CounterVec
 
 	// UsageCalcDuration tracks `usage()` calls that sits in both read and write path.
 	UsageCalcDuration prometheus.Histogram
@@ -212,6 +299,10 @@ func NewCacheMetrics(namespace string, reg prometheus.Registerer) *CacheMetrics
 			Namespace: namespace,
 			Name:      "query_cache_miss_total",
 		}, []string{"collection", "tenant"}),
+		Evictions: r.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "query_cache_evictions_total",
+		}, []string{"collection", "tenant"}),
 
 		UsageCalcDuration: r.NewHistogram(prometheus.HistogramOpts{
 			Namespace: namespace,
@@ -79,6 +79,50 @@ func TestCache_Tenant(t *testing.T) {
 	assert.ErrorIs(t, err, ErrTenantNotFound)
 }
 
+func TestCache_EvictKeepsLatestTenant(t *testing.T) {
+	root := t.TempDir()
+
+	c := NewDiskCache(root, 100, NewCacheMetrics("test", prometheus.NewPedanticRegistry())) // 100 bytes max cap
+
+	// tenant alone exceeds the max capacity. It's still served from the cache instead of
+	// being evicted right after it was added.
+	key := c.TenantKey("test-collection", "test-tenant")
+	createTempFileWithSize(t, path.Join(root, CachePrefix, key, "0"), t.Name(), 150)
+	require.NoError(t, c.AddTenant("test-collection", "test-tenant", 0))
+
+	assert.True(t, c.Has("test-collection", "test-tenant"))
+	_, err := c.Tenant("test-collection", "test-tenant")
+	require.NoError(t, err)
+
+	// vector index opened on the older version is closed once it's replaced
+	closed := false
+	old, err := c.Tenant("test-collection", "test-tenant")
+	require.NoError(t, err)
+	_, release, err := old.openedVectorIndex(nil, func() (vectorIndex, func(), error) {
+		return &stubVectorIndex{}, func() { closed = true }, nil
+	})
+	require.NoError(t, err)
+	release()
+
+	// newer version of the same tenant replaces the older one
+	createTempFileWithSize(t, path.Join(root, CachePrefix, key, "1"), t.Name(), 10)
+	require.NoError(t, os.RemoveAll(path.Join(root, CachePrefix, key, "0")))
+	require.NoError(t, c.AddTenant("test-collection", "test-tenant", 1))
+
+	tt, err := c.Tenant("test-collection", "test-tenant")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), tt.Version)
+	assert.Equal(t, 1, c.evictList.Len())
+	assert.True(t, closed)
+	_, _, err = old.openedVectorIndex(nil, nil)
+	assert.ErrorIs(t, err, errTenantEvicted)
+
+	assert.False(t, c.Has("test-collection", "unknown-tenant"))
+}
+
+// stubVectorIndex stands in for a vector index that is never searched.
+type stubVectorIndex struct{ vectorIndex }
+
 func createTempFileWithSize(t *testing.T, dir, prefix string, size int64) *os.File {
 	t.Helper()
 
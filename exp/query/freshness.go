@@ -0,0 +1,250 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/exp/metadata/proto/api"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	subscribeMinBackoff = 1 * time.Second
+	subscribeMaxBackoff = 30 * time.Second
+)
+
+// TenantRefresher refreshes the locally cached data of the tenant. Usually `query.API`.
+type TenantRefresher interface {
+	// Refresh returns the version of tenant's data served after the refresh. 0 if nothing was refreshed.
+	Refresh(ctx context.Context, collection, tenant string) (int64, error)
+}
+
+// TenantFreshness describes how up to date the data served for a tenant is.
+type TenantFreshness struct {
+	Collection string
+	Tenant     string
+
+	// UpdatedAt is when the querier last learned that tenant's data was updated.
+	UpdatedAt time.Time
+	// RefreshedAt is when the querier last caught up with the update, zero if the tenant is not
+	// cached (next search fetches the latest version anyway).
+	RefreshedAt time.Time
+	// Version is the version of the tenant's data served since `RefreshedAt`.
+	Version int64
+}
+
+// Stale returns true if the tenant's data was updated after the querier last refreshed it.
+func (f TenantFreshness) Stale() bool {
+	return !f.RefreshedAt.IsZero() && f.RefreshedAt.Before(f.UpdatedAt)
+}
+
+// MetadataSubscriber subscribes to the querier events of the metadata node and refreshes the
+// cached tenants whenever their data is updated (e.g: tenant is frozen again).
+type MetadataSubscriber struct {
+	addr      string
+	dialOpts  []grpc.DialOption
+	refresher TenantRefresher
+	metrics   *FreshnessMetrics
+	log       logrus.FieldLogger
+
+	mu sync.Mutex
+	// key: <collection>/<tenant>
+	freshness map[string]TenantFreshness
+}
+
+// NewMetadataSubscriber creates the subscriber to the metadata node at `addr`.
+// If host of the `addr` is empty, localhost is used.
+func NewMetadataSubscriber(addr string, refresher TenantRefresher, metrics *FreshnessMetrics,
+	log logrus.FieldLogger, dialOpts ...grpc.DialOption,
+) *MetadataSubscriber {
+	if host, port, err := net.SplitHostPort(addr); err == nil && host == "" {
+		addr = net.JoinHostPort("localhost", port)
+	}
+
+	return &MetadataSubscriber{
+		addr:      addr,
+		dialOpts:  append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, dialOpts...),
+		refresher: refresher,
+		metrics:   metrics,
+		log:       log.WithField("action", "metadata_subscriber"),
+		freshness: make(map[string]TenantFreshness),
+	}
+}
+
+// Run keeps the subscription to the metadata node alive, re-connecting with backoff, until ctx is done. Blocking.
+func (s *MetadataSubscriber) Run(ctx context.Context) {
+	backoff := subscribeMinBackoff
+	for {
+		start := time.Now()
+		err := s.subscribe(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(start) > subscribeMaxBackoff {
+			// the subscription was healthy for a while, start over
+			backoff = subscribeMinBackoff
+		}
+
+		s.log.WithError(err).WithField("addr", s.addr).WithField("retry_in", backoff).
+			Warn("querier stream to metadata node closed")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, subscribeMaxBackoff)
+	}
+}
+
+func (s *MetadataSubscriber) subscribe(ctx context.Context) error {
+	conn, err := grpc.NewClient(s.addr, s.dialOpts...)
+	if err != nil {
+		return fmt.Errorf("failed to create metadata client: %w", err)
+	}
+	defer conn.Close()
+
+	stream, err := api.NewMetadataServiceClient(conn).QuerierStream(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to open querier stream: %w", err)
+	}
+	s.log.WithField("addr", s.addr).Info("subscribed to metadata node")
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if resp.Type != api.QuerierStreamResponse_TYPE_CLASS_TENANT_DATA_UPDATE || resp.ClassTenant == nil {
+			s.log.WithField("type", resp.Type).Debug("ignoring unknown querier event")
+			continue
+		}
+
+		collection, tenant := resp.ClassTenant.ClassName, resp.ClassTenant.TenantName
+		updatedAt := s.updated(collection, tenant)
+
+		// don't block the stream while the tenant's data is downloaded
+		enterrors.GoWrapper(func() {
+			s.refresh(ctx, collection, tenant, updatedAt)
+		}, s.log)
+	}
+}
+
+func (s *MetadataSubscriber) updated(collection, tenant string) time.Time {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := freshnessKey(collection, tenant)
+	f := s.freshness[key]
+	f.Collection, f.Tenant, f.UpdatedAt = collection, tenant, now
+	s.freshness[key] = f
+
+	s.metrics.DataEvents.WithLabelValues(collection, tenant).Inc()
+	return now
+}
+
+func (s *MetadataSubscriber) refresh(ctx context.Context, collection, tenant string, updatedAt time.Time) {
+	log := s.log.WithFields(logrus.Fields{
+		"collection": collection,
+		"tenant":     tenant,
+	})
+
+	version, err := s.refresher.Refresh(ctx, collection, tenant)
+	if err != nil {
+		log.WithError(err).Warn("failed to refresh tenant data")
+		return
+	}
+	if version == 0 {
+		// not cached, nothing to refresh
+		return
+	}
+
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := freshnessKey(collection, tenant)
+	f := s.freshness[key]
+	if f.Version > version {
+		// a later refresh finished first
+		return
+	}
+	f.RefreshedAt, f.Version = now, version
+	s.freshness[key] = f
+
+	s.metrics.RefreshLag.Observe(now.Sub(updatedAt).Seconds())
+	s.metrics.DataVersion.WithLabelValues(collection, tenant).Set(float64(version))
+	log.WithField("version", version).Debug("refreshed tenant data")
+}
+
+// Freshness returns how up to date the data of the tenant is. False if no update of the tenant
+// was seen since the querier started.
+func (s *MetadataSubscriber) Freshness(collection, tenant string) (TenantFreshness, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, ok := s.freshness[freshnessKey(collection, tenant)]
+	return f, ok
+}
+
+func freshnessKey(collection, tenant string) string {
+	return collection + "/" + tenant
+}
+
+// FreshnessMetrics exposes how up to date the data served by the querier is.
+type FreshnessMetrics struct {
+	// DataEvents counts the data updates of the tenants received from the metadata node.
+	DataEvents *prometheus.CounterVec
+	// RefreshLag tracks the time from receiving the update until the cached tenant is refreshed.
+	RefreshLag prometheus.Histogram
+	// DataVersion is the version of tenant's data currently served.
+	DataVersion *prometheus.GaugeVec
+}
+
+func NewFreshnessMetrics(namespace string, reg prometheus.Registerer) *FreshnessMetrics {
+	r := promauto.With(reg)
+
+	return &FreshnessMetrics{
+		DataEvents: r.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "query_tenant_data_events_total",
+		}, []string{"collection", "tenant"}),
+		RefreshLag: r.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "query_tenant_refresh_lag_seconds",
+			Buckets:   monitoring.LatencyBuckets,
+		}),
+		DataVersion: r.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "query_tenant_data_version",
+		}, []string{"collection", "tenant"}),
+	}
+}
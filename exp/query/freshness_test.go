@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package query
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/exp/metadata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestMetadataSubscriber(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	log := logrus.New()
+	log.SetLevel(logrus.ErrorLevel)
+
+	listener := bufconn.Listen(1024 * 1024)
+	defer listener.Close()
+	querierManager := metadata.NewQuerierManager(log)
+	server := metadata.NewServer("", querierManager, 100, log)
+	enterrors.GoWrapper(func() {
+		server.Serve(ctx, listener, nil)
+	}, log)
+
+	refresher := &mockRefresher{
+		versions: map[string]int64{
+			"Question/cached-tenant": 3,
+		},
+	}
+	subscriber := NewMetadataSubscriber("passthrough:///bufnet", refresher,
+		NewFreshnessMetrics("test", prometheus.NewPedanticRegistry()), log,
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}))
+	enterrors.GoWrapper(func() {
+		subscriber.Run(ctx)
+	}, log)
+
+	// wait until the subscriber is registered with the metadata node, events sent before are lost
+	notify := func(ct metadata.ClassTenant) {
+		require.Eventually(t, func() bool {
+			return querierManager.NotifyClassTenantDataEvent(ct) == nil && refresher.called(ct)
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	t.Run("cached tenant is refreshed", func(t *testing.T) {
+		notify(metadata.ClassTenant{ClassName: "Question", TenantName: "cached-tenant"})
+
+		require.Eventually(t, func() bool {
+			f, ok := subscriber.Freshness("Question", "cached-tenant")
+			return ok && !f.RefreshedAt.IsZero()
+		}, 5*time.Second, 10*time.Millisecond)
+
+		f, _ := subscriber.Freshness("Question", "cached-tenant")
+		assert.Equal(t, int64(3), f.Version)
+		assert.False(t, f.Stale())
+		assert.False(t, f.RefreshedAt.Before(f.UpdatedAt))
+	})
+
+	t.Run("tenant which is not cached is only tracked", func(t *testing.T) {
+		notify(metadata.ClassTenant{ClassName: "Question", TenantName: "other-tenant"})
+
+		f, ok := subscriber.Freshness("Question", "other-tenant")
+		require.True(t, ok)
+		assert.False(t, f.UpdatedAt.IsZero())
+		assert.True(t, f.RefreshedAt.IsZero())
+		assert.Zero(t, f.Version)
+	})
+
+	_, ok := subscriber.Freshness("Question", "unknown-tenant")
+	assert.False(t, ok)
+}
+
+func TestTenantFreshness_Stale(t *testing.T) {
+	now := time.Now()
+
+	assert.False(t, TenantFreshness{UpdatedAt: now}.Stale(), "not cached")
+	assert.False(t, TenantFreshness{UpdatedAt: now, RefreshedAt: now.Add(time.Second)}.Stale())
+	assert.True(t, TenantFreshness{UpdatedAt: now, RefreshedAt: now.Add(-time.Second)}.Stale())
+}
+
+type mockRefresher struct {
+	mu       sync.Mutex
+	versions map[string]int64
+	calls    map[string]int
+}
+
+func (m *mockRefresher) Refresh(ctx context.Context, collection, tenant string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.calls == nil {
+		m.calls = make(map[string]int)
+	}
+	key := freshnessKey(collection, tenant)
+	m.calls[key]++
+	return m.versions[key], nil
+}
+
+func (m *mockRefresher) called(ct metadata.ClassTenant) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls[freshnessKey(ct.ClassName, ct.TenantName)] > 0
+}
@@ -19,6 +19,7 @@ import (
 	v1 "github.com/weaviate/weaviate/adapters/handlers/grpc/v1"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 )
 
@@ -63,7 +64,7 @@ func (g *GRPC) Search(ctx context.Context, req *protocol.SearchRequest) (*protoc
 		return nil, err
 	}
 
-	return toProtoResponse(res), nil
+	return toProtoResponse(res, class)
 }
 
 func requestFromProto(req *protocol.SearchRequest, getClass func(string) *models.Class) (*SearchRequest, error) {
@@ -78,6 +79,10 @@ func requestFromProto(req *protocol.SearchRequest, getClass func(string) *models
 			sr.Certainty = *req.NearText.Certainty
 		}
 	}
+	if req.Bm25Search != nil {
+		sr.Keyword = req.Bm25Search.Query
+		sr.KeywordProperties = req.Bm25Search.Properties
+	}
 	if req.Filters != nil {
 		filter, err := v1.ExtractFilters(req.Filters, getClass, req.Collection)
 		if err != nil {
@@ -88,27 +93,35 @@ func requestFromProto(req *protocol.SearchRequest, getClass func(string) *models
 	return sr, nil
 }
 
-func toProtoResponse(res *SearchResponse) *protocol.SearchReply {
+func toProtoResponse(res *SearchResponse, class *models.Class) (*protocol.SearchReply, error) {
 	var resp protocol.SearchReply
 
+	mapper := v1.NewMapping(true)
+
 	// TODO(kavi): copy rest of the fields accordingly.
 	for _, v := range res.Results {
 		props := protocol.Properties{
 			Fields: make(map[string]*protocol.Value),
 		}
-		objprops := v.Obj.Object.Properties.(map[string]interface{})
+		objprops, _ := v.Obj.Object.Properties.(map[string]interface{})
 		for prop, val := range objprops {
-			props.Fields[prop] = &protocol.Value{
-				Kind: &protocol.Value_StringValue{
-					StringValue: val.(string),
-				},
+			value, err := toProtoValue(mapper, class, prop, val)
+			if err != nil {
+				return nil, fmt.Errorf("property %q: %w", prop, err)
+			}
+			if value != nil {
+				props.Fields[prop] = value
 			}
 		}
 
 		resp.Results = append(resp.Results, &protocol.SearchResult{
 			Metadata: &protocol.MetadataResult{
-				Id:        v.Obj.ID().String(),
-				Certainty: float32(v.Certainty),
+				Id:              v.Obj.ID().String(),
+				Certainty:       float32(v.Certainty),
+				Distance:        v.Distance,
+				DistancePresent: v.Distance != 0,
+				Score:           v.Score,
+				ScorePresent:    v.Score != 0,
 			},
 			Properties: &protocol.PropertiesResult{
 				TargetCollection: v.Obj.Object.Class,
@@ -117,5 +130,35 @@ func toProtoResponse(res *SearchResponse) *protocol.SearchReply {
 		})
 
 	}
-	return &resp
+	return &resp, nil
+}
+
+// toProtoValue converts primitive property values based on their data type in the collection.
+// Reference and nested properties are not supported yet and are skipped.
+func toProtoValue(mapper *v1.Mapper, class *models.Class, prop string, val interface{}) (*protocol.Value, error) {
+	if val == nil {
+		return mapper.NewNilValue(), nil
+	}
+
+	if class != nil {
+		if p, err := schema.GetPropertyByName(class, prop); err == nil {
+			dt, ok := schema.AsPrimitive(p.DataType)
+			if !ok {
+				return nil, nil
+			}
+			return mapper.NewPrimitiveValue(val, dt)
+		}
+	}
+
+	// not in the schema (e.g: `id`), best effort
+	switch v := val.(type) {
+	case string:
+		return v1.NewTextValue(v), nil
+	case float64:
+		return v1.NewNumberValue(v), nil
+	case bool:
+		return v1.NewBoolValue(v), nil
+	default:
+		return nil, nil
+	}
 }
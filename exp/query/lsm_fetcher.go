@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strconv"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -52,6 +53,9 @@ type LSMCache interface {
 	// put tenant
 	AddTenant(collection, tenantID string, version int64) error
 
+	// check tenant, without accessing it
+	Has(collection, tenantID string) bool
+
 	BasePath() string
 }
 
@@ -99,6 +103,41 @@ func (l *LSMFetcher) Fetch(ctx context.Context, node, collection, tenant string,
 	return store, lsmPath, nil
 }
 
+// Prefetch makes sure the given version of the tenant's LSM data is available locally,
+// without opening it. Useful to refresh the tenant's data ahead of the next search.
+func (l *LSMFetcher) Prefetch(ctx context.Context, node, collection, tenant string, version int64) error {
+	key := fmt.Sprintf("%s-%s-%d", collection, tenant, version)
+	if _, err, _ := l.sg.Do(key, func() (any, error) {
+		return l.fetch(ctx, node, collection, tenant, version)
+	}); err != nil {
+		return fmt.Errorf("failed to prefetch tenant data: %w", err)
+	}
+	return nil
+}
+
+// Cached returns true if the tenant's LSM data is in the local cache.
+// Always false if the cache is disabled.
+func (l *LSMFetcher) Cached(collection, tenant string) bool {
+	if l.cache == nil {
+		return false
+	}
+	return l.cache.Has(collection, tenant)
+}
+
+// cachedTenant returns the cache entry of the tenant's data fetched to
+// tenantPath, or nil if the cache is disabled or the data was replaced by a
+// newer version in the meantime.
+func (l *LSMFetcher) cachedTenant(collection, tenant, tenantPath string) *TenantCache {
+	if l.cache == nil {
+		return nil
+	}
+	tc, err := l.cache.Tenant(collection, tenant)
+	if err != nil || path.Base(tenantPath) != strconv.FormatInt(tc.Version, 10) {
+		return nil
+	}
+	return tc
+}
+
 func (l *LSMFetcher) fetch(ctx context.Context, node, collection, tenant string, version int64) (string, error) {
 	var (
 		basePath          = l.basePath
@@ -239,6 +239,11 @@ func (m *mockCache) AddTenant(collection, tenantID string, version int64) error
 	return nil
 }
 
+func (m *mockCache) Has(collection, tenantID string) bool {
+	_, ok := m.tenants[collection+tenantID]
+	return ok
+}
+
 func (m *mockCache) BasePath() string {
 	return m.basePath
 }
@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package query
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// mainVectorIndexID is the id a shard uses for the index of the object's
+// (non-target) vector.
+const mainVectorIndexID = "main"
+
+// vectorIndex is the read-only subset of the shard's vector index, the querier
+// needs to serve vector search.
+type vectorIndex interface {
+	SearchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error)
+	SearchByVectorDistance(ctx context.Context, vector []float32, dist float32,
+		maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error)
+	Shutdown(ctx context.Context) error
+}
+
+// openVectorIndex opens the offloaded vector index of the tenant based on the
+// collection's vector index config.
+// flat index is read from the LSM store directly, hnsw index is restored from
+// the snapshot and commit logs found in tenantPath. Neither of them is ever
+// written to.
+func openVectorIndex(class *models.Class, store *lsmkv.Store, tenantPath, lsmPath string,
+	log logrus.FieldLogger,
+) (vectorIndex, error) {
+	indexType := class.VectorIndexType
+	if indexType == "" {
+		indexType = vectorindex.DefaultVectorIndexType
+	}
+
+	config, err := vectorindex.ParseAndValidateConfig(class.VectorIndexConfig, indexType)
+	if err != nil {
+		return nil, fmt.Errorf("parse vector index config: %w", err)
+	}
+
+	distProv, err := distanceProvider(config.DistanceName())
+	if err != nil {
+		return nil, err
+	}
+
+	switch uc := config.(type) {
+	case flatent.UserConfig:
+		index, err := flat.New(flat.Config{
+			ID:               mainVectorIndexID,
+			Logger:           log,
+			DistanceProvider: distProv,
+			RootPath:         lsmPath,
+		}, uc, store)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize flat index: %w", err)
+		}
+		return index, nil
+	case hnswent.UserConfig:
+		vectorForID := func(ctx context.Context, id uint64, targetVector string) ([]float32, error) {
+			return vectorByDocID(store, id, targetVector)
+		}
+		tempVectorForID := func(ctx context.Context, id uint64, container *common.VectorSlice,
+			targetVector string,
+		) ([]float32, error) {
+			return vectorByDocID(store, id, targetVector)
+		}
+
+		index, err := hnsw.New(hnsw.Config{
+			RootPath:             tenantPath,
+			ID:                   mainVectorIndexID,
+			Logger:               log,
+			DistanceProvider:     distProv,
+			VectorForIDThunk:     hnsw.NewVectorForIDThunk("", vectorForID),
+			TempVectorForIDThunk: hnsw.NewTempVectorForIDThunk("", tempVectorForID),
+			MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
+				// the offloaded index is read-only
+				return &hnsw.NoopCommitLogger{}, nil
+			},
+		}, uc, cyclemanager.NewCallbackGroupNoop(), store)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize hnsw index: %w", err)
+		}
+		return index, nil
+	default:
+		return nil, fmt.Errorf("vector index type %q is not supported on query nodes", indexType)
+	}
+}
+
+func vectorByDocID(store *lsmkv.Store, docID uint64, targetVector string) ([]float32, error) {
+	key := make([]byte, 8)
+	binary.LittleEndian.PutUint64(key, docID)
+
+	objB, err := store.Bucket(helpers.ObjectsBucketLSM).GetBySecondary(helpers.ObjectsBucketLSMDocIDSecondaryIndex, key)
+	if err != nil {
+		return nil, err
+	}
+	if objB == nil {
+		return nil, storobj.NewErrNotFoundf(docID, "no object for doc id, it could have been deleted")
+	}
+
+	return storobj.VectorFromBinary(objB, nil, targetVector)
+}
+
+func distanceProvider(name string) (distancer.Provider, error) {
+	switch name {
+	case "", vectorIndexCommon.DistanceCosine:
+		return distancer.NewCosineDistanceProvider(), nil
+	case vectorIndexCommon.DistanceDot:
+		return distancer.NewDotProductProvider(), nil
+	case vectorIndexCommon.DistanceL2Squared:
+		return distancer.NewL2SquaredProvider(), nil
+	case vectorIndexCommon.DistanceManhattan:
+		return distancer.NewManhattanProvider(), nil
+	case vectorIndexCommon.DistanceHamming:
+		return distancer.NewHammingProvider(), nil
+	default:
+		return nil, fmt.Errorf("unrecognized distance metric %q", name)
+	}
+}
+
+// tenantVectorIndex returns the vector index to search the tenant's data with.
+// If the data is cached (tc != nil), the index is opened only once and kept
+// open along with the cache entry on its own store, so it outlives `store`.
+// Otherwise it is opened on `store` for a single search.
+// `release` must be called once the search is done.
+func (a *API) tenantVectorIndex(class *models.Class, store *lsmkv.Store, tc *TenantCache,
+	tenantPath, lsmPath string,
+) (index vectorIndex, release func(), err error) {
+	if tc != nil {
+		index, release, err = tc.openedVectorIndex(class.VectorIndexConfig, func() (vectorIndex, func(), error) {
+			store, err := lsmkv.New(lsmPath, lsmPath, a.log, nil, cyclemanager.NewCallbackGroupNoop(),
+				cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to create store for the cached vector index: %w", err)
+			}
+			index, err := openVectorIndex(class, store, tenantPath, lsmPath, a.log)
+			if err != nil {
+				store.Shutdown(context.Background())
+				return nil, nil, err
+			}
+			return index, func() {
+				ctx := context.Background()
+				if err := index.Shutdown(ctx); err != nil {
+					a.log.WithError(err).Warn("failed to shut down cached vector index")
+				}
+				if err := store.Shutdown(ctx); err != nil {
+					a.log.WithError(err).Warn("failed to shut down store of cached vector index")
+				}
+			}, nil
+		})
+		// an evicted entry falls back to a per search index below
+		if !errors.Is(err, errTenantEvicted) {
+			return index, release, err
+		}
+	}
+
+	index, err = openVectorIndex(class, store, tenantPath, lsmPath, a.log)
+	if err != nil {
+		return nil, nil, err
+	}
+	return index, func() { index.Shutdown(context.Background()) }, nil
+}
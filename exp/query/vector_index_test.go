@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package query
+
+import (
+	"context"
+	"encoding/binary"
+	"path"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestOpenVectorIndex_HNSW(t *testing.T) {
+	ctx := context.Background()
+	logger := testLogger()
+	tenantPath := t.TempDir()
+	lsmPath := path.Join(tenantPath, defaultLSMRoot)
+
+	vectors := [][]float32{
+		{1, 0, 0},
+		{0, 1, 0},
+		{0, 0, 1},
+		{0.7, 0.7, 0},
+	}
+
+	// write the tenant the way a shard does, before it's offloaded
+	store := newTestStore(t, lsmPath)
+	require.NoError(t, store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM, lsmkv.WithSecondaryIndices(2)))
+	for i, vec := range vectors {
+		putTestObject(t, store, uint64(i), vec)
+	}
+
+	index, err := hnsw.New(hnsw.Config{
+		RootPath:         tenantPath,
+		ID:               mainVectorIndexID,
+		Logger:           logger,
+		DistanceProvider: distancer.NewCosineDistanceProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[id], nil
+		},
+		MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
+			return hnsw.NewCommitLogger(tenantPath, mainVectorIndexID, logger, cyclemanager.NewCallbackGroupNoop())
+		},
+	}, hnswent.NewDefaultUserConfig(), cyclemanager.NewCallbackGroupNoop(), store)
+	require.NoError(t, err)
+	for i, vec := range vectors {
+		require.NoError(t, index.Add(ctx, uint64(i), vec))
+	}
+	require.NoError(t, index.Flush())
+	require.NoError(t, index.Shutdown(ctx))
+	require.NoError(t, store.Shutdown(ctx))
+
+	// read it back on the querier
+	store = newTestStore(t, lsmPath)
+	defer store.Shutdown(ctx)
+	require.NoError(t, store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM, lsmkv.WithSecondaryIndices(2)))
+
+	class := &models.Class{
+		Class:             testCollection,
+		VectorIndexType:   "hnsw",
+		VectorIndexConfig: map[string]interface{}{"distance": "cosine"},
+	}
+	restored, err := openVectorIndex(class, store, tenantPath, lsmPath, logger)
+	require.NoError(t, err)
+	defer restored.Shutdown(ctx)
+
+	ids, _, err := restored.SearchByVector(ctx, []float32{0.9, 0.1, 0}, 2, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{0, 3}, ids)
+
+	t.Run("with allow list", func(t *testing.T) {
+		ids, _, err := restored.SearchByVector(ctx, []float32{0.9, 0.1, 0}, 2, helpers.NewAllowList(1, 2))
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{1, 2}, ids)
+	})
+}
+
+func TestOpenVectorIndex_Unsupported(t *testing.T) {
+	class := &models.Class{
+		Class:             testCollection,
+		VectorIndexType:   "dynamic",
+		VectorIndexConfig: map[string]interface{}{},
+	}
+	_, err := openVectorIndex(class, nil, "", "", testLogger())
+	assert.ErrorContains(t, err, "not supported on query nodes")
+}
+
+func newTestStore(t *testing.T, lsmPath string) *lsmkv.Store {
+	store, err := lsmkv.New(lsmPath, lsmPath, testLogger(), nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.NoError(t, err)
+	return store
+}
+
+func putTestObject(t *testing.T, store *lsmkv.Store, docID uint64, vector []float32) {
+	id := strfmt.UUID(uuid.NewString())
+	obj := storobj.FromObject(&models.Object{
+		ID:         id,
+		Class:      testCollection,
+		Properties: map[string]interface{}{},
+	}, vector, nil)
+	obj.SetDocID(docID)
+
+	data, err := obj.MarshalBinary()
+	require.NoError(t, err)
+
+	uid, err := uuid.Parse(id.String())
+	require.NoError(t, err)
+	idBytes, err := uid.MarshalBinary()
+	require.NoError(t, err)
+
+	docIDBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(docIDBytes, docID)
+
+	require.NoError(t, store.Bucket(helpers.ObjectsBucketLSM).Put(idBytes, data,
+		lsmkv.WithSecondaryKey(helpers.ObjectsBucketLSMDocIDSecondaryIndex, docIDBytes),
+		lsmkv.WithSecondaryKey(helpers.ObjectsBucketLSMTokenRangeSecondaryIndex, idBytes),
+	))
+}
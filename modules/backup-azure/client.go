@@ -274,6 +274,36 @@ func (a *azureClient) Read(ctx context.Context, backupID, key, overrideBucket, o
 	return read, nil
 }
 
+// Delete removes all blobs stored under the prefix of the backup backupID
+func (a *azureClient) Delete(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	containerName := a.config.Container
+	if overrideBucket != "" {
+		containerName = overrideBucket
+	}
+
+	prefix := a.makeObjectName(overridePath, []string{backupID}) + "/"
+	pager := a.client.NewListBlobsFlatPager(containerName, &azblob.ListBlobsFlatOptions{Prefix: &prefix})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			if bloberror.HasCode(err, bloberror.ContainerNotFound) {
+				return nil
+			}
+			return backup.NewErrInternal(errors.Wrapf(err, "list blobs %s", prefix))
+		}
+		for _, blob := range page.Segment.BlobItems {
+			if blob.Name == nil {
+				continue
+			}
+			if _, err := a.client.DeleteBlob(ctx, containerName, *blob.Name, nil); err != nil &&
+				!bloberror.HasCode(err, bloberror.BlobNotFound) {
+				return backup.NewErrInternal(errors.Wrapf(err, "delete blob %s", *blob.Name))
+			}
+		}
+	}
+	return nil
+}
+
 func (a *azureClient) SourceDataPath() string {
 	return a.dataPath
 }
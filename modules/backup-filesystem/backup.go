@@ -218,6 +218,21 @@ func (m *Module) Read(ctx context.Context, backupID, key, overrideBucket, overri
 	return read, err
 }
 
+// Delete removes the directory of the backup backupID
+func (m *Module) Delete(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	if backupID == "" {
+		return fmt.Errorf("empty backup id")
+	}
+	dir := m.HomeDir(backupID, overrideBucket, overridePath)
+	if err := ctx.Err(); err != nil {
+		return backup.NewErrContextExpired(errors.Wrapf(err, "delete backup %s", dir))
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete backup %s", dir))
+	}
+	return nil
+}
+
 func (m *Module) SourceDataPath() string {
 	return m.dataPath
 }
@@ -17,6 +17,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -52,3 +53,22 @@ func TestBackend_StoreBackup(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestBackend_DeleteBackup(t *testing.T) {
+	ctx := context.Background()
+	module := New()
+	module.logger, _ = test.NewNullLogger()
+	assert.Nil(t, module.initBackupBackend(ctx, t.TempDir()))
+
+	assert.Nil(t, module.PutObject(ctx, "b1", "backup_config.json", "", "", []byte("{}")))
+	assert.Nil(t, module.PutObject(ctx, "b2", "backup_config.json", "", "", []byte("{}")))
+
+	assert.Nil(t, module.Delete(ctx, "b1", "", ""))
+	_, err := os.Stat(module.HomeDir("b1", "", ""))
+	assert.True(t, os.IsNotExist(err))
+	_, err = module.GetObject(ctx, "b2", "backup_config.json", "", "")
+	assert.Nil(t, err)
+
+	// deleting a missing backup is not an error
+	assert.Nil(t, module.Delete(ctx, "b1", "", ""))
+}
@@ -96,5 +96,6 @@ func (m *Module) makeBackupDirPath(path, id string) string {
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.BackupBackend(New())
+	_ = modulecapabilities.BackupBackendDeleter(New())
 	_ = modulecapabilities.MetaProvider(New())
 )
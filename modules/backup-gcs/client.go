@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"golang.org/x/oauth2/google"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 )
 
@@ -333,6 +334,29 @@ func (g *gcsClient) Read(ctx context.Context, backupID, key, overrideBucket, ove
 	return read, nil
 }
 
+// Delete removes all objects stored under the prefix of the backup backupID
+func (g *gcsClient) Delete(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	bucket, err := g.findBucket(ctx, overrideBucket)
+	if err != nil {
+		return errors.Wrap(err, "find bucket")
+	}
+
+	prefix := g.makeObjectName(overridePath, []string{backupID}) + "/"
+	it := bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return nil
+		}
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list objects %s", prefix))
+		}
+		if err := bucket.Object(attrs.Name).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return backup.NewErrInternal(errors.Wrapf(err, "delete object %s", attrs.Name))
+		}
+	}
+}
+
 func (g *gcsClient) SourceDataPath() string {
 	return g.dataPath
 }
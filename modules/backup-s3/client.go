@@ -297,6 +297,39 @@ func (s *s3Client) Read(ctx context.Context, backupID, key, overrideBucket, over
 	return read, nil
 }
 
+// Delete removes all objects stored under the prefix of the backup backupID
+func (s *s3Client) Delete(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	client, err := s.getClient(ctx)
+	if err != nil {
+		return errors.Wrap(err, "delete: failed to get client")
+	}
+
+	prefix := s.makeObjectName(backupID) + "/"
+	if overridePath != "" {
+		prefix = path.Join(overridePath, backupID) + "/"
+	}
+
+	bucket := s.config.Bucket
+	if overrideBucket != "" {
+		bucket = overrideBucket
+	}
+
+	// listing and removal run in goroutines of the client. Stop both on the
+	// first error and drain the results, so that neither is left blocked.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var delErr error
+	objects := client.ListObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true})
+	for rErr := range client.RemoveObjects(ctx, bucket, objects, minio.RemoveObjectsOptions{}) {
+		if rErr.Err != nil && delErr == nil {
+			delErr = backup.NewErrInternal(errors.Wrapf(rErr.Err, "delete object %s:%s", bucket, rErr.ObjectName))
+			cancel()
+		}
+	}
+	return delErr
+}
+
 func (s *s3Client) SourceDataPath() string {
 	return s.dataPath
 }
@@ -109,5 +109,6 @@ func (m *Module) MetaInfo() (map[string]interface{}, error) {
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.BackupBackend(New())
+	_ = modulecapabilities.BackupBackendDeleter(New())
 	_ = modulecapabilities.MetaProvider(New())
 )
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/generative"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -51,23 +52,27 @@ func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *anthr
 	}
 }
 
-func (a *anthropic) GenerateSingleResult(ctx context.Context, textProperties map[string]string, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
-	forPrompt, err := a.generateForPrompt(textProperties, prompt)
+func (a *anthropic) GenerateSingleResult(ctx context.Context, properties *modulecapabilities.GenerateProperties, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	forPrompt, err := a.generateForPrompt(properties.Text, prompt)
 	if err != nil {
 		return nil, err
 	}
-	return a.Generate(ctx, cfg, forPrompt, options, debug)
+	return a.generate(ctx, cfg, forPrompt, generative.Blobs([]*modulecapabilities.GenerateProperties{properties}), options, debug)
 }
 
-func (a *anthropic) GenerateAllResults(ctx context.Context, textProperties []map[string]string, task string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
-	forTask, err := a.generatePromptForTask(textProperties, task)
+func (a *anthropic) GenerateAllResults(ctx context.Context, properties []*modulecapabilities.GenerateProperties, task string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	forTask, err := a.generatePromptForTask(generative.Texts(properties), task)
 	if err != nil {
 		return nil, err
 	}
-	return a.Generate(ctx, cfg, forTask, options, debug)
+	return a.generate(ctx, cfg, forTask, generative.Blobs(properties), options, debug)
 }
 
 func (a *anthropic) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
+	return a.generate(ctx, cfg, prompt, nil, options, debug)
+}
+
+func (a *anthropic) generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, images []*string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
 	params := a.getParameters(cfg, options)
 	debugInformation := a.getDebugInformation(debug, prompt)
 
@@ -80,7 +85,7 @@ func (a *anthropic) Generate(ctx context.Context, cfg moduletools.ClassConfig, p
 		Messages: []message{
 			{
 				Role:    "user",
-				Content: prompt,
+				Content: a.getContent(prompt, images),
 			},
 		},
 		Model:         params.Model,
@@ -141,6 +146,25 @@ func (a *anthropic) Generate(ctx context.Context, cfg moduletools.ClassConfig, p
 	}, nil
 }
 
+func (a *anthropic) getContent(prompt string, images []*string) interface{} {
+	if len(images) == 0 {
+		return prompt
+	}
+	// Anthropic recommends to place images before the text
+	blocks := make([]contentBlock, 0, len(images)+1)
+	for _, image := range images {
+		blocks = append(blocks, contentBlock{
+			Type: "image",
+			Source: &imageSource{
+				Type:      "base64",
+				MediaType: generative.ImageMediaType(*image),
+				Data:      *image,
+			},
+		})
+	}
+	return append(blocks, contentBlock{Type: "text", Text: prompt})
+}
+
 func (a *anthropic) getParameters(cfg moduletools.ClassConfig, options interface{}) anthropicparams.Params {
 	settings := config.NewClassSettings(cfg)
 
@@ -265,8 +289,20 @@ type generateInput struct {
 }
 
 type message struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
+	Role    string      `json:"role"`
+	Content interface{} `json:"content"` // string or []contentBlock
+}
+
+type contentBlock struct {
+	Type   string       `json:"type"`
+	Text   string       `json:"text,omitempty"`
+	Source *imageSource `json:"source,omitempty"`
+}
+
+type imageSource struct {
+	Type      string `json:"type"`
+	MediaType string `json:"media_type"`
+	Data      string `json:"data"`
 }
 
 type generateResponse struct {
@@ -24,6 +24,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 )
 
 func nullLogger() logrus.FieldLogger {
@@ -32,7 +33,7 @@ func nullLogger() logrus.FieldLogger {
 }
 
 func TestGenerate(t *testing.T) {
-	textProperties := []map[string]string{{"prop": "My name is John"}}
+	properties := []*modulecapabilities.GenerateProperties{{Text: map[string]string{"prop": "My name is John"}}}
 
 	tests := []struct {
 		name           string
@@ -73,7 +74,7 @@ func TestGenerate(t *testing.T) {
 			a := New("apiKey", test.timeout, nullLogger())
 
 			settings := &fakeClassConfig{baseURL: server.URL}
-			res, err := a.GenerateAllResults(context.Background(), textProperties, "What is my name?", nil, false, settings)
+			res, err := a.GenerateAllResults(context.Background(), properties, "What is my name?", nil, false, settings)
 
 			if len(test.answer.Content) == 0 {
 				assert.Error(t, err)
@@ -102,6 +103,26 @@ func TestGenerate(t *testing.T) {
 	})
 }
 
+func TestGetContent(t *testing.T) {
+	a := New("apiKey", 0, nullLogger())
+
+	t.Run("without images", func(t *testing.T) {
+		assert.Equal(t, "What is my name?", a.getContent("What is my name?", nil))
+	})
+
+	t.Run("with images", func(t *testing.T) {
+		// base64 encoded GIF header
+		image := "R0lGODlhAQABAAAAACw="
+		content, ok := a.getContent("What is on the picture?", []*string{&image}).([]contentBlock)
+		require.True(t, ok)
+		require.Len(t, content, 2)
+		assert.Equal(t, "image", content[0].Type)
+		require.NotNil(t, content[0].Source)
+		assert.Equal(t, imageSource{Type: "base64", MediaType: "image/gif", Data: image}, *content[0].Source)
+		assert.Equal(t, contentBlock{Type: "text", Text: "What is on the picture?"}, content[1])
+	})
+}
+
 type testAnthropicHandler struct {
 	t       *testing.T
 	answer  generateResponse
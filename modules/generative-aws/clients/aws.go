@@ -33,6 +33,7 @@ import (
 	generativeconfig "github.com/weaviate/weaviate/modules/generative-aws/config"
 	awsparams "github.com/weaviate/weaviate/modules/generative-aws/parameters"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/generative"
 )
 
 var compile, _ = regexp.Compile(`{([\w\s]*?)}`)
@@ -71,23 +72,27 @@ func New(awsAccessKey, awsSecretKey, awsSessionToken string, timeout time.Durati
 	}
 }
 
-func (v *awsClient) GenerateSingleResult(ctx context.Context, textProperties map[string]string, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
-	forPrompt, err := v.generateForPrompt(textProperties, prompt)
+func (v *awsClient) GenerateSingleResult(ctx context.Context, properties *modulecapabilities.GenerateProperties, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	forPrompt, err := v.generateForPrompt(properties.Text, prompt)
 	if err != nil {
 		return nil, err
 	}
-	return v.Generate(ctx, cfg, forPrompt, options, debug)
+	return v.generate(ctx, cfg, forPrompt, generative.Blobs([]*modulecapabilities.GenerateProperties{properties}), options, debug)
 }
 
-func (v *awsClient) GenerateAllResults(ctx context.Context, textProperties []map[string]string, task string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
-	forTask, err := v.generatePromptForTask(textProperties, task)
+func (v *awsClient) GenerateAllResults(ctx context.Context, properties []*modulecapabilities.GenerateProperties, task string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	forTask, err := v.generatePromptForTask(generative.Texts(properties), task)
 	if err != nil {
 		return nil, err
 	}
-	return v.Generate(ctx, cfg, forTask, options, debug)
+	return v.generate(ctx, cfg, forTask, generative.Blobs(properties), options, debug)
 }
 
 func (v *awsClient) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
+	return v.generate(ctx, cfg, prompt, nil, options, debug)
+}
+
+func (v *awsClient) generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, images []*string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
 	params := v.getParameters(cfg, options)
 	service := params.Service
 	debugInformation := v.getDebugInformation(debug, prompt)
@@ -109,12 +114,16 @@ func (v *awsClient) Generate(ctx context.Context, cfg moduletools.ClassConfig, p
 	if v.isBedrock(service) {
 		return v.sendBedrockRequest(ctx,
 			prompt,
+			images,
 			accessKey, secretKey, awsSessionToken, maxRetries,
 			params,
 			cfg,
 			debugInformation,
 		)
 	} else if v.isSagemaker(service) {
+		if len(images) > 0 {
+			return nil, errors.Errorf("images are not supported with %s service", service)
+		}
 		var body []byte
 		var endpointUrl string
 		var host string
@@ -227,6 +236,7 @@ func (v *awsClient) getParameters(cfg moduletools.ClassConfig, options interface
 func (v *awsClient) sendBedrockRequest(
 	ctx context.Context,
 	prompt string,
+	images []*string,
 	awsKey, awsSecret, awsSessionToken string,
 	maxRetries int,
 	params awsparams.Params,
@@ -235,7 +245,7 @@ func (v *awsClient) sendBedrockRequest(
 ) (*modulecapabilities.GenerateResponse, error) {
 	model := params.Model
 	region := params.Region
-	req, err := v.createRequestBody(prompt, params, cfg)
+	req, err := v.createRequestBody(prompt, images, params, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request for model %s: %w", model, err)
 	}
@@ -280,10 +290,13 @@ func (v *awsClient) sendBedrockRequest(
 	return v.parseBedrockResponse(result.Body, model, debugInformation)
 }
 
-func (v *awsClient) createRequestBody(prompt string, params awsparams.Params, cfg moduletools.ClassConfig) (interface{}, error) {
+func (v *awsClient) createRequestBody(prompt string, images []*string, params awsparams.Params, cfg moduletools.ClassConfig) (interface{}, error) {
 	settings := generativeconfig.NewClassSettings(cfg)
 	model := params.Model
 	service := settings.Service()
+	if len(images) > 0 && !v.isAnthropicClaude3Model(model) {
+		return nil, fmt.Errorf("model %s doesn't support images", model)
+	}
 	if v.isAmazonModel(model) {
 		return bedrockAmazonGenerateRequest{
 			InputText: prompt,
@@ -294,13 +307,8 @@ func (v *awsClient) createRequestBody(prompt string, params awsparams.Params, cf
 			MaxTokens:        settings.MaxTokenCount(service, model),
 			Messages: []bedrockAnthropicClaude3Message{
 				{
-					Role: "user",
-					Content: []bedrockAnthropicClaude3Content{
-						{
-							ContentType: "text",
-							Text:        &prompt,
-						},
-					},
+					Role:    "user",
+					Content: v.getAnthropicClaude3Content(prompt, images),
 				},
 			},
 		}, nil
@@ -353,6 +361,24 @@ func (v *awsClient) createRequestBody(prompt string, params awsparams.Params, cf
 	return nil, fmt.Errorf("unspported model: %s", model)
 }
 
+func (v *awsClient) getAnthropicClaude3Content(prompt string, images []*string) []bedrockAnthropicClaude3Content {
+	content := make([]bedrockAnthropicClaude3Content, 0, len(images)+1)
+	for _, image := range images {
+		content = append(content, bedrockAnthropicClaude3Content{
+			ContentType: "image",
+			Source: &bedrockAnthropicClaudeV3Source{
+				ContentType: "base64",
+				MediaType:   generative.ImageMediaType(*image),
+				Data:        *image,
+			},
+		})
+	}
+	return append(content, bedrockAnthropicClaude3Content{
+		ContentType: "text",
+		Text:        &prompt,
+	})
+}
+
 func (v *awsClient) parseBedrockResponse(bodyBytes []byte,
 	model string,
 	debug *modulecapabilities.GenerateDebugInformation,
@@ -24,6 +24,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 )
 
 func nullLogger() logrus.FieldLogger {
@@ -32,7 +33,7 @@ func nullLogger() logrus.FieldLogger {
 }
 
 func TestGetAnswer(t *testing.T) {
-	textProperties := []map[string]string{{"prop": "My name is john"}}
+	properties := []*modulecapabilities.GenerateProperties{{Text: map[string]string{"prop": "My name is john"}}}
 
 	tests := []struct {
 		name           string
@@ -81,7 +82,7 @@ func TestGetAnswer(t *testing.T) {
 			c := New("apiKey", test.timeout, nullLogger())
 
 			settings := &fakeClassConfig{baseURL: server.URL}
-			res, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", nil, false, settings)
+			res, err := c.GenerateAllResults(context.Background(), properties, "What is my name?", nil, false, settings)
 
 			if test.answer.Error != nil {
 				assert.Contains(t, err.Error(), test.answer.Error.Message)
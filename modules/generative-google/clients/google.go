@@ -29,6 +29,7 @@ import (
 	"github.com/weaviate/weaviate/modules/generative-google/config"
 	googleparams "github.com/weaviate/weaviate/modules/generative-google/parameters"
 	"github.com/weaviate/weaviate/usecases/modulecomponents/apikey"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/generative"
 )
 
 type harmCategory string
@@ -153,23 +154,27 @@ func New(apiKey string, useGoogleAuth bool, timeout time.Duration, logger logrus
 	}
 }
 
-func (v *google) GenerateSingleResult(ctx context.Context, textProperties map[string]string, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
-	forPrompt, err := v.generateForPrompt(textProperties, prompt)
+func (v *google) GenerateSingleResult(ctx context.Context, properties *modulecapabilities.GenerateProperties, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	forPrompt, err := v.generateForPrompt(properties.Text, prompt)
 	if err != nil {
 		return nil, err
 	}
-	return v.Generate(ctx, cfg, forPrompt, options, debug)
+	return v.generate(ctx, cfg, forPrompt, generative.Blobs([]*modulecapabilities.GenerateProperties{properties}), options, debug)
 }
 
-func (v *google) GenerateAllResults(ctx context.Context, textProperties []map[string]string, task string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
-	forTask, err := v.generatePromptForTask(textProperties, task)
+func (v *google) GenerateAllResults(ctx context.Context, properties []*modulecapabilities.GenerateProperties, task string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	forTask, err := v.generatePromptForTask(generative.Texts(properties), task)
 	if err != nil {
 		return nil, err
 	}
-	return v.Generate(ctx, cfg, forTask, options, debug)
+	return v.generate(ctx, cfg, forTask, generative.Blobs(properties), options, debug)
 }
 
 func (v *google) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
+	return v.generate(ctx, cfg, prompt, nil, options, debug)
+}
+
+func (v *google) generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, images []*string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
 	params := v.getParameters(cfg, options)
 	debugInformation := v.getDebugInformation(debug, prompt)
 
@@ -180,7 +185,10 @@ func (v *google) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	}
 
 	endpointURL := v.buildUrlFn(useGenerativeAIEndpoint, params.ApiEndpoint, params.ProjectID, modelID, params.Region)
-	input := v.getPayload(useGenerativeAIEndpoint, prompt, params)
+	input, err := v.getPayload(useGenerativeAIEndpoint, prompt, images, params)
+	if err != nil {
+		return nil, err
+	}
 
 	body, err := json.Marshal(input)
 	if err != nil {
@@ -406,11 +414,14 @@ func (v *google) useGenerativeAIEndpoint(apiEndpoint string) bool {
 	return apiEndpoint == "generativelanguage.googleapis.com"
 }
 
-func (v *google) getPayload(useGenerativeAI bool, prompt string, params googleparams.Params) any {
+func (v *google) getPayload(useGenerativeAI bool, prompt string, images []*string, params googleparams.Params) (any, error) {
+	if strings.HasPrefix(params.Model, "gemini") {
+		return v.getGeminiPayload(prompt, images, params), nil
+	}
+	if len(images) > 0 {
+		return nil, errors.Errorf("model %s doesn't support images, use one of the gemini models instead", params.Model)
+	}
 	if useGenerativeAI {
-		if strings.HasPrefix(params.Model, "gemini") {
-			return v.getGeminiPayload(prompt, params)
-		}
 		input := generateMessageRequest{
 			Prompt: &generateMessagePrompt{
 				Messages: []generateMessage{
@@ -424,10 +435,7 @@ func (v *google) getPayload(useGenerativeAI bool, prompt string, params googlepa
 			TopK:           params.TopK,
 			CandidateCount: 1,
 		}
-		return input
-	}
-	if strings.HasPrefix(params.Model, "gemini") {
-		return v.getGeminiPayload(prompt, params)
+		return input, nil
 	}
 	input := generateInput{
 		Instances: []instance{
@@ -449,19 +457,28 @@ func (v *google) getPayload(useGenerativeAI bool, prompt string, params googlepa
 			FrequencyPenalty: params.FrequencyPenalty,
 		},
 	}
-	return input
+	return input, nil
 }
 
-func (v *google) getGeminiPayload(prompt string, params googleparams.Params) any {
+func (v *google) getGeminiPayload(prompt string, images []*string, params googleparams.Params) any {
+	parts := []part{
+		{
+			Text: prompt,
+		},
+	}
+	for _, image := range images {
+		parts = append(parts, part{
+			InlineData: &inlineData{
+				MimeType: generative.ImageMediaType(*image),
+				Data:     *image,
+			},
+		})
+	}
 	input := generateContentRequest{
 		Contents: []content{
 			{
-				Role: "user",
-				Parts: []part{
-					{
-						Text: prompt,
-					},
-				},
+				Role:  "user",
+				Parts: parts,
 			},
 		},
 		GenerationConfig: &generationConfig{
@@ -682,8 +699,13 @@ type content struct {
 }
 
 type part struct {
-	Text       string `json:"text,omitempty"`
-	InlineData string `json:"inline_data,omitempty"`
+	Text       string      `json:"text,omitempty"`
+	InlineData *inlineData `json:"inlineData,omitempty"`
+}
+
+type inlineData struct {
+	MimeType string `json:"mimeType"`
+	Data     string `json:"data"`
 }
 
 type safetySetting struct {
@@ -33,6 +33,30 @@ func nullLogger() logrus.FieldLogger {
 	return l
 }
 
+func TestGetPayloadWithImages(t *testing.T) {
+	// base64 encoded JPEG header
+	image := "/9j/4AAQSkZJRgABAQAAAQABAAD/2wBDAAgGBgcGBQgHBwcJCQgKDBQNDAsLDBkSEw8UHRofHh0aHBwgJC4nICIsIxwcKDcpLDAxNDQ0Hyc5PTgyPC4zNDL/"
+	c := &google{}
+
+	t.Run("gemini model", func(t *testing.T) {
+		payload, err := c.getPayload(true, "What is on the picture?", []*string{&image}, googleparams.Params{Model: "gemini-1.5-pro"})
+		require.NoError(t, err)
+		request, ok := payload.(generateContentRequest)
+		require.True(t, ok)
+		require.Len(t, request.Contents, 1)
+		parts := request.Contents[0].Parts
+		require.Len(t, parts, 2)
+		assert.Equal(t, "What is on the picture?", parts[0].Text)
+		require.NotNil(t, parts[1].InlineData)
+		assert.Equal(t, inlineData{MimeType: "image/jpeg", Data: image}, *parts[1].InlineData)
+	})
+
+	t.Run("text only model", func(t *testing.T) {
+		_, err := c.getPayload(true, "What is on the picture?", []*string{&image}, googleparams.Params{Model: "chat-bison-001"})
+		require.Error(t, err)
+	})
+}
+
 func TestGetAnswer(t *testing.T) {
 	t.Run("when the server has a successful answer ", func(t *testing.T) {
 		handler := &testAnswerHandler{
@@ -64,12 +88,12 @@ func TestGetAnswer(t *testing.T) {
 		}
 
 		params := googleparams.Params{ApiEndpoint: server.URL}
-		textProperties := []map[string]string{{"prop": "My name is john"}}
+		properties := []*modulecapabilities.GenerateProperties{{Text: map[string]string{"prop": "My name is john"}}}
 		expected := modulecapabilities.GenerateResponse{
 			Result: ptString("John"),
 		}
 
-		res, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", params, false, nil)
+		res, err := c.GenerateAllResults(context.Background(), properties, "What is my name?", params, false, nil)
 
 		assert.Nil(t, err)
 		assert.Equal(t, expected, *res)
@@ -96,9 +120,9 @@ func TestGetAnswer(t *testing.T) {
 			logger: nullLogger(),
 		}
 
-		textProperties := []map[string]string{{"prop": "My name is john"}}
+		properties := []*modulecapabilities.GenerateProperties{{Text: map[string]string{"prop": "My name is john"}}}
 
-		_, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", nil, false, nil)
+		_, err := c.GenerateAllResults(context.Background(), properties, "What is my name?", nil, false, nil)
 
 		require.NotNil(t, err)
 		assert.EqualError(t, err, "connection to Google failed with status: 500 error: some error from the server")
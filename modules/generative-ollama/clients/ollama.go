@@ -25,6 +25,7 @@ import (
 	"github.com/weaviate/weaviate/modules/generative-ollama/config"
 	ollamaparams "github.com/weaviate/weaviate/modules/generative-ollama/parameters"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/generative"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -48,23 +49,27 @@ func New(timeout time.Duration, logger logrus.FieldLogger) *ollama {
 	}
 }
 
-func (v *ollama) GenerateSingleResult(ctx context.Context, textProperties map[string]string, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
-	forPrompt, err := v.generateForPrompt(textProperties, prompt)
+func (v *ollama) GenerateSingleResult(ctx context.Context, properties *modulecapabilities.GenerateProperties, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	forPrompt, err := v.generateForPrompt(properties.Text, prompt)
 	if err != nil {
 		return nil, err
 	}
-	return v.Generate(ctx, cfg, forPrompt, options, debug)
+	return v.generate(ctx, cfg, forPrompt, generative.Blobs([]*modulecapabilities.GenerateProperties{properties}), options, debug)
 }
 
-func (v *ollama) GenerateAllResults(ctx context.Context, textProperties []map[string]string, task string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
-	forTask, err := v.generatePromptForTask(textProperties, task)
+func (v *ollama) GenerateAllResults(ctx context.Context, properties []*modulecapabilities.GenerateProperties, task string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	forTask, err := v.generatePromptForTask(generative.Texts(properties), task)
 	if err != nil {
 		return nil, err
 	}
-	return v.Generate(ctx, cfg, forTask, options, debug)
+	return v.generate(ctx, cfg, forTask, generative.Blobs(properties), options, debug)
 }
 
 func (v *ollama) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
+	return v.generate(ctx, cfg, prompt, nil, options, debug)
+}
+
+func (v *ollama) generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, images []*string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
 	params := v.getParameters(cfg, options)
 	debugInformation := v.getDebugInformation(debug, prompt)
 
@@ -74,6 +79,9 @@ func (v *ollama) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 		Prompt: prompt,
 		Stream: false,
 	}
+	for _, image := range images {
+		input.Images = append(input.Images, *image)
+	}
 	if params.Temperature != nil {
 		input.Options = &generateOptions{Temperature: params.Temperature}
 	}
@@ -187,6 +195,7 @@ type generateInput struct {
 	Model   string           `json:"model"`
 	Prompt  string           `json:"prompt"`
 	Stream  bool             `json:"stream"`
+	Images  []string         `json:"images,omitempty"`
 	Options *generateOptions `json:"options,omitempty"`
 }
 
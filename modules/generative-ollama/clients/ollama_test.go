@@ -24,6 +24,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 )
 
 func nullLogger() logrus.FieldLogger {
@@ -32,7 +33,7 @@ func nullLogger() logrus.FieldLogger {
 }
 
 func TestGetAnswer(t *testing.T) {
-	textProperties := []map[string]string{{"prop": "My name is john"}}
+	properties := []*modulecapabilities.GenerateProperties{{Text: map[string]string{"prop": "My name is john"}}}
 
 	tests := []struct {
 		name           string
@@ -72,7 +73,7 @@ func TestGetAnswer(t *testing.T) {
 			c := New(test.timeout, nullLogger())
 
 			settings := &fakeClassConfig{apiEndpoint: server.URL}
-			res, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", nil, false, settings)
+			res, err := c.GenerateAllResults(context.Background(), properties, "What is my name?", nil, false, settings)
 
 			if test.answer.Error != "" {
 				assert.Contains(t, err.Error(), test.answer.Error)
@@ -81,13 +82,37 @@ func TestGetAnswer(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("when images are passed", func(t *testing.T) {
+		image := "aW1hZ2U="
+		handler := &testAnswerHandler{
+			t:              t,
+			answer:         generateResponse{Response: "A cat"},
+			expectedImages: []string{image},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New(0, nullLogger())
+
+		settings := &fakeClassConfig{apiEndpoint: server.URL}
+		properties := []*modulecapabilities.GenerateProperties{{
+			Text: map[string]string{"prop": "My cat"},
+			Blob: map[string]*string{"image": &image},
+		}}
+		res, err := c.GenerateAllResults(context.Background(), properties, "What is on the picture?", nil, false, settings)
+
+		require.NoError(t, err)
+		assert.Equal(t, "A cat", *res.Result)
+	})
 }
 
 type testAnswerHandler struct {
 	t *testing.T
 	// the test handler will report as not ready before the time has passed
-	answer  generateResponse
-	timeout time.Duration
+	answer         generateResponse
+	timeout        time.Duration
+	expectedImages []string
 }
 
 func (f *testAnswerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -109,8 +134,9 @@ func (f *testAnswerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	require.Nil(f.t, err)
 	defer r.Body.Close()
 
-	var b map[string]interface{}
+	var b generateInput
 	require.Nil(f.t, json.Unmarshal(bodyBytes, &b))
+	assert.Equal(f.t, f.expectedImages, b.Images)
 
 	outBytes, err := json.Marshal(f.answer)
 	require.Nil(f.t, err)
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/generative"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -76,23 +77,27 @@ func New(openAIApiKey, openAIOrganization, azureApiKey string, timeout time.Dura
 	}
 }
 
-func (v *openai) GenerateSingleResult(ctx context.Context, textProperties map[string]string, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
-	forPrompt, err := v.generateForPrompt(textProperties, prompt)
+func (v *openai) GenerateSingleResult(ctx context.Context, properties *modulecapabilities.GenerateProperties, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	forPrompt, err := v.generateForPrompt(properties.Text, prompt)
 	if err != nil {
 		return nil, err
 	}
-	return v.Generate(ctx, cfg, forPrompt, options, debug)
+	return v.generate(ctx, cfg, forPrompt, generative.Blobs([]*modulecapabilities.GenerateProperties{properties}), options, debug)
 }
 
-func (v *openai) GenerateAllResults(ctx context.Context, textProperties []map[string]string, task string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
-	forTask, err := v.generatePromptForTask(textProperties, task)
+func (v *openai) GenerateAllResults(ctx context.Context, properties []*modulecapabilities.GenerateProperties, task string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	forTask, err := v.generatePromptForTask(generative.Texts(properties), task)
 	if err != nil {
 		return nil, err
 	}
-	return v.Generate(ctx, cfg, forTask, options, debug)
+	return v.generate(ctx, cfg, forTask, generative.Blobs(properties), options, debug)
 }
 
 func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
+	return v.generate(ctx, cfg, prompt, nil, options, debug)
+}
+
+func (v *openai) generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, images []*string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
 	params := v.getParameters(cfg, options)
 	isAzure := config.IsAzure(params.IsAzure, params.ResourceName, params.DeploymentID)
 	debugInformation := v.getDebugInformation(debug, prompt)
@@ -102,7 +107,7 @@ func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 		return nil, errors.Wrap(err, "url join path")
 	}
 
-	input, err := v.generateInput(prompt, params)
+	input, err := v.generateInput(prompt, images, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "generate input")
 	}
@@ -161,7 +166,7 @@ func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 
 	message := resBody.Choices[0].Message
 	if message != nil {
-		textResponse = message.Content
+		textResponse, _ = message.Content.(string)
 		trimmedResponse := strings.Trim(textResponse, "\n")
 		return &modulecapabilities.GenerateResponse{
 			Result: &trimmedResponse,
@@ -274,7 +279,7 @@ func (v *openai) buildOpenAIUrl(ctx context.Context, params openaiparams.Params)
 	return v.buildUrl(isLegacy, isAzure, resourceName, deploymentID, baseURL, params.ApiVersion)
 }
 
-func (v *openai) generateInput(prompt string, params openaiparams.Params) (generateInput, error) {
+func (v *openai) generateInput(prompt string, images []*string, params openaiparams.Params) (generateInput, error) {
 	if config.IsLegacy(params.Model) {
 		return generateInput{
 			Prompt:           prompt,
@@ -305,6 +310,9 @@ func (v *openai) generateInput(prompt string, params openaiparams.Params) (gener
 		if err != nil {
 			return input, errors.Wrap(err, "determine tokens count")
 		}
+		if len(images) > 0 {
+			messages[0].Content = v.contentWithImages(prompt, images)
+		}
 		input = generateInput{
 			Messages:         messages,
 			Stream:           false,
@@ -324,6 +332,15 @@ func (v *openai) generateInput(prompt string, params openaiparams.Params) (gener
 	}
 }
 
+func (v *openai) contentWithImages(prompt string, images []*string) []contentPart {
+	content := []contentPart{{Type: "text", Text: prompt}}
+	for _, image := range images {
+		url := fmt.Sprintf("data:%s;base64,%s", generative.ImageMediaType(*image), *image)
+		content = append(content, contentPart{Type: "image_url", ImageURL: &imageURL{URL: url}})
+	}
+	return content
+}
+
 func (v *openai) getError(statusCode int, requestID string, resBodyError *openAIApiError, isAzure bool) error {
 	endpoint := "OpenAI API"
 	if isAzure {
@@ -449,9 +466,19 @@ type generateInput struct {
 }
 
 type message struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
-	Name    string `json:"name,omitempty"`
+	Role    string      `json:"role"`
+	Content interface{} `json:"content"` // string or []contentPart
+	Name    string      `json:"name,omitempty"`
+}
+
+type contentPart struct {
+	Type     string    `json:"type"`
+	Text     string    `json:"text,omitempty"`
+	ImageURL *imageURL `json:"image_url,omitempty"`
+}
+
+type imageURL struct {
+	URL string `json:"url"`
 }
 
 type generateResponse struct {
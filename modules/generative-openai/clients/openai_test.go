@@ -69,7 +69,7 @@ func TestBuildUrlFn(t *testing.T) {
 }
 
 func TestGetAnswer(t *testing.T) {
-	textProperties := []map[string]string{{"prop": "My name is john"}}
+	properties := []*modulecapabilities.GenerateProperties{{Text: map[string]string{"prop": "My name is john"}}}
 	t.Run("when the server has a successful answer ", func(t *testing.T) {
 		handler := &testAnswerHandler{
 			t: t,
@@ -94,7 +94,7 @@ func TestGetAnswer(t *testing.T) {
 			Result: ptString("John"),
 		}
 
-		res, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", nil, false, nil)
+		res, err := c.GenerateAllResults(context.Background(), properties, "What is my name?", nil, false, nil)
 
 		assert.Nil(t, err)
 		assert.Equal(t, expected, *res)
@@ -116,7 +116,7 @@ func TestGetAnswer(t *testing.T) {
 			return fakeBuildUrl(server.URL, isAzure, isLegacy, resourceName, deploymentID, baseURL, apiVersion)
 		}
 
-		_, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", nil, false, nil)
+		_, err := c.GenerateAllResults(context.Background(), properties, "What is my name?", nil, false, nil)
 
 		require.NotNil(t, err)
 		assert.Error(t, err, "connection to OpenAI failed with status: 500 error: some error from the server")
@@ -139,7 +139,7 @@ func TestGetAnswer(t *testing.T) {
 			return fakeBuildUrl(server.URL, isAzure, isLegacy, resourceName, deploymentID, baseURL, apiVersion)
 		}
 
-		_, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", nil, false, nil)
+		_, err := c.GenerateAllResults(context.Background(), properties, "What is my name?", nil, false, nil)
 
 		require.NotNil(t, err)
 		assert.Error(t, err, "connection to OpenAI failed with status: 500 request-id: some-request-id error: some error from the server")
@@ -183,6 +183,26 @@ func TestGetAnswer(t *testing.T) {
 	})
 }
 
+func TestGenerateInputWithImages(t *testing.T) {
+	// base64 encoded PNG signature
+	image := "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNk+M9QDwADhgGAWjR9awAAAABJRU5ErkJggg=="
+	maxTokens := 100
+	params := openaiparams.Params{BaseURL: "https://api.openai.com", Model: "gpt-4o", MaxTokens: &maxTokens}
+	c := New("", "", "", 0, nullLogger())
+
+	input, err := c.generateInput("What is on the picture?", []*string{&image}, params)
+	require.NoError(t, err)
+	require.Len(t, input.Messages, 1)
+
+	content, ok := input.Messages[0].Content.([]contentPart)
+	require.True(t, ok)
+	require.Len(t, content, 2)
+	assert.Equal(t, contentPart{Type: "text", Text: "What is on the picture?"}, content[0])
+	assert.Equal(t, "image_url", content[1].Type)
+	require.NotNil(t, content[1].ImageURL)
+	assert.Equal(t, "data:image/png;base64,"+image, content[1].ImageURL.URL)
+}
+
 type testAnswerHandler struct {
 	t *testing.T
 	// the test handler will report as not ready before the time has passed
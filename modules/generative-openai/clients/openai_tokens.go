@@ -38,7 +38,9 @@ func getTokensCount(model string, messages []message) (int, error) {
 	for _, message := range messages {
 		tokensCount += tokensPerMessage
 		tokensCount += len(tke.Encode(message.Role, nil, nil))
-		tokensCount += len(tke.Encode(message.Content, nil, nil))
+		if content, ok := message.Content.(string); ok {
+			tokensCount += len(tke.Encode(content, nil, nil))
+		}
 		if message.Name != "" {
 			tokensCount += tokensPerName
 			tokensCount += len(tke.Encode(message.Name, nil, nil))
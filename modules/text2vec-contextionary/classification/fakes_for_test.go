@@ -118,6 +118,26 @@ func (f *fakeClassificationRepo) Get(ctx context.Context, id strfmt.UUID) (*mode
 	return &class, nil
 }
 
+func (f *fakeClassificationRepo) List(ctx context.Context) ([]*models.Classification, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	var out []*models.Classification
+	for _, class := range f.db {
+		class := class
+		out = append(out, &class)
+	}
+	return out, nil
+}
+
+func (f *fakeClassificationRepo) PutModel(ctx context.Context, model usecasesclassfication.Model) error {
+	return nil
+}
+
+func (f *fakeClassificationRepo) GetModels(ctx context.Context, class string) ([]*usecasesclassfication.Model, error) {
+	return nil, nil
+}
+
 func newFakeVectorRepoKNN(unclassified, classified search.Results) *fakeVectorRepoKNN {
 	return &fakeVectorRepoKNN{
 		unclassified: unclassified,
@@ -146,6 +166,21 @@ func (f *fakeVectorRepoKNN) GetUnclassified(ctx context.Context,
 	return f.unclassified, nil
 }
 
+func (f *fakeVectorRepoKNN) GetClassified(ctx context.Context,
+	class string, properties []string, filter *libfilters.LocalFilter,
+) ([]search.Result, error) {
+	f.Lock()
+	defer f.Unlock()
+	return f.classified, nil
+}
+
+func (f *fakeVectorRepoKNN) Object(ctx context.Context, class string, id strfmt.UUID,
+	props search.SelectProperties, additional additional.Properties,
+	repl *additional.ReplicationProperties, tenant string,
+) (*search.Result, error) {
+	panic("not implemented")
+}
+
 func (f *fakeVectorRepoKNN) AggregateNeighbors(ctx context.Context, vector []float32,
 	class string, properties []string, k int,
 	filter *libfilters.LocalFilter,
@@ -267,6 +302,19 @@ func (f *fakeVectorRepoContextual) GetUnclassified(ctx context.Context,
 	return f.unclassified, nil
 }
 
+func (f *fakeVectorRepoContextual) GetClassified(ctx context.Context,
+	class string, properties []string, filter *libfilters.LocalFilter,
+) ([]search.Result, error) {
+	panic("not implemented")
+}
+
+func (f *fakeVectorRepoContextual) Object(ctx context.Context, class string, id strfmt.UUID,
+	props search.SelectProperties, additional additional.Properties,
+	repl *additional.ReplicationProperties, tenant string,
+) (*search.Result, error) {
+	panic("not implemented")
+}
+
 func (f *fakeVectorRepoContextual) AggregateNeighbors(ctx context.Context, vector []float32,
 	class string, properties []string, k int,
 	filter *libfilters.LocalFilter,
@@ -358,100 +358,71 @@ func TestUpdateDistanceSettings(t *testing.T) {
 	})
 }
 
-// TODO: https://github.com/weaviate/weaviate/issues/973
-// // This test prevents a regression on the fix for this bug:
-// // https://github.com/weaviate/weaviate/issues/831
-// func TestDeleteSingleProperties(t *testing.T) {
-// 	t.Parallel()
-
-// 	randomObjectClassName := "RedShip"
-
-// 	// Ensure that this name is not in the schema yet.
-// 	t.Log("Asserting that this class does not exist yet")
-// 	assert.NotContains(t, GetThingClassNames(t), randomThingClassName)
-
-// 	tc := &models.Class{
-// 		Class: randomThingClassName,
-// 		Properties: []*models.Property{
-// 			&models.Property{
-// DataType:     schema.DataTypeText.PropString(),
-// Tokenization: models.PropertyTokenizationWhitespace,
-// 				Name:     "name",
-// 			},
-// 			&models.Property{
-// DataType:     schema.DataTypeText.PropString(),
-// Tokenization: models.PropertyTokenizationWhitespace,
-// 				Name:     "description",
-// 			},
-// 		},
-// 	}
-
-// 	t.Log("Creating class")
-// 	params := clschema.NewSchemaThingsCreateParams().WithThingClass(tc)
-// 	resp, err := helper.Client(t).Schema.SchemaThingsCreate(params, nil)
-// 	helper.AssertRequestOk(t, resp, err, nil)
-
-// 	t.Log("Asserting that this class is now created")
-// 	assert.Contains(t, GetThingClassNames(t), randomThingClassName)
-
-// 	t.Log("adding an instance of this particular class that uses both properties")
-// 	instanceParams := things.NewThingsCreateParams().WithBody(
-// 		&models.Thing{
-// 			Class: randomThingClassName,
-// 			Schema: map[string]interface{}{
-// 				"name":        "my name",
-// 				"description": "my description",
-// 			},
-// 		})
-// 	instanceRes, err := helper.Client(t).Things.ThingsCreate(instanceParams, nil)
-// 	assert.Nil(t, err, "adding a class instance should not error")
-
-// 	t.Log("delete a single property of the class")
-// 	deleteParams := clschema.NewSchemaThingsPropertiesDeleteParams().
-// 		WithClassName(randomThingClassName).
-// 		WithPropertyName("description")
-// 	_, err = helper.Client(t).Schema.SchemaThingsPropertiesDelete(deleteParams, nil)
-// 	assert.Nil(t, err, "deleting the property should not error")
-
-// 	t.Log("retrieve the class and make sure the property is gone")
-// 	thing := assertGetThingEventually(t, instanceRes.Payload.ID)
-// 	expectedSchema := map[string]interface{}{
-// 		"name": "my name",
-// 	}
-// 	assert.Equal(t, expectedSchema, thing.Schema)
-
-// 	t.Log("verifying that we can still retrieve the thing through graphQL")
-// 	result := gql.AssertGraphQL(t, helper.RootAuth, "{  Get { Things { RedShip { name } } } }")
-// 	ships := result.Get("Get", "Things", "RedShip").AsSlice()
-// 	expectedShip := map[string]interface{}{
-// 		"name": "my name",
-// 	}
-// 	assert.Contains(t, ships, expectedShip)
-
-// 	t.Log("verifying other GQL/REST queries still work")
-// 	gql.AssertGraphQL(t, helper.RootAuth, "{  Meta { Things { RedShip { name { count } } } } }")
-// 	gql.AssertGraphQL(t, helper.RootAuth, `{  Aggregate { Things { RedShip(groupBy: ["name"]) { name { count } } } } }`)
-// 	_, err = helper.Client(t).Things.ThingsList(things.NewThingsListParams(), nil)
-// 	assert.Nil(t, err, "listing things should not error")
-
-// 	t.Log("verifying we could re-add the property with the same name")
-// 	readdParams := clschema.NewSchemaThingsPropertiesAddParams().
-// 		WithClassName(randomThingClassName).
-// 		WithBody(&models.Property{
-// 			Name:     "description",
-// DataType:     schema.DataTypeText.PropString(),
-// Tokenization: models.PropertyTokenizationWhitespace,
-// 		})
-
-// 	_, err = helper.Client(t).Schema.SchemaThingsPropertiesAdd(readdParams, nil)
-// 	assert.Nil(t, err, "adding the previously deleted property again should not error")
-
-// 	// Now clean up this class.
-// 	t.Log("Remove the class")
-// 	delParams := clschema.NewSchemaThingsDeleteParams().WithClassName(randomThingClassName)
-// 	delResp, err := helper.Client(t).Schema.SchemaThingsDelete(delParams, nil)
-// 	helper.AssertRequestOk(t, delResp, err, nil)
-
-// 	// And verify that the class does not exist anymore.
-// 	assert.NotContains(t, GetThingClassNames(t), randomThingClassName)
-// }
+// This test prevents a regression on the fix for this bug:
+// https://github.com/weaviate/weaviate/issues/831
+func TestDeleteSingleProperties(t *testing.T) {
+	className := "RedShip"
+
+	// Ensure that this name is not in the schema yet.
+	t.Log("Asserting that this class does not exist yet")
+	assert.NotContains(t, GetObjectClassNames(t), className)
+
+	helper.CreateClass(t, &models.Class{
+		Class:      className,
+		Vectorizer: "none",
+		Properties: []*models.Property{
+			{
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+				Name:         "name",
+			},
+			{
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+				Name:         "description",
+			},
+		},
+	})
+	defer helper.DeleteClass(t, className)
+
+	t.Log("adding an instance of this particular class that uses both properties")
+	id := helper.AssertCreateObject(t, className, map[string]interface{}{
+		"name":        "my name",
+		"description": "my description",
+	})
+
+	t.Log("delete a single property of the class")
+	deleteParams := clschema.NewSchemaObjectsPropertiesDeleteParams().
+		WithClassName(className).
+		WithPropertyName("description")
+	_, err := helper.Client(t).Schema.SchemaObjectsPropertiesDelete(deleteParams, nil)
+	require.Nil(t, err, "deleting the property should not error")
+
+	t.Log("retrieve the class and make sure the property is gone")
+	class := helper.GetClass(t, className)
+	require.Len(t, class.Properties, 1)
+	assert.Equal(t, "name", class.Properties[0].Name)
+
+	t.Log("retrieve the object and make sure the value is gone")
+	obj := helper.AssertGetObject(t, className, id)
+	assert.Equal(t, map[string]interface{}{"name": "my name"}, obj.Properties)
+
+	t.Log("deleting an unknown property fails")
+	deleteParams = clschema.NewSchemaObjectsPropertiesDeleteParams().
+		WithClassName(className).
+		WithPropertyName("description")
+	_, err = helper.Client(t).Schema.SchemaObjectsPropertiesDelete(deleteParams, nil)
+	var notFound *clschema.SchemaObjectsPropertiesDeleteNotFound
+	assert.ErrorAs(t, err, &notFound)
+
+	t.Log("verifying we could re-add the property with the same name")
+	readdParams := clschema.NewSchemaObjectsPropertiesAddParams().
+		WithClassName(className).
+		WithBody(&models.Property{
+			Name:         "description",
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationWhitespace,
+		})
+	_, err = helper.Client(t).Schema.SchemaObjectsPropertiesAdd(readdParams, nil)
+	assert.Nil(t, err, "adding the previously deleted property again should not error")
+}
@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package test
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clschema "github.com/weaviate/weaviate/client/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/test/helper"
+)
+
+func TestAliases(t *testing.T) {
+	var (
+		alias = "ProductsAlias"
+		v1    = "ProductsV1"
+		v2    = "ProductsV2"
+		id1   = strfmt.UUID("3b8f6ca6-2b36-4a0c-9cc9-d4a0b9b0fd01")
+		id2   = strfmt.UUID("3b8f6ca6-2b36-4a0c-9cc9-d4a0b9b0fd02")
+	)
+
+	defer func() {
+		helper.Client(t).Schema.AliasesDelete(clschema.NewAliasesDeleteParams().WithAliasName(alias), nil)
+		helper.DeleteClass(t, v1)
+		helper.DeleteClass(t, v2)
+	}()
+
+	t.Run("create classes with one object each", func(t *testing.T) {
+		for class, id := range map[string]strfmt.UUID{v1: id1, v2: id2} {
+			helper.CreateClass(t, &models.Class{Class: class, Vectorizer: "none"})
+			require.Nil(t, helper.CreateObject(t, &models.Object{Class: class, ID: id}))
+		}
+	})
+
+	t.Run("create alias", func(t *testing.T) {
+		params := clschema.NewAliasesCreateParams().WithBody(&models.Alias{Alias: alias, Class: v1})
+		_, err := helper.Client(t).Schema.AliasesCreate(params, nil)
+		require.Nil(t, err)
+	})
+
+	t.Run("alias cannot shadow a class", func(t *testing.T) {
+		params := clschema.NewAliasesCreateParams().WithBody(&models.Alias{Alias: v2, Class: v1})
+		_, err := helper.Client(t).Schema.AliasesCreate(params, nil)
+		require.NotNil(t, err)
+		var unprocessable *clschema.AliasesCreateUnprocessableEntity
+		assert.ErrorAs(t, err, &unprocessable)
+	})
+
+	t.Run("objects are served by the class the alias points to", func(t *testing.T) {
+		obj, err := helper.GetObject(t, alias, id1)
+		require.Nil(t, err)
+		assert.Equal(t, v1, obj.Class)
+	})
+
+	t.Run("swap alias", func(t *testing.T) {
+		params := clschema.NewAliasesUpdateParams().WithAliasName(alias).
+			WithBody(&models.Alias{Class: v2})
+		res, err := helper.Client(t).Schema.AliasesUpdate(params, nil)
+		require.Nil(t, err)
+		assert.Equal(t, v2, res.Payload.Class)
+
+		obj, err := helper.GetObject(t, alias, id2)
+		require.Nil(t, err)
+		assert.Equal(t, v2, obj.Class)
+	})
+
+	t.Run("list aliases", func(t *testing.T) {
+		res, err := helper.Client(t).Schema.AliasesGet(clschema.NewAliasesGetParams().WithClass(&v2), nil)
+		require.Nil(t, err)
+		require.Len(t, res.Payload.Aliases, 1)
+		assert.Equal(t, alias, res.Payload.Aliases[0].Alias)
+
+		res, err = helper.Client(t).Schema.AliasesGet(clschema.NewAliasesGetParams().WithClass(&v1), nil)
+		require.Nil(t, err)
+		assert.Empty(t, res.Payload.Aliases)
+	})
+
+	t.Run("delete alias", func(t *testing.T) {
+		_, err := helper.Client(t).Schema.AliasesDelete(clschema.NewAliasesDeleteParams().WithAliasName(alias), nil)
+		require.Nil(t, err)
+
+		_, err = helper.Client(t).Schema.AliasesGetAlias(clschema.NewAliasesGetAliasParams().WithAliasName(alias), nil)
+		var notFound *clschema.AliasesGetAliasNotFound
+		assert.ErrorAs(t, err, &notFound)
+	})
+}
@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type auditingAuthorizer struct {
+	authorizer authorization.Authorizer
+	log        *Logger
+}
+
+// NewAuthorizer wraps authorizer so that every authorization decision is
+// written to the audit log together with the resources it was made for. If
+// auditing is disabled the authorizer is returned as is.
+func NewAuthorizer(authorizer authorization.Authorizer, log *Logger) authorization.Authorizer {
+	if log == nil {
+		return authorizer
+	}
+	return &auditingAuthorizer{authorizer: authorizer, log: log}
+}
+
+func (a *auditingAuthorizer) Authorize(principal *models.Principal, verb string, resources ...string) error {
+	start := time.Now()
+	err := a.authorizer.Authorize(principal, verb, resources...)
+
+	class := authzOperationClass(verb, resources)
+	if !a.log.Enabled(class) {
+		return err
+	}
+
+	e := Event{
+		Time:           start.UTC(),
+		Protocol:       ProtocolAuthz,
+		Operation:      "authorize",
+		OperationClass: class,
+		Verb:           verb,
+		Resources:      resources,
+		Status:         StatusOK,
+		LatencyMs:      float64(time.Since(start)) / float64(time.Millisecond),
+	}
+	e.withPrincipal(principal)
+	for _, res := range resources {
+		collection, tenant, id := parseResource(res)
+		if e.Collection == "" {
+			e.Collection = collection
+		}
+		if e.Tenant == "" {
+			e.Tenant = tenant
+		}
+		if id != "" {
+			e.ObjectIDs = append(e.ObjectIDs, id)
+		}
+	}
+	if err != nil {
+		e.Status = StatusServerError
+		if errors.As(err, &autherrs.Forbidden{}) {
+			e.Status = StatusDenied
+		}
+		e.Error = err.Error()
+	}
+	a.log.Log(e)
+
+	return err
+}
+
+// authzOperationClass classifies an authorization decision. Reads are always
+// read operations, other verbs on objects are writes and everything else,
+// e.g. changing collections or tenants, is administrative.
+func authzOperationClass(verb string, resources []string) string {
+	if verb == authorization.READ {
+		return config.AuditOperationRead
+	}
+	for _, res := range resources {
+		if strings.Contains(res, "/objects/") {
+			return config.AuditOperationWrite
+		}
+	}
+	return config.AuditOperationAdmin
+}
+
+// parseResource extracts collection, tenant and object id from resource
+// strings as built by the authorization package. Wildcards are dropped.
+func parseResource(res string) (collection, tenant, id string) {
+	parts := strings.Split(res, "/")
+	for i := 0; i+1 < len(parts); i += 2 {
+		value := parts[i+1]
+		if value == "*" {
+			continue
+		}
+		switch parts[i] {
+		case "collections", "collection":
+			collection = value
+		case "shards":
+			tenant = value
+		case "objects":
+			id = value
+		}
+	}
+	return collection, tenant, id
+}
@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeAuthorizer struct {
+	err error
+}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb string, resources ...string) error {
+	return a.err
+}
+
+func TestAuthorizer(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	principal := &models.Principal{Username: "jane"}
+
+	t.Run("disabled audit log returns authorizer", func(t *testing.T) {
+		inner := &fakeAuthorizer{}
+		assert.Same(t, inner, NewAuthorizer(inner, nil))
+	})
+
+	t.Run("allowed object write", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		a := NewAuthorizer(&fakeAuthorizer{}, NewLogger(nil, logger, NewJSONSink(buf)))
+
+		require.Nil(t, a.Authorize(principal, authorization.UPDATE,
+			authorization.Objects("Article", "tenant1", "8a6e2d62-5b3e-4d7a-9c3e-0d6b0f6f6c01")))
+
+		events := decodeEvents(t, buf)
+		require.Len(t, events, 1)
+		e := events[0]
+		assert.Equal(t, ProtocolAuthz, e.Protocol)
+		assert.Equal(t, config.AuditOperationWrite, e.OperationClass)
+		assert.Equal(t, authorization.UPDATE, e.Verb)
+		assert.Equal(t, "jane", e.Principal)
+		assert.Equal(t, "Article", e.Collection)
+		assert.Equal(t, "tenant1", e.Tenant)
+		assert.Equal(t, []string{"8a6e2d62-5b3e-4d7a-9c3e-0d6b0f6f6c01"}, e.ObjectIDs)
+		assert.Equal(t, StatusOK, e.Status)
+	})
+
+	t.Run("denied admin operation", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		forbidden := errors.NewForbidden(principal, authorization.DELETE, "collection/Article/shards/tenant1")
+		a := NewAuthorizer(&fakeAuthorizer{err: forbidden}, NewLogger(nil, logger, NewJSONSink(buf)))
+
+		err := a.Authorize(principal, authorization.DELETE, authorization.Shards("Article", "tenant1")...)
+		assert.Equal(t, forbidden, err)
+
+		events := decodeEvents(t, buf)
+		require.Len(t, events, 1)
+		e := events[0]
+		assert.Equal(t, config.AuditOperationAdmin, e.OperationClass)
+		assert.Equal(t, "Article", e.Collection)
+		assert.Equal(t, "tenant1", e.Tenant)
+		assert.Equal(t, StatusDenied, e.Status)
+		assert.Equal(t, forbidden.Error(), e.Error)
+	})
+
+	t.Run("filtered operation class", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		a := NewAuthorizer(&fakeAuthorizer{},
+			NewLogger([]string{config.AuditOperationWrite}, logger, NewJSONSink(buf)))
+
+		require.Nil(t, a.Authorize(nil, authorization.READ, authorization.Collections()...))
+		assert.Empty(t, decodeEvents(t, buf))
+	})
+}
@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type recordCtxKey struct{}
+
+// Record collects the details of a request while it is being served. The
+// protocol layer creates it through NewContext and turns it into an Event once
+// the request completed; handlers further down only enrich it.
+type Record struct {
+	sync.Mutex
+	principal    *models.Principal
+	principalSet bool
+	operation    string
+	collection   string
+	tenant       string
+	objectIDs    []string
+	filter       string
+}
+
+// NewContext returns a context carrying a new, empty record
+func NewContext(ctx context.Context) (context.Context, *Record) {
+	rec := &Record{}
+	return context.WithValue(ctx, recordCtxKey{}, rec), rec
+}
+
+// FromContext returns the record of the request, nil if the request is not
+// audited
+func FromContext(ctx context.Context) *Record {
+	rec, _ := ctx.Value(recordCtxKey{}).(*Record)
+	return rec
+}
+
+// SetPrincipal records the authenticated principal of the request
+func SetPrincipal(ctx context.Context, principal *models.Principal) {
+	FromContext(ctx).SetPrincipal(principal)
+}
+
+// SetTarget records the collection and tenant the request operates on
+func SetTarget(ctx context.Context, collection, tenant string) {
+	FromContext(ctx).SetTarget(collection, tenant)
+}
+
+// AddObjectIDs records the ids of the objects the request touched
+func AddObjectIDs(ctx context.Context, ids ...string) {
+	FromContext(ctx).AddObjectIDs(ids...)
+}
+
+// SetFilter records the filter or query which selected the objects
+func SetFilter(ctx context.Context, filter string) {
+	FromContext(ctx).SetFilter(filter)
+}
+
+func (r *Record) SetPrincipal(principal *models.Principal) {
+	if r == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.principal = principal
+	r.principalSet = true
+}
+
+func (r *Record) SetOperation(operation string) {
+	if r == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.operation = operation
+}
+
+func (r *Record) Operation() string {
+	if r == nil {
+		return ""
+	}
+	r.Lock()
+	defer r.Unlock()
+	return r.operation
+}
+
+// SetTarget records collection and tenant, empty values do not overwrite
+// what was recorded before
+func (r *Record) SetTarget(collection, tenant string) {
+	if r == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	if collection != "" {
+		r.collection = collection
+	}
+	if tenant != "" {
+		r.tenant = tenant
+	}
+}
+
+func (r *Record) AddObjectIDs(ids ...string) {
+	if r == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	for _, id := range ids {
+		if id != "" {
+			r.objectIDs = append(r.objectIDs, id)
+		}
+	}
+}
+
+func (r *Record) SetFilter(filter string) {
+	if r == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.filter = filter
+}
+
+// Event turns the record into an audit event. A principal which was never
+// set is left empty, as the request did not get as far as authentication.
+func (r *Record) Event(protocol, operationClass, status string, err error,
+	start time.Time,
+) Event {
+	r.Lock()
+	defer r.Unlock()
+
+	e := Event{
+		Time:           start.UTC(),
+		Protocol:       protocol,
+		Operation:      r.operation,
+		OperationClass: operationClass,
+		Collection:     r.collection,
+		Tenant:         r.tenant,
+		ObjectIDs:      r.objectIDs,
+		Filter:         r.filter,
+		Status:         status,
+		LatencyMs:      float64(time.Since(start)) / float64(time.Millisecond),
+	}
+	if r.principalSet {
+		e.withPrincipal(r.principal)
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	return e
+}
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package audit records who did what to which data. Every audited request
+// produces a single Event which is written to all configured sinks.
+package audit
+
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	ProtocolREST    = "rest"
+	ProtocolGraphQL = "graphql"
+	ProtocolGRPC    = "grpc"
+	// ProtocolAuthz marks events emitted by the authorizer rather than by an
+	// API handler. They carry the resources a decision was made for.
+	ProtocolAuthz = "authz"
+)
+
+const (
+	StatusOK          = "ok"
+	StatusDenied      = "denied"
+	StatusUserError   = "user_error"
+	StatusServerError = "server_error"
+)
+
+// Event is a single entry of the audit log
+type Event struct {
+	Time           time.Time `json:"time"`
+	Protocol       string    `json:"protocol"`
+	Operation      string    `json:"operation"`
+	OperationClass string    `json:"operation_class"`
+	Principal      string    `json:"principal,omitempty"`
+	Groups         []string  `json:"groups,omitempty"`
+	Collection     string    `json:"collection,omitempty"`
+	Tenant         string    `json:"tenant,omitempty"`
+	ObjectIDs      []string  `json:"object_ids,omitempty"`
+	Filter         string    `json:"filter,omitempty"`
+	Verb           string    `json:"verb,omitempty"`
+	Resources      []string  `json:"resources,omitempty"`
+	Status         string    `json:"status"`
+	Error          string    `json:"error,omitempty"`
+	LatencyMs      float64   `json:"latency_ms"`
+}
+
+// withPrincipal sets the principal fields of the event, a nil principal is
+// recorded as anonymous access
+func (e *Event) withPrincipal(principal *models.Principal) {
+	if principal == nil {
+		e.Principal = "anonymous"
+		return
+	}
+	e.Principal = principal.Username
+	e.Groups = principal.Groups
+}
+
+// StatusFromHTTP maps an HTTP status code to the status of an event
+func StatusFromHTTP(code int) string {
+	switch {
+	case code == 401 || code == 403:
+		return StatusDenied
+	case code >= 500:
+		return StatusServerError
+	case code >= 400:
+		return StatusUserError
+	default:
+		return StatusOK
+	}
+}
@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// Logger filters events by their operation class and fans them out to the
+// configured sinks. A nil *Logger is valid and discards all events, so
+// callers don't need to check whether auditing is enabled.
+type Logger struct {
+	classes map[string]struct{}
+	sinks   []Sink
+	logger  logrus.FieldLogger
+}
+
+// New creates the audit logger described by cfg. It returns nil if auditing
+// is disabled.
+func New(cfg config.Audit, logger logrus.FieldLogger) (*Logger, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	sinks := make([]Sink, 0, len(cfg.Sinks))
+	for _, name := range cfg.Sinks {
+		switch name {
+		case config.AuditSinkStdout:
+			sinks = append(sinks, NewJSONSink(os.Stdout))
+		case config.AuditSinkFile:
+			maxSizeMB := cfg.File.MaxSizeMB
+			if maxSizeMB == 0 {
+				maxSizeMB = config.DefaultAuditFileMaxSizeMB
+			}
+			sink, err := NewFileSink(cfg.File.Path, int64(maxSizeMB)*1024*1024, cfg.File.MaxBackups)
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, sink)
+		default:
+			return nil, fmt.Errorf("unknown audit sink %q", name)
+		}
+	}
+
+	return NewLogger(cfg.Operations, logger, sinks...), nil
+}
+
+// NewLogger creates an audit logger writing events of the given operation
+// classes to sinks. If no classes are given all events are written.
+func NewLogger(classes []string, logger logrus.FieldLogger, sinks ...Sink) *Logger {
+	l := &Logger{sinks: sinks, logger: logger}
+	if len(classes) > 0 {
+		l.classes = make(map[string]struct{}, len(classes))
+		for _, c := range classes {
+			l.classes[c] = struct{}{}
+		}
+	}
+	return l
+}
+
+// Enabled reports whether events of the operation class are audited
+func (l *Logger) Enabled(operationClass string) bool {
+	if l == nil {
+		return false
+	}
+	if l.classes == nil {
+		return true
+	}
+	_, ok := l.classes[operationClass]
+	return ok
+}
+
+// Log writes the event to all sinks. Failing sinks are logged but don't
+// fail the request that produced the event.
+func (l *Logger) Log(e Event) {
+	if !l.Enabled(e.OperationClass) {
+		return
+	}
+	for _, sink := range l.sinks {
+		if err := sink.Write(e); err != nil {
+			l.logger.WithField("action", "audit_log").
+				WithError(err).Error("failed to write audit event")
+		}
+	}
+}
+
+func (l *Logger) Close() error {
+	if l == nil {
+		return nil
+	}
+	var errs []error
+	for _, sink := range l.sinks {
+		errs = append(errs, sink.Close())
+	}
+	return errors.Join(errs...)
+}
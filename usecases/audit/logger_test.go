@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func decodeEvents(t *testing.T, buf *bytes.Buffer) []Event {
+	t.Helper()
+	var events []Event
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var e Event
+		require.Nil(t, dec.Decode(&e))
+		events = append(events, e)
+	}
+	return events
+}
+
+func TestLoggerFiltersOperationClasses(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	buf := &bytes.Buffer{}
+	l := NewLogger([]string{config.AuditOperationWrite}, logger, NewJSONSink(buf))
+
+	l.Log(Event{Operation: "objects.get", OperationClass: config.AuditOperationRead})
+	l.Log(Event{Operation: "objects.create", OperationClass: config.AuditOperationWrite})
+
+	events := decodeEvents(t, buf)
+	require.Len(t, events, 1)
+	assert.Equal(t, "objects.create", events[0].Operation)
+	assert.False(t, l.Enabled(config.AuditOperationAdmin))
+}
+
+func TestNilLoggerDiscards(t *testing.T) {
+	var l *Logger
+	assert.False(t, l.Enabled(config.AuditOperationRead))
+	l.Log(Event{OperationClass: config.AuditOperationRead})
+	assert.Nil(t, l.Close())
+
+	l, err := New(config.Audit{}, nil)
+	require.Nil(t, err)
+	assert.Nil(t, l)
+}
+
+func TestRecordEvent(t *testing.T) {
+	ctx, rec := NewContext(context.Background())
+	rec.SetOperation("batch.objects.create")
+	SetPrincipal(ctx, &models.Principal{Username: "jane", Groups: []string{"ops"}})
+	SetTarget(ctx, "Article", "")
+	SetTarget(ctx, "", "tenant1")
+	AddObjectIDs(ctx, "id1", "", "id2")
+	SetFilter(ctx, `{"operator":"Equal"}`)
+
+	e := rec.Event(ProtocolREST, config.AuditOperationWrite, StatusOK, nil, time.Now())
+	assert.Equal(t, "batch.objects.create", e.Operation)
+	assert.Equal(t, "jane", e.Principal)
+	assert.Equal(t, []string{"ops"}, e.Groups)
+	assert.Equal(t, "Article", e.Collection)
+	assert.Equal(t, "tenant1", e.Tenant)
+	assert.Equal(t, []string{"id1", "id2"}, e.ObjectIDs)
+	assert.Equal(t, `{"operator":"Equal"}`, e.Filter)
+	assert.Equal(t, StatusOK, e.Status)
+
+	t.Run("anonymous and unauthenticated", func(t *testing.T) {
+		ctx, rec := NewContext(context.Background())
+		e := rec.Event(ProtocolREST, config.AuditOperationRead, StatusDenied, nil, time.Now())
+		assert.Empty(t, e.Principal)
+
+		SetPrincipal(ctx, nil)
+		e = rec.Event(ProtocolREST, config.AuditOperationRead, StatusOK, nil, time.Now())
+		assert.Equal(t, "anonymous", e.Principal)
+	})
+
+	t.Run("context without record", func(t *testing.T) {
+		ctx := context.Background()
+		assert.Nil(t, FromContext(ctx))
+		SetPrincipal(ctx, nil)
+		AddObjectIDs(ctx, "id")
+	})
+}
+
+func TestStatusFromHTTP(t *testing.T) {
+	for code, status := range map[int]string{
+		200: StatusOK,
+		204: StatusOK,
+		401: StatusDenied,
+		403: StatusDenied,
+		404: StatusUserError,
+		422: StatusUserError,
+		500: StatusServerError,
+	} {
+		assert.Equal(t, status, StatusFromHTTP(code), code)
+	}
+}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func countLines(t *testing.T, path string) int {
+	t.Helper()
+	f, err := os.Open(path)
+	require.Nil(t, err)
+	defer f.Close()
+
+	n := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e Event
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), &e))
+		n++
+	}
+	return n
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	line, err := json.Marshal(Event{Operation: "objects.get"})
+	require.Nil(t, err)
+
+	// room for exactly two events per file
+	sink, err := NewFileSink(path, int64(2*(len(line)+1)), 2)
+	require.Nil(t, err)
+
+	for i := 0; i < 7; i++ {
+		require.Nil(t, sink.Write(Event{Operation: "objects.get"}))
+	}
+	require.Nil(t, sink.Close())
+
+	assert.Equal(t, 1, countLines(t, path))
+	assert.Equal(t, 2, countLines(t, path+".1"))
+	assert.Equal(t, 2, countLines(t, path+".2"))
+	assert.NoFileExists(t, path+".3")
+
+	t.Run("reopen appends", func(t *testing.T) {
+		sink, err := NewFileSink(path, int64(2*(len(line)+1)), 2)
+		require.Nil(t, err)
+		require.Nil(t, sink.Write(Event{Operation: "objects.get"}))
+		require.Nil(t, sink.Close())
+		assert.Equal(t, 2, countLines(t, path))
+	})
+
+	t.Run("without backups", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.log")
+		sink, err := NewFileSink(path, int64(len(line)+1), 0)
+		require.Nil(t, err)
+		for i := 0; i < 3; i++ {
+			require.Nil(t, sink.Write(Event{Operation: "objects.get"}))
+		}
+		require.Nil(t, sink.Close())
+		assert.Equal(t, 1, countLines(t, path))
+		assert.NoFileExists(t, path+".1")
+	})
+
+	t.Run("write after close", func(t *testing.T) {
+		assert.NotNil(t, sink.Write(Event{}))
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
@@ -115,6 +116,59 @@ func Test_Authorization(t *testing.T) {
 	})
 }
 
+func Test_ScheduleAuthorization(t *testing.T) {
+	sched := backup.Schedule{Name: "nightly", Cron: "@daily", Backend: "s3"}
+	type testCase struct {
+		methodName     string
+		additionalArgs []interface{}
+		expectedVerb   string
+	}
+
+	tests := []testCase{
+		{methodName: "List", expectedVerb: authorization.READ},
+		{methodName: "Get", additionalArgs: []interface{}{"nightly"}, expectedVerb: authorization.READ},
+		{methodName: "Create", additionalArgs: []interface{}{sched}, expectedVerb: authorization.CREATE},
+		{methodName: "Update", additionalArgs: []interface{}{sched}, expectedVerb: authorization.UPDATE},
+		{methodName: "Delete", additionalArgs: []interface{}{"nightly"}, expectedVerb: authorization.DELETE},
+	}
+
+	t.Run("verify that a test for every public method exists", func(t *testing.T) {
+		testedMethods := make([]string, len(tests))
+		for i, test := range tests {
+			testedMethods[i] = test.methodName
+		}
+
+		for _, method := range allExportedMethods(&ScheduleManager{}) {
+			switch method {
+			case "Start", "Close":
+				continue
+			}
+			assert.Contains(t, testedMethods, method)
+		}
+	})
+
+	t.Run("verify the tested methods require correct permissions from the authorizer", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		for _, test := range tests {
+			t.Run(test.methodName, func(t *testing.T) {
+				authorizer := mocks.NewMockAuthorizer()
+				authorizer.SetErr(errors.New("just a test fake"))
+				m := NewScheduleManager(authorizer, nil, nil, nil, logger)
+
+				args := append([]interface{}{context.Background(), principal}, test.additionalArgs...)
+				out, _ := callFuncByName(m, test.methodName, args...)
+
+				require.Len(t, authorizer.Calls(), 1, "authorizer must be called")
+				assert.Equal(t, errors.New("just a test fake"), out[len(out)-1].Interface(),
+					"execution must abort with authorizer error")
+				assert.Equal(t, mocks.AuthZReq{Principal: principal, Verb: test.expectedVerb, Resources: []string{authorization.Cluster()}},
+					authorizer.Calls()[0], "correct parameters must have been used on authorizer")
+			})
+		}
+	})
+}
+
 // inspired by https://stackoverflow.com/a/33008200
 func callFuncByName(manager interface{}, funcName string, params ...interface{}) (out []reflect.Value, err error) {
 	managerValue := reflect.ValueOf(manager)
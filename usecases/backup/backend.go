@@ -456,6 +456,7 @@ type fileWriter struct {
 	compressed bool
 	GoPoolSize int
 	migrator   func(classPath string) error
+	filter     func(relPath string) bool // optional, restricts which files are written
 	logger     logrus.FieldLogger
 }
 
@@ -482,6 +483,8 @@ func (fw *fileWriter) WithPoolPercentage(p int) *fileWriter {
 
 func (fw *fileWriter) setMigrator(m func(classPath string) error) { fw.migrator = m }
 
+func (fw *fileWriter) setFilter(f func(relPath string) bool) { fw.filter = f }
+
 // Write downloads files and put them in the destination directory
 func (fw *fileWriter) Write(ctx context.Context, desc *backup.ClassDescriptor, overrideBucket, overridePath string) (err error) {
 	if len(desc.Shards) == 0 { // nothing to copy
@@ -533,6 +536,7 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir, override
 		chunk := chunkKey(desc.Name, k)
 		eg.Go(func() error {
 			uz, w := NewUnzip(classTempDir)
+			uz.filter = fw.filter
 			enterrors.GoWrapper(func() {
 				fw.backend.Read(ctx, chunk, overrideBucket, overridePath, w)
 			}, fw.logger)
@@ -30,6 +30,9 @@ type backupper struct {
 	logger   logrus.FieldLogger
 	sourcer  Sourcer
 	backends BackupBackendProvider
+	// archiver is notified about completed backups, it is nil if commit log
+	// archiving is disabled
+	archiver *LogArchiver
 	// shardCoordinationChan is sync and coordinate operations
 	shardSyncChan
 }
@@ -176,6 +179,9 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 
 		} else {
 			b.logger.WithFields(logFields).Info("backup completed successfully")
+			if b.archiver != nil {
+				b.archiver.BaseBackupCompleted(req.Backend, &result)
+			}
 		}
 		result.CompletedAt = time.Now().UTC()
 	}
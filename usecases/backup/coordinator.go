@@ -388,15 +388,18 @@ func (c *coordinator) canCommit(ctx context.Context, req *Request) (map[string]s
 			reqChan <- pair{
 				nodeHost{node, host},
 				&Request{
-					Method:      req.Method,
-					ID:          id,
-					Backend:     req.Backend,
-					Classes:     gr.Classes,
-					Duration:    _BookingPeriod,
-					NodeMapping: nodeMapping,
-					Compression: req.Compression,
-					Bucket:      req.Bucket,
-					Path:        req.Path,
+					Method:       req.Method,
+					ID:           id,
+					Backend:      req.Backend,
+					Classes:      gr.Classes,
+					Duration:     _BookingPeriod,
+					NodeMapping:  nodeMapping,
+					ClassMapping: req.ClassMapping,
+					Tenants:      req.Tenants,
+					PointInTime:  req.PointInTime,
+					Compression:  req.Compression,
+					Bucket:       req.Bucket,
+					Path:         req.Path,
 				},
 			}
 		}
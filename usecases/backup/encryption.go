@@ -0,0 +1,494 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+// Encrypted objects start with a header followed by a sequence of AES-GCM
+// sealed segments:
+//
+//	magic | len(keyID) | keyID | len(wrappedKey) | wrappedKey | noncePrefix
+//
+// Every segment but the last holds exactly segmentSize bytes of plaintext.
+// The segment nonce is the prefix, a segment counter and a flag marking the
+// last segment, which prevents reordering and truncation. The header and
+// the object's backup id and key are authenticated as additional data of
+// every segment and of the wrapped data key, so objects can't be swapped
+// within or between backups.
+var encryptionMagic = []byte("WVBKENC1")
+
+const (
+	segmentSize     = 64 * 1024
+	noncePrefixSize = 7
+	gcmTagSize      = 16
+	// sealedSegmentSize is the size of a full segment within the object
+	sealedSegmentSize = segmentSize + gcmTagSize
+)
+
+var (
+	errTruncated = errors.New("encrypted backup object is truncated")
+	// errUnencrypted is returned for objects without the encryption header.
+	// They are not authenticated, so they are only accepted if unencrypted
+	// backups were explicitly allowed.
+	errUnencrypted = errors.New("backup object is not encrypted, but backup encryption is enabled")
+)
+
+type segmentCipher struct {
+	aead    cipher.AEAD
+	header  []byte
+	prefix  []byte
+	counter uint32
+}
+
+// objectAAD identifies a backup object. The override bucket and path are
+// left out, backups can be moved to another location and still be restored.
+func objectAAD(backupID, key string) []byte {
+	aad := binary.BigEndian.AppendUint16(nil, uint16(len(backupID)))
+	aad = append(aad, backupID...)
+	aad = binary.BigEndian.AppendUint16(aad, uint16(len(key)))
+	return append(aad, key...)
+}
+
+func newSegmentCipher(dataKey, header, object, prefix []byte) (*segmentCipher, error) {
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	aad := append(append([]byte{}, header...), object...)
+	return &segmentCipher{aead: aead, header: aad, prefix: prefix}, nil
+}
+
+func (c *segmentCipher) nonce(last bool) []byte {
+	nonce := make([]byte, c.aead.NonceSize())
+	copy(nonce, c.prefix)
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], c.counter)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+func (c *segmentCipher) seal(dst, plaintext []byte, last bool) []byte {
+	dst = c.aead.Seal(dst, c.nonce(last), plaintext, c.header)
+	c.counter++
+	return dst
+}
+
+func (c *segmentCipher) open(dst, sealed []byte, last bool) ([]byte, error) {
+	dst, err := c.aead.Open(dst, c.nonce(last), sealed, c.header)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt backup segment %d: %w", c.counter, err)
+	}
+	c.counter++
+	return dst, nil
+}
+
+// encryptReader encrypts everything read from src
+type encryptReader struct {
+	src    io.ReadCloser
+	cipher *segmentCipher
+	buf    []byte
+	// carry is the byte read ahead to find out whether a segment is the last
+	carry    []byte
+	out      []byte
+	finished bool
+}
+
+func newEncryptReader(ctx context.Context, keys KeyProvider, object []byte, src io.ReadCloser) (*encryptReader, error) {
+	dataKey, err := newDataKey()
+	if err != nil {
+		return nil, err
+	}
+	keyID, wrapped, err := keys.WrapKey(ctx, dataKey, object)
+	if err != nil {
+		return nil, fmt.Errorf("wrap data key: %w", err)
+	}
+	if len(keyID) > 0xffff || len(wrapped) > 0xffff {
+		return nil, fmt.Errorf("key id or wrapped data key too long")
+	}
+	prefix := make([]byte, noncePrefixSize)
+	if _, err := io.ReadFull(rand.Reader, prefix); err != nil {
+		return nil, fmt.Errorf("generate nonce prefix: %w", err)
+	}
+
+	header := append([]byte{}, encryptionMagic...)
+	header = binary.BigEndian.AppendUint16(header, uint16(len(keyID)))
+	header = append(header, keyID...)
+	header = binary.BigEndian.AppendUint16(header, uint16(len(wrapped)))
+	header = append(header, wrapped...)
+	header = append(header, prefix...)
+
+	c, err := newSegmentCipher(dataKey, header, object, prefix)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptReader{
+		src:    src,
+		cipher: c,
+		buf:    make([]byte, segmentSize),
+		out:    header,
+	}, nil
+}
+
+func (r *encryptReader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.finished {
+			return 0, io.EOF
+		}
+		if err := r.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+// next seals the next segment of src
+func (r *encryptReader) next() error {
+	n := copy(r.buf, r.carry)
+	r.carry = nil
+	m, err := io.ReadFull(r.src, r.buf[n:])
+	n += m
+	last := false
+	switch {
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		last = true
+	case err != nil:
+		return err
+	default:
+		var peek [1]byte
+		m, err := io.ReadFull(r.src, peek[:])
+		if errors.Is(err, io.EOF) {
+			last = true
+		} else if err != nil {
+			return err
+		}
+		r.carry = peek[:m]
+	}
+	r.out = r.cipher.seal(r.out[:0], r.buf[:n], last)
+	r.finished = last
+	return nil
+}
+
+func (r *encryptReader) Close() error {
+	return r.src.Close()
+}
+
+// decryptWriter decrypts everything written to it into dst. Objects without
+// the encryption header are rejected, unless allowPlain is set: then they
+// are passed through unchanged, so backups created before encryption was
+// enabled can still be restored.
+type decryptWriter struct {
+	ctx        context.Context
+	keys       KeyProvider
+	object     []byte
+	dst        io.WriteCloser
+	allowPlain bool
+	buf        []byte
+	plain      bool
+	cipher     *segmentCipher
+	err        error
+}
+
+func newDecryptWriter(ctx context.Context, keys KeyProvider, object []byte, dst io.WriteCloser, allowPlain bool) *decryptWriter {
+	return &decryptWriter{ctx: ctx, keys: keys, object: object, dst: dst, allowPlain: allowPlain}
+}
+
+func (w *decryptWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	if w.plain {
+		return w.dst.Write(p)
+	}
+	w.buf = append(w.buf, p...)
+	if err := w.process(); err != nil {
+		w.err = err
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *decryptWriter) process() error {
+	if w.cipher == nil {
+		if len(w.buf) < len(encryptionMagic) {
+			return nil
+		}
+		if !bytes.HasPrefix(w.buf, encryptionMagic) {
+			if !w.allowPlain {
+				return errUnencrypted
+			}
+			w.plain = true
+			_, err := w.dst.Write(w.buf)
+			w.buf = nil
+			return err
+		}
+		ok, err := w.readHeader()
+		if !ok || err != nil {
+			return err
+		}
+	}
+
+	// the last segment is only known once the object is complete, so
+	// always keep back at least one full segment
+	var plaintext []byte
+	offset := 0
+	for len(w.buf)-offset > sealedSegmentSize {
+		var err error
+		plaintext, err = w.cipher.open(plaintext[:0], w.buf[offset:offset+sealedSegmentSize], false)
+		if err != nil {
+			return err
+		}
+		if _, err := w.dst.Write(plaintext); err != nil {
+			return err
+		}
+		offset += sealedSegmentSize
+	}
+	w.buf = w.buf[:copy(w.buf, w.buf[offset:])]
+	return nil
+}
+
+// readHeader parses the header once it is complete and unwraps the data key
+func (w *decryptWriter) readHeader() (bool, error) {
+	pos := len(encryptionMagic)
+	field := func() ([]byte, bool) {
+		if len(w.buf) < pos+2 {
+			return nil, false
+		}
+		n := int(binary.BigEndian.Uint16(w.buf[pos:]))
+		if len(w.buf) < pos+2+n {
+			return nil, false
+		}
+		f := w.buf[pos+2 : pos+2+n]
+		pos += 2 + n
+		return f, true
+	}
+	keyID, ok := field()
+	if !ok {
+		return false, nil
+	}
+	wrapped, ok := field()
+	if !ok || len(w.buf) < pos+noncePrefixSize {
+		return false, nil
+	}
+	pos += noncePrefixSize
+
+	dataKey, err := w.keys.UnwrapKey(w.ctx, string(keyID), wrapped, w.object)
+	if err != nil {
+		return false, err
+	}
+	header := append([]byte{}, w.buf[:pos]...)
+	w.cipher, err = newSegmentCipher(dataKey, header, w.object, header[pos-noncePrefixSize:])
+	if err != nil {
+		return false, err
+	}
+	w.buf = w.buf[:copy(w.buf, w.buf[pos:])]
+	return true, nil
+}
+
+// Close decrypts and verifies the last segment. Errors are handed on to
+// dst, so that a reader on the other end of a pipe sees them.
+func (w *decryptWriter) Close() error {
+	if w.err == nil {
+		w.err = w.finish()
+	}
+	if w.err != nil {
+		if cw, ok := w.dst.(interface{ CloseWithError(error) error }); ok {
+			cw.CloseWithError(w.err)
+		} else {
+			w.dst.Close()
+		}
+		return w.err
+	}
+	return w.dst.Close()
+}
+
+func (w *decryptWriter) finish() error {
+	switch {
+	case w.plain:
+		return nil
+	case w.cipher == nil && bytes.HasPrefix(w.buf, encryptionMagic):
+		return errTruncated
+	case w.cipher == nil:
+		// shorter than the magic bytes, can only be a plain object
+		if !w.allowPlain {
+			return errUnencrypted
+		}
+		_, err := w.dst.Write(w.buf)
+		return err
+	case len(w.buf) < gcmTagSize:
+		return errTruncated
+	}
+	plaintext, err := w.cipher.open(nil, w.buf, true)
+	if err != nil {
+		return err
+	}
+	_, err = w.dst.Write(plaintext)
+	return err
+}
+
+func encryptBytes(ctx context.Context, keys KeyProvider, object, plaintext []byte) ([]byte, error) {
+	r, err := newEncryptReader(ctx, keys, object, io.NopCloser(bytes.NewReader(plaintext)))
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func decryptBytes(ctx context.Context, keys KeyProvider, object, data []byte, allowPlain bool) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := newDecryptWriter(ctx, keys, object, nopWriteCloser{buf}, allowPlain)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// encryptedBackends wraps every backup backend returned by the provider
+type encryptedBackends struct {
+	backends         BackupBackendProvider
+	keys             KeyProvider
+	allowUnencrypted bool
+}
+
+// NewEncryptedBackends returns a provider whose backends encrypt all chunks
+// and descriptors before they are stored and decrypt them when they are
+// read back. Unencrypted objects can't be read back, unless allowUnencrypted
+// is set. If keys is nil, backends are returned unchanged.
+func NewEncryptedBackends(backends BackupBackendProvider, keys KeyProvider,
+	allowUnencrypted bool,
+) BackupBackendProvider {
+	if keys == nil {
+		return backends
+	}
+	return &encryptedBackends{backends: backends, keys: keys, allowUnencrypted: allowUnencrypted}
+}
+
+func (p *encryptedBackends) BackupBackend(name string) (modulecapabilities.BackupBackend, error) {
+	backend, err := p.backends.BackupBackend(name)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedBackend{BackupBackend: backend, keys: p.keys, allowUnencrypted: p.allowUnencrypted}, nil
+}
+
+type encryptedBackend struct {
+	modulecapabilities.BackupBackend
+	keys             KeyProvider
+	allowUnencrypted bool
+}
+
+func (b *encryptedBackend) GetObject(ctx context.Context, backupID, key, overrideBucket, overridePath string) ([]byte, error) {
+	data, err := b.BackupBackend.GetObject(ctx, backupID, key, overrideBucket, overridePath)
+	if err != nil {
+		return nil, err
+	}
+	return decryptBytes(ctx, b.keys, objectAAD(backupID, key), data, b.allowUnencrypted)
+}
+
+func (b *encryptedBackend) PutObject(ctx context.Context, backupID, key, overrideBucket, overridePath string, data []byte) error {
+	sealed, err := encryptBytes(ctx, b.keys, objectAAD(backupID, key), data)
+	if err != nil {
+		return fmt.Errorf("encrypt %q: %w", key, err)
+	}
+	return b.BackupBackend.PutObject(ctx, backupID, key, overrideBucket, overridePath, sealed)
+}
+
+func (b *encryptedBackend) Write(ctx context.Context, backupID, key, overrideBucket, overridePath string, r io.ReadCloser) (int64, error) {
+	er, err := newEncryptReader(ctx, b.keys, objectAAD(backupID, key), r)
+	if err != nil {
+		r.Close()
+		return 0, fmt.Errorf("encrypt %q: %w", key, err)
+	}
+	return b.BackupBackend.Write(ctx, backupID, key, overrideBucket, overridePath, er)
+}
+
+func (b *encryptedBackend) Read(ctx context.Context, backupID, key, overrideBucket, overridePath string, w io.WriteCloser) (int64, error) {
+	return b.BackupBackend.Read(ctx, backupID, key, overrideBucket, overridePath, newDecryptWriter(ctx, b.keys, objectAAD(backupID, key), w, b.allowUnencrypted))
+}
+
+// WriteToFile is only used for backups which predate chunking. Encrypted
+// files are decrypted in place, plain ones are left as they are if
+// unencrypted backups are allowed and removed otherwise.
+func (b *encryptedBackend) WriteToFile(ctx context.Context, backupID, key, destPath, overrideBucket, overridePath string) error {
+	if err := b.BackupBackend.WriteToFile(ctx, backupID, key, destPath, overrideBucket, overridePath); err != nil {
+		return err
+	}
+
+	src, err := os.Open(destPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	magic := make([]byte, len(encryptionMagic))
+	if _, err := io.ReadFull(src, magic); err != nil || !bytes.Equal(magic, encryptionMagic) {
+		if b.allowUnencrypted {
+			return nil
+		}
+		src.Close()
+		os.Remove(destPath)
+		return fmt.Errorf("decrypt %q: %w", key, errUnencrypted)
+	}
+	if _, err := src.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	tmpPath := destPath + ".decrypt"
+	dst, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	dw := newDecryptWriter(ctx, b.keys, objectAAD(backupID, key), dst, b.allowUnencrypted)
+	if _, err = io.Copy(dw, src); err == nil {
+		err = dw.Close()
+	} else {
+		dst.Close()
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("decrypt %q: %w", key, err)
+	}
+	return os.Rename(tmpPath, destPath)
+}
+
+// Delete is forwarded to the wrapped backend, encryption does not change
+// where the objects of a backup are stored.
+func (b *encryptedBackend) Delete(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	return deleteBackup(ctx, b.BackupBackend, backupID, overrideBucket, overridePath)
+}
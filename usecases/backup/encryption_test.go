@@ -0,0 +1,386 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// memBackend keeps backup objects in memory
+type memBackend struct {
+	modulecapabilities.BackupBackend
+	sync.Mutex
+	objects map[string][]byte
+}
+
+func newMemBackend() *memBackend {
+	return &memBackend{objects: map[string][]byte{}}
+}
+
+func (b *memBackend) PutObject(ctx context.Context, backupID, key, overrideBucket, overridePath string, data []byte) error {
+	b.Lock()
+	defer b.Unlock()
+	b.objects[key] = data
+	return nil
+}
+
+func (b *memBackend) GetObject(ctx context.Context, backupID, key, overrideBucket, overridePath string) ([]byte, error) {
+	b.Lock()
+	defer b.Unlock()
+	data, ok := b.objects[key]
+	if !ok {
+		return nil, backup.NewErrNotFound(fmt.Errorf("object %q not found", key))
+	}
+	return data, nil
+}
+
+func (b *memBackend) Initialize(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	return nil
+}
+
+func (b *memBackend) Write(ctx context.Context, backupID, key, overrideBucket, overridePath string, r io.ReadCloser) (int64, error) {
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(data)), b.PutObject(ctx, backupID, key, overrideBucket, overridePath, data)
+}
+
+func (b *memBackend) Read(ctx context.Context, backupID, key, overrideBucket, overridePath string, w io.WriteCloser) (int64, error) {
+	defer w.Close()
+	data, _ := b.GetObject(ctx, backupID, key, overrideBucket, overridePath)
+	// small writes exercise segments spanning several calls
+	var written int64
+	for len(data) > 0 {
+		n := min(len(data), 1000)
+		if _, err := w.Write(data[:n]); err != nil {
+			return written, err
+		}
+		written += int64(n)
+		data = data[n:]
+	}
+	return written, nil
+}
+
+func (b *memBackend) WriteToFile(ctx context.Context, backupID, key, destPath, overrideBucket, overridePath string) error {
+	data, err := b.GetObject(ctx, backupID, key, overrideBucket, overridePath)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(destPath, data, 0o644)
+}
+
+type memProvider struct {
+	backend *memBackend
+}
+
+func (p memProvider) BackupBackend(name string) (modulecapabilities.BackupBackend, error) {
+	return p.backend, nil
+}
+
+func writeKey(t *testing.T, dir, name string) string {
+	t.Helper()
+	key := make([]byte, keyEncryptionKeySize)
+	_, err := rand.Read(key)
+	require.Nil(t, err)
+	path := filepath.Join(dir, name)
+	require.Nil(t, os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0o600))
+	return path
+}
+
+func randomBytes(t *testing.T, n int) []byte {
+	t.Helper()
+	data := make([]byte, n)
+	_, err := rand.Read(data)
+	require.Nil(t, err)
+	return data
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	object := objectAAD("backup-1", "chunk-1")
+	keys, err := NewLocalKeyProvider(writeKey(t, t.TempDir(), "key"))
+	require.Nil(t, err)
+
+	for _, size := range []int{0, 1, 7, segmentSize - 1, segmentSize, segmentSize + 1, 3*segmentSize + 5} {
+		plaintext := randomBytes(t, size)
+
+		sealed, err := encryptBytes(ctx, keys, object, plaintext)
+		require.Nil(t, err)
+		assert.True(t, bytes.HasPrefix(sealed, encryptionMagic))
+		if size > 16 {
+			assert.False(t, bytes.Contains(sealed, plaintext[:16]))
+		}
+
+		decrypted, err := decryptBytes(ctx, keys, object, sealed, false)
+		require.Nil(t, err, size)
+		assert.Equal(t, plaintext, append([]byte{}, decrypted...), size)
+	}
+}
+
+func TestEncryptionVerifies(t *testing.T) {
+	ctx := context.Background()
+	object := objectAAD("backup-1", "chunk-1")
+	dir := t.TempDir()
+	keys, err := NewLocalKeyProvider(writeKey(t, dir, "key"))
+	require.Nil(t, err)
+	plaintext := randomBytes(t, 2*segmentSize+100)
+	sealed, err := encryptBytes(ctx, keys, object, plaintext)
+	require.Nil(t, err)
+
+	t.Run("tampered segment", func(t *testing.T) {
+		tampered := append([]byte{}, sealed...)
+		tampered[len(tampered)/2] ^= 1
+		_, err := decryptBytes(ctx, keys, object, tampered, false)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("other object", func(t *testing.T) {
+		for _, other := range [][]byte{objectAAD("backup-1", "chunk-2"), objectAAD("backup-2", "chunk-1")} {
+			_, err := decryptBytes(ctx, keys, other, sealed, false)
+			assert.NotNil(t, err)
+		}
+	})
+
+	t.Run("truncated at segment boundary", func(t *testing.T) {
+		headerSize := len(sealed) - 2*sealedSegmentSize - 100 - gcmTagSize
+		_, err := decryptBytes(ctx, keys, object, sealed[:headerSize+sealedSegmentSize], false)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("truncated header", func(t *testing.T) {
+		_, err := decryptBytes(ctx, keys, object, sealed[:len(encryptionMagic)+3], false)
+		assert.ErrorIs(t, err, errTruncated)
+	})
+
+	t.Run("other key", func(t *testing.T) {
+		other, err := NewLocalKeyProvider(writeKey(t, dir, "other"))
+		require.Nil(t, err)
+		_, err = decryptBytes(ctx, other, object, sealed, false)
+		assert.ErrorContains(t, err, "unknown key")
+	})
+
+	t.Run("plain objects are rejected", func(t *testing.T) {
+		for _, plain := range [][]byte{nil, []byte("{}"), []byte(`{"id":"backup-1","status":"SUCCESS"}`)} {
+			_, err := decryptBytes(ctx, keys, object, plain, false)
+			assert.ErrorIs(t, err, errUnencrypted)
+		}
+	})
+
+	t.Run("plain objects pass through if allowed", func(t *testing.T) {
+		for _, plain := range [][]byte{nil, []byte("{}"), []byte(`{"id":"backup-1","status":"SUCCESS"}`)} {
+			decrypted, err := decryptBytes(ctx, keys, object, plain, true)
+			require.Nil(t, err)
+			assert.Equal(t, string(plain), string(decrypted))
+		}
+	})
+}
+
+func TestKeyProviders(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	t.Run("raw local key", func(t *testing.T) {
+		path := filepath.Join(dir, "raw")
+		require.Nil(t, os.WriteFile(path, randomBytes(t, keyEncryptionKeySize), 0o600))
+		keys, err := NewLocalKeyProvider(path)
+		require.Nil(t, err)
+		id, wrapped, err := keys.WrapKey(ctx, []byte("data key"), []byte("object"))
+		require.Nil(t, err)
+		dataKey, err := keys.UnwrapKey(ctx, id, wrapped, []byte("object"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("data key"), dataKey)
+		_, err = keys.UnwrapKey(ctx, id, wrapped, []byte("other object"))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("invalid local key", func(t *testing.T) {
+		path := filepath.Join(dir, "short")
+		require.Nil(t, os.WriteFile(path, []byte("too short"), 0o600))
+		_, err := NewLocalKeyProvider(path)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("file kms rotation", func(t *testing.T) {
+		object := objectAAD("backup-1", BackupFile)
+		oldKey := base64.StdEncoding.EncodeToString(randomBytes(t, keyEncryptionKeySize))
+		newKey := base64.StdEncoding.EncodeToString(randomBytes(t, keyEncryptionKeySize))
+		path := filepath.Join(dir, "keyring.json")
+		writeKeyring := func(kr fileKeyring) {
+			data, err := json.Marshal(kr)
+			require.Nil(t, err)
+			require.Nil(t, os.WriteFile(path, data, 0o600))
+		}
+
+		writeKeyring(fileKeyring{Active: "2023", Keys: map[string]string{"2023": oldKey}})
+		keys, err := NewKeyProvider(config.BackupEncryption{
+			Enabled: true, KMS: config.BackupEncryptionKMSFile, KMSKeyring: path,
+		})
+		require.Nil(t, err)
+		sealed, err := encryptBytes(ctx, keys, object, []byte("descriptor"))
+		require.Nil(t, err)
+
+		writeKeyring(fileKeyring{Active: "2024", Keys: map[string]string{"2023": oldKey, "2024": newKey}})
+		rotated, err := NewFileKMS(path)
+		require.Nil(t, err)
+		decrypted, err := decryptBytes(ctx, rotated, object, sealed, false)
+		require.Nil(t, err)
+		assert.Equal(t, "descriptor", string(decrypted))
+		id, _, err := rotated.WrapKey(ctx, []byte("data key"), nil)
+		require.Nil(t, err)
+		assert.Equal(t, "2024", id)
+
+		writeKeyring(fileKeyring{Active: "2025", Keys: map[string]string{"2024": newKey}})
+		_, err = NewFileKMS(path)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		keys, err := NewKeyProvider(config.BackupEncryption{KeyFile: "/does/not/exist"})
+		require.Nil(t, err)
+		assert.Nil(t, keys)
+		backends := memProvider{newMemBackend()}
+		assert.Equal(t, backends, NewEncryptedBackends(backends, keys, false))
+	})
+}
+
+func TestEncryptedBackend(t *testing.T) {
+	ctx := context.Background()
+	keys, err := NewLocalKeyProvider(writeKey(t, t.TempDir(), "key"))
+	require.Nil(t, err)
+	mem := newMemBackend()
+	backend, err := NewEncryptedBackends(memProvider{mem}, keys, false).BackupBackend("mem")
+	require.Nil(t, err)
+
+	t.Run("descriptor", func(t *testing.T) {
+		require.Nil(t, backend.PutObject(ctx, "id", BackupFile, "", "", []byte(`{"id":"id"}`)))
+		assert.True(t, bytes.HasPrefix(mem.objects[BackupFile], encryptionMagic))
+		got, err := backend.GetObject(ctx, "id", BackupFile, "", "")
+		require.Nil(t, err)
+		assert.Equal(t, `{"id":"id"}`, string(got))
+	})
+
+	t.Run("chunk", func(t *testing.T) {
+		src := t.TempDir()
+		for i, size := range []int{10, segmentSize * 2, 12345} {
+			name := filepath.Join("shard", string(rune('a'+i)))
+			require.Nil(t, os.MkdirAll(filepath.Join(src, "shard"), 0o755))
+			require.Nil(t, os.WriteFile(filepath.Join(src, name), randomBytes(t, size), 0o644))
+		}
+
+		z, r := NewZip(src, int(DefaultCompression))
+		errs := make(chan error, 1)
+		go func() {
+			_, err := z.WriteRegulars(ctx, []string{"shard/a", "shard/b", "shard/c"})
+			if err == nil {
+				err = z.Close()
+			}
+			errs <- err
+		}()
+		_, err := backend.Write(ctx, "id", "chunk-1", "", "", r)
+		require.Nil(t, err)
+		require.Nil(t, <-errs)
+		assert.True(t, bytes.HasPrefix(mem.objects["chunk-1"], encryptionMagic))
+
+		dst := t.TempDir()
+		uz, w := NewUnzip(dst)
+		go backend.Read(ctx, "id", "chunk-1", "", "", w)
+		_, err = uz.ReadChunk()
+		require.Nil(t, err)
+		require.Nil(t, uz.Close())
+
+		for _, name := range []string{"shard/a", "shard/b", "shard/c"} {
+			want, err := os.ReadFile(filepath.Join(src, name))
+			require.Nil(t, err)
+			got, err := os.ReadFile(filepath.Join(dst, name))
+			require.Nil(t, err)
+			assert.Equal(t, want, got, name)
+		}
+	})
+
+	t.Run("swapped chunk fails restore", func(t *testing.T) {
+		mem.objects["chunk-2"] = mem.objects["chunk-1"]
+		defer delete(mem.objects, "chunk-2")
+		for _, object := range []struct{ backupID, key string }{{"id", "chunk-2"}, {"other-id", "chunk-1"}} {
+			uz, w := NewUnzip(t.TempDir())
+			go backend.Read(ctx, object.backupID, object.key, "", "", w)
+			_, err := uz.ReadChunk()
+			assert.NotNil(t, err, object)
+		}
+	})
+
+	t.Run("tampered chunk fails restore", func(t *testing.T) {
+		mem.objects["chunk-1"][len(mem.objects["chunk-1"])-1] ^= 1
+		uz, w := NewUnzip(t.TempDir())
+		go backend.Read(ctx, "id", "chunk-1", "", "", w)
+		_, err := uz.ReadChunk()
+		assert.NotNil(t, err)
+	})
+
+	// objects swapped for plaintext ones by anyone with access to the bucket
+	// are not authenticated and must not be restored
+	src := t.TempDir()
+	require.Nil(t, os.MkdirAll(filepath.Join(src, "shard"), 0o755))
+	require.Nil(t, os.WriteFile(filepath.Join(src, "shard", "a"), randomBytes(t, 100), 0o644))
+	z, r := NewZip(src, int(DefaultCompression))
+	go func() {
+		z.WriteRegulars(ctx, []string{"shard/a"})
+		z.Close()
+	}()
+	_, err = mem.Write(ctx, "id", "chunk-plain", "", "", r)
+	require.Nil(t, err)
+	mem.objects[BackupFile] = []byte(`{"id":"id"}`)
+	mem.objects["legacy"] = []byte("legacy file")
+
+	restoreAll := func(t *testing.T, backend modulecapabilities.BackupBackend) []error {
+		_, errDescriptor := backend.GetObject(ctx, "id", BackupFile, "", "")
+
+		uz, w := NewUnzip(t.TempDir())
+		go backend.Read(ctx, "id", "chunk-plain", "", "", w)
+		_, errChunk := uz.ReadChunk()
+		uz.Close()
+
+		errFile := backend.WriteToFile(ctx, "id", "legacy", filepath.Join(t.TempDir(), "legacy"), "", "")
+		return []error{errDescriptor, errChunk, errFile}
+	}
+
+	t.Run("plaintext objects fail restore", func(t *testing.T) {
+		for _, err := range restoreAll(t, backend) {
+			assert.NotNil(t, err)
+		}
+	})
+
+	t.Run("plaintext objects restore if unencrypted backups are allowed", func(t *testing.T) {
+		legacy, err := NewEncryptedBackends(memProvider{mem}, keys, true).BackupBackend("mem")
+		require.Nil(t, err)
+		for _, err := range restoreAll(t, legacy) {
+			assert.Nil(t, err)
+		}
+	})
+}
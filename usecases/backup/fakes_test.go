@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 )
 
@@ -77,6 +78,13 @@ func (s *fakeSourcer) ClassExists(name string) bool {
 	return args.Bool(0)
 }
 
+func (s *fakeSourcer) RewriteRestoredClass(ctx context.Context, indexDir string,
+	class *models.Class, mapping map[string]string,
+) error {
+	args := s.Called(ctx, indexDir, class, mapping)
+	return args.Error(0)
+}
+
 type fakeBackend struct {
 	mock.Mock
 	sync.RWMutex
@@ -100,6 +100,12 @@ func NewHandler(
 	return m
 }
 
+// SetLogArchiver makes completed backups the base of the commit logs
+// archived by a, so that segments contained in them can be pruned
+func (m *Handler) SetLogArchiver(a *LogArchiver) {
+	m.backupper.archiver = a
+}
+
 // Compression is the compression configuration.
 type Compression struct {
 	// Level is one of DefaultCompression, BestSpeed, BestCompression
@@ -136,6 +142,19 @@ type BackupRequest struct {
 	// No effect if the map is empty
 	NodeMapping map[string]string
 
+	// ClassMapping renames classes on restore where key is the name in the backup and value is the new name
+	// Cross-references between restored classes are rewritten accordingly
+	ClassMapping map[string]string
+
+	// Tenants restricts the restoration of multi-tenant classes to the given tenants
+	// All tenants are restored if the list is empty
+	Tenants []string
+
+	// PointInTime replays archived commit logs on top of the backup up to this time
+	// Logs are replayed up to the last archived segment before this time
+	// No logs are replayed if it is zero
+	PointInTime time.Time
+
 	// Override bucket (optional) - replaces environement variable for one call
 	Bucket string
 
@@ -152,12 +171,7 @@ func (m *Handler) OnCanCommit(ctx context.Context, req *Request) *CanCommitRespo
 	// If we are doing a restore and have a nodeMapping specified, ensure we use the "old" node name from the backup to retrieve/store the
 	// backup information.
 	if req.Method == OpRestore {
-		for oldNodeName, newNodeName := range req.NodeMapping {
-			if nodeName == newNodeName {
-				nodeName = oldNodeName
-				break
-			}
-		}
+		nodeName = originalNodeName(nodeName, req.NodeMapping)
 	}
 	store, err := nodeBackend(nodeName, m.backends, req.Backend, req.ID, req.Bucket, req.Path)
 	if err != nil {
@@ -261,9 +275,22 @@ func validateID(backupID string) error {
 	if !regExpID.MatchString(backupID) {
 		return fmt.Errorf("invalid backup id: '%v' allowed characters are lowercase, 0-9, _, -", backupID)
 	}
+	if backupID == archiveID {
+		return fmt.Errorf("invalid backup id: '%v' is reserved for the commit log archive", backupID)
+	}
 	return nil
 }
 
+// originalNodeName returns the name node had when the backup was created
+func originalNodeName(node string, nodeMapping map[string]string) string {
+	for oldNodeName, newNodeName := range nodeMapping {
+		if node == newNodeName {
+			return oldNodeName
+		}
+	}
+	return node
+}
+
 func nodeBackend(node string, provider BackupBackendProvider, backend, id, bucket, path string) (nodeStore, error) {
 	caps, err := provider.BackupBackend(backend)
 	if err != nil {
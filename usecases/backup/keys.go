@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+const (
+	// dataKeySize is the size of the AES-256 keys encrypting backup objects
+	dataKeySize = 32
+	// keyEncryptionKeySize is the size of the AES-256 keys wrapping data keys
+	keyEncryptionKeySize = 32
+)
+
+// KeyProvider wraps and unwraps the data keys of encrypted backups. It
+// mirrors the envelope encryption API of key management services: the key
+// encryption key never leaves the provider and is identified by an id which
+// is stored next to the wrapped data key. The additional data is
+// authenticated along with the data key, it has to match on unwrap.
+type KeyProvider interface {
+	WrapKey(ctx context.Context, dataKey, aad []byte) (keyID string, wrapped []byte, err error)
+	UnwrapKey(ctx context.Context, keyID string, wrapped, aad []byte) ([]byte, error)
+}
+
+// NewKeyProvider returns the key provider described by cfg, nil if backup
+// encryption is disabled
+func NewKeyProvider(cfg config.BackupEncryption) (KeyProvider, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	if cfg.KeyFile != "" {
+		return NewLocalKeyProvider(cfg.KeyFile)
+	}
+	switch cfg.KMS {
+	case config.BackupEncryptionKMSFile:
+		return NewFileKMS(cfg.KMSKeyring)
+	default:
+		return nil, fmt.Errorf("unknown backup encryption kms %q", cfg.KMS)
+	}
+}
+
+// keyring holds named key encryption keys, new data keys are wrapped with
+// the active one
+type keyring struct {
+	active string
+	keys   map[string]cipher.AEAD
+}
+
+func (k *keyring) add(id string, key []byte) error {
+	if len(key) != keyEncryptionKeySize {
+		return fmt.Errorf("key %q: expected %d bytes, got %d", id, keyEncryptionKeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return fmt.Errorf("key %q: %w", id, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return fmt.Errorf("key %q: %w", id, err)
+	}
+	k.keys[id] = aead
+	return nil
+}
+
+// WrapKey seals the data key with the active key. The key id is used as
+// additional data along with aad, so a wrapped key can't be moved to another
+// key id or object.
+func (k *keyring) WrapKey(ctx context.Context, dataKey, aad []byte) (string, []byte, error) {
+	aead := k.keys[k.active]
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(dataKey)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	return k.active, aead.Seal(nonce, nonce, dataKey, wrapAAD(k.active, aad)), nil
+}
+
+func (k *keyring) UnwrapKey(ctx context.Context, keyID string, wrapped, aad []byte) ([]byte, error) {
+	aead, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("backup was encrypted with unknown key %q", keyID)
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, fmt.Errorf("wrapped data key too short")
+	}
+	nonce, sealed := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, sealed, wrapAAD(keyID, aad))
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key with key %q: %w", keyID, err)
+	}
+	return dataKey, nil
+}
+
+func wrapAAD(keyID string, aad []byte) []byte {
+	return append(binary.BigEndian.AppendUint16(nil, uint16(len(keyID))), append([]byte(keyID), aad...)...)
+}
+
+// NewLocalKeyProvider reads a single key encryption key from path. The file
+// contains the 32 byte key either raw or base64 encoded. The key id is
+// derived from the key, so restores fail early if the key was changed.
+func NewLocalKeyProvider(path string) (KeyProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read backup encryption key: %w", err)
+	}
+	key := content
+	if len(key) != keyEncryptionKeySize {
+		key, err = base64.StdEncoding.DecodeString(strings.TrimSpace(string(content)))
+		if err != nil {
+			return nil, fmt.Errorf("backup encryption key %q is neither %d raw bytes nor base64 encoded",
+				path, keyEncryptionKeySize)
+		}
+	}
+
+	fingerprint := sha256.Sum256(key)
+	id := "local-" + hex.EncodeToString(fingerprint[:8])
+	k := &keyring{active: id, keys: map[string]cipher.AEAD{}}
+	if err := k.add(id, key); err != nil {
+		return nil, fmt.Errorf("backup encryption key %q: %w", path, err)
+	}
+	return k, nil
+}
+
+// fileKeyring is the format read by NewFileKMS, keys are base64 encoded
+type fileKeyring struct {
+	Active string            `json:"active"`
+	Keys   map[string]string `json:"keys"`
+}
+
+// NewFileKMS is a stand-in for a key management service. It reads a JSON
+// keyring from path which names the active key and lists all keys by id.
+// Keeping retired keys in the keyring keeps older backups restorable after
+// a key rotation.
+func NewFileKMS(path string) (KeyProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read backup encryption keyring: %w", err)
+	}
+	var fk fileKeyring
+	if err := json.Unmarshal(content, &fk); err != nil {
+		return nil, fmt.Errorf("parse backup encryption keyring %q: %w", path, err)
+	}
+	if _, ok := fk.Keys[fk.Active]; !ok {
+		return nil, fmt.Errorf("backup encryption keyring %q: active key %q not found", path, fk.Active)
+	}
+
+	k := &keyring{active: fk.Active, keys: make(map[string]cipher.AEAD, len(fk.Keys))}
+	for id, encoded := range fk.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("backup encryption keyring %q: key %q: %w", path, id, err)
+		}
+		if err := k.add(id, key); err != nil {
+			return nil, fmt.Errorf("backup encryption keyring %q: %w", path, err)
+		}
+	}
+	return k, nil
+}
+
+// newDataKey generates the random key encrypting a single backup object
+func newDataKey() ([]byte, error) {
+	key := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, fmt.Errorf("generate data key: %w", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,276 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+var errTenantsNotMultiTenant = errors.New("tenants can only be restored for multi-tenant classes")
+
+// validateClassMapping checks that every class to be renamed is part of the restoration
+// and that the new names neither collide with each other nor with other restored classes.
+// It returns the mapping with normalized class names.
+func validateClassMapping(mapping map[string]string, classes []string) (map[string]string, error) {
+	if len(mapping) == 0 {
+		return nil, nil
+	}
+	ret := make(map[string]string, len(mapping))
+	targets := make(map[string]string, len(mapping))
+	for from, to := range mapping {
+		if !slices.Contains(classes, from) {
+			return nil, fmt.Errorf("class mapping: class %s is not restored, restored classes are %v", from, classes)
+		}
+		name, err := schema.ValidateClassName(schema.UppercaseClassName(to))
+		if err != nil {
+			return nil, fmt.Errorf("class mapping: %w", err)
+		}
+		to = name.String()
+		if to == from {
+			continue
+		}
+		if prev, ok := targets[strings.ToLower(to)]; ok {
+			return nil, fmt.Errorf("class mapping: classes %s and %s cannot both be restored as %s", prev, from, to)
+		}
+		targets[strings.ToLower(to)] = from
+		ret[from] = to
+	}
+	for _, cls := range classes {
+		if _, renamed := ret[cls]; renamed {
+			continue
+		}
+		if from, ok := targets[strings.ToLower(cls)]; ok {
+			return nil, fmt.Errorf("class mapping: class %s cannot be restored as %s which is restored as well", from, cls)
+		}
+	}
+	return ret, nil
+}
+
+// rewriteClassDescriptors renames restored classes according to mapping, rewrites references
+// pointing to renamed classes and drops tenants not contained in tenants.
+// Only descriptors listed in classes are considered, the descriptor names are kept unchanged
+// since they identify the classes in the backup.
+func rewriteClassDescriptors(descs []backup.ClassDescriptor, classes []string,
+	mapping map[string]string, tenants []string,
+) error {
+	if len(mapping) == 0 && len(tenants) == 0 {
+		return nil
+	}
+	found := make(map[string]struct{}, len(tenants))
+	for i := range descs {
+		d := &descs[i]
+		if !slices.Contains(classes, d.Name) {
+			continue
+		}
+		if err := rewriteClassSchema(d, mapping); err != nil {
+			return fmt.Errorf("class %s: %w", d.Name, err)
+		}
+		if err := rewriteShardingState(d, mapping, tenants, found); err != nil {
+			return fmt.Errorf("class %s: %w", d.Name, err)
+		}
+	}
+	for _, tenant := range tenants {
+		if _, ok := found[tenant]; !ok {
+			return fmt.Errorf("tenant %s doesn't exist in any of the restored classes %v", tenant, classes)
+		}
+	}
+	return nil
+}
+
+func rewriteClassSchema(d *backup.ClassDescriptor, mapping map[string]string) error {
+	if len(mapping) == 0 {
+		return nil
+	}
+	class := &models.Class{}
+	if err := json.Unmarshal(d.Schema, class); err != nil {
+		return fmt.Errorf("unmarshal class schema: %w", err)
+	}
+	if to, ok := mapping[class.Class]; ok {
+		class.Class = to
+	}
+	for _, prop := range class.Properties {
+		for i, dt := range prop.DataType {
+			if to, ok := mapping[dt]; ok {
+				prop.DataType[i] = to
+			}
+		}
+	}
+	b, err := json.Marshal(class)
+	if err != nil {
+		return fmt.Errorf("marshal class schema: %w", err)
+	}
+	d.Schema = b
+	return nil
+}
+
+func rewriteShardingState(d *backup.ClassDescriptor, mapping map[string]string,
+	tenants []string, found map[string]struct{},
+) error {
+	if len(tenants) > 0 && d.ShardingState == nil {
+		return errTenantsNotMultiTenant
+	}
+	to, renamed := mapping[d.Name]
+	if (!renamed && len(tenants) == 0) || d.ShardingState == nil {
+		return nil
+	}
+	var ss sharding.State
+	if err := json.Unmarshal(d.ShardingState, &ss); err != nil {
+		return fmt.Errorf("unmarshal sharding state: %w", err)
+	}
+	if renamed {
+		ss.IndexID = to
+	}
+	if len(tenants) > 0 {
+		if !ss.PartitioningEnabled {
+			return errTenantsNotMultiTenant
+		}
+		for name := range ss.Physical {
+			if !slices.Contains(tenants, name) {
+				delete(ss.Physical, name)
+				continue
+			}
+			found[name] = struct{}{}
+		}
+	}
+	b, err := json.Marshal(&ss)
+	if err != nil {
+		return fmt.Errorf("marshal sharding state: %w", err)
+	}
+	d.ShardingState = b
+	return nil
+}
+
+// filterTenants removes all shards of d which don't belong to one of the given tenants
+func filterTenants(d *backup.ClassDescriptor, tenants []string) {
+	if len(tenants) == 0 {
+		return
+	}
+	shards := make([]*backup.ShardDescriptor, 0, len(d.Shards))
+	for _, sd := range d.Shards {
+		if slices.Contains(tenants, sd.Name) {
+			shards = append(shards, sd)
+		}
+	}
+	d.Shards = shards
+
+	if len(d.Chunks) == 0 {
+		return
+	}
+	chunks := make(map[int32][]string, len(d.Chunks))
+	for k, xs := range d.Chunks {
+		for _, x := range xs {
+			if slices.Contains(tenants, x) {
+				chunks[k] = xs
+				break
+			}
+		}
+	}
+	d.Chunks = chunks
+}
+
+// tenantFilter returns a predicate accepting files which are located in the shard
+// directory of one of the given tenants. Paths are relative to the data root.
+func tenantFilter(tenants []string) func(relPath string) bool {
+	if len(tenants) == 0 {
+		return nil
+	}
+	return func(relPath string) bool {
+		parts := strings.SplitN(filepath.ToSlash(relPath), "/", 3)
+		return len(parts) > 1 && slices.Contains(tenants, parts[1])
+	}
+}
+
+// renameClassDir moves the temporary files of class from into the temporary directory of class to.
+// The index directory is renamed as well since it is derived from the class name.
+func renameClassDir(tempDir, from, to string) error {
+	src := filepath.Join(tempDir, from)
+	dst := filepath.Join(tempDir, to)
+	if err := os.RemoveAll(dst); err != nil {
+		return fmt.Errorf("remove %s: %w", dst, err)
+	}
+	defer os.RemoveAll(src)
+
+	srcIndex := filepath.Join(src, strings.ToLower(from))
+	if _, err := os.Stat(srcIndex); err != nil {
+		return nil // nothing restored on this node
+	}
+	if err := os.MkdirAll(dst, os.ModePerm); err != nil {
+		return fmt.Errorf("create temp class folder %s: %w", dst, err)
+	}
+	dstIndex := filepath.Join(dst, strings.ToLower(to))
+	if err := os.Rename(srcIndex, dstIndex); err != nil {
+		return fmt.Errorf("move %s %s: %w", srcIndex, dstIndex, err)
+	}
+	return nil
+}
+
+// rewriteRestoredClass rewrites the restored objects of d if its class is renamed or references a renamed class.
+// The objects would otherwise keep the old class name and beacons pointing to the old names.
+func (r *restorer) rewriteRestoredClass(ctx context.Context, tempDir string,
+	d *backup.ClassDescriptor, mapping map[string]string,
+) error {
+	if len(mapping) == 0 {
+		return nil
+	}
+	class := &models.Class{}
+	if err := json.Unmarshal(d.Schema, class); err != nil {
+		return fmt.Errorf("unmarshal class schema: %w", err)
+	}
+	name, renamed := mapping[d.Name]
+	if !renamed {
+		name = d.Name
+		if !referencesClasses(class, mapping) {
+			return nil
+		}
+	}
+	indexDir := filepath.Join(tempDir, name, strings.ToLower(name))
+	return r.sourcer.RewriteRestoredClass(ctx, indexDir, class, mapping)
+}
+
+// referencesClasses returns whether class has a reference to one of the classes in mapping
+func referencesClasses(class *models.Class, mapping map[string]string) bool {
+	for _, prop := range class.Properties {
+		for _, dt := range prop.DataType {
+			if _, ok := mapping[dt]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mappedClasses returns the names under which classes are restored
+func mappedClasses(classes []string, mapping map[string]string) []string {
+	if len(mapping) == 0 {
+		return classes
+	}
+	ret := make([]string, len(classes))
+	for i, cls := range classes {
+		ret[i] = cls
+		if to, ok := mapping[cls]; ok {
+			ret[i] = to
+		}
+	}
+	return ret
+}
@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestValidateClassMapping(t *testing.T) {
+	classes := []string{"Article", "Author", "Publication"}
+
+	got, err := validateClassMapping(nil, classes)
+	require.Nil(t, err)
+	assert.Nil(t, got)
+
+	got, err = validateClassMapping(map[string]string{"Article": "articleCopy", "Author": "Author"}, classes)
+	require.Nil(t, err)
+	assert.Equal(t, map[string]string{"Article": "ArticleCopy"}, got)
+
+	for _, tc := range []struct {
+		name    string
+		mapping map[string]string
+		errMsg  string
+	}{
+		{"NotRestored", map[string]string{"Book": "BookCopy"}, "class Book is not restored"},
+		{"InvalidName", map[string]string{"Article": "Article-Copy"}, "class mapping"},
+		{"SameTarget", map[string]string{"Article": "Copy", "Author": "copy"}, "cannot both be restored as"},
+		{"RestoredTarget", map[string]string{"Article": "Author"}, "restored as well"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := validateClassMapping(tc.mapping, classes)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
+
+func TestRewriteClassDescriptors(t *testing.T) {
+	descriptor := func(name string, mt bool, refs ...string) backup.ClassDescriptor {
+		class := &models.Class{
+			Class:      name,
+			Properties: []*models.Property{{Name: "title", DataType: []string{"text"}}},
+		}
+		if len(refs) > 0 {
+			class.Properties = append(class.Properties, &models.Property{Name: "ref", DataType: refs})
+		}
+		ss := &sharding.State{
+			IndexID:             name,
+			PartitioningEnabled: mt,
+			Physical: map[string]sharding.Physical{
+				"tenant1": {Name: "tenant1"},
+				"tenant2": {Name: "tenant2"},
+			},
+		}
+		schema, _ := json.Marshal(class)
+		state, _ := json.Marshal(ss)
+		return backup.ClassDescriptor{Name: name, Schema: schema, ShardingState: state}
+	}
+	decode := func(t *testing.T, d backup.ClassDescriptor) (*models.Class, *sharding.State) {
+		class := &models.Class{}
+		require.Nil(t, json.Unmarshal(d.Schema, class))
+		ss := &sharding.State{}
+		require.Nil(t, json.Unmarshal(d.ShardingState, ss))
+		return class, ss
+	}
+
+	t.Run("RenameAndFilterTenants", func(t *testing.T) {
+		descs := []backup.ClassDescriptor{
+			descriptor("Article", true, "Author", "Publication"),
+			descriptor("Author", true, "Article"),
+			descriptor("Publication", false),
+		}
+		mapping := map[string]string{"Article": "ArticleCopy", "Author": "AuthorCopy"}
+		err := rewriteClassDescriptors(descs, []string{"Article", "Author"}, mapping, []string{"tenant1"})
+		require.Nil(t, err)
+
+		class, ss := decode(t, descs[0])
+		assert.Equal(t, "Article", descs[0].Name)
+		assert.Equal(t, "ArticleCopy", class.Class)
+		assert.Equal(t, []string{"AuthorCopy", "Publication"}, class.Properties[1].DataType)
+		assert.Equal(t, []string{"text"}, class.Properties[0].DataType)
+		assert.Equal(t, "ArticleCopy", ss.IndexID)
+		assert.Equal(t, []string{"tenant1"}, ss.AllPhysicalShards())
+
+		class, ss = decode(t, descs[1])
+		assert.Equal(t, "AuthorCopy", class.Class)
+		assert.Equal(t, []string{"ArticleCopy"}, class.Properties[1].DataType)
+		assert.Equal(t, []string{"tenant1"}, ss.AllPhysicalShards())
+
+		// not restored
+		class, ss = decode(t, descs[2])
+		assert.Equal(t, "Publication", class.Class)
+		assert.Len(t, ss.Physical, 2)
+	})
+
+	t.Run("UnknownTenant", func(t *testing.T) {
+		descs := []backup.ClassDescriptor{descriptor("Article", true)}
+		err := rewriteClassDescriptors(descs, []string{"Article"}, nil, []string{"tenant1", "tenant3"})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "tenant tenant3 doesn't exist")
+	})
+
+	t.Run("NotMultiTenant", func(t *testing.T) {
+		descs := []backup.ClassDescriptor{descriptor("Article", true), descriptor("Publication", false)}
+		err := rewriteClassDescriptors(descs, []string{"Article", "Publication"}, nil, []string{"tenant1"})
+		assert.ErrorIs(t, err, errTenantsNotMultiTenant)
+	})
+}
+
+func TestFilterTenants(t *testing.T) {
+	d := backup.ClassDescriptor{
+		Name: "Article",
+		Shards: []*backup.ShardDescriptor{
+			{Name: "tenant1"}, {Name: "tenant2"}, {Name: "tenant3"},
+		},
+		Chunks: map[int32][]string{1: {"tenant1", "tenant2"}, 2: {"tenant3"}},
+	}
+	filterTenants(&d, []string{"tenant2"})
+	require.Len(t, d.Shards, 1)
+	assert.Equal(t, "tenant2", d.Shards[0].Name)
+	assert.Equal(t, map[int32][]string{1: {"tenant1", "tenant2"}}, d.Chunks)
+
+	assert.Nil(t, tenantFilter(nil))
+	keep := tenantFilter([]string{"tenant2"})
+	assert.True(t, keep("article/tenant2/lsm/objects/segment-1.db"))
+	assert.False(t, keep("article/tenant1/lsm/objects/segment-1.db"))
+	assert.False(t, keep("article"))
+}
+
+func TestRenameClassDir(t *testing.T) {
+	tempDir := t.TempDir()
+	file := filepath.Join(tempDir, "Article", "article", "shard1", "indexcount")
+	require.Nil(t, os.MkdirAll(filepath.Dir(file), os.ModePerm))
+	require.Nil(t, os.WriteFile(file, []byte("1"), os.ModePerm))
+
+	require.Nil(t, renameClassDir(tempDir, "Article", "ArticleCopy"))
+	b, err := os.ReadFile(filepath.Join(tempDir, "ArticleCopy", "articlecopy", "shard1", "indexcount"))
+	require.Nil(t, err)
+	assert.Equal(t, "1", string(b))
+	_, err = os.Stat(filepath.Join(tempDir, "Article"))
+	assert.True(t, os.IsNotExist(err))
+
+	// nothing to rename if no shard of the class is stored on this node
+	require.Nil(t, os.MkdirAll(filepath.Join(tempDir, "Author"), os.ModePerm))
+	require.Nil(t, renameClassDir(tempDir, "Author", "AuthorCopy"))
+	_, err = os.Stat(filepath.Join(tempDir, "AuthorCopy"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestRewriteRestoredClass(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		tempDir = t.TempDir()
+		mapping = map[string]string{"Author": "AuthorCopy"}
+	)
+	descriptor := func(name string, refs ...string) (*models.Class, backup.ClassDescriptor) {
+		class := &models.Class{
+			Class:      name,
+			Properties: []*models.Property{{Name: "title", DataType: []string{"text"}}},
+		}
+		if len(refs) > 0 {
+			class.Properties = append(class.Properties, &models.Property{Name: "ref", DataType: refs})
+		}
+		schema, _ := json.Marshal(class)
+		return class, backup.ClassDescriptor{Name: name, Schema: schema}
+	}
+	author, authorDesc := descriptor("Author")
+	article, articleDesc := descriptor("Article", "Author")
+	_, publicationDesc := descriptor("Publication", "Article")
+
+	sourcer := &fakeSourcer{}
+	sourcer.On("RewriteRestoredClass", ctx, filepath.Join(tempDir, "AuthorCopy", "authorcopy"),
+		author, mapping).Return(nil)
+	sourcer.On("RewriteRestoredClass", ctx, filepath.Join(tempDir, "Article", "article"),
+		article, mapping).Return(nil)
+	r := &restorer{sourcer: sourcer}
+
+	// renamed classes and classes referencing them are rewritten
+	require.Nil(t, r.rewriteRestoredClass(ctx, tempDir, &authorDesc, mapping))
+	require.Nil(t, r.rewriteRestoredClass(ctx, tempDir, &articleDesc, mapping))
+	// other classes are restored as they are
+	require.Nil(t, r.rewriteRestoredClass(ctx, tempDir, &publicationDesc, mapping))
+	require.Nil(t, r.rewriteRestoredClass(ctx, tempDir, &authorDesc, nil))
+	sourcer.AssertExpectations(t)
+	sourcer.AssertNumberOfCalls(t, "RewriteRestoredClass", 2)
+}
@@ -16,6 +16,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"sync"
 	"time"
@@ -109,10 +110,7 @@ func (r *restorer) restore(
 			return
 		}
 
-		overrideBucket := req.Bucket
-		overridePath := req.Path
-
-		err = r.restoreAll(context.Background(), desc, req.CPUPercentage, store, overrideBucket, overridePath)
+		err = r.restoreAll(context.Background(), desc, req, store)
 		logFields := logrus.Fields{"action": "restore", "backup_id": req.ID}
 		if err != nil {
 			r.logger.WithFields(logFields).Error(err)
@@ -128,19 +126,61 @@ func (r *restorer) restore(
 // restoreAll restores classes in temporary directories on the filesystem.
 // The final backup restoration is orchestrated by the raft store.
 func (r *restorer) restoreAll(ctx context.Context,
-	desc *backup.BackupDescriptor, cpuPercentage int,
-	store nodeStore, overrideBucket, overridePath string,
+	desc *backup.BackupDescriptor, req *Request, store nodeStore,
 ) (err error) {
 	compressed := desc.Version > version1
 	r.lastOp.set(backup.Transferring)
 	for _, cdesc := range desc.Classes {
-		if err := r.restoreOne(ctx, &cdesc, desc.ServerVersion, compressed, cpuPercentage, store, overrideBucket, overridePath); err != nil {
+		filterTenants(&cdesc, req.Tenants)
+		if err := r.restoreOne(ctx, &cdesc, desc.ServerVersion, compressed, req.CPUPercentage, store,
+			req.Tenants, req.Bucket, req.Path); err != nil {
 			return fmt.Errorf("restore class %s: %w", cdesc.Name, err)
 		}
 		r.logger.WithField("action", "restore").
 			WithField("backup_id", desc.ID).
 			WithField("class", cdesc.Name).Info("successfully restored")
 	}
+
+	tempDir := filepath.Join(store.SourceDataPath(), TempDirectory)
+	if !req.PointInTime.IsZero() {
+		if err := r.replayArchive(ctx, desc, req, tempDir); err != nil {
+			return err
+		}
+	}
+
+	for _, cdesc := range desc.Classes {
+		if to, ok := req.ClassMapping[cdesc.Name]; ok && to != cdesc.Name {
+			if err := renameClassDir(tempDir, cdesc.Name, to); err != nil {
+				return fmt.Errorf("restore class %s as %s: %w", cdesc.Name, to, err)
+			}
+		}
+	}
+	for i := range desc.Classes {
+		if err := r.rewriteRestoredClass(ctx, tempDir, &desc.Classes[i], req.ClassMapping); err != nil {
+			return fmt.Errorf("restore class %s: %w", desc.Classes[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// replayArchive applies commit logs archived since the backup up to req.PointInTime
+func (r *restorer) replayArchive(ctx context.Context,
+	desc *backup.BackupDescriptor, req *Request, tempDir string,
+) error {
+	node := originalNodeName(r.node, req.NodeMapping)
+	store, manifest, err := loadArchiveManifest(ctx, r.backends, req.Backend, node)
+	if err != nil {
+		return fmt.Errorf("replay commit logs: %w", err)
+	}
+	segments := manifest.segmentsBetween(desc.StartedAt, req.PointInTime)
+	replayer := newWALReplayer(tempDir, desc.List(), desc.StartedAt, r.logger)
+	if err := replayer.replay(ctx, store, segments); err != nil {
+		return fmt.Errorf("replay commit logs: %w", err)
+	}
+	r.logger.WithField("action", "restore").
+		WithField("backup_id", desc.ID).
+		WithField("point_in_time", req.PointInTime).
+		WithField("segments", len(segments)).Info("commit logs replayed")
 	return nil
 }
 
@@ -155,7 +195,7 @@ func getType(myvar interface{}) string {
 func (r *restorer) restoreOne(ctx context.Context,
 	desc *backup.ClassDescriptor, serverVersion string,
 	compressed bool, cpuPercentage int, store nodeStore,
-	overrideBucket, overridePath string,
+	tenants []string, overrideBucket, overridePath string,
 ) (err error) {
 	classLabel := desc.Name
 	if monitoring.GetMetrics().Group {
@@ -169,6 +209,7 @@ func (r *restorer) restoreOne(ctx context.Context,
 
 	fw := newFileWriter(r.sourcer, store, compressed, r.logger).
 		WithPoolPercentage(cpuPercentage)
+	fw.setFilter(tenantFilter(tenants))
 
 	// Pre-v1.23 versions store files in a flat format
 	if serverVersion < "1.23" {
@@ -226,6 +267,16 @@ func (r *restorer) validate(ctx context.Context, store *nodeStore, req *Request)
 	if v := meta.Version; v[0] > Version[0] {
 		return nil, nil, fmt.Errorf("%s: %s > %s", errMsgHigherVersion, v, Version)
 	}
+	if len(req.Tenants) > 0 && meta.ServerVersion < "1.23" {
+		return nil, nil, fmt.Errorf("restoring selected tenants requires a backup created by v1.23 or higher, got %s", meta.ServerVersion)
+	}
+	var manifest *archiveManifest
+	if !req.PointInTime.IsZero() {
+		node := originalNodeName(r.node, req.NodeMapping)
+		if _, manifest, err = loadArchiveManifest(ctx, r.backends, req.Backend, node); err != nil {
+			return nil, nil, fmt.Errorf("point in time restore: %w", err)
+		}
+	}
 	cs := meta.List()
 	if len(req.Classes) > 0 {
 		if first := meta.AllExist(req.Classes); first != "" {
@@ -234,6 +285,11 @@ func (r *restorer) validate(ctx context.Context, store *nodeStore, req *Request)
 		}
 		meta.Include(req.Classes)
 	}
+	if manifest != nil {
+		if err := manifest.replayableSince(meta); err != nil {
+			return nil, cs, fmt.Errorf("point in time restore: %w", err)
+		}
+	}
 	return meta, cs, nil
 }
 
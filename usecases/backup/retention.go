@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+// expiredBackups returns the successful backups of runs which are not kept by
+// the retention rules, oldest first. Backups still in progress, failed ones and
+// the ones already deleted are never returned.
+func expiredBackups(runs []backup.ScheduleRun, r backup.Retention) []backup.ScheduleRun {
+	if r.Empty() {
+		return nil
+	}
+
+	candidates := make([]backup.ScheduleRun, 0, len(runs))
+	for _, run := range runs {
+		if run.Status == backup.Success && run.DeletedAt.IsZero() {
+			candidates = append(candidates, run)
+		}
+	}
+	// newest first, so that each period keeps its most recent backup
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].StartedAt.After(candidates[j].StartedAt)
+	})
+
+	keep := make(map[string]bool, len(candidates))
+	for i := 0; i < r.KeepLast && i < len(candidates); i++ {
+		keep[candidates[i].BackupID] = true
+	}
+	keepPeriods(candidates, r.KeepDaily, keep, func(run backup.ScheduleRun) string {
+		return run.StartedAt.UTC().Format("2006-01-02")
+	})
+	keepPeriods(candidates, r.KeepWeekly, keep, func(run backup.ScheduleRun) string {
+		y, w := run.StartedAt.UTC().ISOWeek()
+		return fmt.Sprintf("%d-%d", y, w)
+	})
+	keepPeriods(candidates, r.KeepMonthly, keep, func(run backup.ScheduleRun) string {
+		return run.StartedAt.UTC().Format("2006-01")
+	})
+
+	var expired []backup.ScheduleRun
+	for i := len(candidates) - 1; i >= 0; i-- {
+		if !keep[candidates[i].BackupID] {
+			expired = append(expired, candidates[i])
+		}
+	}
+	return expired
+}
+
+// keepPeriods marks the newest backup of each of the n most recent periods,
+// candidates must be ordered newest first
+func keepPeriods(candidates []backup.ScheduleRun, n int, keep map[string]bool,
+	period func(backup.ScheduleRun) string,
+) {
+	last := ""
+	for _, run := range candidates {
+		if n <= 0 {
+			return
+		}
+		if p := period(run); p != last {
+			keep[run.BackupID] = true
+			last = p
+			n--
+		}
+	}
+}
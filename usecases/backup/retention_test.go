@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestExpiredBackups(t *testing.T) {
+	// one successful backup every 12 hours for 60 days, newest last
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var runs []backup.ScheduleRun
+	for i := 0; i < 120; i++ {
+		runs = append(runs, backup.ScheduleRun{
+			BackupID:  fmt.Sprintf("b%d", i),
+			StartedAt: start.Add(time.Duration(i) * 12 * time.Hour),
+			Status:    backup.Success,
+		})
+	}
+	kept := func(r backup.Retention) []string {
+		expired := map[string]bool{}
+		for _, run := range expiredBackups(runs, r) {
+			expired[run.BackupID] = true
+		}
+		var ids []string
+		for _, run := range runs {
+			if !expired[run.BackupID] {
+				ids = append(ids, run.BackupID)
+			}
+		}
+		return ids
+	}
+
+	t.Run("no rules", func(t *testing.T) {
+		assert.Empty(t, expiredBackups(runs, backup.Retention{}))
+	})
+
+	t.Run("keep last", func(t *testing.T) {
+		assert.Equal(t, []string{"b117", "b118", "b119"}, kept(backup.Retention{KeepLast: 3}))
+	})
+
+	t.Run("keep daily", func(t *testing.T) {
+		// the newest backup of each day is the one taken at noon
+		assert.Equal(t, []string{"b115", "b117", "b119"}, kept(backup.Retention{KeepDaily: 3}))
+	})
+
+	t.Run("keep daily and weekly", func(t *testing.T) {
+		got := kept(backup.Retention{KeepDaily: 2, KeepWeekly: 3})
+		// 2024-02-29 is a thursday, the two weeks before end on the 25th and 18th
+		assert.Equal(t, []string{"b97", "b111", "b117", "b119"}, got)
+	})
+
+	t.Run("keep monthly", func(t *testing.T) {
+		assert.Equal(t, []string{"b61", "b119"}, kept(backup.Retention{KeepMonthly: 5}))
+	})
+
+	t.Run("only successful backups which exist", func(t *testing.T) {
+		runs := []backup.ScheduleRun{
+			{BackupID: "failed", StartedAt: start, Status: backup.Failed},
+			{BackupID: "deleted", StartedAt: start.Add(time.Hour), Status: backup.Success, DeletedAt: start},
+			{BackupID: "old", StartedAt: start.Add(2 * time.Hour), Status: backup.Success},
+			{BackupID: "new", StartedAt: start.Add(3 * time.Hour), Status: backup.Success},
+			{BackupID: "running", StartedAt: start.Add(4 * time.Hour), Status: backup.Transferring},
+		}
+		expired := expiredBackups(runs, backup.Retention{KeepLast: 1})
+		assert.Len(t, expired, 1)
+		assert.Equal(t, "old", expired[0].BackupID)
+	})
+}
@@ -0,0 +1,378 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/backup"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+const (
+	// scheduleInterval is how often the leader checks schedules. Cron
+	// expressions have a resolution of one minute.
+	scheduleInterval = 30 * time.Second
+	// staleRunTimeout is how long the status of a run may be unavailable
+	// before the run is considered failed, e.g. after the leader crashed
+	// before the backup descriptor was written.
+	staleRunTimeout = 24 * time.Hour
+)
+
+var errBackendDeleteNotSupported = errors.New("backend does not support deleting backups")
+
+// scheduleStore applies changes of backup schedules to the cluster state
+type scheduleStore interface {
+	IsLeader() bool
+	PutBackupSchedule(ctx context.Context, sched backup.Schedule, create bool) (uint64, error)
+	DeleteBackupSchedule(ctx context.Context, name string) (uint64, error)
+	RecordBackupScheduleRun(ctx context.Context, name string, run backup.ScheduleRun) (uint64, error)
+}
+
+// scheduleReader reads backup schedules from the local cluster state
+type scheduleReader interface {
+	BackupSchedule(name string) (backup.ScheduleState, bool)
+	ListBackupSchedules() []backup.ScheduleState
+}
+
+// ScheduleStatus is a schedule, the backups it created and when it runs next
+type ScheduleStatus struct {
+	backup.ScheduleState
+	// NextRun is zero if the schedule is paused or never runs again
+	NextRun time.Time
+}
+
+// ScheduleManager manages backup schedules. Schedules are part of the
+// cluster state, the current leader creates their backups through the
+// Scheduler and deletes the ones which are no longer retained.
+type ScheduleManager struct {
+	logger     logrus.FieldLogger
+	authorizer authorization.Authorizer
+	scheduler  *Scheduler
+	store      scheduleStore
+	reader     scheduleReader
+
+	now      func() time.Time
+	interval time.Duration
+	stop     chan struct{}
+	done     chan struct{}
+	once     sync.Once
+}
+
+func NewScheduleManager(
+	authorizer authorization.Authorizer,
+	scheduler *Scheduler,
+	store scheduleStore,
+	reader scheduleReader,
+	logger logrus.FieldLogger,
+) *ScheduleManager {
+	return &ScheduleManager{
+		logger:     logger,
+		authorizer: authorizer,
+		scheduler:  scheduler,
+		store:      store,
+		reader:     reader,
+		now:        time.Now,
+		interval:   scheduleInterval,
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+func (m *ScheduleManager) List(ctx context.Context, pr *models.Principal) ([]ScheduleStatus, error) {
+	if err := m.authorizer.Authorize(pr, authorization.READ, authorization.Cluster()); err != nil {
+		return nil, err
+	}
+	states := m.reader.ListBackupSchedules()
+	res := make([]ScheduleStatus, len(states))
+	for i, st := range states {
+		res[i] = m.status(st)
+	}
+	return res, nil
+}
+
+func (m *ScheduleManager) Get(ctx context.Context, pr *models.Principal, name string) (*ScheduleStatus, error) {
+	if err := m.authorizer.Authorize(pr, authorization.READ, authorization.Cluster()); err != nil {
+		return nil, err
+	}
+	st, ok := m.reader.BackupSchedule(name)
+	if !ok {
+		return nil, backup.NewErrNotFound(fmt.Errorf("backup schedule %q not found", name))
+	}
+	status := m.status(st)
+	return &status, nil
+}
+
+// Create adds a new schedule, its first backup is created at the next time
+// matched by its cron expression
+func (m *ScheduleManager) Create(ctx context.Context, pr *models.Principal, sched backup.Schedule) (*ScheduleStatus, error) {
+	if err := m.authorizer.Authorize(pr, authorization.CREATE, authorization.Cluster()); err != nil {
+		return nil, err
+	}
+	if err := m.validate(&sched); err != nil {
+		return nil, backup.NewErrUnprocessable(err)
+	}
+	if _, ok := m.reader.BackupSchedule(sched.Name); ok {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf("backup schedule %q already exists", sched.Name))
+	}
+	return m.put(ctx, sched, true)
+}
+
+// Update replaces the schedule called sched.Name. The backups it created
+// so far remain subject to its new retention rules.
+func (m *ScheduleManager) Update(ctx context.Context, pr *models.Principal, sched backup.Schedule) (*ScheduleStatus, error) {
+	if err := m.authorizer.Authorize(pr, authorization.UPDATE, authorization.Cluster()); err != nil {
+		return nil, err
+	}
+	if err := m.validate(&sched); err != nil {
+		return nil, backup.NewErrUnprocessable(err)
+	}
+	if _, ok := m.reader.BackupSchedule(sched.Name); !ok {
+		return nil, backup.NewErrNotFound(fmt.Errorf("backup schedule %q not found", sched.Name))
+	}
+	return m.put(ctx, sched, false)
+}
+
+// Delete removes the schedule. The backups it created are not deleted.
+func (m *ScheduleManager) Delete(ctx context.Context, pr *models.Principal, name string) error {
+	if err := m.authorizer.Authorize(pr, authorization.DELETE, authorization.Cluster()); err != nil {
+		return err
+	}
+	if _, ok := m.reader.BackupSchedule(name); !ok {
+		return backup.NewErrNotFound(fmt.Errorf("backup schedule %q not found", name))
+	}
+	if _, err := m.store.DeleteBackupSchedule(ctx, name); err != nil {
+		return fmt.Errorf("delete backup schedule %q: %w", name, err)
+	}
+	return nil
+}
+
+func (m *ScheduleManager) put(ctx context.Context, sched backup.Schedule, create bool) (*ScheduleStatus, error) {
+	sched.UpdatedAt = m.now().UTC()
+	if _, err := m.store.PutBackupSchedule(ctx, sched, create); err != nil {
+		return nil, backup.NewErrUnprocessable(fmt.Errorf("store backup schedule %q: %w", sched.Name, err))
+	}
+	st, ok := m.reader.BackupSchedule(sched.Name)
+	if !ok {
+		// this node has not applied the change yet
+		st = backup.ScheduleState{Schedule: sched}
+	}
+	status := m.status(st)
+	return &status, nil
+}
+
+func (m *ScheduleManager) validate(sched *backup.Schedule) error {
+	if err := validateID(sched.Name); err != nil {
+		return fmt.Errorf("invalid schedule name: %w", err)
+	}
+	if _, err := parseCron(sched.Cron); err != nil {
+		return err
+	}
+	if _, err := m.scheduler.backends.BackupBackend(sched.Backend); err != nil {
+		return fmt.Errorf("no backup backend %q: %w, did you enable the right module?", sched.Backend, err)
+	}
+	if len(sched.Include) > 0 && len(sched.Exclude) > 0 {
+		return errIncludeExclude
+	}
+	r := sched.Retention
+	if r.KeepLast < 0 || r.KeepDaily < 0 || r.KeepWeekly < 0 || r.KeepMonthly < 0 {
+		return fmt.Errorf("retention rules must not be negative")
+	}
+	return nil
+}
+
+func (m *ScheduleManager) status(st backup.ScheduleState) ScheduleStatus {
+	status := ScheduleStatus{ScheduleState: st}
+	if !st.Schedule.Paused {
+		status.NextRun = nextRun(&st)
+	}
+	return status
+}
+
+// nextRun returns when the schedule creates its next backup, the zero time if
+// the cron expression is invalid or never matches again
+func nextRun(st *backup.ScheduleState) time.Time {
+	cron, err := parseCron(st.Schedule.Cron)
+	if err != nil {
+		return time.Time{}
+	}
+	after := st.Schedule.UpdatedAt
+	if last, ok := st.LastRun(); ok && last.StartedAt.After(after) {
+		after = last.StartedAt
+	}
+	return cron.Next(after)
+}
+
+// Start runs schedules in the background until Close is called. Only the
+// leader acts on schedules, every other node stays idle.
+func (m *ScheduleManager) Start() {
+	enterrors.GoWrapper(func() {
+		defer close(m.done)
+		t := time.NewTicker(m.interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-m.stop:
+				return
+			case <-t.C:
+				if m.store.IsLeader() {
+					m.tick(context.Background())
+				}
+			}
+		}
+	}, m.logger)
+}
+
+// Close stops running schedules started by Start
+func (m *ScheduleManager) Close() {
+	m.once.Do(func() {
+		close(m.stop)
+		<-m.done
+	})
+}
+
+// tick updates the runs of all schedules, applies their retention rules
+// and starts the backups which are due
+func (m *ScheduleManager) tick(ctx context.Context) {
+	now := m.now()
+	for _, st := range m.reader.ListBackupSchedules() {
+		m.updateRuns(ctx, &st, now)
+		m.applyRetention(ctx, &st, now)
+		if st.Schedule.Paused {
+			continue
+		}
+		if last, ok := st.LastRun(); ok && !last.Finished() {
+			continue // backups of a schedule never overlap
+		}
+		if next := nextRun(&st); !next.IsZero() && !now.Before(next) {
+			m.run(ctx, &st, now)
+		}
+	}
+}
+
+// updateRuns fetches the status of the backups still in progress
+func (m *ScheduleManager) updateRuns(ctx context.Context, st *backup.ScheduleState, now time.Time) {
+	sched := &st.Schedule
+	for i := range st.Runs {
+		run := st.Runs[i]
+		if run.Finished() {
+			continue
+		}
+		status, err := m.scheduler.backupStatus(ctx, sched.Backend, run.BackupID, sched.Bucket, sched.Path)
+		switch {
+		case err != nil && now.Sub(run.StartedAt) < staleRunTimeout:
+			m.logger.WithField("action", "backup_schedule_status").
+				WithField("schedule", sched.Name).WithField("backup_id", run.BackupID).
+				WithError(err).Warn("could not get backup status")
+			continue
+		case err != nil:
+			run.Status, run.Error, run.CompletedAt = backup.Failed, err.Error(), now.UTC()
+		case status.Status == run.Status:
+			continue
+		default:
+			run.Status, run.Error = status.Status, status.Err
+			if run.Finished() {
+				run.CompletedAt = status.CompletedAt
+			}
+		}
+		if m.record(ctx, sched.Name, run) {
+			st.Runs[i] = run
+		}
+	}
+}
+
+// applyRetention deletes the backups which are no longer retained
+func (m *ScheduleManager) applyRetention(ctx context.Context, st *backup.ScheduleState, now time.Time) {
+	sched := &st.Schedule
+	expired := expiredBackups(st.Runs, sched.Retention)
+	if len(expired) == 0 {
+		return
+	}
+	backend, err := m.scheduler.backends.BackupBackend(sched.Backend)
+	if err != nil {
+		m.logger.WithField("action", "backup_schedule_retention").
+			WithField("schedule", sched.Name).WithError(err).Error("no backup backend")
+		return
+	}
+	for _, run := range expired {
+		err := deleteBackup(ctx, backend, run.BackupID, sched.Bucket, sched.Path)
+		logOperation(m.logger, "backup_schedule_delete", run.BackupID, sched.Backend, now, err)
+		if err != nil {
+			continue
+		}
+		run.DeletedAt = now.UTC()
+		if m.record(ctx, sched.Name, run) {
+			i := slices.IndexFunc(st.Runs, func(r backup.ScheduleRun) bool { return r.BackupID == run.BackupID })
+			st.Runs[i] = run
+		}
+	}
+}
+
+// run starts a backup of the schedule. A backup which could not be started
+// is recorded as failed, the schedule tries again at its next time.
+func (m *ScheduleManager) run(ctx context.Context, st *backup.ScheduleState, now time.Time) {
+	sched := &st.Schedule
+	run := backup.ScheduleRun{
+		BackupID:  fmt.Sprintf("%s-%s", sched.Name, now.UTC().Format("20060102150405")),
+		StartedAt: now.UTC(),
+		Status:    backup.Started,
+	}
+	req := &BackupRequest{
+		ID:      run.BackupID,
+		Backend: sched.Backend,
+		Include: sched.Include,
+		Exclude: sched.Exclude,
+		Bucket:  sched.Bucket,
+		Path:    sched.Path,
+		Compression: Compression{
+			Level:         DefaultCompression,
+			CPUPercentage: DefaultCPUPercentage,
+			ChunkSize:     DefaultChunkSize,
+		},
+	}
+	_, err := m.scheduler.backup(ctx, req)
+	logOperation(m.logger, "backup_schedule_run", run.BackupID, sched.Backend, now, err)
+	if err != nil {
+		run.Status, run.Error, run.CompletedAt = backup.Failed, err.Error(), now.UTC()
+	}
+	if m.record(ctx, sched.Name, run) {
+		st.Runs = append(st.Runs, run)
+	}
+}
+
+func (m *ScheduleManager) record(ctx context.Context, name string, run backup.ScheduleRun) bool {
+	if _, err := m.store.RecordBackupScheduleRun(ctx, name, run); err != nil {
+		m.logger.WithField("action", "backup_schedule_record").
+			WithField("schedule", name).WithField("backup_id", run.BackupID).
+			WithError(err).Error("could not record backup schedule run")
+		return false
+	}
+	return true
+}
+
+// deleteBackup removes a backup if the backend supports it
+func deleteBackup(ctx context.Context, backend modulecapabilities.BackupBackend, backupID, overrideBucket, overridePath string) error {
+	deleter, ok := backend.(modulecapabilities.BackupBackendDeleter)
+	if !ok {
+		return fmt.Errorf("%s: %w", backend.Name(), errBackendDeleteNotSupported)
+	}
+	return deleter.Delete(ctx, backupID, overrideBucket, overridePath)
+}
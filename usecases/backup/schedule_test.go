@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+// fakeScheduleStore keeps schedules in memory and acts as both store and reader
+type fakeScheduleStore struct {
+	sync.Mutex
+	states map[string]*backup.ScheduleState
+}
+
+func newFakeScheduleStore() *fakeScheduleStore {
+	return &fakeScheduleStore{states: map[string]*backup.ScheduleState{}}
+}
+
+func (f *fakeScheduleStore) IsLeader() bool { return true }
+
+func (f *fakeScheduleStore) PutBackupSchedule(ctx context.Context, sched backup.Schedule, create bool) (uint64, error) {
+	f.Lock()
+	defer f.Unlock()
+	if st, ok := f.states[sched.Name]; ok {
+		st.Schedule = sched
+	} else {
+		f.states[sched.Name] = &backup.ScheduleState{Schedule: sched}
+	}
+	return 1, nil
+}
+
+func (f *fakeScheduleStore) DeleteBackupSchedule(ctx context.Context, name string) (uint64, error) {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.states, name)
+	return 1, nil
+}
+
+func (f *fakeScheduleStore) RecordBackupScheduleRun(ctx context.Context, name string, run backup.ScheduleRun) (uint64, error) {
+	f.Lock()
+	defer f.Unlock()
+	st := f.states[name]
+	for i := range st.Runs {
+		if st.Runs[i].BackupID == run.BackupID {
+			st.Runs[i] = run
+			return 1, nil
+		}
+	}
+	st.Runs = append(st.Runs, run)
+	return 1, nil
+}
+
+func (f *fakeScheduleStore) BackupSchedule(name string) (backup.ScheduleState, bool) {
+	f.Lock()
+	defer f.Unlock()
+	st, ok := f.states[name]
+	if !ok {
+		return backup.ScheduleState{}, false
+	}
+	return backup.ScheduleState{Schedule: st.Schedule, Runs: append([]backup.ScheduleRun{}, st.Runs...)}, true
+}
+
+func (f *fakeScheduleStore) ListBackupSchedules() []backup.ScheduleState {
+	f.Lock()
+	names := make([]string, 0, len(f.states))
+	for name := range f.states {
+		names = append(names, name)
+	}
+	f.Unlock()
+	res := make([]backup.ScheduleState, 0, len(names))
+	for _, name := range names {
+		st, _ := f.BackupSchedule(name)
+		res = append(res, st)
+	}
+	return res
+}
+
+type deletingBackend struct {
+	*fakeBackend
+	deleted []string
+}
+
+func (b *deletingBackend) Delete(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	b.deleted = append(b.deleted, backupID)
+	return nil
+}
+
+func newFakeScheduleManager(t *testing.T) (*ScheduleManager, *fakeScheduler, *fakeScheduleStore, *deletingBackend) {
+	t.Helper()
+	fs := newFakeScheduler(nil)
+	backend := &deletingBackend{fakeBackend: fs.backend}
+	s := fs.scheduler()
+	s.backends = &fakeBackupBackendProvider{backend, nil}
+	store := newFakeScheduleStore()
+	logger, _ := test.NewNullLogger()
+	return NewScheduleManager(fs.auth, s, store, store, logger), fs, store, backend
+}
+
+func TestScheduleManagerCRUD(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		now   = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+		sched = backup.Schedule{Name: "nightly", Cron: "0 2 * * *", Backend: "s3"}
+	)
+	m, _, _, _ := newFakeScheduleManager(t)
+	m.now = func() time.Time { return now }
+
+	got, err := m.Create(ctx, nil, sched)
+	require.Nil(t, err)
+	assert.Equal(t, now, got.Schedule.UpdatedAt)
+	assert.Equal(t, time.Date(2024, 1, 2, 2, 0, 0, 0, time.UTC), got.NextRun)
+
+	_, err = m.Create(ctx, nil, sched)
+	assert.ErrorAs(t, err, &backup.ErrUnprocessable{})
+
+	for _, invalid := range []backup.Schedule{
+		{Name: "Upper", Cron: "@daily", Backend: "s3"},
+		{Name: "cron", Cron: "0 2 * *", Backend: "s3"},
+		{Name: "both", Cron: "@daily", Backend: "s3", Include: []string{"A"}, Exclude: []string{"B"}},
+		{Name: "negative", Cron: "@daily", Backend: "s3", Retention: backup.Retention{KeepLast: -1}},
+	} {
+		_, err = m.Create(ctx, nil, invalid)
+		assert.ErrorAs(t, err, &backup.ErrUnprocessable{}, invalid.Name)
+	}
+
+	sched.Paused = true
+	got, err = m.Update(ctx, nil, sched)
+	require.Nil(t, err)
+	assert.True(t, got.Schedule.Paused)
+	assert.True(t, got.NextRun.IsZero())
+
+	_, err = m.Update(ctx, nil, backup.Schedule{Name: "missing", Cron: "@daily", Backend: "s3"})
+	assert.ErrorAs(t, err, &backup.ErrNotFound{})
+
+	list, err := m.List(ctx, nil)
+	require.Nil(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "nightly", list[0].Schedule.Name)
+
+	require.Nil(t, m.Delete(ctx, nil, "nightly"))
+	_, err = m.Get(ctx, nil, "nightly")
+	assert.ErrorAs(t, err, &backup.ErrNotFound{})
+	assert.ErrorAs(t, m.Delete(ctx, nil, "nightly"), &backup.ErrNotFound{})
+}
+
+func TestScheduleManagerTick(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+	m, fs, store, backend := newFakeScheduleManager(t)
+	store.states["hourly"] = &backup.ScheduleState{
+		Schedule: backup.Schedule{
+			Name: "hourly", Cron: "@hourly", Backend: "s3", Include: []string{"C1"},
+			Retention: backup.Retention{KeepLast: 2}, UpdatedAt: start,
+		},
+		Runs: []backup.ScheduleRun{
+			{BackupID: "hourly-1", StartedAt: start.Add(-3 * time.Hour), Status: backup.Success},
+			{BackupID: "hourly-2", StartedAt: start.Add(-2 * time.Hour), Status: backup.Success},
+			{BackupID: "hourly-3", StartedAt: start.Add(-1 * time.Hour), Status: backup.Transferring},
+		},
+	}
+	completedAt := start.Add(-30 * time.Minute)
+	fs.backend.On("GetObject", mock.Anything, "hourly-3", GlobalBackupFile).Return(marshalCoordinatorMeta(
+		backup.DistributedBackupDescriptor{
+			StartedAt: start.Add(-1 * time.Hour), CompletedAt: completedAt, Status: backup.Success,
+		}), nil)
+	fs.backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return("path")
+
+	t.Run("not due", func(t *testing.T) {
+		m.now = func() time.Time { return start.Add(30 * time.Minute) }
+		m.tick(ctx)
+
+		st, _ := store.BackupSchedule("hourly")
+		require.Len(t, st.Runs, 3)
+		assert.Equal(t, backup.Success, st.Runs[2].Status)
+		assert.Equal(t, completedAt, st.Runs[2].CompletedAt)
+		// the oldest backup is no longer retained
+		assert.Equal(t, []string{"hourly-1"}, backend.deleted)
+		assert.False(t, st.Runs[0].DeletedAt.IsZero())
+		assert.True(t, st.Runs[1].DeletedAt.IsZero())
+	})
+
+	t.Run("due", func(t *testing.T) {
+		now := start.Add(time.Hour)
+		m.now = func() time.Time { return now }
+		fs.selector.On("Backupable", mock.Anything, []string{"C1"}).Return(ErrAny)
+		m.tick(ctx)
+
+		st, _ := store.BackupSchedule("hourly")
+		require.Len(t, st.Runs, 4)
+		run := st.Runs[3]
+		assert.Equal(t, "hourly-20240101010000", run.BackupID)
+		assert.Equal(t, now, run.StartedAt)
+		// the backup could not be started, the schedule waits for its next time
+		assert.Equal(t, backup.Failed, run.Status)
+		assert.Contains(t, run.Error, ErrAny.Error())
+		assert.Equal(t, start.Add(2*time.Hour), nextRun(&st))
+	})
+}
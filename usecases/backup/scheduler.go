@@ -79,6 +79,12 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 	if err := s.authorizer.Authorize(pr, authorization.CREATE, authorization.Cluster()); err != nil {
 		return nil, err
 	}
+	return s.backup(ctx, req)
+}
+
+// backup starts a backup without checking permissions, it is shared by
+// Backup and scheduled backups.
+func (s *Scheduler) backup(ctx context.Context, req *BackupRequest) (*models.BackupCreateResponse, error) {
 	store, err := coordBackend(s.backends, req.Backend, req.ID, req.Bucket, req.Path)
 	if err != nil {
 		err = fmt.Errorf("no backup backend %q: %w, did you enable the right module?", req.Backend, err)
@@ -145,22 +151,28 @@ func (s *Scheduler) Restore(ctx context.Context, pr *models.Principal,
 	if err != nil {
 		return nil, backup.NewErrUnprocessable(err)
 	}
+	if err := rewriteClassDescriptors(schema, meta.Classes(), req.ClassMapping, req.Tenants); err != nil {
+		return nil, backup.NewErrUnprocessable(err)
+	}
 	status := string(backup.Started)
 	data := &models.BackupRestoreResponse{
 		Backend: req.Backend,
 		ID:      req.ID,
 		Path:    store.HomeDir(req.Bucket, req.Path),
-		Classes: meta.Classes(),
+		Classes: mappedClasses(meta.Classes(), req.ClassMapping),
 	}
 
 	rReq := Request{
-		Method:      OpRestore,
-		ID:          req.ID,
-		Backend:     req.Backend,
-		Compression: req.Compression,
-		Classes:     meta.Classes(),
-		Bucket:      req.Bucket,
-		Path:        req.Path,
+		Method:       OpRestore,
+		ID:           req.ID,
+		Backend:      req.Backend,
+		Compression:  req.Compression,
+		Classes:      meta.Classes(),
+		ClassMapping: req.ClassMapping,
+		Tenants:      req.Tenants,
+		PointInTime:  req.PointInTime,
+		Bucket:       req.Bucket,
+		Path:         req.Path,
 	}
 	err = s.restorer.Restore(ctx, store, &rReq, meta, schema)
 	if err != nil {
@@ -182,6 +194,10 @@ func (s *Scheduler) BackupStatus(ctx context.Context, principal *models.Principa
 	if err := s.authorizer.Authorize(principal, authorization.READ, authorization.Cluster()); err != nil {
 		return nil, err
 	}
+	return s.backupStatus(ctx, backend, backupID, overrideBucket, overridePath)
+}
+
+func (s *Scheduler) backupStatus(ctx context.Context, backend, backupID, overrideBucket, overridePath string) (*Status, error) {
 	store, err := coordBackend(s.backends, backend, backupID, overrideBucket, overridePath)
 	if err != nil {
 		err = fmt.Errorf("no backup provider %q: %w, did you enable the right module?", backend, err)
@@ -387,6 +403,21 @@ func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore
 	if meta.RemoveEmpty().Count() == 0 {
 		return nil, fmt.Errorf("nothing left to restore: please choose from : %v", cs)
 	}
+	if req.ClassMapping, err = validateClassMapping(req.ClassMapping, meta.Classes()); err != nil {
+		return nil, err
+	}
+	if dup := findDuplicate(req.Tenants); dup != "" {
+		return nil, fmt.Errorf("tenant list contains duplicate: %s", dup)
+	}
+	if pit := req.PointInTime; !pit.IsZero() {
+		if pit.Before(meta.CompletedAt) {
+			return nil, fmt.Errorf("point in time %s is before backup completion %s",
+				pit.Format(time.RFC3339), meta.CompletedAt.Format(time.RFC3339))
+		}
+		if pit.After(time.Now()) {
+			return nil, fmt.Errorf("point in time %s is in the future", pit.Format(time.RFC3339))
+		}
+	}
 	if len(req.NodeMapping) > 0 {
 		meta.NodeMapping = req.NodeMapping
 		meta.ApplyNodeMapping()
@@ -739,6 +739,40 @@ func TestSchedulerRestoreRequestValidation(t *testing.T) {
 		assert.Contains(t, err.Error(), "unknown")
 	})
 
+	t.Run("ClassMappingOfUnrestoredClass", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+
+		bytes := marshalCoordinatorMeta(meta)
+		fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(bytes, nil)
+		fs.backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
+		_, err := fs.scheduler().Restore(ctx, nil, &BackupRequest{
+			ID:           id,
+			Include:      []string{cls},
+			ClassMapping: map[string]string{"Other": "OtherCopy"},
+		})
+		assert.NotNil(t, err)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.Contains(t, err.Error(), "class mapping")
+	})
+
+	t.Run("PointInTime", func(t *testing.T) {
+		for _, pit := range []time.Time{meta.CompletedAt.Add(-time.Minute), time.Now().Add(time.Hour)} {
+			fs := newFakeScheduler(nil)
+
+			bytes := marshalCoordinatorMeta(meta)
+			fs.backend.On("GetObject", ctx, id, GlobalBackupFile).Return(bytes, nil)
+			fs.backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
+			_, err := fs.scheduler().Restore(ctx, nil, &BackupRequest{
+				ID:          id,
+				Include:     []string{cls},
+				PointInTime: pit,
+			})
+			assert.NotNil(t, err)
+			assert.IsType(t, backup.ErrUnprocessable{}, err)
+			assert.Contains(t, err.Error(), "point in time")
+		}
+	})
+
 	t.Run("EmptyResultClassList", func(t *testing.T) { //  backup was successful but class list is empty
 		fs := newFakeScheduler(&fakeNodeResolver{})
 
@@ -15,6 +15,7 @@ import (
 	"context"
 
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
 )
 
 // Sourcer represents the source of artifacts used in the backup
@@ -42,4 +43,9 @@ type Sourcer interface { // implemented by the index
 	//
 	// A class cannot be backed up either if it doesn't exist or if it has more than one physical shard.
 	ListBackupable() []string
+
+	// RewriteRestoredClass rewrites the restored shards in indexDir, so that objects are stored
+	// under the name their class is restored as and references point to the new names of renamed classes.
+	// class is the class schema as stored in the backup.
+	RewriteRestoredClass(_ context.Context, indexDir string, class *models.Class, mapping map[string]string) error
 }
@@ -40,6 +40,15 @@ type Request struct {
 	// NodeMapping specify node names replacement to be made on restore
 	NodeMapping map[string]string
 
+	// ClassMapping specify class names replacement to be made on restore
+	ClassMapping map[string]string
+
+	// Tenants restricts a restore to the shards of these tenants
+	Tenants []string
+
+	// PointInTime up to which archived commit logs are replayed on restore
+	PointInTime time.Time
+
 	// Classes is list of class which need to be backed up
 	Classes []string
 
@@ -0,0 +1,579 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/backup"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+const (
+	// archiveID is the backup id under which commit logs are archived,
+	// it is reserved and cannot be used for backups
+	archiveID = "wal-archive"
+	// archiveManifestFile lists all archived segments of a node
+	archiveManifestFile = "manifest.json"
+	// archiveStagingDir holds hard links to archived commit logs, so that a
+	// commit log can be archived completely even after it was removed
+	archiveStagingDir = ".wal-archive"
+
+	paxKind          = "WEAVIATE.kind"
+	paxOffset        = "WEAVIATE.offset"
+	archiveKindLog   = "log"
+	archiveKindState = "state"
+)
+
+// archiveManifest lists the segments archived by a node, oldest first
+type archiveManifest struct {
+	Node     string           `json:"node"`
+	Segments []archiveSegment `json:"segments"`
+	// Generation is increased with every new base backup. Segments are
+	// stored by generation, so that pruned ones can be removed together.
+	Generation int `json:"generation"`
+	// Bases maps index directories to the start of the newest successful
+	// backup on the archive backend which contains them
+	Bases map[string]time.Time `json:"bases,omitempty"`
+	// Pruned maps index directories to the time until which their segments
+	// were pruned. Backups started before can't be replayed anymore.
+	Pruned map[string]time.Time `json:"pruned,omitempty"`
+}
+
+// archiveSegment is a gzipped tarball with the data appended to commit logs
+// and the latest version of state files since the previous segment
+type archiveSegment struct {
+	Key string `json:"key"`
+	// Time until which all writes are contained in the segment
+	Time       time.Time `json:"time"`
+	Size       int64     `json:"size"`
+	Generation int       `json:"generation"`
+	// Indexes lists the index directories with data in the segment
+	Indexes []string `json:"indexes,omitempty"`
+}
+
+func (m *archiveManifest) clone() *archiveManifest {
+	c := *m
+	c.Segments = append([]archiveSegment{}, m.Segments...)
+	c.Bases = make(map[string]time.Time, len(m.Bases))
+	for index, t := range m.Bases {
+		c.Bases[index] = t
+	}
+	c.Pruned = make(map[string]time.Time, len(m.Pruned))
+	for index, t := range m.Pruned {
+		c.Pruned[index] = t
+	}
+	return &c
+}
+
+// addBases records newer base backups of indexes and starts a new
+// generation if any was added
+func (m *archiveManifest) addBases(bases map[string]time.Time) bool {
+	added := false
+	for index, startedAt := range bases {
+		if startedAt.After(m.Bases[index]) {
+			m.Bases[index] = startedAt
+			added = true
+		}
+	}
+	if added {
+		m.Generation++
+	}
+	return added
+}
+
+// prune drops segments which are no longer needed to restore the newest
+// base backups to a point in time: all their data is contained in newer base
+// backups and they were written before keepAfter. It returns the past
+// generations of which no segment is left.
+func (m *archiveManifest) prune(keepAfter time.Time) (pruned bool, empty []int) {
+	generations := map[int]bool{}
+	kept := m.Segments[:0]
+	for _, s := range m.Segments {
+		if !s.Time.Before(keepAfter) || !m.covered(s) {
+			kept = append(kept, s)
+			generations[s.Generation] = true
+			continue
+		}
+		pruned = true
+		for _, index := range s.Indexes {
+			if s.Time.After(m.Pruned[index]) {
+				m.Pruned[index] = s.Time
+			}
+		}
+		if _, ok := generations[s.Generation]; !ok {
+			generations[s.Generation] = false
+		}
+	}
+	m.Segments = kept
+	for g, left := range generations {
+		if !left && g < m.Generation {
+			empty = append(empty, g)
+		}
+	}
+	sort.Ints(empty)
+	return pruned, empty
+}
+
+// covered reports whether all data of the segment is contained in base backups
+func (m *archiveManifest) covered(s archiveSegment) bool {
+	for _, index := range s.Indexes {
+		if m.Bases[index].Before(s.Time) {
+			return false
+		}
+	}
+	return true
+}
+
+// generationID is the backup id under which the segments of a generation are stored
+func generationID(store *objectStore, generation int) string {
+	return fmt.Sprintf("%s/%d", store.backupId, generation)
+}
+
+func archiveStore(backend BackupBackendProvider, name, node string) (objectStore, error) {
+	caps, err := backend.BackupBackend(name)
+	if err != nil {
+		return objectStore{}, err
+	}
+	return objectStore{backend: caps, backupId: fmt.Sprintf("%s/%s", archiveID, node)}, nil
+}
+
+// LogArchiver continuously archives the commit logs of all shards of a node
+// to a backup backend. Commit logs are append-only, every interval the data
+// appended since the last run is uploaded as a new segment. Files which are
+// rewritten in place are archived as a whole whenever they change.
+type LogArchiver struct {
+	node      string
+	dataPath  string
+	backend   string
+	interval  time.Duration
+	retention time.Duration
+	logger    logrus.FieldLogger
+
+	sync.Mutex // protects logs, states and bases
+	// logs maps relative paths of staged commit logs to the number of bytes archived
+	logs map[string]int64
+	// states maps relative paths of state files to the mod time of their archived version
+	states map[string]time.Time
+	// bases maps index directories to the start of base backups completed
+	// since the manifest was last written
+	bases map[string]time.Time
+
+	// archiveLock serializes runs of archive
+	archiveLock sync.Mutex
+	store       *objectStore
+	manifest    *archiveManifest
+	cancel      context.CancelFunc
+	done        chan struct{}
+}
+
+func NewLogArchiver(node, dataPath string, cfg config.BackupWALArchive, logger logrus.FieldLogger) *LogArchiver {
+	return &LogArchiver{
+		node:      node,
+		dataPath:  dataPath,
+		backend:   cfg.Backend,
+		interval:  cfg.Interval,
+		retention: cfg.Retention,
+		logger:    logger.WithField("action", "wal_archive"),
+		logs:      map[string]int64{},
+		states:    map[string]time.Time{},
+		bases:     map[string]time.Time{},
+	}
+}
+
+// BaseBackupCompleted is called once the node completed a backup. Backups
+// on the archive backend become the base of the archived segments of their
+// classes, segments fully contained in them are pruned after the retention.
+func (a *LogArchiver) BaseBackupCompleted(backend string, desc *backup.BackupDescriptor) {
+	if backend != a.backend {
+		return
+	}
+	a.Lock()
+	defer a.Unlock()
+	for _, class := range desc.List() {
+		index := strings.ToLower(class)
+		if desc.StartedAt.After(a.bases[index]) {
+			a.bases[index] = desc.StartedAt
+		}
+	}
+}
+
+func (a *LogArchiver) relPath(path string) (string, bool) {
+	rel, err := filepath.Rel(a.dataPath, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		a.logger.WithField("path", path).Warn("file outside of data path cannot be archived")
+		return "", false
+	}
+	return rel, true
+}
+
+func (a *LogArchiver) stagingPath(rel string) string {
+	return filepath.Join(a.dataPath, archiveStagingDir, rel)
+}
+
+// ArchiveLog hard links the commit log into the staging directory. The link
+// keeps the content available once the log was removed, e.g. after a flush.
+func (a *LogArchiver) ArchiveLog(path string) {
+	rel, ok := a.relPath(path)
+	if !ok {
+		return
+	}
+	staged := a.stagingPath(rel)
+	if err := linkFile(path, staged); err != nil {
+		a.logger.WithField("path", path).WithError(err).Error("stage commit log")
+		return
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	if _, ok := a.logs[rel]; !ok {
+		a.logs[rel] = 0
+	}
+}
+
+// ArchiveState registers a file whose latest content is archived on change
+func (a *LogArchiver) ArchiveState(path string) {
+	rel, ok := a.relPath(path)
+	if !ok {
+		return
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	if _, ok := a.states[rel]; !ok {
+		a.states[rel] = time.Time{}
+	}
+}
+
+// linkFile hard links src to dst unless dst is already a link to src
+func linkFile(src, dst string) error {
+	if dstInfo, err := os.Stat(dst); err == nil {
+		srcInfo, err := os.Stat(src)
+		if err != nil {
+			return err
+		}
+		if os.SameFile(srcInfo, dstInfo) {
+			return nil
+		}
+		if err := os.Remove(dst); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+		return err
+	}
+	return os.Link(src, dst)
+}
+
+// Start resumes archiving of previously staged commit logs and uploads new
+// data every interval until Close is called.
+func (a *LogArchiver) Start(backends BackupBackendProvider) error {
+	store, err := archiveStore(backends, a.backend, a.node)
+	if err != nil {
+		return fmt.Errorf("wal archive backend %q: %w", a.backend, err)
+	}
+	a.store = &store
+
+	// staged logs are archived from the start again, restores skip data which
+	// has already been applied
+	root := filepath.Join(a.dataPath, archiveStagingDir)
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		a.Lock()
+		if _, ok := a.logs[rel]; !ok {
+			a.logs[rel] = 0
+		}
+		a.Unlock()
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("load staged commit logs: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancel = cancel
+	a.done = make(chan struct{})
+	enterrors.GoWrapper(func() {
+		defer close(a.done)
+		ticker := time.NewTicker(a.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := a.archive(ctx); err != nil {
+					a.logger.WithError(err).Error("archive commit logs")
+				}
+			}
+		}
+	}, a.logger)
+	return nil
+}
+
+// Close stops the periodic archiving and archives what has been written so far
+func (a *LogArchiver) Close(ctx context.Context) error {
+	if a.cancel == nil {
+		return nil
+	}
+	a.cancel()
+	<-a.done
+	return a.archive(ctx)
+}
+
+// archiveFile is a file with data to be archived in the next segment
+type archiveFile struct {
+	rel    string
+	kind   string
+	path   string
+	offset int64
+	size   int64
+	// gone is set for staged logs removed by the storage engine,
+	// they are complete and can be unstaged once archived
+	gone    bool
+	modTime time.Time
+}
+
+func (a *LogArchiver) archive(ctx context.Context) error {
+	a.archiveLock.Lock()
+	defer a.archiveLock.Unlock()
+
+	if a.manifest == nil {
+		m := &archiveManifest{Node: a.node}
+		if err := a.store.meta(ctx, archiveManifestFile, "", "", m); err != nil {
+			if !errors.As(err, &backup.ErrNotFound{}) {
+				return fmt.Errorf("get manifest: %w", err)
+			}
+			if err := a.store.Initialize(ctx, "", ""); err != nil {
+				return fmt.Errorf("init backend: %w", err)
+			}
+		}
+		a.manifest = m
+	}
+
+	a.Lock()
+	bases := a.bases
+	a.bases = map[string]time.Time{}
+	a.Unlock()
+
+	files := a.pending()
+	// all writes up to now are contained in the files
+	now := time.Now().UTC()
+	m := a.manifest.clone()
+	changed := m.addBases(bases)
+
+	if len(files) > 0 {
+		key := fmt.Sprintf("%d/%d.tar.gz", m.Generation, now.UnixNano())
+		pr, pw := io.Pipe()
+		enterrors.GoWrapper(func() {
+			pw.CloseWithError(writeArchiveSegment(pw, files))
+		}, a.logger)
+		size, err := a.store.Write(ctx, key, "", "", pr)
+		if err != nil {
+			a.restoreBases(bases)
+			return fmt.Errorf("upload segment %s: %w", key, err)
+		}
+		m.Segments = append(m.Segments, archiveSegment{
+			Key: key, Time: now, Size: size, Generation: m.Generation, Indexes: archivedIndexes(files),
+		})
+		changed = true
+	}
+
+	pruned, empty := m.prune(now.Add(-a.retention))
+	if !changed && !pruned {
+		return nil
+	}
+	if err := a.store.putMeta(ctx, archiveManifestFile, "", "", m); err != nil {
+		a.restoreBases(bases)
+		return fmt.Errorf("put manifest: %w", err)
+	}
+	a.manifest = m
+
+	// segments are only removed once the manifest doesn't reference them
+	for _, g := range empty {
+		if err := deleteBackup(ctx, a.store.backend, generationID(a.store, g), "", ""); err != nil {
+			a.logger.WithField("generation", g).WithError(err).Warn("remove pruned segments")
+		}
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	for _, f := range files {
+		switch f.kind {
+		case archiveKindLog:
+			if f.gone {
+				delete(a.logs, f.rel)
+				os.Remove(f.path)
+				continue
+			}
+			a.logs[f.rel] = f.size
+		case archiveKindState:
+			a.states[f.rel] = f.modTime
+		}
+	}
+	return nil
+}
+
+// restoreBases keeps base backups which couldn't be written to the manifest
+// for the next run
+func (a *LogArchiver) restoreBases(bases map[string]time.Time) {
+	a.Lock()
+	defer a.Unlock()
+	for index, startedAt := range bases {
+		if startedAt.After(a.bases[index]) {
+			a.bases[index] = startedAt
+		}
+	}
+}
+
+// archivedIndexes returns the index directories the files belong to
+func archivedIndexes(files []archiveFile) []string {
+	seen := map[string]bool{}
+	var indexes []string
+	for _, f := range files {
+		index := strings.SplitN(filepath.ToSlash(f.rel), "/", 2)[0]
+		if !seen[index] {
+			seen[index] = true
+			indexes = append(indexes, index)
+		}
+	}
+	sort.Strings(indexes)
+	return indexes
+}
+
+// pending returns all files with data which hasn't been archived yet
+func (a *LogArchiver) pending() []archiveFile {
+	a.Lock()
+	logs := make(map[string]int64, len(a.logs))
+	for rel, offset := range a.logs {
+		logs[rel] = offset
+	}
+	states := make(map[string]time.Time, len(a.states))
+	for rel, modTime := range a.states {
+		states[rel] = modTime
+	}
+	a.Unlock()
+
+	files := make([]archiveFile, 0, len(logs)+len(states))
+	for rel, offset := range logs {
+		// check the original first, no data is appended once it is gone
+		_, err := os.Stat(filepath.Join(a.dataPath, rel))
+		gone := errors.Is(err, fs.ErrNotExist)
+		staged := a.stagingPath(rel)
+		info, err := os.Stat(staged)
+		if err != nil {
+			a.logger.WithField("path", staged).WithError(err).Warn("staged commit log vanished")
+			a.Lock()
+			delete(a.logs, rel)
+			a.Unlock()
+			continue
+		}
+		if info.Size() > offset || gone {
+			files = append(files, archiveFile{
+				rel: rel, kind: archiveKindLog, path: staged,
+				offset: offset, size: info.Size(), gone: gone,
+			})
+		}
+	}
+	for rel, modTime := range states {
+		path := filepath.Join(a.dataPath, rel)
+		info, err := os.Stat(path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				a.Lock()
+				delete(a.states, rel)
+				a.Unlock()
+			}
+			continue
+		}
+		if !info.ModTime().Equal(modTime) {
+			files = append(files, archiveFile{
+				rel: rel, kind: archiveKindState, path: path,
+				size: info.Size(), modTime: info.ModTime(),
+			})
+		}
+	}
+	return files
+}
+
+func writeArchiveSegment(w io.Writer, files []archiveFile) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	for _, f := range files {
+		if err := writeArchiveFile(tw, f); err != nil {
+			return fmt.Errorf("%s: %w", f.rel, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+func writeArchiveFile(tw *tar.Writer, f archiveFile) error {
+	var data io.Reader
+	size := f.size - f.offset
+	if f.kind == archiveKindState {
+		// state files are rewritten in place, the size might have changed
+		b, err := os.ReadFile(f.path)
+		if err != nil {
+			return err
+		}
+		data, size = strings.NewReader(string(b)), int64(len(b))
+	} else {
+		file, err := os.Open(f.path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		data = io.NewSectionReader(file, f.offset, size)
+	}
+
+	hdr := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     filepath.ToSlash(f.rel),
+		Size:     size,
+		Mode:     0o644,
+		ModTime:  f.modTime,
+		Format:   tar.FormatPAX,
+		PAXRecords: map[string]string{
+			paxKind:   f.kind,
+			paxOffset: strconv.FormatInt(f.offset, 10),
+		},
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := io.Copy(tw, data)
+	return err
+}
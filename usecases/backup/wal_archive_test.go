@@ -0,0 +1,272 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestLogArchiver(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		logger, _ = test.NewNullLogger()
+		dataPath  = t.TempDir()
+		backend   = newMemBackend()
+		startedAt = time.Now()
+		shardDir  = filepath.Join(dataPath, "article", "shard1")
+		walName   = fmt.Sprintf("segment-%d.wal", time.Now().UnixNano())
+		walPath   = filepath.Join(shardDir, "lsm", "objects", walName)
+		statePath = filepath.Join(shardDir, "indexcount")
+	)
+	require.Nil(t, os.MkdirAll(filepath.Dir(walPath), os.ModePerm))
+	require.Nil(t, os.WriteFile(walPath, []byte("abc"), 0o644))
+	require.Nil(t, os.WriteFile(statePath, []byte("1"), 0o644))
+
+	cfg := config.BackupWALArchive{Backend: "mem", Interval: time.Hour}
+	a := NewLogArchiver("node1", dataPath, cfg, logger)
+	a.ArchiveLog(walPath)
+	a.ArchiveState(statePath)
+	require.Nil(t, a.Start(memProvider{backend}))
+	defer a.Close(ctx)
+
+	manifest := func() archiveManifest {
+		m := archiveManifest{}
+		store, _ := archiveStore(memProvider{backend}, "mem", "node1")
+		require.Nil(t, store.meta(ctx, archiveManifestFile, "", "", &m))
+		return m
+	}
+
+	require.Nil(t, a.archive(ctx))
+	assert.Len(t, manifest().Segments, 1)
+
+	t.Run("NothingToArchive", func(t *testing.T) {
+		require.Nil(t, a.archive(ctx))
+		assert.Len(t, manifest().Segments, 1)
+	})
+
+	t.Run("AppendedData", func(t *testing.T) {
+		f, err := os.OpenFile(walPath, os.O_APPEND|os.O_WRONLY, 0o644)
+		require.Nil(t, err)
+		_, err = f.Write([]byte("def"))
+		require.Nil(t, err)
+		require.Nil(t, f.Close())
+
+		require.Nil(t, a.archive(ctx))
+		m := manifest()
+		require.Len(t, m.Segments, 2)
+		assert.True(t, m.Segments[0].Time.Before(m.Segments[1].Time))
+		assert.Equal(t, int64(6), a.logs[filepath.Join("article", "shard1", "lsm", "objects", walName)])
+	})
+
+	t.Run("RemovedLogIsUnstaged", func(t *testing.T) {
+		require.Nil(t, os.Remove(walPath))
+		require.Nil(t, a.archive(ctx))
+		assert.Len(t, manifest().Segments, 3)
+		assert.Empty(t, a.logs)
+		_, err := os.Stat(a.stagingPath(filepath.Join("article", "shard1", "lsm", "objects", walName)))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("Replay", func(t *testing.T) {
+		tempDir := t.TempDir()
+		restored := filepath.Join(tempDir, "Article", "article", "shard1", "lsm", "objects", walName)
+		// the backup contains the beginning of the log
+		require.Nil(t, os.MkdirAll(filepath.Dir(restored), os.ModePerm))
+		require.Nil(t, os.WriteFile(restored, []byte("ab"), 0o644))
+
+		store, m, err := loadArchiveManifest(ctx, memProvider{backend}, "mem", "node1")
+		require.Nil(t, err)
+		segments := m.segmentsBetween(startedAt, time.Now())
+		require.Len(t, segments, 3)
+
+		r := newWALReplayer(tempDir, []string{"Article", "Paragraph"}, startedAt, logger)
+		require.Nil(t, r.replay(ctx, store, segments))
+
+		data, err := os.ReadFile(restored)
+		require.Nil(t, err)
+		assert.Equal(t, "abcdef", string(data))
+		data, err = os.ReadFile(filepath.Join(tempDir, "Article", "article", "shard1", "indexcount"))
+		require.Nil(t, err)
+		assert.Equal(t, "1", string(data))
+	})
+
+	t.Run("ReplayUntilPointInTime", func(t *testing.T) {
+		tempDir := t.TempDir()
+		restored := filepath.Join(tempDir, "Article", "article", "shard1", "lsm", "objects", walName)
+		require.Nil(t, os.MkdirAll(filepath.Dir(restored), os.ModePerm))
+
+		store, m, err := loadArchiveManifest(ctx, memProvider{backend}, "mem", "node1")
+		require.Nil(t, err)
+		segments := m.segmentsBetween(startedAt, m.Segments[0].Time)
+		require.Len(t, segments, 1)
+
+		r := newWALReplayer(tempDir, []string{"Article"}, startedAt, logger)
+		require.Nil(t, r.replay(ctx, store, segments))
+		data, err := os.ReadFile(restored)
+		require.Nil(t, err)
+		assert.Equal(t, "abc", string(data))
+	})
+
+	t.Run("NoArchive", func(t *testing.T) {
+		_, _, err := loadArchiveManifest(ctx, memProvider{newMemBackend()}, "mem", "node1")
+		assert.ErrorIs(t, err, errArchiveNotFound)
+	})
+}
+
+// memDeleter is a memBackend which records deleted backups
+type memDeleter struct {
+	*memBackend
+	deleted []string
+}
+
+func (b *memDeleter) Delete(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	b.deleted = append(b.deleted, backupID)
+	return nil
+}
+
+type memDeleterProvider struct {
+	backend *memDeleter
+}
+
+func (p memDeleterProvider) BackupBackend(name string) (modulecapabilities.BackupBackend, error) {
+	return p.backend, nil
+}
+
+func TestLogArchiverPrune(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		logger, _ = test.NewNullLogger()
+		dataPath  = t.TempDir()
+		backend   = &memDeleter{memBackend: newMemBackend()}
+		walPath   = filepath.Join(dataPath, "article", "shard1", "lsm", "objects",
+			fmt.Sprintf("segment-%d.wal", time.Now().UnixNano()))
+	)
+	require.Nil(t, os.MkdirAll(filepath.Dir(walPath), os.ModePerm))
+	appendLog := func(data string) {
+		f, err := os.OpenFile(walPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		require.Nil(t, err)
+		_, err = f.Write([]byte(data))
+		require.Nil(t, err)
+		require.Nil(t, f.Close())
+	}
+	appendLog("abc")
+
+	cfg := config.BackupWALArchive{Backend: "mem", Interval: time.Hour, Retention: time.Hour}
+	a := NewLogArchiver("node1", dataPath, cfg, logger)
+	a.ArchiveLog(walPath)
+	require.Nil(t, a.Start(memDeleterProvider{backend}))
+	defer a.Close(ctx)
+	require.Nil(t, a.archive(ctx))
+	require.Len(t, a.manifest.Segments, 1)
+	assert.Equal(t, []string{"article"}, a.manifest.Segments[0].Indexes)
+	assert.Equal(t, 0, a.manifest.Segments[0].Generation)
+
+	base := &backup.BackupDescriptor{StartedAt: time.Now().UTC(), Classes: []backup.ClassDescriptor{{Name: "Article"}}}
+
+	t.Run("BackupOnOtherBackend", func(t *testing.T) {
+		a.BaseBackupCompleted("other", base)
+		require.Nil(t, a.archive(ctx))
+		assert.Empty(t, a.manifest.Bases)
+		assert.Equal(t, 0, a.manifest.Generation)
+	})
+
+	t.Run("KeptForRetention", func(t *testing.T) {
+		a.BaseBackupCompleted("mem", base)
+		appendLog("def")
+		require.Nil(t, a.archive(ctx))
+		assert.Equal(t, 1, a.manifest.Generation)
+		assert.Equal(t, base.StartedAt, a.manifest.Bases["article"])
+		require.Len(t, a.manifest.Segments, 2)
+		assert.Equal(t, 1, a.manifest.Segments[1].Generation)
+		assert.True(t, strings.HasPrefix(a.manifest.Segments[1].Key, "1/"))
+		assert.Empty(t, backend.deleted)
+	})
+
+	t.Run("PrunedAfterRetention", func(t *testing.T) {
+		a.retention = 0
+		require.Nil(t, a.archive(ctx))
+		// the segment written after the base backup is still needed
+		require.Len(t, a.manifest.Segments, 1)
+		assert.Equal(t, 1, a.manifest.Segments[0].Generation)
+		assert.True(t, a.manifest.Pruned["article"].Before(base.StartedAt))
+		assert.Equal(t, []string{"wal-archive/node1/0"}, backend.deleted)
+
+		m := archiveManifest{}
+		store, _ := archiveStore(memDeleterProvider{backend}, "mem", "node1")
+		require.Nil(t, store.meta(ctx, archiveManifestFile, "", "", &m))
+		assert.Equal(t, a.manifest.Segments, m.Segments)
+	})
+
+	t.Run("OlderBackupsAreNotReplayable", func(t *testing.T) {
+		assert.Nil(t, a.manifest.replayableSince(base))
+		older := &backup.BackupDescriptor{StartedAt: base.StartedAt.Add(-time.Hour), Classes: base.Classes}
+		assert.NotNil(t, a.manifest.replayableSince(older))
+		other := &backup.BackupDescriptor{StartedAt: older.StartedAt, Classes: []backup.ClassDescriptor{{Name: "Paragraph"}}}
+		assert.Nil(t, a.manifest.replayableSince(other))
+	})
+}
+
+func TestWALReplayerReplayable(t *testing.T) {
+	var (
+		dir       = t.TempDir()
+		startedAt = time.Now()
+		logger, _ = test.NewNullLogger()
+		r         = newWALReplayer(dir, nil, startedAt, logger)
+		before    = startedAt.Add(-time.Second)
+		after     = startedAt.Add(time.Second)
+	)
+
+	wal := func(ts time.Time) string {
+		return filepath.Join(dir, fmt.Sprintf("segment-%d.wal", ts.UnixNano()))
+	}
+	hnsw := func(ts time.Time) string {
+		return filepath.Join(dir, fmt.Sprintf("%d", ts.Unix()))
+	}
+
+	assert.False(t, r.replayable(wal(before)))
+	assert.True(t, r.replayable(wal(after)))
+	assert.False(t, r.replayable(hnsw(before)))
+	assert.True(t, r.replayable(hnsw(after)))
+
+	// flushed or condensed logs are contained in the backup
+	require.Nil(t, os.WriteFile(strings.TrimSuffix(wal(after), ".wal")+".db", nil, 0o644))
+	assert.False(t, r.replayable(wal(after)))
+	require.Nil(t, os.WriteFile(hnsw(after)+".condensed", nil, 0o644))
+	assert.False(t, r.replayable(hnsw(after)))
+}
+
+func TestAppendAt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log")
+	require.Nil(t, appendAt(path, 0, bytes.NewReader([]byte("abc"))))
+	// overlapping data is skipped
+	require.Nil(t, appendAt(path, 1, bytes.NewReader([]byte("bcde"))))
+	data, err := os.ReadFile(path)
+	require.Nil(t, err)
+	assert.Equal(t, "abcde", string(data))
+
+	// data in between is missing
+	assert.NotNil(t, appendAt(path, 10, bytes.NewReader([]byte("x"))))
+}
@@ -0,0 +1,238 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/backup"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+var errArchiveNotFound = errors.New("no commit log archive found")
+
+// loadArchiveManifest returns the manifest of the commit logs archived by node
+func loadArchiveManifest(ctx context.Context, backends BackupBackendProvider, backend, node string) (objectStore, *archiveManifest, error) {
+	store, err := archiveStore(backends, backend, node)
+	if err != nil {
+		return store, nil, err
+	}
+	m := &archiveManifest{}
+	if err := store.meta(ctx, archiveManifestFile, "", "", m); err != nil {
+		if errors.As(err, &backup.ErrNotFound{}) {
+			return store, nil, fmt.Errorf("%w for node %s", errArchiveNotFound, node)
+		}
+		return store, nil, fmt.Errorf("get manifest: %w", err)
+	}
+	return store, m, nil
+}
+
+// replayableSince checks that no segment needed to replay the classes of the
+// backup has been pruned, i.e. the backup is one of the newest base backups
+func (m *archiveManifest) replayableSince(desc *backup.BackupDescriptor) error {
+	for _, class := range desc.List() {
+		if pruned := m.Pruned[strings.ToLower(class)]; pruned.After(desc.StartedAt) {
+			return fmt.Errorf("commit logs of class %s archived until %s were pruned, "+
+				"only newer backups can be restored to a point in time", class, pruned.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+// segmentsBetween returns the segments containing writes after from up to
+// until. Restores stop at the last segment before until, writes archived
+// in a later segment are not replayed even if they happened before until.
+func (m *archiveManifest) segmentsBetween(from, until time.Time) []archiveSegment {
+	var segments []archiveSegment
+	for _, s := range m.Segments {
+		if s.Time.After(from) && !s.Time.After(until) {
+			segments = append(segments, s)
+		}
+	}
+	return segments
+}
+
+// walReplayer applies archived commit logs to restored classes in the temp directory
+type walReplayer struct {
+	tempDir string
+	// classes maps index directories to the names of restored classes
+	classes map[string]string
+	// startedAt is the start time of the backup, older commit logs are contained in the backup
+	startedAt time.Time
+	logger    logrus.FieldLogger
+}
+
+func newWALReplayer(tempDir string, classes []string, startedAt time.Time, logger logrus.FieldLogger) *walReplayer {
+	m := make(map[string]string, len(classes))
+	for _, c := range classes {
+		m[strings.ToLower(c)] = c
+	}
+	return &walReplayer{tempDir: tempDir, classes: m, startedAt: startedAt, logger: logger}
+}
+
+// replay downloads the given segments in order and applies their entries
+func (p *walReplayer) replay(ctx context.Context, store objectStore, segments []archiveSegment) error {
+	for _, s := range segments {
+		pr, pw := io.Pipe()
+		enterrors.GoWrapper(func() {
+			_, err := store.Read(ctx, s.Key, "", "", pw)
+			pw.CloseWithError(err)
+		}, p.logger)
+		err := p.apply(pr)
+		pr.CloseWithError(err)
+		if err != nil {
+			return fmt.Errorf("replay segment %s: %w", s.Key, err)
+		}
+	}
+	return nil
+}
+
+// apply applies all entries of a single gzipped segment
+func (p *walReplayer) apply(r io.Reader) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("gzip.NewReader: %w", err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read tar header: %w", err)
+		}
+		if err := p.applyEntry(hdr, tr); err != nil {
+			return fmt.Errorf("%s: %w", hdr.Name, err)
+		}
+	}
+}
+
+func (p *walReplayer) applyEntry(hdr *tar.Header, r io.Reader) error {
+	rel := filepath.FromSlash(hdr.Name)
+	parts := strings.SplitN(rel, string(filepath.Separator), 3)
+	if len(parts) < 3 {
+		return nil
+	}
+	class, ok := p.classes[parts[0]]
+	if !ok {
+		return nil
+	}
+	// only shards restored on this node are replayed
+	if _, err := os.Stat(filepath.Join(p.tempDir, class, parts[0], parts[1])); err != nil {
+		return nil
+	}
+	dst := filepath.Join(p.tempDir, class, rel)
+
+	switch hdr.PAXRecords[paxKind] {
+	case archiveKindState:
+		return writeFile(dst, r)
+	case archiveKindLog:
+		if !p.replayable(dst) {
+			return nil
+		}
+		offset, err := strconv.ParseInt(hdr.PAXRecords[paxOffset], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid offset: %w", err)
+		}
+		return appendAt(dst, offset, r)
+	default:
+		return fmt.Errorf("unknown entry kind %q", hdr.PAXRecords[paxKind])
+	}
+}
+
+// replayable reports whether the commit log at path has been created after the
+// backup started and has not been compacted into a file contained in the backup
+func (p *walReplayer) replayable(path string) bool {
+	name := filepath.Base(path)
+	if strings.HasSuffix(name, ".wal") { // lsmkv write-ahead log
+		ts, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, "segment-"), ".wal"), 10, 64)
+		if err != nil || ts < p.startedAt.UnixNano() {
+			return false
+		}
+		return !fileExists(strings.TrimSuffix(path, ".wal") + ".db")
+	}
+	// hnsw commit log
+	ts, err := strconv.ParseInt(name, 10, 64)
+	if err != nil || ts < p.startedAt.Unix() {
+		return false
+	}
+	return !fileExists(path + ".condensed")
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// appendAt writes the data read from r at offset to the file at path.
+// Data which is already present is skipped, since commit logs are append-only.
+func appendAt(path string, offset int64, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	size := info.Size()
+	if offset > size {
+		return fmt.Errorf("missing data: file has %d bytes but archive continues at %d", size, offset)
+	}
+	if _, err := io.CopyN(io.Discard, r, size-offset); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	if _, err := f.Seek(size, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// writeFile replaces the file at path with the content read from r
+func writeFile(path string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
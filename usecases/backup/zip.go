@@ -176,6 +176,7 @@ func (z *zip) lastWritten() int64 {
 
 type unzip struct {
 	destPath   string
+	filter     func(name string) bool // optional, skips entries it rejects
 	gzr        *gzip.Reader
 	r          *tar.Reader
 	pipeReader *io.PipeReader
@@ -233,6 +234,9 @@ func (u *unzip) ReadChunk() (written int64, err error) {
 		if header == nil {
 			continue
 		}
+		if u.filter != nil && !u.filter(header.Name) {
+			continue
+		}
 
 		// target file
 		target := filepath.Join(u.destPath, header.Name)
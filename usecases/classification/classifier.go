@@ -65,6 +65,7 @@ type Classifier struct {
 	distancer             distancer
 	modulesProvider       ModulesProvider
 	logger                logrus.FieldLogger
+	continuous            *continuousClassifications
 }
 
 type ModulesProvider interface {
@@ -86,6 +87,9 @@ func New(sg schemaUC.SchemaGetter, cr Repo, vr vectorRepo, authorizer authorizat
 		distancer:             libvectorizer.NormalizedDistance,
 		vectorClassSearchRepo: newVectorClassSearchRepo(vr),
 		modulesProvider:       modulesProvider,
+		continuous: &continuousClassifications{
+			byClass: map[string]*continuousClassification{},
+		},
 	}
 }
 
@@ -94,11 +98,19 @@ func New(sg schemaUC.SchemaGetter, cr Repo, vr vectorRepo, authorizer authorizat
 type Repo interface {
 	Put(ctx context.Context, classification models.Classification) error
 	Get(ctx context.Context, id strfmt.UUID) (*models.Classification, error)
+	List(ctx context.Context) ([]*models.Classification, error)
+	// PutModel stores a trained model, replacing any previous model of the
+	// same class and property
+	PutModel(ctx context.Context, model Model) error
+	// GetModels returns the latest trained model per property of a class
+	GetModels(ctx context.Context, class string) ([]*Model, error)
 }
 
 type VectorRepo interface {
 	GetUnclassified(ctx context.Context, class string,
 		properties []string, propertiesToReturn []string, filter *libfilters.LocalFilter) ([]search.Result, error)
+	GetClassified(ctx context.Context, class string,
+		properties []string, filter *libfilters.LocalFilter) ([]search.Result, error)
 	AggregateNeighbors(ctx context.Context, vector []float32,
 		class string, properties []string, k int,
 		filter *libfilters.LocalFilter) ([]NeighborRef, error)
@@ -106,6 +118,9 @@ type VectorRepo interface {
 	ZeroShotSearch(ctx context.Context, vector []float32,
 		class string, properties []string,
 		filter *libfilters.LocalFilter) ([]search.Result, error)
+	Object(ctx context.Context, class string, id strfmt.UUID,
+		props search.SelectProperties, additional additional.Properties,
+		repl *additional.ReplicationProperties, tenant string) (*search.Result, error)
 }
 
 type vectorRepo interface {
@@ -204,7 +219,7 @@ func (c *Classifier) extractFilters(params models.Classification) (Filters, erro
 }
 
 func (c *Classifier) validateFilters(params *models.Classification, filters *classificationFilters) (err error) {
-	if params.Type == TypeKNN {
+	if params.Type == TypeKNN || params.Type == TypeLogistic {
 		if err = c.validateFilter(filters.Source()); err != nil {
 			return fmt.Errorf("invalid sourceWhere: %s", err)
 		}
@@ -264,6 +279,13 @@ func (c *Classifier) parseAndSetDefaults(params *models.Classification) error {
 		return nil
 	}
 
+	if params.Type == TypeLogistic {
+		if err := c.parseLogisticSettings(params); err != nil {
+			return errors.Wrapf(err, "parse logistic specific settings")
+		}
+		return nil
+	}
+
 	if c.modulesProvider != nil {
 		if err := c.modulesProvider.ParseClassifierSettings(params.Type, params); err != nil {
 			return errors.Wrapf(err, "parse %s specific settings", params.Type)
@@ -311,6 +333,112 @@ func (params *ParamsKNN) SetDefaults() {
 	}
 }
 
+func (c *Classifier) parseLogisticSettings(params *models.Classification) error {
+	raw := params.Settings
+	settings := &ParamsLogistic{}
+	if raw == nil {
+		settings.SetDefaults()
+		params.Settings = settings
+		return nil
+	}
+
+	asMap, ok := raw.(map[string]interface{})
+	if !ok {
+		return errors.Errorf("settings must be an object got %T", raw)
+	}
+
+	epochs, err := extractNumberFromMap(asMap, "epochs")
+	if err != nil {
+		return err
+	}
+	settings.Epochs = epochs
+
+	learningRate, err := extractFloatFromMap(asMap, "learningRate")
+	if err != nil {
+		return err
+	}
+	settings.LearningRate = learningRate
+
+	minConfidence, err := extractFloatFromMap(asMap, "minConfidence")
+	if err != nil {
+		return err
+	}
+	settings.MinConfidence = minConfidence
+
+	if unparsed, present := asMap["classifyOnInsert"]; present {
+		parsed, ok := unparsed.(bool)
+		if !ok {
+			return errors.Errorf("settings.classifyOnInsert must be boolean, got %T", unparsed)
+		}
+		settings.ClassifyOnInsert = &parsed
+	}
+
+	settings.SetDefaults()
+	if *settings.Epochs < 1 {
+		return errors.Errorf("settings.epochs must be at least 1, got %d", *settings.Epochs)
+	}
+	if *settings.LearningRate <= 0 {
+		return errors.Errorf("settings.learningRate must be greater than 0, got %v", *settings.LearningRate)
+	}
+	if *settings.MinConfidence < 0 || *settings.MinConfidence > 1 {
+		return errors.Errorf("settings.minConfidence must be between 0 and 1, got %v", *settings.MinConfidence)
+	}
+	params.Settings = settings
+
+	return nil
+}
+
+type ParamsLogistic struct {
+	Epochs        *int32   `json:"epochs"`
+	LearningRate  *float64 `json:"learningRate"`
+	MinConfidence *float64 `json:"minConfidence"`
+	// ClassifyOnInsert controls whether the trained model is used to set the
+	// classified properties of objects which are added without them
+	ClassifyOnInsert *bool `json:"classifyOnInsert"`
+}
+
+func (params *ParamsLogistic) SetDefaults() {
+	if params.Epochs == nil {
+		defaultEpochs := int32(50)
+		params.Epochs = &defaultEpochs
+	}
+
+	if params.LearningRate == nil {
+		defaultLearningRate := 0.1
+		params.LearningRate = &defaultLearningRate
+	}
+
+	if params.MinConfidence == nil {
+		defaultMinConfidence := 0.0
+		params.MinConfidence = &defaultMinConfidence
+	}
+
+	if params.ClassifyOnInsert == nil {
+		defaultClassifyOnInsert := false
+		params.ClassifyOnInsert = &defaultClassifyOnInsert
+	}
+}
+
+func extractFloatFromMap(in map[string]interface{}, field string) (*float64, error) {
+	unparsed, present := in[field]
+	if present {
+		parsed, ok := unparsed.(json.Number)
+		if !ok {
+			return nil, errors.Errorf("settings.%s must be number, got %T",
+				field, unparsed)
+		}
+
+		asFloat64, err := parsed.Float64()
+		if err != nil {
+			return nil, errors.Wrapf(err, "settings.%s", field)
+		}
+
+		return &asFloat64, nil
+	}
+
+	return nil, nil
+}
+
 func extractNumberFromMap(in map[string]interface{}, field string) (*int32, error) {
 	unparsed, present := in[field]
 	if present {
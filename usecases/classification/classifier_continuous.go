@@ -0,0 +1,522 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package classification
+
+import (
+	"context"
+	"encoding/json"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// the contents of this file deal with continuous classifications, which keep
+// classifying the objects of a class that are written after the initial run
+// completed. Written objects are queued in memory and classified in batches
+// using the same workers as a regular run.
+
+const (
+	// continuousSyncInterval is how often the persisted classifications are
+	// checked for continuous classifications which were scheduled on other
+	// nodes, have been replaced, or whose class has been deleted
+	continuousSyncInterval  = 10 * time.Second
+	continuousFlushInterval = 1 * time.Second
+	continuousBatchSize     = 1000
+	// continuousMaxPending bounds the memory used by queued objects. Once
+	// exceeded, the queue is dropped in favor of searching for all
+	// unclassified objects of the class.
+	continuousMaxPending = 100_000
+)
+
+// IsContinuous returns whether the classification keeps classifying written
+// objects after its initial run
+func IsContinuous(params models.Classification) bool {
+	return params.Continuous != nil && *params.Continuous
+}
+
+type pendingObject struct {
+	id      strfmt.UUID
+	tenant  string
+	written time.Time
+}
+
+type continuousClassification struct {
+	params       models.Classification
+	filters      Filters
+	classifyItem ClassifyItemFn
+
+	sync.Mutex
+	pending []pendingObject
+	// overflowed is set if the queue was dropped, overflowedAt is the time
+	// the oldest dropped object was written
+	overflowed   bool
+	overflowedAt time.Time
+	// processingSince is the time the oldest object of the batch which is
+	// currently being classified was written
+	processingSince time.Time
+
+	wake   chan struct{}
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func (cc *continuousClassification) enqueue(obj pendingObject) {
+	cc.Lock()
+	switch {
+	case cc.overflowed:
+		// the object will be found by the search for unclassified objects
+	case len(cc.pending) >= continuousMaxPending:
+		cc.overflowed = true
+		cc.overflowedAt = cc.pending[0].written
+		cc.pending = nil
+	default:
+		cc.pending = append(cc.pending, obj)
+	}
+	cc.Unlock()
+
+	select {
+	case cc.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (cc *continuousClassification) stop() {
+	cc.cancel()
+	<-cc.done
+}
+
+// lag returns for how long the oldest queued or currently classified object
+// has been waiting, as well as the number of queued objects
+func (cc *continuousClassification) lag() (time.Duration, int) {
+	cc.Lock()
+	defer cc.Unlock()
+
+	var oldest time.Time
+	for _, t := range []time.Time{cc.processingSince, cc.overflowedAt} {
+		if !t.IsZero() && (oldest.IsZero() || t.Before(oldest)) {
+			oldest = t
+		}
+	}
+	if len(cc.pending) > 0 && (oldest.IsZero() || cc.pending[0].written.Before(oldest)) {
+		oldest = cc.pending[0].written
+	}
+
+	if oldest.IsZero() {
+		return 0, len(cc.pending)
+	}
+	return time.Since(oldest), len(cc.pending)
+}
+
+type continuousClassifications struct {
+	sync.RWMutex
+	byClass map[string]*continuousClassification
+}
+
+// ClassifyAfterWrite queues a written object to be classified by the
+// continuous classification of its class. It is a no-op if the class has
+// none. Objects are classified again when they are updated, unless the
+// written properties set any of the classified properties themselves.
+func (c *Classifier) ClassifyAfterWrite(class string, id strfmt.UUID, tenant string,
+	properties interface{},
+) {
+	c.continuous.RLock()
+	cc, ok := c.continuous.byClass[class]
+	c.continuous.RUnlock()
+	if !ok || setsAny(properties, cc.params.ClassifyProperties) {
+		return
+	}
+
+	cc.enqueue(pendingObject{id: id, tenant: tenant, written: time.Now()})
+}
+
+// startContinuous starts classifying written objects of the class,
+// replacing the class's previous continuous classification, if any
+func (c *Classifier) startContinuous(params models.Classification, filters Filters,
+	classifyItem ClassifyItemFn,
+) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cc := &continuousClassification{
+		params:       params,
+		filters:      filters,
+		classifyItem: classifyItem,
+		wake:         make(chan struct{}, 1),
+		cancel:       cancel,
+		done:         make(chan struct{}),
+	}
+
+	c.continuous.Lock()
+	prev := c.continuous.byClass[params.Class]
+	c.continuous.byClass[params.Class] = cc
+	c.continuous.Unlock()
+
+	if prev != nil {
+		prev.stop()
+		// objects written in the meantime still need to be classified
+		prev.Lock()
+		cc.Lock()
+		cc.pending = append(prev.pending, cc.pending...)
+		cc.overflowed = cc.overflowed || prev.overflowed
+		cc.overflowedAt = prev.overflowedAt
+		cc.Unlock()
+		prev.Unlock()
+	}
+
+	c.logBase(params, "continuous_classification_start").
+		Info("continuous classification started")
+	enterrors.GoWrapper(func() { c.runContinuous(ctx, cc) }, c.logger)
+}
+
+func (c *Classifier) stopContinuous(class string) {
+	c.continuous.Lock()
+	cc, ok := c.continuous.byClass[class]
+	delete(c.continuous.byClass, class)
+	c.continuous.Unlock()
+	if !ok {
+		return
+	}
+
+	cc.stop()
+	metrics := monitoring.GetMetrics()
+	metrics.ContinuousClassificationLag.DeleteLabelValues(class)
+	metrics.ContinuousClassificationQueueSize.DeleteLabelValues(class)
+	c.logBase(cc.params, "continuous_classification_stop").
+		Info("continuous classification stopped")
+}
+
+func (c *Classifier) runContinuous(ctx context.Context, cc *continuousClassification) {
+	defer close(cc.done)
+
+	ticker := time.NewTicker(continuousFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-cc.wake:
+		case <-ticker.C:
+		}
+
+		for c.classifyPending(ctx, cc) {
+		}
+		c.reportContinuousLag(cc)
+	}
+}
+
+// classifyPending classifies a single batch of written objects and returns
+// whether there are more to be classified
+func (c *Classifier) classifyPending(ctx context.Context, cc *continuousClassification) bool {
+	items, sweep, err := c.takePending(ctx, cc)
+	if err != nil {
+		c.logExecutionError("continuous_classification_fetch", err, cc.params)
+	}
+	if len(items) == 0 {
+		return false
+	}
+	c.reportContinuousLag(cc)
+
+	workerCount := runtime.GOMAXPROCS(0)
+	if len(items) < workerCount {
+		workerCount = len(items)
+	}
+
+	workers := newRunWorkers(workerCount, cc.classifyItem, cc.params, cc.filters, c.vectorRepo, c.logger)
+	workers.addJobs(items)
+	res := workers.work(ctx)
+
+	metrics := monitoring.GetMetrics()
+	metrics.ContinuousClassificationObjects.WithLabelValues(cc.params.Class, "succeeded").
+		Add(float64(res.successCount))
+	metrics.ContinuousClassificationObjects.WithLabelValues(cc.params.Class, "failed").
+		Add(float64(res.errorCount))
+	if res.err != nil {
+		c.logExecutionError("continuous_classification_run", res.err, cc.params)
+	}
+
+	cc.Lock()
+	cc.processingSince = time.Time{}
+	if sweep && res.successCount > 0 {
+		// the search is limited, there might be more unclassified objects. As
+		// objects which can't be classified are found again, only search again
+		// if progress was made.
+		cc.overflowed = true
+	}
+	more := cc.overflowed || len(cc.pending) > 0
+	cc.Unlock()
+
+	return more && ctx.Err() == nil
+}
+
+// takePending returns the next batch of written objects. If the queue
+// overflowed, it searches for all unclassified objects of the class instead,
+// in which case updated objects which were classified before are missed.
+func (c *Classifier) takePending(ctx context.Context,
+	cc *continuousClassification,
+) ([]search.Result, bool, error) {
+	cc.Lock()
+	if cc.overflowed {
+		cc.processingSince = cc.overflowedAt
+		cc.overflowed = false
+		cc.overflowedAt = time.Time{}
+		cc.Unlock()
+
+		items, err := c.vectorRepo.GetUnclassified(ctx, cc.params.Class,
+			cc.params.ClassifyProperties, cc.params.BasedOnProperties, nil)
+		return items, true, err
+	}
+
+	n := len(cc.pending)
+	if n > continuousBatchSize {
+		n = continuousBatchSize
+	}
+	batch := make([]pendingObject, n)
+	copy(batch, cc.pending)
+	cc.pending = cc.pending[n:]
+	if len(cc.pending) == 0 {
+		cc.pending = nil
+	}
+	if n > 0 {
+		cc.processingSince = batch[0].written
+	}
+	cc.Unlock()
+
+	seen := make(map[strfmt.UUID]struct{}, n)
+	items := make([]search.Result, 0, n)
+	for _, obj := range batch {
+		if _, ok := seen[obj.id]; ok {
+			continue
+		}
+
+		item, err := c.vectorRepo.Object(ctx, cc.params.Class, obj.id, nil,
+			additional.Properties{Vector: true, Classification: true}, nil, obj.tenant)
+		if err != nil {
+			return items, false, errors.Wrapf(err, "get object %s", obj.id)
+		}
+		// the object might have been deleted in the meantime. If it was
+		// written again, the later write either queued it again or set the
+		// classified properties itself.
+		if item == nil || item.Updated > obj.written.UnixMilli() {
+			continue
+		}
+		seen[obj.id] = struct{}{}
+		items = append(items, *item)
+	}
+
+	return items, false, nil
+}
+
+// setsAny returns whether the written properties contain a value for any of
+// the given properties
+func setsAny(written interface{}, properties []string) bool {
+	props, ok := written.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	for _, prop := range properties {
+		switch value := props[prop].(type) {
+		case nil:
+		case models.MultipleRef:
+			if len(value) > 0 {
+				return true
+			}
+		case []interface{}:
+			if len(value) > 0 {
+				return true
+			}
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Classifier) reportContinuousLag(cc *continuousClassification) {
+	lag, size := cc.lag()
+	metrics := monitoring.GetMetrics()
+	metrics.ContinuousClassificationLag.WithLabelValues(cc.params.Class).Set(lag.Seconds())
+	metrics.ContinuousClassificationQueueSize.WithLabelValues(cc.params.Class).Set(float64(size))
+}
+
+// RunContinuousClassifications keeps the continuous classifications running
+// on this node in sync with the persisted classifications until ctx is done.
+// A continuous classification is scheduled on a single node, but objects can
+// be written through any node, so every node needs to run it.
+func (c *Classifier) RunContinuousClassifications(ctx context.Context) {
+	ticker := time.NewTicker(continuousSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.syncContinuous(ctx); err != nil {
+			c.logger.WithField("action", "continuous_classification_sync").
+				WithError(err).Error("could not sync continuous classifications")
+		}
+
+		select {
+		case <-ctx.Done():
+			c.continuous.RLock()
+			classes := make([]string, 0, len(c.continuous.byClass))
+			for class := range c.continuous.byClass {
+				classes = append(classes, class)
+			}
+			c.continuous.RUnlock()
+			for _, class := range classes {
+				c.stopContinuous(class)
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Classifier) syncContinuous(ctx context.Context) error {
+	all, err := c.repo.List(ctx)
+	if err != nil {
+		return errors.Wrap(err, "list classifications")
+	}
+
+	// the most recently started continuous classification of a class wins
+	latest := map[string]*models.Classification{}
+	for _, params := range all {
+		if !IsContinuous(*params) || params.Status != models.ClassificationStatusCompleted {
+			continue
+		}
+		if cur, ok := latest[params.Class]; !ok || startedAt(*params).After(startedAt(*cur)) {
+			latest[params.Class] = params
+		}
+	}
+
+	c.continuous.RLock()
+	running := make(map[string]models.Classification, len(c.continuous.byClass))
+	for class, cc := range c.continuous.byClass {
+		running[class] = cc.params
+	}
+	c.continuous.RUnlock()
+
+	for class := range running {
+		if c.schemaGetter.ReadOnlyClass(class) == nil {
+			c.stopContinuous(class)
+		}
+	}
+
+	for class, params := range latest {
+		if c.schemaGetter.ReadOnlyClass(class) == nil {
+			continue
+		}
+
+		// a classification which was started on this node, might not have been
+		// persisted as completed yet
+		if cur, ok := running[class]; ok && (cur.ID == params.ID ||
+			!startedAt(*params).After(startedAt(cur))) {
+			continue
+		}
+
+		if err := c.resumeContinuous(ctx, *params); err != nil {
+			c.logExecutionError("continuous_classification_resume", err, *params)
+		}
+	}
+
+	return nil
+}
+
+func (c *Classifier) resumeContinuous(ctx context.Context, params models.Classification) error {
+	if err := restoreSettings(&params); err != nil {
+		return errors.Wrap(err, "restore settings")
+	}
+
+	filters, err := c.extractFilters(params)
+	if err != nil {
+		return errors.Wrap(err, "extract filters")
+	}
+
+	classifyItem, err := c.prepareContinuous(ctx, params, filters)
+	if err != nil {
+		return errors.Wrap(err, "prepare classification")
+	}
+
+	c.startContinuous(params, filters, classifyItem)
+	return nil
+}
+
+// prepareContinuous reuses the models trained by a logistic classification
+// instead of training them again
+func (c *Classifier) prepareContinuous(ctx context.Context, params models.Classification,
+	filters Filters,
+) (ClassifyItemFn, error) {
+	if params.Type == TypeLogistic {
+		stored, err := c.repo.GetModels(ctx, params.Class)
+		if err != nil {
+			return nil, errors.Wrap(err, "get models")
+		}
+
+		var trained []*Model
+		for _, model := range stored {
+			if model.ClassificationID == params.ID {
+				trained = append(trained, model)
+			}
+		}
+
+		if len(trained) == len(params.ClassifyProperties) {
+			return func(item search.Result, itemIndex int, params models.Classification,
+				filters Filters, writer Writer,
+			) error {
+				return c.classifyItemUsingModels(item, params, trained, writer)
+			}, nil
+		}
+	}
+
+	return c.prepareRun(ctx, params, filters, nil)
+}
+
+// restoreSettings turns the settings of a persisted classification back
+// into their type-specific representation
+func restoreSettings(params *models.Classification) error {
+	var settings interface {
+		SetDefaults()
+	}
+	switch params.Type {
+	case TypeKNN, "":
+		settings = &ParamsKNN{}
+	case TypeLogistic:
+		settings = &ParamsLogistic{}
+	default:
+		return nil
+	}
+
+	if params.Settings != nil {
+		raw, err := json.Marshal(params.Settings)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(raw, settings); err != nil {
+			return err
+		}
+	}
+	settings.SetDefaults()
+	params.Settings = settings
+	return nil
+}
+
+func startedAt(params models.Classification) time.Time {
+	if params.Meta == nil {
+		return time.Time{}
+	}
+	return time.Time(params.Meta.Started)
+}
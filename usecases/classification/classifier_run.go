@@ -46,14 +46,16 @@ func (c *Classifier) run(params models.Classification,
 		return
 	}
 
-	if len(unclassifiedItems) == 0 {
+	// continuous classifications are started regardless, as they are mostly
+	// about objects which are written later on
+	if len(unclassifiedItems) == 0 && !IsContinuous(params) {
 		c.failRunWithError(params,
 			fmt.Errorf("no classes to be classified - did you run a previous classification already?"))
 		return
 	}
 	c.logItemsFetched(params, unclassifiedItems)
 
-	classifyItem, err := c.prepareRun(params, filters, unclassifiedItems)
+	classifyItem, err := c.prepareRun(ctx, params, filters, unclassifiedItems)
 	if err != nil {
 		c.failRunWithError(params, errors.Wrap(err, "prepare classification"))
 		return
@@ -65,6 +67,10 @@ func (c *Classifier) run(params models.Classification,
 		return
 	}
 
+	if IsContinuous(params) {
+		c.startContinuous(params, filters, classifyItem)
+	}
+
 	c.succeedRun(params)
 }
 
@@ -85,7 +91,7 @@ func (c *Classifier) monitorClassification(ctx context.Context, cancelFn context
 	}
 }
 
-func (c *Classifier) prepareRun(params models.Classification, filters Filters,
+func (c *Classifier) prepareRun(ctx context.Context, params models.Classification, filters Filters,
 	unclassifiedItems []search.Result,
 ) (ClassifyItemFn, error) {
 	c.logBeginPreparation(params)
@@ -99,6 +105,10 @@ func (c *Classifier) prepareRun(params models.Classification, filters Filters,
 		return c.classifyItemUsingZeroShot, nil
 	}
 
+	if params.Type == TypeLogistic {
+		return c.prepareLogistic(ctx, params, filters)
+	}
+
 	if c.modulesProvider != nil {
 		classifyItemFn, err := c.modulesProvider.GetClassificationFn(params.Class, params.Type,
 			c.getClassifyParams(params, filters, unclassifiedItems))
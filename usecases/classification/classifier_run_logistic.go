@@ -0,0 +1,203 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package classification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// prepareLogistic trains one model per classify property on the labelled
+// objects and persists them, so they can be reused to classify objects on
+// insert
+func (c *Classifier) prepareLogistic(ctx context.Context, params models.Classification,
+	filters Filters,
+) (ClassifyItemFn, error) {
+	// this type assertion is safe to make, since we have passed the parsing stage
+	settings := params.Settings.(*ParamsLogistic)
+
+	labelled, err := c.vectorRepo.GetClassified(ctx, params.Class,
+		params.ClassifyProperties, filters.TrainingSet())
+	if err != nil {
+		return nil, errors.Wrap(err, "retrieve labelled objects")
+	}
+
+	trained := make([]*Model, len(params.ClassifyProperties))
+	for i, prop := range params.ClassifyProperties {
+		model, err := trainModel(trainingSamples(labelled, prop),
+			int(*settings.Epochs), *settings.LearningRate)
+		if err != nil {
+			return nil, errors.Wrapf(err, "train model for property '%s'", prop)
+		}
+
+		model.ClassificationID = params.ID
+		model.Class = params.Class
+		model.Property = prop
+		model.MinConfidence = *settings.MinConfidence
+		model.ClassifyOnInsert = *settings.ClassifyOnInsert
+		model.Trained = strfmt.DateTime(time.Now())
+
+		if err := c.repo.PutModel(ctx, *model); err != nil {
+			return nil, errors.Wrapf(err, "store model for property '%s'", prop)
+		}
+		trained[i] = model
+	}
+
+	return func(item search.Result, itemIndex int, params models.Classification,
+		filters Filters, writer Writer,
+	) error {
+		return c.classifyItemUsingModels(item, params, trained, writer)
+	}, nil
+}
+
+func trainingSamples(labelled []search.Result, prop string) []trainingSample {
+	var samples []trainingSample
+	for _, item := range labelled {
+		if len(item.Vector) == 0 {
+			continue
+		}
+
+		props, ok := item.Schema.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		label, ok := props[prop].(string)
+		if !ok || label == "" {
+			continue
+		}
+
+		samples = append(samples, trainingSample{vector: item.Vector, label: label})
+	}
+
+	return samples
+}
+
+func (c *Classifier) classifyItemUsingModels(item search.Result, params models.Classification,
+	trained []*Model, writer Writer,
+) error {
+	var (
+		classified []string
+		confidence []additional.ClassificationConfidence
+	)
+
+	for _, model := range trained {
+		label, prob, err := model.Predict(item.Vector)
+		if err != nil {
+			return fmt.Errorf("classify %s/%s: %v", item.ClassName, item.ID, err)
+		}
+
+		if prob < model.MinConfidence {
+			continue
+		}
+
+		item.Schema.(map[string]interface{})[model.Property] = label
+		classified = append(classified, model.Property)
+		confidence = append(confidence, additional.ClassificationConfidence{
+			Property:   model.Property,
+			Confidence: prob,
+		})
+	}
+
+	c.extendItemWithObjectMeta(&item, params, classified)
+	meta := item.AdditionalProperties["classification"].(additional.Classification)
+	meta.Confidence = confidence
+	item.AdditionalProperties["classification"] = meta
+
+	if err := writer.Store(item); err != nil {
+		return fmt.Errorf("store %s/%s: %v", item.ClassName, item.ID, err)
+	}
+
+	return nil
+}
+
+// ClassifyOnInsert sets the properties of a new object which are missing,
+// but can be predicted by a model trained with classifyOnInsert enabled. It
+// is a no-op for objects without a vector or classes without such models.
+func (c *Classifier) ClassifyOnInsert(ctx context.Context, object *models.Object) error {
+	if len(object.Vector) == 0 {
+		return nil
+	}
+
+	trained, err := c.repo.GetModels(ctx, object.Class)
+	if err != nil {
+		return errors.Wrap(err, "get classification models")
+	}
+	if len(trained) == 0 {
+		return nil
+	}
+
+	class := c.schemaGetter.ReadOnlyClass(object.Class)
+	if class == nil {
+		return nil
+	}
+
+	props, ok := object.Properties.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	meta := additional.Classification{Completed: strfmt.DateTime(time.Now())}
+	for _, model := range trained {
+		// models outlive the schema they were trained on, skip any which no
+		// longer match the class
+		if !model.ClassifyOnInsert || props[model.Property] != nil ||
+			!classHasProperty(class, model.Property) || model.Dimensions() != len(object.Vector) {
+			continue
+		}
+
+		label, prob, err := model.Predict(object.Vector)
+		if err != nil {
+			return errors.Wrapf(err, "classify property '%s'", model.Property)
+		}
+
+		if prob < model.MinConfidence {
+			continue
+		}
+
+		props[model.Property] = label
+		meta.ID = model.ClassificationID
+		meta.Scope = append(meta.Scope, model.Property)
+		meta.ClassifiedFields = append(meta.ClassifiedFields, model.Property)
+		meta.Confidence = append(meta.Confidence, additional.ClassificationConfidence{
+			Property:   model.Property,
+			Confidence: prob,
+		})
+	}
+
+	if len(meta.ClassifiedFields) == 0 {
+		return nil
+	}
+
+	if object.Additional == nil {
+		object.Additional = models.AdditionalProperties{}
+	}
+	object.Additional["classification"] = meta
+
+	return nil
+}
+
+func classHasProperty(class *models.Class, name string) bool {
+	for _, prop := range class.Properties {
+		if prop.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	testhelper "github.com/weaviate/weaviate/test/helper"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
@@ -207,6 +208,235 @@ func Test_Classifier_KNN(t *testing.T) {
 	})
 }
 
+func Test_Classifier_Continuous(t *testing.T) {
+	sg := &fakeSchemaGetter{testSchema()}
+	repo := newFakeClassificationRepo()
+	vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
+	classifier := New(sg, repo, vectorRepo, mocks.NewMockAuthorizer(), newNullLogger(), nil)
+
+	continuous := true
+	params := models.Classification{
+		Class:              "Article",
+		BasedOnProperties:  []string{"description"},
+		ClassifyProperties: []string{"exactCategory", "mainCategory"},
+		Settings: map[string]interface{}{
+			"k": json.Number("1"),
+		},
+		Continuous: &continuous,
+	}
+
+	class, err := classifier.Schedule(context.Background(), nil, params)
+	require.Nil(t, err)
+	waitForStatusToNoLongerBeRunning(t, classifier, class.ID)
+
+	class, err = classifier.Get(context.Background(), nil, class.ID)
+	require.Nil(t, err)
+	require.Equal(t, models.ClassificationStatusCompleted, class.Status)
+
+	t.Run("objects written after the run are classified", func(t *testing.T) {
+		id := strfmt.UUID("4f6d2a3e-46b8-4d7c-a0e4-0d6d2a9c2d31")
+		vectorRepo.put(&models.Object{
+			ID:     id,
+			Class:  "Article",
+			Vector: []float32{0.85, 0, 0},
+			Properties: map[string]interface{}{
+				"description": "The president gave a speech",
+			},
+		})
+		classifier.ClassifyAfterWrite("Article", id, "", map[string]interface{}{
+			"description": "The president gave a speech",
+		})
+
+		testhelper.AssertEventuallyEqual(t, true, func() interface{} {
+			obj, _ := vectorRepo.get(id)
+			_, ok := obj.Properties.(map[string]interface{})["exactCategory"]
+			return ok
+		})
+		checkRef(t, vectorRepo, id.String(), "exactCategory", idCategoryPolitics)
+		checkRef(t, vectorRepo, id.String(), "mainCategory", idMainCategoryPoliticsAndSociety)
+
+		obj, _ := vectorRepo.get(id)
+		require.NotNil(t, obj.Additional["classification"])
+		meta := obj.Additional["classification"].(additional.Classification)
+		assert.Equal(t, class.ID, meta.ID)
+	})
+
+	t.Run("updated objects are classified again", func(t *testing.T) {
+		id := strfmt.UUID("7a1d3c52-0a57-4a0e-9d2b-5f6c1d2e3f40")
+		vectorRepo.put(&models.Object{
+			ID:     id,
+			Class:  "Article",
+			Vector: []float32{0, 0, 0.85},
+			Properties: map[string]interface{}{
+				"description":   "A recipe for bread",
+				"exactCategory": models.MultipleRef{beaconRef(idCategoryPolitics)},
+				"mainCategory":  models.MultipleRef{beaconRef(idMainCategoryPoliticsAndSociety)},
+			},
+		})
+		classifier.ClassifyAfterWrite("Article", id, "", map[string]interface{}{
+			"description": "A recipe for bread",
+		})
+
+		testhelper.AssertEventuallyEqual(t, "weaviate://localhost/"+idCategoryFoodAndDrink, func() interface{} {
+			obj, _ := vectorRepo.get(id)
+			refs, _ := obj.Properties.(map[string]interface{})["exactCategory"].(models.MultipleRef)
+			if len(refs) != 1 {
+				return nil
+			}
+			return refs[0].Beacon.String()
+		})
+		checkRef(t, vectorRepo, id.String(), "mainCategory", idMainCategoryFoodAndDrink)
+	})
+
+	t.Run("updates setting the classified properties are kept", func(t *testing.T) {
+		classifier.ClassifyAfterWrite("Article", idCategoryPolitics, "", map[string]interface{}{
+			"exactCategory": models.MultipleRef{beaconRef(idCategorySociety)},
+		})
+		_, size := classifier.continuous.byClass["Article"].lag()
+		assert.Zero(t, size)
+	})
+
+	t.Run("objects of other classes are ignored", func(t *testing.T) {
+		classifier.ClassifyAfterWrite("ExactCategory", idCategoryPolitics, "", nil)
+		lag, size := classifier.continuous.byClass["Article"].lag()
+		assert.Zero(t, size)
+		assert.Less(t, lag, time.Second)
+	})
+
+	t.Run("another node resumes the persisted classification", func(t *testing.T) {
+		other := New(sg, repo, vectorRepo, mocks.NewMockAuthorizer(), newNullLogger(), nil)
+		require.Nil(t, other.syncContinuous(context.Background()))
+		defer other.stopContinuous("Article")
+
+		other.continuous.RLock()
+		cc, ok := other.continuous.byClass["Article"]
+		other.continuous.RUnlock()
+		require.True(t, ok)
+		assert.Equal(t, class.ID, cc.params.ID)
+		assert.IsType(t, &ParamsKNN{}, cc.params.Settings)
+	})
+
+	classifier.stopContinuous("Article")
+	assert.Empty(t, classifier.continuous.byClass)
+}
+
+func Test_Classifier_Logistic(t *testing.T) {
+	sg := &fakeSchemaGetter{testSchema()}
+	repo := newFakeClassificationRepo()
+	authorizer := mocks.NewMockAuthorizer()
+	vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataTopicLabelled())
+	classifier := New(sg, repo, vectorRepo, authorizer, newNullLogger(), nil)
+
+	params := models.Classification{
+		Class:              "Article",
+		BasedOnProperties:  []string{"description"},
+		ClassifyProperties: []string{"topic"},
+		Type:               TypeLogistic,
+		Settings: map[string]interface{}{
+			"epochs":           json.Number("200"),
+			"learningRate":     json.Number("0.5"),
+			"classifyOnInsert": true,
+		},
+	}
+
+	var id strfmt.UUID
+	t.Run("scheduling a classification", func(t *testing.T) {
+		class, err := classifier.Schedule(context.Background(), nil, params)
+		require.Nil(t, err, "should not error")
+		require.NotNil(t, class)
+		id = class.ID
+	})
+
+	waitForStatusToNoLongerBeRunning(t, classifier, id)
+
+	t.Run("status is now completed", func(t *testing.T) {
+		class, err := classifier.Get(context.Background(), nil, id)
+		require.Nil(t, err)
+		require.NotNil(t, class)
+		assert.Equal(t, models.ClassificationStatusCompleted, class.Status, class.Error)
+		assert.Equal(t, int64(6), class.Meta.CountSucceeded)
+	})
+
+	t.Run("the classifier set the topics with their confidence", func(t *testing.T) {
+		expected := map[strfmt.UUID]string{
+			"75ba35af-6a08-40ae-b442-3bec69b355f9": "politics",
+			"f850439a-d3cd-4f17-8fbf-5a64405645cd": "politics",
+			"a2bbcbdc-76e1-477d-9e72-a6d2cfb50109": "society",
+			"069410c3-4b9e-4f68-8034-32a066cb7997": "society",
+			"06a1e824-889c-4649-97f9-1ed3fa401d8e": "food",
+			"6402e649-b1e0-40ea-b192-a64eab0d5e56": "food",
+		}
+
+		for id, topic := range expected {
+			obj, ok := vectorRepo.get(id)
+			require.True(t, ok, "object must be stored")
+			assert.Equal(t, topic, obj.Properties.(map[string]interface{})["topic"])
+
+			meta := obj.Additional["classification"].(additional.Classification)
+			assert.Equal(t, []string{"topic"}, meta.ClassifiedFields)
+			require.Len(t, meta.Confidence, 1)
+			assert.Equal(t, "topic", meta.Confidence[0].Property)
+			assert.Greater(t, meta.Confidence[0].Confidence, 0.5)
+		}
+	})
+
+	t.Run("the trained model was persisted", func(t *testing.T) {
+		trained, err := repo.GetModels(context.Background(), "Article")
+		require.Nil(t, err)
+		require.Len(t, trained, 1)
+		assert.Equal(t, id, trained[0].ClassificationID)
+		assert.Equal(t, "topic", trained[0].Property)
+		assert.Equal(t, []string{"food", "politics", "society"}, trained[0].Labels)
+		assert.True(t, trained[0].ClassifyOnInsert)
+	})
+
+	t.Run("new objects are classified on insert", func(t *testing.T) {
+		object := &models.Object{
+			Class:      "Article",
+			Vector:     []float32{0, 0.7, 0.1},
+			Properties: map[string]interface{}{"description": "Tom Hanks is an actor"},
+		}
+		require.Nil(t, classifier.ClassifyOnInsert(context.Background(), object))
+
+		assert.Equal(t, "society", object.Properties.(map[string]interface{})["topic"])
+		meta := object.Additional["classification"].(additional.Classification)
+		assert.Equal(t, id, meta.ID)
+		assert.Equal(t, []string{"topic"}, meta.ClassifiedFields)
+	})
+
+	t.Run("existing values are not overwritten on insert", func(t *testing.T) {
+		object := &models.Object{
+			Class:      "Article",
+			Vector:     []float32{0, 0.7, 0.1},
+			Properties: map[string]interface{}{"topic": "movies"},
+		}
+		require.Nil(t, classifier.ClassifyOnInsert(context.Background(), object))
+
+		assert.Equal(t, "movies", object.Properties.(map[string]interface{})["topic"])
+		assert.Nil(t, object.Additional)
+	})
+
+	t.Run("objects with vectors of a different length are skipped on insert", func(t *testing.T) {
+		object := &models.Object{
+			Class:      "Article",
+			Vector:     []float32{0, 0.7},
+			Properties: map[string]interface{}{},
+		}
+		require.Nil(t, classifier.ClassifyOnInsert(context.Background(), object))
+		assert.Nil(t, object.Properties.(map[string]interface{})["topic"])
+	})
+
+	t.Run("with invalid settings", func(t *testing.T) {
+		params := params
+		params.Settings = map[string]interface{}{
+			"minConfidence": json.Number("2"),
+		}
+		_, err := classifier.Schedule(context.Background(), nil, params)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "settings.minConfidence must be between 0 and 1")
+	})
+}
+
 func Test_Classifier_Custom_Classifier(t *testing.T) {
 	var id strfmt.UUID
 	// so we can reuse it for follow up requests, such as checking the status
@@ -95,12 +95,14 @@ func (f *fakeSchemaGetter) Statistics() map[string]any {
 
 type fakeClassificationRepo struct {
 	sync.Mutex
-	db map[strfmt.UUID]models.Classification
+	db     map[strfmt.UUID]models.Classification
+	models map[string]map[string]Model
 }
 
 func newFakeClassificationRepo() *fakeClassificationRepo {
 	return &fakeClassificationRepo{
-		db: map[strfmt.UUID]models.Classification{},
+		db:     map[strfmt.UUID]models.Classification{},
+		models: map[string]map[string]Model{},
 	}
 }
 
@@ -124,6 +126,41 @@ func (f *fakeClassificationRepo) Get(ctx context.Context, id strfmt.UUID) (*mode
 	return &class, nil
 }
 
+func (f *fakeClassificationRepo) List(ctx context.Context) ([]*models.Classification, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	var out []*models.Classification
+	for _, class := range f.db {
+		class := class
+		out = append(out, &class)
+	}
+	return out, nil
+}
+
+func (f *fakeClassificationRepo) PutModel(ctx context.Context, model Model) error {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.models[model.Class] == nil {
+		f.models[model.Class] = map[string]Model{}
+	}
+	f.models[model.Class][model.Property] = model
+	return nil
+}
+
+func (f *fakeClassificationRepo) GetModels(ctx context.Context, class string) ([]*Model, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	var out []*Model
+	for _, model := range f.models[class] {
+		model := model
+		out = append(out, &model)
+	}
+	return out, nil
+}
+
 func newFakeVectorRepoKNN(unclassified, classified search.Results) *fakeVectorRepoKNN {
 	return &fakeVectorRepoKNN{
 		unclassified: unclassified,
@@ -152,6 +189,14 @@ func (f *fakeVectorRepoKNN) GetUnclassified(ctx context.Context,
 	return f.unclassified, nil
 }
 
+func (f *fakeVectorRepoKNN) GetClassified(ctx context.Context,
+	class string, properties []string, filter *libfilters.LocalFilter,
+) ([]search.Result, error) {
+	f.Lock()
+	defer f.Unlock()
+	return f.classified, nil
+}
+
 func (f *fakeVectorRepoKNN) AggregateNeighbors(ctx context.Context, vector []float32,
 	class string, properties []string, k int,
 	filter *libfilters.LocalFilter,
@@ -234,6 +279,41 @@ func (f *fakeVectorRepoKNN) BatchPutObjects(ctx context.Context, objects objects
 	return objects, nil
 }
 
+func (f *fakeVectorRepoKNN) Object(ctx context.Context, class string, id strfmt.UUID,
+	props search.SelectProperties, additional additional.Properties,
+	repl *additional.ReplicationProperties, tenant string,
+) (*search.Result, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	obj, ok := f.db[id]
+	if !ok {
+		return nil, nil
+	}
+
+	// like the real repo, return a copy which is safe to modify
+	schema := map[string]interface{}{}
+	for key, value := range obj.Properties.(map[string]interface{}) {
+		schema[key] = value
+	}
+
+	return &search.Result{
+		ID:                   obj.ID,
+		ClassName:            obj.Class,
+		Tenant:               obj.Tenant,
+		Vector:               obj.Vector,
+		Schema:               schema,
+		AdditionalProperties: obj.Additional,
+	}, nil
+}
+
+// put simulates an object written by a user, as opposed to the classifier
+func (f *fakeVectorRepoKNN) put(obj *models.Object) {
+	f.Lock()
+	defer f.Unlock()
+	f.db[obj.ID] = obj
+}
+
 func (f *fakeVectorRepoKNN) get(id strfmt.UUID) (*models.Object, bool) {
 	f.Lock()
 	defer f.Unlock()
@@ -273,6 +353,19 @@ func (f *fakeVectorRepoContextual) GetUnclassified(ctx context.Context,
 	return f.unclassified, nil
 }
 
+func (f *fakeVectorRepoContextual) GetClassified(ctx context.Context,
+	class string, properties []string, filter *libfilters.LocalFilter,
+) ([]search.Result, error) {
+	panic("not implemented")
+}
+
+func (f *fakeVectorRepoContextual) Object(ctx context.Context, class string, id strfmt.UUID,
+	props search.SelectProperties, additional additional.Properties,
+	repl *additional.ReplicationProperties, tenant string,
+) (*search.Result, error) {
+	panic("not implemented")
+}
+
 func (f *fakeVectorRepoContextual) AggregateNeighbors(ctx context.Context, vector []float32,
 	class string, properties []string, k int,
 	filter *libfilters.LocalFilter,
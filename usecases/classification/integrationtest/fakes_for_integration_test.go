@@ -34,6 +34,7 @@ import (
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/classification"
 	"github.com/weaviate/weaviate/usecases/cluster/mocks"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -138,12 +139,14 @@ func singleShardState() *sharding.State {
 
 type fakeClassificationRepo struct {
 	sync.Mutex
-	db map[strfmt.UUID]models.Classification
+	db     map[strfmt.UUID]models.Classification
+	models map[string]map[string]classification.Model
 }
 
 func newFakeClassificationRepo() *fakeClassificationRepo {
 	return &fakeClassificationRepo{
-		db: map[strfmt.UUID]models.Classification{},
+		db:     map[strfmt.UUID]models.Classification{},
+		models: map[string]map[string]classification.Model{},
 	}
 }
 
@@ -167,6 +170,41 @@ func (f *fakeClassificationRepo) Get(ctx context.Context, id strfmt.UUID) (*mode
 	return &class, nil
 }
 
+func (f *fakeClassificationRepo) List(ctx context.Context) ([]*models.Classification, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	var out []*models.Classification
+	for _, class := range f.db {
+		class := class
+		out = append(out, &class)
+	}
+	return out, nil
+}
+
+func (f *fakeClassificationRepo) PutModel(ctx context.Context, model classification.Model) error {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.models[model.Class] == nil {
+		f.models[model.Class] = map[string]classification.Model{}
+	}
+	f.models[model.Class][model.Property] = model
+	return nil
+}
+
+func (f *fakeClassificationRepo) GetModels(ctx context.Context, class string) ([]*classification.Model, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	var out []*classification.Model
+	for _, model := range f.models[class] {
+		model := model
+		out = append(out, &model)
+	}
+	return out, nil
+}
+
 func testSchema() schema.Schema {
 	return schema.Schema{
 		Objects: &models.Schema{
@@ -412,7 +450,7 @@ func (f *fakeRemoteClient) Exists(ctx context.Context, hostName, indexName,
 }
 
 func (f *fakeRemoteClient) DeleteObject(ctx context.Context, hostName, indexName,
-	shardName string, id strfmt.UUID, schemaVersion uint64,
+	shardName string, id strfmt.UUID, ifMatch *int64, schemaVersion uint64,
 ) error {
 	return nil
 }
@@ -510,6 +548,10 @@ func (f *fakeRemoteNodeClient) GetStatistics(ctx context.Context, hostName strin
 	return &models.Statistics{}, nil
 }
 
+func (f *fakeRemoteNodeClient) GetTenantUsage(ctx context.Context, hostName, className, tenant string) (*models.TenantUsage, error) {
+	return &models.TenantUsage{}, nil
+}
+
 type fakeReplicationClient struct{}
 
 var _ replica.Client = (*fakeReplicationClient)(nil)
@@ -521,7 +563,7 @@ func (f *fakeReplicationClient) PutObject(ctx context.Context, host, index, shar
 }
 
 func (f *fakeReplicationClient) DeleteObject(ctx context.Context, host, index, shard, requestID string,
-	id strfmt.UUID, schemaVersion uint64,
+	id strfmt.UUID, ifMatch *int64, schemaVersion uint64,
 ) (replica.SimpleResponse, error) {
 	return replica.SimpleResponse{}, nil
 }
@@ -606,3 +648,8 @@ func (c *fakeReplicationClient) HashTreeLevel(ctx context.Context, host, index,
 ) (digests []hashtree.Digest, err error) {
 	return nil, nil
 }
+
+func (c *fakeReplicationClient) AppliedWatermark(ctx context.Context, host, index, shard string,
+) (int64, error) {
+	return 0, nil
+}
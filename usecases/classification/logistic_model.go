@@ -0,0 +1,194 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package classification
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// Model is a multinomial logistic regression model which was trained on the
+// vectors of labelled objects to predict the value of a single text property.
+// Models are persisted, so that they can be used to classify objects on
+// insert long after the classification that trained them has completed.
+type Model struct {
+	ClassificationID strfmt.UUID `json:"classificationId"`
+	Class            string      `json:"class"`
+	Property         string      `json:"property"`
+
+	// Labels are the distinct property values seen during training, the
+	// index of a label matches the row of its weights
+	Labels  []string    `json:"labels"`
+	Weights [][]float32 `json:"weights"`
+	Bias    []float32   `json:"bias"`
+
+	MinConfidence    float64         `json:"minConfidence"`
+	ClassifyOnInsert bool            `json:"classifyOnInsert"`
+	Trained          strfmt.DateTime `json:"trained"`
+}
+
+// Dimensions returns the length of the vectors the model was trained on
+func (m *Model) Dimensions() int {
+	if len(m.Weights) == 0 {
+		return 0
+	}
+	return len(m.Weights[0])
+}
+
+// Predict returns the most likely label for the vector alongside its
+// probability
+func (m *Model) Predict(vector []float32) (string, float64, error) {
+	if len(vector) != m.Dimensions() {
+		return "", 0, fmt.Errorf("model for %s.%s expects vectors of length %d, got %d",
+			m.Class, m.Property, m.Dimensions(), len(vector))
+	}
+
+	probs := m.probabilities(normalizeVector(vector), make([]float64, len(m.Labels)))
+	best := 0
+	for i := range probs {
+		if probs[i] > probs[best] {
+			best = i
+		}
+	}
+
+	return m.Labels[best], probs[best], nil
+}
+
+// probabilities calculates the softmax over all labels into out
+func (m *Model) probabilities(vector []float32, out []float64) []float64 {
+	max := math.Inf(-1)
+	for i := range m.Weights {
+		logit := float64(m.Bias[i])
+		for j, w := range m.Weights[i] {
+			logit += float64(w * vector[j])
+		}
+		out[i] = logit
+		if logit > max {
+			max = logit
+		}
+	}
+
+	sum := 0.0
+	for i := range out {
+		out[i] = math.Exp(out[i] - max)
+		sum += out[i]
+	}
+	for i := range out {
+		out[i] /= sum
+	}
+
+	return out
+}
+
+type trainingSample struct {
+	vector []float32
+	label  string
+}
+
+// trainModel fits a model using stochastic gradient descent on the
+// cross-entropy loss. Vectors are normalized to unit length before training
+// and prediction, so that the learning rate does not depend on the magnitude
+// of the vectors produced by a specific vectorizer.
+func trainModel(samples []trainingSample, epochs int, learningRate float64) (*Model, error) {
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("no labelled objects with vectors found")
+	}
+
+	dims := len(samples[0].vector)
+	if dims == 0 {
+		return nil, fmt.Errorf("labelled objects have no vectors")
+	}
+
+	labelIndex := map[string]int{}
+	for _, sample := range samples {
+		if len(sample.vector) != dims {
+			return nil, fmt.Errorf("labelled objects have vectors of different lengths %d and %d",
+				dims, len(sample.vector))
+		}
+		labelIndex[sample.label] = 0
+	}
+	if len(labelIndex) < 2 {
+		return nil, fmt.Errorf("need at least two distinct values in labelled objects, got %d",
+			len(labelIndex))
+	}
+
+	model := &Model{
+		Labels:  make([]string, 0, len(labelIndex)),
+		Weights: make([][]float32, len(labelIndex)),
+		Bias:    make([]float32, len(labelIndex)),
+	}
+	for label := range labelIndex {
+		model.Labels = append(model.Labels, label)
+	}
+	// sort labels, so that training is deterministic for the same input
+	sort.Strings(model.Labels)
+	for i, label := range model.Labels {
+		labelIndex[label] = i
+		model.Weights[i] = make([]float32, dims)
+	}
+
+	vectors := make([][]float32, len(samples))
+	for i, sample := range samples {
+		vectors[i] = normalizeVector(sample.vector)
+	}
+
+	order := make([]int, len(samples))
+	for i := range order {
+		order[i] = i
+	}
+	rnd := rand.New(rand.NewSource(int64(len(samples))))
+	probs := make([]float64, len(model.Labels))
+
+	for epoch := 0; epoch < epochs; epoch++ {
+		rnd.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] })
+		for _, pos := range order {
+			vector := vectors[pos]
+			target := labelIndex[samples[pos].label]
+			model.probabilities(vector, probs)
+
+			for i := range model.Weights {
+				gradient := probs[i]
+				if i == target {
+					gradient -= 1
+				}
+				step := float32(learningRate * gradient)
+				for j := range model.Weights[i] {
+					model.Weights[i][j] -= step * vector[j]
+				}
+				model.Bias[i] -= step
+			}
+		}
+	}
+
+	return model, nil
+}
+
+func normalizeVector(in []float32) []float32 {
+	var sum float64
+	for _, v := range in {
+		sum += float64(v * v)
+	}
+	if sum == 0 {
+		return in
+	}
+
+	norm := float32(math.Sqrt(sum))
+	out := make([]float32, len(in))
+	for i, v := range in {
+		out[i] = v / norm
+	}
+	return out
+}
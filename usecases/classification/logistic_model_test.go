@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package classification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrainModel(t *testing.T) {
+	samples := []trainingSample{
+		{vector: []float32{1, 0.1}, label: "a"},
+		{vector: []float32{0.9, 0}, label: "a"},
+		{vector: []float32{2, 0.3}, label: "a"},
+		{vector: []float32{0.1, 1}, label: "b"},
+		{vector: []float32{0, 0.8}, label: "b"},
+		{vector: []float32{0.2, 3}, label: "b"},
+	}
+
+	model, err := trainModel(samples, 100, 0.5)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, model.Labels)
+	assert.Equal(t, 2, model.Dimensions())
+
+	t.Run("predicts labels of the training data", func(t *testing.T) {
+		for _, sample := range samples {
+			label, confidence, err := model.Predict(sample.vector)
+			require.Nil(t, err)
+			assert.Equal(t, sample.label, label)
+			assert.Greater(t, confidence, 0.5)
+		}
+	})
+
+	t.Run("is less confident close to the boundary", func(t *testing.T) {
+		_, far, err := model.Predict([]float32{1, 0})
+		require.Nil(t, err)
+		_, near, err := model.Predict([]float32{1, 0.9})
+		require.Nil(t, err)
+		assert.Less(t, near, far)
+	})
+
+	t.Run("training is deterministic", func(t *testing.T) {
+		again, err := trainModel(samples, 100, 0.5)
+		require.Nil(t, err)
+		assert.Equal(t, model, again)
+	})
+
+	t.Run("rejects vectors of a different length", func(t *testing.T) {
+		_, _, err := model.Predict([]float32{1, 0, 0})
+		assert.ErrorContains(t, err, "expects vectors of length 2, got 3")
+	})
+}
+
+func TestTrainModel_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name     string
+		samples  []trainingSample
+		expected string
+	}{
+		{
+			name:     "no samples",
+			expected: "no labelled objects with vectors found",
+		},
+		{
+			name: "single label",
+			samples: []trainingSample{
+				{vector: []float32{1, 0}, label: "a"},
+				{vector: []float32{0, 1}, label: "a"},
+			},
+			expected: "need at least two distinct values in labelled objects, got 1",
+		},
+		{
+			name: "different vector lengths",
+			samples: []trainingSample{
+				{vector: []float32{1, 0}, label: "a"},
+				{vector: []float32{0, 1, 0}, label: "b"},
+			},
+			expected: "labelled objects have vectors of different lengths 2 and 3",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := trainModel(test.samples, 10, 0.1)
+			assert.ErrorContains(t, err, test.expected)
+		})
+	}
+}
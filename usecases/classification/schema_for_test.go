@@ -62,6 +62,11 @@ func testSchema() schema.Schema {
 							Name:     "words",
 							DataType: schema.DataTypeInt.PropString(),
 						},
+						{
+							Name:         "topic",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationField,
+						},
 					},
 				},
 			},
@@ -123,6 +128,27 @@ func testDataToBeClassified() search.Results {
 	}
 }
 
+// text labels for the "topic" property, using the same vector positions as
+// testDataToBeClassified
+func testDataTopicLabelled() search.Results {
+	labelled := search.Results{}
+	for i, topic := range []string{"politics", "society", "food"} {
+		for _, value := range []float32{0.6, 0.8, 1} {
+			vector := []float32{0.1, 0.1, 0.1}
+			vector[i] = value
+			labelled = append(labelled, search.Result{
+				ClassName: "Article",
+				Vector:    vector,
+				Schema: map[string]interface{}{
+					"description": "labelled " + topic,
+					"topic":       topic,
+				},
+			})
+		}
+	}
+	return labelled
+}
+
 const (
 	idMainCategoryPoliticsAndSociety = "39c6abe3-4bbe-4c4e-9e60-ca5e99ec6b4e"
 	idMainCategoryFoodAndDrink       = "5a3d909a-4f0d-4168-8f5c-cd3074d1e79a"
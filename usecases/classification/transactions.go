@@ -19,18 +19,27 @@ import (
 	"github.com/weaviate/weaviate/usecases/cluster"
 )
 
-const TransactionPut cluster.TransactionType = "put_single"
+const (
+	TransactionPut      cluster.TransactionType = "put_single"
+	TransactionPutModel cluster.TransactionType = "put_model"
+)
 
 type TransactionPutPayload struct {
 	Classification models.Classification `json:"classification"`
 }
 
+type TransactionPutModelPayload struct {
+	Model Model `json:"model"`
+}
+
 func UnmarshalTransaction(txType cluster.TransactionType,
 	payload json.RawMessage,
 ) (interface{}, error) {
 	switch txType {
 	case TransactionPut:
 		return unmarshalPut(payload)
+	case TransactionPutModel:
+		return unmarshalPutModel(payload)
 
 	default:
 		return nil, errors.Errorf("unrecognized schema transaction type %q", txType)
@@ -46,3 +55,12 @@ func unmarshalPut(payload json.RawMessage) (interface{}, error) {
 
 	return pl, nil
 }
+
+func unmarshalPutModel(payload json.RawMessage) (interface{}, error) {
+	var pl TransactionPutModelPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
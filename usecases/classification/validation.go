@@ -23,6 +23,7 @@ const (
 	TypeKNN        = "knn"
 	TypeContextual = "text2vec-contextionary-contextual"
 	TypeZeroShot   = "zeroshot"
+	TypeLogistic   = "logistic"
 )
 
 type Validator struct {
@@ -64,6 +65,8 @@ func (v *Validator) validate() {
 
 	v.contextualTypeFeasibility()
 	v.knnTypeFeasibility()
+	v.logisticTypeFeasibility()
+	v.continuousFeasibility()
 	v.basedOnProperties(class)
 	v.classifyProperties(class)
 }
@@ -88,6 +91,30 @@ func (v *Validator) knnTypeFeasibility() {
 	}
 }
 
+func (v *Validator) logisticTypeFeasibility() {
+	if !v.typeLogistic() {
+		return
+	}
+
+	if v.subject.Filters != nil && v.subject.Filters.TargetWhere != nil {
+		v.errors.Addf("type is 'logistic', but 'targetWhere' filter is set, for 'logistic' you cannot limit target data directly, instead limit training data through setting 'trainingSetWhere'")
+	}
+}
+
+func (v *Validator) continuousFeasibility() {
+	if !IsContinuous(v.subject) {
+		return
+	}
+
+	if !v.typeKNN() && v.subject.Type != TypeZeroShot && !v.typeLogistic() {
+		v.errors.Addf("type is '%s', but only types 'knn', 'zeroshot' and 'logistic' can be continuous", v.subject.Type)
+	}
+
+	if v.subject.Filters != nil && v.subject.Filters.SourceWhere != nil {
+		v.errors.Addf("classification is continuous, but 'sourceWhere' filter is set, continuous classifications classify every written object")
+	}
+}
+
 func (v *Validator) basedOnProperties(class *models.Class) {
 	if len(v.subject.BasedOnProperties) == 0 {
 		v.errors.Addf("basedOnProperties must have at least one property")
@@ -153,6 +180,13 @@ func (v *Validator) classifyProperty(class *models.Class, propName string) {
 		return
 	}
 
+	if v.typeLogistic() {
+		if !dt.IsPrimitive() || dt.AsPrimitive() != schema.DataTypeText {
+			v.errors.Addf("classifyProperties: property '%s' must be of type 'text' for classification of type 'logistic'", propName)
+		}
+		return
+	}
+
 	if !dt.IsReference() {
 		v.errors.Addf("classifyProperties: property '%s' must be of reference type (cref)", propName)
 		return
@@ -192,3 +226,7 @@ func (v *Validator) typeKNN() bool {
 
 	return v.subject.Type == TypeKNN
 }
+
+func (v *Validator) typeLogistic() bool {
+	return v.subject.Type == TypeLogistic
+}
@@ -173,6 +173,70 @@ func Test_ValidateUserInput(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("invalid classification: type is 'text2vec-contextionary-contextual', but 'trainingSetWhere' filter is set, for 'text2vec-contextionary-contextual' there is no training data, instead limit possible target data directly through setting 'targetWhere'"),
 		},
+
+		// specific for logistic
+		{
+			name: "classifyProperty is not of type text",
+			input: models.Classification{
+				Class:              "Article",
+				BasedOnProperties:  []string{"description"},
+				ClassifyProperties: []string{"exactCategory"},
+				Type:               "logistic",
+			},
+			expectedError: fmt.Errorf("invalid classification: classifyProperties: property 'exactCategory' must be of type 'text' for classification of type 'logistic'"),
+		},
+
+		{
+			name: "targetWhere is set for logistic",
+			input: models.Classification{
+				Class:              "Article",
+				BasedOnProperties:  []string{"description"},
+				ClassifyProperties: []string{"topic"},
+				Filters: &models.ClassificationFilters{
+					TargetWhere: &models.WhereFilter{Operator: "Equal", Path: []string{"foo"}, ValueText: ptString("bar")},
+				},
+				Type: "logistic",
+			},
+			expectedError: fmt.Errorf("invalid classification: type is 'logistic', but 'targetWhere' filter is set, for 'logistic' you cannot limit target data directly, instead limit training data through setting 'trainingSetWhere'"),
+		},
+
+		{
+			name: "valid logistic classification",
+			input: models.Classification{
+				Class:              "Article",
+				BasedOnProperties:  []string{"description"},
+				ClassifyProperties: []string{"topic"},
+				Type:               "logistic",
+			},
+			expectedError: nil,
+		},
+
+		// specific for continuous
+		{
+			name: "continuous with a type that cannot be continuous",
+			input: models.Classification{
+				Class:              "Article",
+				BasedOnProperties:  []string{"description"},
+				ClassifyProperties: []string{"exactCategory"},
+				Type:               "text2vec-contextionary-contextual",
+				Continuous:         ptBool(true),
+			},
+			expectedError: fmt.Errorf("invalid classification: type is 'text2vec-contextionary-contextual', but only types 'knn', 'zeroshot' and 'logistic' can be continuous"),
+		},
+
+		{
+			name: "continuous with sourceWhere",
+			input: models.Classification{
+				Class:              "Article",
+				BasedOnProperties:  []string{"description"},
+				ClassifyProperties: []string{"exactCategory"},
+				Filters: &models.ClassificationFilters{
+					SourceWhere: &models.WhereFilter{Operator: "Equal", Path: []string{"foo"}, ValueText: ptString("bar")},
+				},
+				Continuous: ptBool(true),
+			},
+			expectedError: fmt.Errorf("invalid classification: classification is continuous, but 'sourceWhere' filter is set, continuous classifications classify every written object"),
+		},
 	}
 
 	for _, test := range tests {
@@ -188,3 +252,7 @@ func Test_ValidateUserInput(t *testing.T) {
 func ptString(in string) *string {
 	return &in
 }
+
+func ptBool(in bool) *bool {
+	return &in
+}
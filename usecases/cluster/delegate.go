@@ -119,6 +119,24 @@ type delegate struct {
 
 	mutex    sync.Mutex
 	hostInfo NodeInfo
+
+	// joinListeners are notified about nodes joining the cluster
+	joinListeners []func(node string)
+}
+
+func (d *delegate) addJoinListener(f func(node string)) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.joinListeners = append(d.joinListeners, f)
+}
+
+func (d *delegate) notifyJoin(node string) {
+	d.mutex.Lock()
+	listeners := d.joinListeners
+	d.mutex.Unlock()
+	for _, f := range listeners {
+		f(node)
+	}
 }
 
 func (d *delegate) setOwnSpace(x DiskUsage) {
@@ -291,7 +309,11 @@ type events struct {
 
 // NotifyJoin is invoked when a node is detected to have joined.
 // The Node argument must not be modified.
-func (e events) NotifyJoin(*memberlist.Node) {}
+func (e events) NotifyJoin(node *memberlist.Node) {
+	if node != nil {
+		e.d.notifyJoin(node.Name)
+	}
+}
 
 // NotifyLeave is invoked when a node is detected to have left.
 // The Node argument must not be modified.
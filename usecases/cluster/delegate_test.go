@@ -226,8 +226,12 @@ func TestDelegateCleanUp(t *testing.T) {
 	assert.True(t, ok, "N0 must exist")
 	st.delegate.set("N1", NodeInfo{LastTimeMilli: 1})
 	st.delegate.set("N2", NodeInfo{LastTimeMilli: 2})
+	var joined []string
+	st.OnJoin(func(node string) { joined = append(joined, node) })
 	handler := events{&st.delegate}
 	handler.NotifyJoin(nil)
+	handler.NotifyJoin(&memberlist.Node{Name: "N3"})
+	assert.Equal(t, []string{"N3"}, joined)
 	handler.NotifyUpdate(nil)
 	handler.NotifyLeave(&memberlist.Node{Name: "N0"})
 	handler.NotifyLeave(&memberlist.Node{Name: "N1"})
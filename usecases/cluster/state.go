@@ -156,6 +156,13 @@ func Init(userConfig Config, dataPath string, nonStorageNodes map[string]struct{
 	return &state, nil
 }
 
+// OnJoin registers f to be called with the name of each node which joins the
+// cluster, this includes nodes which rejoin after they have been unavailable.
+// f is called synchronously by memberlist and must not block.
+func (s *State) OnJoin(f func(node string)) {
+	s.delegate.addJoinListener(f)
+}
+
 // Hostnames for all live members, except self. Use AllHostnames to include
 // self, prefixes the data port.
 func (s *State) Hostnames() []string {
@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+)
+
+const (
+	// AuditOperationRead covers requests which only read data, e.g. queries
+	// and object lookups
+	AuditOperationRead = "read"
+	// AuditOperationWrite covers requests which create, update or delete data
+	AuditOperationWrite = "write"
+	// AuditOperationAdmin covers schema, tenant, backup, cluster and other
+	// administrative requests
+	AuditOperationAdmin = "admin"
+
+	AuditSinkStdout = "stdout"
+	AuditSinkFile   = "file"
+
+	DefaultAuditFileMaxSizeMB  = 100
+	DefaultAuditFileMaxBackups = 5
+)
+
+// Audit configures the audit log. Every audited request produces a single
+// structured event which is written to all configured sinks.
+type Audit struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// Operations limits the audit log to the given operation classes, if
+	// empty all classes are audited
+	Operations []string  `json:"operations" yaml:"operations"`
+	Sinks      []string  `json:"sinks" yaml:"sinks"`
+	File       AuditFile `json:"file" yaml:"file"`
+}
+
+// AuditFile configures the file sink of the audit log. The file is rotated
+// once it grows beyond MaxSizeMB, keeping at most MaxBackups rotated files.
+// A MaxSizeMB of 0 falls back to DefaultAuditFileMaxSizeMB.
+type AuditFile struct {
+	Path       string `json:"path" yaml:"path"`
+	MaxSizeMB  int    `json:"max_size_mb" yaml:"max_size_mb"`
+	MaxBackups int    `json:"max_backups" yaml:"max_backups"`
+}
+
+func (a Audit) Validate() error {
+	if !a.Enabled {
+		return nil
+	}
+
+	for _, op := range a.Operations {
+		switch op {
+		case AuditOperationRead, AuditOperationWrite, AuditOperationAdmin:
+		default:
+			return fmt.Errorf("audit: unknown operation class %q, must be one of %q, %q or %q",
+				op, AuditOperationRead, AuditOperationWrite, AuditOperationAdmin)
+		}
+	}
+
+	if len(a.Sinks) == 0 {
+		return fmt.Errorf("audit: at least one sink must be configured")
+	}
+	for _, sink := range a.Sinks {
+		switch sink {
+		case AuditSinkStdout:
+		case AuditSinkFile:
+			if a.File.Path == "" {
+				return fmt.Errorf("audit: file sink requires a path")
+			}
+			if a.File.MaxSizeMB < 0 {
+				return fmt.Errorf("audit: file max size must not be negative, got %d", a.File.MaxSizeMB)
+			}
+			if a.File.MaxBackups < 0 {
+				return fmt.Errorf("audit: file max backups must not be negative, got %d", a.File.MaxBackups)
+			}
+		default:
+			return fmt.Errorf("audit: unknown sink %q, must be one of %q or %q",
+				sink, AuditSinkStdout, AuditSinkFile)
+		}
+	}
+
+	return nil
+}
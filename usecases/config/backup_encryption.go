@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+)
+
+// BackupEncryptionKMSFile is a file based stand-in for a key management
+// service. It reads a keyring with named keys, one of which is active.
+const BackupEncryptionKMSFile = "file"
+
+// BackupEncryption configures client-side envelope encryption of backups.
+// Every chunk and descriptor is encrypted with its own data key, which is
+// wrapped either by the key in KeyFile or by a key management service.
+type BackupEncryption struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// KeyFile holds a single 32 byte key, raw or base64 encoded
+	KeyFile string `json:"key_file" yaml:"key_file"`
+	// KMS names the key management service to use instead of KeyFile
+	KMS        string `json:"kms" yaml:"kms"`
+	KMSKeyring string `json:"kms_keyring" yaml:"kms_keyring"`
+	// AllowUnencrypted allows restoring backups which were created before
+	// encryption was enabled. Their objects are not authenticated, so anyone
+	// with write access to the backup backend could tamper with them.
+	AllowUnencrypted bool `json:"allow_unencrypted" yaml:"allow_unencrypted"`
+}
+
+func (b BackupEncryption) Validate() error {
+	if !b.Enabled {
+		return nil
+	}
+
+	switch {
+	case b.KeyFile != "" && b.KMS != "":
+		return fmt.Errorf("backup encryption: key file and kms are mutually exclusive")
+	case b.KeyFile != "":
+		return nil
+	case b.KMS == BackupEncryptionKMSFile:
+		if b.KMSKeyring == "" {
+			return fmt.Errorf("backup encryption: kms %q requires a keyring", b.KMS)
+		}
+		return nil
+	case b.KMS != "":
+		return fmt.Errorf("backup encryption: unknown kms %q, must be %q", b.KMS, BackupEncryptionKMSFile)
+	default:
+		return fmt.Errorf("backup encryption: either a key file or a kms must be configured")
+	}
+}
@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultBackupWALArchiveInterval is how often new commit log data is
+// uploaded if BackupWALArchive.Interval is not set.
+const DefaultBackupWALArchiveInterval = time.Minute
+
+// DefaultBackupWALArchiveRetention is how long archived commit logs are kept
+// after a newer backup contains them if BackupWALArchive.Retention is not set.
+const DefaultBackupWALArchiveRetention = 7 * 24 * time.Hour
+
+// BackupWALArchive configures the continuous archiving of commit logs to a
+// backup backend. On restore the archived logs can be replayed on top of a
+// backup up to a point in time. Logs are uploaded in segments every interval
+// and restores stop at the last segment before the point in time, so the
+// interval bounds how much data is lost.
+type BackupWALArchive struct {
+	// Backend names the backup backend module, archiving is disabled if empty
+	Backend  string        `json:"backend" yaml:"backend"`
+	Interval time.Duration `json:"interval" yaml:"interval"`
+	// Retention is how long segments are kept once a newer backup on the
+	// archive backend contains all their data. Older backups can't be
+	// restored to a point in time anymore once their segments were pruned.
+	Retention time.Duration `json:"retention" yaml:"retention"`
+}
+
+func (b BackupWALArchive) Enabled() bool {
+	return b.Backend != ""
+}
+
+func (b BackupWALArchive) Validate() error {
+	if b.Enabled() && b.Interval <= 0 {
+		return fmt.Errorf("backup wal archive: interval must be positive, got %v", b.Interval)
+	}
+	if b.Retention < 0 {
+		return fmt.Errorf("backup wal archive: retention must not be negative, got %v", b.Retention)
+	}
+	return nil
+}
@@ -105,6 +105,9 @@ type Config struct {
 	Cluster                             cluster.Config           `json:"cluster" yaml:"cluster"`
 	Replication                         replication.GlobalConfig `json:"replication" yaml:"replication"`
 	Monitoring                          monitoring.Config        `json:"monitoring" yaml:"monitoring"`
+	Audit                               Audit                    `json:"audit" yaml:"audit"`
+	BackupEncryption                    BackupEncryption         `json:"backup_encryption" yaml:"backup_encryption"`
+	BackupWALArchive                    BackupWALArchive         `json:"backup_wal_archive" yaml:"backup_wal_archive"`
 	GRPC                                GRPC                     `json:"grpc" yaml:"grpc"`
 	Profiling                           Profiling                `json:"profiling" yaml:"profiling"`
 	ResourceUsage                       ResourceUsage            `json:"resource_usage" yaml:"resource_usage"`
@@ -228,6 +231,7 @@ type Persistence struct {
 	LSMSegmentsCleanupIntervalSeconds int    `json:"lsmSegmentsCleanupIntervalSeconds" yaml:"lsmSegmentsCleanupIntervalSeconds"`
 	LSMSeparateObjectsCompactions     bool   `json:"lsmSeparateObjectsCompactions" yaml:"lsmSeparateObjectsCompactions"`
 	HNSWMaxLogSize                    int64  `json:"hnswMaxLogSize" yaml:"hnswMaxLogSize"`
+	HNSWSnapshotIntervalSeconds       int    `json:"hnswSnapshotIntervalSeconds" yaml:"hnswSnapshotIntervalSeconds"`
 }
 
 // DefaultPersistenceDataPath is the default location for data directory when no location is provided
@@ -244,6 +248,10 @@ const DefaultPersistenceLSMSegmentsCleanupIntervalSeconds = 0
 
 const DefaultPersistenceHNSWMaxLogSize = 500 * 1024 * 1024 // 500MB for backward compatibility
 
+// DefaultPersistenceHNSWSnapshotIntervalSeconds = 0 for backward compatibility.
+// value = 0 means HNSW snapshots are turned off.
+const DefaultPersistenceHNSWSnapshotIntervalSeconds = 0
+
 // MetadataServer is experimental.
 type MetadataServer struct {
 	// When enabled startup will include a "metadata server"
@@ -501,6 +509,18 @@ func (f *WeaviateConfig) LoadConfig(flags *swag.CommandLineOptionsGroup, logger
 		return configErr(err)
 	}
 
+	if err := f.Config.Audit.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.BackupEncryption.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := f.Config.BackupWALArchive.Validate(); err != nil {
+		return configErr(err)
+	}
+
 	return nil
 }
 
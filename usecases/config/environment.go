@@ -232,6 +232,14 @@ func FromEnv(config *Config) error {
 		config.Persistence.HNSWMaxLogSize = DefaultPersistenceHNSWMaxLogSize
 	}
 
+	if err := parseNonNegativeInt(
+		"PERSISTENCE_HNSW_SNAPSHOT_INTERVAL_SECONDS",
+		func(seconds int) { config.Persistence.HNSWSnapshotIntervalSeconds = seconds },
+		DefaultPersistenceHNSWSnapshotIntervalSeconds,
+	); err != nil {
+		return err
+	}
+
 	if err := parseInt(
 		"HNSW_VISITED_LIST_POOL_MAX_SIZE",
 		DefaultHNSWVisitedListPoolSize,
@@ -271,6 +279,48 @@ func FromEnv(config *Config) error {
 		return err
 	}
 
+	if err := config.parseAuditConfig(); err != nil {
+		return err
+	}
+
+	if entcfg.Enabled(os.Getenv("BACKUP_ENCRYPTION_ENABLED")) {
+		config.BackupEncryption.Enabled = true
+	}
+	if v := os.Getenv("BACKUP_ENCRYPTION_KEY_FILE"); v != "" {
+		config.BackupEncryption.KeyFile = v
+	}
+	if v := os.Getenv("BACKUP_ENCRYPTION_KMS"); v != "" {
+		config.BackupEncryption.KMS = v
+	}
+	if v := os.Getenv("BACKUP_ENCRYPTION_KMS_KEYRING"); v != "" {
+		config.BackupEncryption.KMSKeyring = v
+	}
+	if entcfg.Enabled(os.Getenv("BACKUP_ENCRYPTION_ALLOW_UNENCRYPTED")) {
+		config.BackupEncryption.AllowUnencrypted = true
+	}
+
+	if v := os.Getenv("BACKUP_WAL_ARCHIVE_BACKEND"); v != "" {
+		config.BackupWALArchive.Backend = v
+	}
+	if v := os.Getenv("BACKUP_WAL_ARCHIVE_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse BACKUP_WAL_ARCHIVE_INTERVAL as time.Duration: %w", err)
+		}
+		config.BackupWALArchive.Interval = interval
+	} else if config.BackupWALArchive.Interval == 0 {
+		config.BackupWALArchive.Interval = DefaultBackupWALArchiveInterval
+	}
+	if v := os.Getenv("BACKUP_WAL_ARCHIVE_RETENTION"); v != "" {
+		retention, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse BACKUP_WAL_ARCHIVE_RETENTION as time.Duration: %w", err)
+		}
+		config.BackupWALArchive.Retention = retention
+	} else if config.BackupWALArchive.Retention == 0 {
+		config.BackupWALArchive.Retention = DefaultBackupWALArchiveRetention
+	}
+
 	if v := os.Getenv("ORIGIN"); v != "" {
 		config.Origin = v
 	}
@@ -460,6 +510,24 @@ func FromEnv(config *Config) error {
 		config.Replication.DeletionStrategy = v
 	}
 
+	config.Replication.HintedHandoff.Disabled = entcfg.Enabled(os.Getenv("REPLICATION_HINTED_HANDOFF_DISABLED"))
+
+	if err := parsePositiveInt(
+		"REPLICATION_HINTS_MAX_BYTES_PER_NODE",
+		func(val int) { config.Replication.HintedHandoff.MaxBytesPerNode = int64(val) },
+		DefaultHintsMaxBytesPerNode,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"REPLICATION_HINTS_MAX_AGE_SECONDS",
+		func(val int) { config.Replication.HintedHandoff.MaxAge = time.Second * time.Duration(val) },
+		DefaultHintsMaxAgeSeconds,
+	); err != nil {
+		return err
+	}
+
 	config.DisableTelemetry = false
 	if entcfg.Enabled(os.Getenv("DISABLE_TELEMETRY")) {
 		config.DisableTelemetry = true
@@ -624,6 +692,41 @@ func (c *Config) parseCORSConfig() error {
 	return nil
 }
 
+func (c *Config) parseAuditConfig() error {
+	if !entcfg.Enabled(os.Getenv("AUDIT_LOG_ENABLED")) {
+		return nil
+	}
+	c.Audit.Enabled = true
+
+	parseStringList("AUDIT_LOG_OPERATIONS",
+		func(val []string) { c.Audit.Operations = val }, c.Audit.Operations)
+	parseStringList("AUDIT_LOG_SINKS",
+		func(val []string) { c.Audit.Sinks = val }, c.Audit.Sinks)
+	if len(c.Audit.Sinks) == 0 {
+		c.Audit.Sinks = []string{AuditSinkStdout}
+	}
+
+	if v := os.Getenv("AUDIT_LOG_FILE_PATH"); v != "" {
+		c.Audit.File.Path = v
+	}
+	if err := parsePositiveInt(
+		"AUDIT_LOG_FILE_MAX_SIZE_MB",
+		func(val int) { c.Audit.File.MaxSizeMB = val },
+		DefaultAuditFileMaxSizeMB,
+	); err != nil {
+		return err
+	}
+	if err := parseNonNegativeInt(
+		"AUDIT_LOG_FILE_MAX_BACKUPS",
+		func(val int) { c.Audit.File.MaxBackups = val },
+		DefaultAuditFileMaxBackups,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (c *Config) parseMemtableConfig() error {
 	// first parse old idle name for flush value
 	if err := parsePositiveInt(
@@ -730,6 +833,8 @@ const (
 	DefaultGRPCPort                            = 50051
 	DefaultGRPCMaxMsgSize                      = 10 * 1024 * 1024
 	DefaultMinimumReplicationFactor            = 1
+	DefaultHintsMaxBytesPerNode                = 256 * 1024 * 1024
+	DefaultHintsMaxAgeSeconds                  = 3 * 60 * 60
 )
 
 const VectorizerModuleNone = "none"
@@ -15,6 +15,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -638,6 +639,33 @@ func TestEnvironmentMinimumReplicationFactor(t *testing.T) {
 	}
 }
 
+func TestEnvironmentHintedHandoff(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.Replication.HintedHandoff.Disabled)
+		assert.Equal(t, int64(DefaultHintsMaxBytesPerNode), conf.Replication.HintedHandoff.MaxBytesPerNode)
+		assert.Equal(t, DefaultHintsMaxAgeSeconds*time.Second, conf.Replication.HintedHandoff.MaxAge)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		t.Setenv("REPLICATION_HINTED_HANDOFF_DISABLED", "true")
+		t.Setenv("REPLICATION_HINTS_MAX_BYTES_PER_NODE", "1024")
+		t.Setenv("REPLICATION_HINTS_MAX_AGE_SECONDS", "60")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.True(t, conf.Replication.HintedHandoff.Disabled)
+		assert.Equal(t, int64(1024), conf.Replication.HintedHandoff.MaxBytesPerNode)
+		assert.Equal(t, time.Minute, conf.Replication.HintedHandoff.MaxAge)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv("REPLICATION_HINTS_MAX_AGE_SECONDS", "0")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
 func TestEnvironmentQueryDefaults_Limit(t *testing.T) {
 	factors := []struct {
 		name     string
@@ -868,3 +896,113 @@ func TestEnvironmentHNSWFlatSearchConcurrency(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentAudit(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.False(t, conf.Audit.Enabled)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv("AUDIT_LOG_ENABLED", "true")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.True(t, conf.Audit.Enabled)
+		require.Empty(t, conf.Audit.Operations)
+		require.Equal(t, []string{AuditSinkStdout}, conf.Audit.Sinks)
+		require.Equal(t, DefaultAuditFileMaxSizeMB, conf.Audit.File.MaxSizeMB)
+		require.Equal(t, DefaultAuditFileMaxBackups, conf.Audit.File.MaxBackups)
+		require.Nil(t, conf.Audit.Validate())
+	})
+
+	t.Run("file sink", func(t *testing.T) {
+		t.Setenv("AUDIT_LOG_ENABLED", "true")
+		t.Setenv("AUDIT_LOG_OPERATIONS", "write,admin")
+		t.Setenv("AUDIT_LOG_SINKS", "stdout,file")
+		t.Setenv("AUDIT_LOG_FILE_PATH", "/var/log/weaviate/audit.log")
+		t.Setenv("AUDIT_LOG_FILE_MAX_SIZE_MB", "10")
+		t.Setenv("AUDIT_LOG_FILE_MAX_BACKUPS", "0")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, []string{AuditOperationWrite, AuditOperationAdmin}, conf.Audit.Operations)
+		require.Equal(t, []string{AuditSinkStdout, AuditSinkFile}, conf.Audit.Sinks)
+		require.Equal(t, AuditFile{Path: "/var/log/weaviate/audit.log", MaxSizeMB: 10}, conf.Audit.File)
+		require.Nil(t, conf.Audit.Validate())
+	})
+
+	t.Run("invalid max size", func(t *testing.T) {
+		t.Setenv("AUDIT_LOG_ENABLED", "true")
+		t.Setenv("AUDIT_LOG_FILE_MAX_SIZE_MB", "0")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		for _, tt := range []struct {
+			name string
+			cfg  Audit
+		}{
+			{"unknown operation", Audit{Enabled: true, Operations: []string{"delete"}, Sinks: []string{AuditSinkStdout}}},
+			{"no sinks", Audit{Enabled: true}},
+			{"unknown sink", Audit{Enabled: true, Sinks: []string{"syslog"}}},
+			{"file without path", Audit{Enabled: true, Sinks: []string{AuditSinkFile}}},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				require.NotNil(t, tt.cfg.Validate())
+			})
+		}
+	})
+}
+
+func TestEnvironmentBackupEncryption(t *testing.T) {
+	t.Setenv("BACKUP_ENCRYPTION_ENABLED", "true")
+	t.Setenv("BACKUP_ENCRYPTION_KMS", BackupEncryptionKMSFile)
+	t.Setenv("BACKUP_ENCRYPTION_KMS_KEYRING", "/etc/weaviate/keyring.json")
+	t.Setenv("BACKUP_ENCRYPTION_ALLOW_UNENCRYPTED", "true")
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+	require.Equal(t, BackupEncryption{
+		Enabled:          true,
+		KMS:              BackupEncryptionKMSFile,
+		KMSKeyring:       "/etc/weaviate/keyring.json",
+		AllowUnencrypted: true,
+	}, conf.BackupEncryption)
+	require.Nil(t, conf.BackupEncryption.Validate())
+
+	for _, tt := range []struct {
+		name string
+		cfg  BackupEncryption
+	}{
+		{"no key", BackupEncryption{Enabled: true}},
+		{"key file and kms", BackupEncryption{Enabled: true, KeyFile: "key", KMS: BackupEncryptionKMSFile, KMSKeyring: "keyring"}},
+		{"kms without keyring", BackupEncryption{Enabled: true, KMS: BackupEncryptionKMSFile}},
+		{"unknown kms", BackupEncryption{Enabled: true, KMS: "vault"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NotNil(t, tt.cfg.Validate())
+		})
+	}
+}
+
+func TestEnvironmentBackupWALArchive(t *testing.T) {
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+	require.False(t, conf.BackupWALArchive.Enabled())
+	require.Equal(t, DefaultBackupWALArchiveInterval, conf.BackupWALArchive.Interval)
+	require.Equal(t, DefaultBackupWALArchiveRetention, conf.BackupWALArchive.Retention)
+
+	t.Setenv("BACKUP_WAL_ARCHIVE_BACKEND", "s3")
+	t.Setenv("BACKUP_WAL_ARCHIVE_INTERVAL", "15s")
+	t.Setenv("BACKUP_WAL_ARCHIVE_RETENTION", "48h")
+	conf = Config{}
+	require.Nil(t, FromEnv(&conf))
+	require.Equal(t, BackupWALArchive{Backend: "s3", Interval: 15 * time.Second, Retention: 48 * time.Hour}, conf.BackupWALArchive)
+	require.Nil(t, conf.BackupWALArchive.Validate())
+
+	require.NotNil(t, BackupWALArchive{Backend: "s3"}.Validate())
+	require.NotNil(t, BackupWALArchive{Backend: "s3", Interval: time.Second, Retention: -time.Hour}.Validate())
+
+	t.Setenv("BACKUP_WAL_ARCHIVE_INTERVAL", "soon")
+	require.NotNil(t, FromEnv(&Config{}))
+}
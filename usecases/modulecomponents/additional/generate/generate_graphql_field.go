@@ -55,6 +55,11 @@ func (p *GenerateProvider) singleResultArguments(className string) graphql.Input
 			Description: "debug",
 			Type:        graphql.Boolean,
 		},
+		"imageProperties": &graphql.InputObjectFieldConfig{
+			Description:  "Blob properties passed as images to multimodal models",
+			Type:         graphql.NewList(graphql.String),
+			DefaultValue: nil,
+		},
 	}
 	p.inputArguments(argumentFields, fmt.Sprintf("%sSingleResult", className))
 	return argumentFields
@@ -75,6 +80,11 @@ func (p *GenerateProvider) groupedResultArguments(className string) graphql.Inpu
 			Description: "debug",
 			Type:        graphql.Boolean,
 		},
+		"imageProperties": &graphql.InputObjectFieldConfig{
+			Description:  "Blob properties passed as images to multimodal models",
+			Type:         graphql.NewList(graphql.String),
+			DefaultValue: nil,
+		},
 	}
 	p.inputArguments(argumentFields, fmt.Sprintf("%sGroupedResult", className))
 	return argumentFields
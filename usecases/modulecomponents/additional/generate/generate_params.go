@@ -15,6 +15,7 @@ type Params struct {
 	Prompt              *string
 	Task                *string
 	Properties          []string
+	ImageProperties     []string
 	PropertiesToExtract []string
 	Debug               bool
 	Options             map[string]interface{}
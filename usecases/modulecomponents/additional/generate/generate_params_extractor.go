@@ -41,6 +41,8 @@ func (p *GenerateProvider) parseGenerateArguments(args []*ast.Argument, class *m
 
 				case "debug":
 					out.Debug = field.Value.(*ast.BooleanValue).Value
+				case "imageProperties":
+					out.ImageProperties = appendImageProperties(out.ImageProperties, field)
 				default:
 					if p.isDynamicRAGSyntaxEnabled {
 						if value := p.extractGenerativeParameter(field); value != nil {
@@ -67,6 +69,8 @@ func (p *GenerateProvider) parseGenerateArguments(args []*ast.Argument, class *m
 					propertiesProvided = true
 				case "debug":
 					out.Debug = field.Value.(*ast.BooleanValue).Value
+				case "imageProperties":
+					out.ImageProperties = appendImageProperties(out.ImageProperties, field)
 				default:
 					if p.isDynamicRAGSyntaxEnabled {
 						if value := p.extractGenerativeParameter(field); value != nil {
@@ -85,6 +89,7 @@ func (p *GenerateProvider) parseGenerateArguments(args []*ast.Argument, class *m
 		}
 	}
 
+	propertiesToExtract = append(propertiesToExtract, out.ImageProperties...)
 	out.PropertiesToExtract = propertiesToExtract
 
 	return out
@@ -101,6 +106,28 @@ func (p *GenerateProvider) extractGenerativeParameter(field *ast.ObjectField) in
 	return nil
 }
 
+func appendImageProperties(imageProperties []string, field *ast.ObjectField) []string {
+	inp, ok := field.Value.GetValue().([]ast.Value)
+	if !ok {
+		return imageProperties
+	}
+	for _, value := range inp {
+		if name, ok := value.(*ast.StringValue); ok && !containsString(imageProperties, name.Value) {
+			imageProperties = append(imageProperties, name.Value)
+		}
+	}
+	return imageProperties
+}
+
+func containsString(values []string, value string) bool {
+	for i := range values {
+		if values[i] == value {
+			return true
+		}
+	}
+	return false
+}
+
 func ExtractPropsFromPrompt(prompt *string) []string {
 	propertiesToExtract := make([]string, 0)
 	all := compile.FindAll([]byte(*prompt), -1)
@@ -40,6 +40,7 @@ func (p *GenerateProvider) generateResult(ctx context.Context,
 	prompt := params.Prompt
 	task := params.Task
 	properties := params.Properties
+	imageProperties := params.ImageProperties
 	debug := params.Debug
 	provider, settings := p.getProviderSettings(params)
 	client, err := p.getClient(provider)
@@ -48,14 +49,14 @@ func (p *GenerateProvider) generateResult(ctx context.Context,
 	}
 
 	if task != nil {
-		_, err = p.generateForAllSearchResults(ctx, in, *task, properties, client, settings, debug, cfg)
+		_, err = p.generateForAllSearchResults(ctx, in, *task, properties, imageProperties, client, settings, debug, cfg)
 	}
 	if prompt != nil {
 		prompt, err = validatePrompt(prompt)
 		if err != nil {
 			return nil, err
 		}
-		_, err = p.generatePerSearchResult(ctx, in, *prompt, client, settings, debug, cfg)
+		_, err = p.generatePerSearchResult(ctx, in, *prompt, imageProperties, client, settings, debug, cfg)
 	}
 
 	return in, err
@@ -100,6 +101,7 @@ func validatePrompt(prompt *string) (*string, error) {
 func (p *GenerateProvider) generatePerSearchResult(ctx context.Context,
 	in []search.Result,
 	prompt string,
+	imageProperties []string,
 	client modulecapabilities.GenerativeClient,
 	settings interface{},
 	debug bool,
@@ -110,12 +112,12 @@ func (p *GenerateProvider) generatePerSearchResult(ctx context.Context,
 	for i, result := range in {
 		wg.Add(1)
 		i := i
-		textProperties := p.getTextProperties(result, nil)
+		props := p.getProperties(result, nil, imageProperties)
 		enterrors.GoWrapper(func() {
 			sem <- struct{}{}
 			defer wg.Done()
 			defer func() { <-sem }()
-			generateResult, err := client.GenerateSingleResult(ctx, textProperties, prompt, settings, debug, cfg)
+			generateResult, err := client.GenerateSingleResult(ctx, props, prompt, settings, debug, cfg)
 			p.setIndividualResult(in, i, generateResult, err)
 		}, p.logger)
 	}
@@ -127,43 +129,51 @@ func (p *GenerateProvider) generateForAllSearchResults(ctx context.Context,
 	in []search.Result,
 	task string,
 	properties []string,
+	imageProperties []string,
 	client modulecapabilities.GenerativeClient,
 	settings interface{},
 	debug bool,
 	cfg moduletools.ClassConfig,
 ) ([]search.Result, error) {
-	var propertiesForAllDocs []map[string]string
+	var propertiesForAllDocs []*modulecapabilities.GenerateProperties
 	for _, res := range in {
-		propertiesForAllDocs = append(propertiesForAllDocs, p.getTextProperties(res, properties))
+		propertiesForAllDocs = append(propertiesForAllDocs, p.getProperties(res, properties, imageProperties))
 	}
 	generateResult, err := client.GenerateAllResults(ctx, propertiesForAllDocs, task, settings, debug, cfg)
 	p.setCombinedResult(in, 0, generateResult, err)
 	return in, nil
 }
 
-func (p *GenerateProvider) getTextProperties(result search.Result,
-	properties []string,
-) map[string]string {
+func (p *GenerateProvider) getProperties(result search.Result,
+	properties, imageProperties []string,
+) *modulecapabilities.GenerateProperties {
 	textProperties := map[string]string{}
+	var blobProperties map[string]*string
 	schema := result.Object().Properties.(map[string]interface{})
 	for property, value := range schema {
-		if len(properties) > 0 {
-			if p.containsProperty(property, properties) {
-				if valueString, ok := value.(string); ok {
-					textProperties[property] = valueString
-				} else if valueArray, ok := value.([]string); ok {
-					textProperties[property] = strings.Join(valueArray, ",")
-				}
-			}
-		} else {
+		if p.containsProperty(property, imageProperties) {
+			// blob properties are stored as base64 encoded strings
 			if valueString, ok := value.(string); ok {
-				textProperties[property] = valueString
-			} else if valueArray, ok := value.([]string); ok {
-				textProperties[property] = strings.Join(valueArray, ",")
+				if blobProperties == nil {
+					blobProperties = map[string]*string{}
+				}
+				blobProperties[property] = &valueString
 			}
+			continue
 		}
+		if len(properties) > 0 && !p.containsProperty(property, properties) {
+			continue
+		}
+		if valueString, ok := value.(string); ok {
+			textProperties[property] = valueString
+		} else if valueArray, ok := value.([]string); ok {
+			textProperties[property] = strings.Join(valueArray, ",")
+		}
+	}
+	return &modulecapabilities.GenerateProperties{
+		Text: textProperties,
+		Blob: blobProperties,
 	}
-	return textProperties
 }
 
 func (p *GenerateProvider) setCombinedResult(in []search.Result, i int,
@@ -65,16 +65,55 @@ func TestAdditionalAnswerProvider(t *testing.T) {
 		assert.True(t, ok)
 		assert.Equal(t, "this is a task", *groupedResult)
 	})
+
+	t.Run("should pass image properties as blobs", func(t *testing.T) {
+		// given
+		logger, _ := test.NewNullLogger()
+		client := &fakeClient{}
+		defaultProviderName := "openai"
+		additionalGenerativeParameters := map[string]modulecapabilities.GenerativeProperty{
+			defaultProviderName: {Client: client},
+		}
+		answerProvider := NewGeneric(additionalGenerativeParameters, defaultProviderName, logger)
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": "content",
+					"image":   "aW1hZ2U=",
+				},
+			},
+		}
+		s := "this is a task"
+		fakeParams := &Params{
+			Task:            &s,
+			ImageProperties: []string{"image"},
+		}
+		limit := 1
+
+		// when
+		_, err := answerProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, &limit, map[string]interface{}{}, nil)
+
+		// then
+		require.Nil(t, err)
+		require.Len(t, client.properties, 1)
+		assert.Equal(t, map[string]string{"content": "content"}, client.properties[0].Text)
+		require.NotNil(t, client.properties[0].Blob["image"])
+		assert.Equal(t, "aW1hZ2U=", *client.properties[0].Blob["image"])
+	})
 }
 
-type fakeClient struct{}
+type fakeClient struct {
+	properties []*modulecapabilities.GenerateProperties
+}
 
-func (c *fakeClient) GenerateAllResults(ctx context.Context, textProperties []map[string]string, task string, settings interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
-	return c.getResults(textProperties, task), nil
+func (c *fakeClient) GenerateAllResults(ctx context.Context, properties []*modulecapabilities.GenerateProperties, task string, settings interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	c.properties = properties
+	return c.getResults(task), nil
 }
 
-func (c *fakeClient) GenerateSingleResult(ctx context.Context, textProperties map[string]string, prompt string, settings interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
-	return c.getResult(textProperties, prompt), nil
+func (c *fakeClient) GenerateSingleResult(ctx context.Context, properties *modulecapabilities.GenerateProperties, prompt string, settings interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	return c.getResult(prompt), nil
 }
 
 func (c *fakeClient) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, settings interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
@@ -83,13 +122,13 @@ func (c *fakeClient) Generate(ctx context.Context, cfg moduletools.ClassConfig,
 	}, nil
 }
 
-func (c *fakeClient) getResults(text []map[string]string, task string) *modulecapabilities.GenerateResponse {
+func (c *fakeClient) getResults(task string) *modulecapabilities.GenerateResponse {
 	return &modulecapabilities.GenerateResponse{
 		Result: &task,
 	}
 }
 
-func (c *fakeClient) getResult(text map[string]string, task string) *modulecapabilities.GenerateResponse {
+func (c *fakeClient) getResult(task string) *modulecapabilities.GenerateResponse {
 	return &modulecapabilities.GenerateResponse{
 		Result: &task,
 	}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generative
+
+import (
+	"encoding/base64"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+const defaultImageMediaType = "image/jpeg"
+
+// Texts returns the text properties of all given objects
+func Texts(properties []*modulecapabilities.GenerateProperties) []map[string]string {
+	texts := make([]map[string]string, 0, len(properties))
+	for _, prop := range properties {
+		if prop != nil && prop.Text != nil {
+			texts = append(texts, prop.Text)
+		} else {
+			texts = append(texts, map[string]string{})
+		}
+	}
+	return texts
+}
+
+// Blobs returns the non empty blob properties of all given objects. Blob
+// properties of every object are returned in alphabetical order of their names
+// so that the payload sent to the model is deterministic
+func Blobs(properties []*modulecapabilities.GenerateProperties) []*string {
+	var blobs []*string
+	for _, prop := range properties {
+		if prop == nil || len(prop.Blob) == 0 {
+			continue
+		}
+		names := make([]string, 0, len(prop.Blob))
+		for name := range prop.Blob {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if blob := prop.Blob[name]; blob != nil && *blob != "" {
+				blobs = append(blobs, blob)
+			}
+		}
+	}
+	return blobs
+}
+
+// ImageMediaType detects the media type of a base64 encoded image,
+// if the type can't be detected then image/jpeg is assumed
+func ImageMediaType(image string) string {
+	// 512 bytes is all http.DetectContentType considers, so it's
+	// enough to decode only the beginning of the image
+	prefix := image
+	if len(prefix) > 684 {
+		prefix = prefix[:684]
+	}
+	decoded, err := base64.StdEncoding.DecodeString(prefix)
+	if err != nil {
+		return defaultImageMediaType
+	}
+	if mediaType := http.DetectContentType(decoded); strings.HasPrefix(mediaType, "image/") {
+		return mediaType
+	}
+	return defaultImageMediaType
+}
@@ -132,6 +132,18 @@ type PrometheusMetrics struct {
 	SchemaTxClosed   *prometheus.CounterVec
 	SchemaTxDuration *prometheus.SummaryVec
 
+	// Continuous classification
+	ContinuousClassificationLag       *prometheus.GaugeVec
+	ContinuousClassificationQueueSize *prometheus.GaugeVec
+	ContinuousClassificationObjects   *prometheus.CounterVec
+
+	// Hinted handoff
+	ReplicationHintsStored       *prometheus.CounterVec
+	ReplicationHintsReplayed     *prometheus.CounterVec
+	ReplicationHintsDropped      *prometheus.CounterVec
+	ReplicationHintsPending      *prometheus.GaugeVec
+	ReplicationHintsPendingBytes *prometheus.GaugeVec
+
 	// Vectorization
 	T2VBatches            *prometheus.GaugeVec
 	T2VBatchQueueDuration *prometheus.HistogramVec
@@ -678,6 +690,42 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Help: "Delete list size of tombstones",
 		}, []string{"class_name", "shard_name"}),
 
+		// Continuous classification
+		ContinuousClassificationLag: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "continuous_classification_lag_seconds",
+			Help: "Time in seconds the oldest written object has been waiting to be classified",
+		}, []string{"class_name"}),
+		ContinuousClassificationQueueSize: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "continuous_classification_queue_size",
+			Help: "Number of written objects waiting to be classified",
+		}, []string{"class_name"}),
+		ContinuousClassificationObjects: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "continuous_classification_objects_total",
+			Help: "Number of objects processed by continuous classifications",
+		}, []string{"class_name", "status"}),
+
+		// Hinted handoff
+		ReplicationHintsStored: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "replication_hints_stored_total",
+			Help: "Number of writes missed by an unavailable replica which were stored for later replay",
+		}, []string{"node"}),
+		ReplicationHintsReplayed: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "replication_hints_replayed_total",
+			Help: "Number of stored writes replayed to a replica after it became available again",
+		}, []string{"node"}),
+		ReplicationHintsDropped: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "replication_hints_dropped_total",
+			Help: "Number of missed writes which were not stored or not replayed",
+		}, []string{"node", "reason"}),
+		ReplicationHintsPending: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "replication_hints_pending",
+			Help: "Number of stored writes waiting to be replayed to a replica",
+		}, []string{"node"}),
+		ReplicationHintsPendingBytes: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "replication_hints_pending_bytes",
+			Help: "Size of the stored writes waiting to be replayed to a replica",
+		}, []string{"node"}),
+
 		T2VBatches: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "t2v_concurrent_batches",
 			Help: "Number of batches currently running",
@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/cluster/types"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+type raftCluster interface {
+	RaftStatus() (*models.RaftStatus, error)
+	RemovePeer(ctx context.Context, id string) error
+	PromotePeer(ctx context.Context, id string) error
+	TakeSnapshot() (*models.RaftSnapshot, error)
+}
+
+// RaftManager exposes the raft membership operations of the cluster to
+// authorized users.
+type RaftManager struct {
+	logger     logrus.FieldLogger
+	authorizer authorization.Authorizer
+	raft       raftCluster
+}
+
+func NewRaftManager(logger logrus.FieldLogger, authorizer authorization.Authorizer,
+	raft raftCluster,
+) *RaftManager {
+	return &RaftManager{logger, authorizer, raft}
+}
+
+// GetRaftStatus returns the raft state of the cluster as seen by this node.
+func (m *RaftManager) GetRaftStatus(ctx context.Context, principal *models.Principal) (*models.RaftStatus, error) {
+	if err := m.authorizer.Authorize(principal, authorization.READ, authorization.Cluster()); err != nil {
+		return nil, err
+	}
+	status, err := m.raft.RaftStatus()
+	return status, raftErr(err)
+}
+
+// RemovePeer removes a peer, typically a dead voter, from the raft configuration.
+func (m *RaftManager) RemovePeer(ctx context.Context, principal *models.Principal, id string) error {
+	if err := m.authorizer.Authorize(principal, authorization.DELETE, authorization.Cluster()); err != nil {
+		return err
+	}
+	m.logger.WithField("action", "raft_remove_peer").WithField("id", id).Info("removing raft peer")
+	return raftErr(m.raft.RemovePeer(ctx, id))
+}
+
+// PromotePeer turns a non-voter into a voter.
+func (m *RaftManager) PromotePeer(ctx context.Context, principal *models.Principal, id string) error {
+	if err := m.authorizer.Authorize(principal, authorization.UPDATE, authorization.Cluster()); err != nil {
+		return err
+	}
+	m.logger.WithField("action", "raft_promote_peer").WithField("id", id).Info("promoting raft peer")
+	return raftErr(m.raft.PromotePeer(ctx, id))
+}
+
+// TakeSnapshot forces a raft snapshot on this node.
+func (m *RaftManager) TakeSnapshot(ctx context.Context, principal *models.Principal) (*models.RaftSnapshot, error) {
+	if err := m.authorizer.Authorize(principal, authorization.UPDATE, authorization.Cluster()); err != nil {
+		return nil, err
+	}
+	snapshot, err := m.raft.TakeSnapshot()
+	return snapshot, raftErr(err)
+}
+
+// raftErr maps the raft membership errors onto the errors understood by the
+// REST handlers.
+func raftErr(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, types.ErrPeerNotFound):
+		return enterrors.NewErrNotFound(err)
+	case errors.Is(err, types.ErrPeerAlreadyVoter), errors.Is(err, types.ErrRemoveLeader),
+		errors.Is(err, types.ErrRemoveLastVoter), errors.Is(err, types.ErrRemoveQuorum):
+		return enterrors.NewErrUnprocessable(err)
+	default:
+		return err
+	}
+}
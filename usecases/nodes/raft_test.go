@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/cluster/types"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+)
+
+type fakeRaft struct {
+	err     error
+	removed []string
+}
+
+func (f *fakeRaft) RaftStatus() (*models.RaftStatus, error) {
+	return &models.RaftStatus{NodeID: "node1"}, f.err
+}
+
+func (f *fakeRaft) RemovePeer(ctx context.Context, id string) error {
+	f.removed = append(f.removed, id)
+	return f.err
+}
+
+func (f *fakeRaft) PromotePeer(ctx context.Context, id string) error {
+	return f.err
+}
+
+func (f *fakeRaft) TakeSnapshot() (*models.RaftSnapshot, error) {
+	return &models.RaftSnapshot{Index: 7}, f.err
+}
+
+func TestRaftManager_Authorization(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	authorizer := mocks.NewMockAuthorizer()
+	m := NewRaftManager(logger, authorizer, &fakeRaft{})
+
+	_, err := m.GetRaftStatus(ctx, nil)
+	require.Nil(t, err)
+	require.Nil(t, m.RemovePeer(ctx, nil, "node2"))
+	require.Nil(t, m.PromotePeer(ctx, nil, "node2"))
+	_, err = m.TakeSnapshot(ctx, nil)
+	require.Nil(t, err)
+
+	verbs := []string{}
+	for _, call := range authorizer.Calls() {
+		assert.Equal(t, []string{authorization.Cluster()}, call.Resources)
+		verbs = append(verbs, call.Verb)
+	}
+	assert.Equal(t, []string{
+		authorization.READ, authorization.DELETE, authorization.UPDATE, authorization.UPDATE,
+	}, verbs)
+
+	t.Run("forbidden", func(t *testing.T) {
+		raft := &fakeRaft{}
+		authorizer := mocks.NewMockAuthorizer()
+		authorizer.SetErr(errors.New("forbidden"))
+		m := NewRaftManager(logger, authorizer, raft)
+		assert.NotNil(t, m.RemovePeer(ctx, nil, "node2"))
+		assert.Empty(t, raft.removed)
+	})
+}
+
+func TestRaftManager_Errors(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	tests := []struct {
+		err      error
+		notFound bool
+		invalid  bool
+	}{
+		{err: fmt.Errorf("%w: %q", types.ErrPeerNotFound, "node3"), notFound: true},
+		{err: fmt.Errorf("%w: %q", types.ErrPeerAlreadyVoter, "node2"), invalid: true},
+		{err: fmt.Errorf("%w: %q", types.ErrRemoveLeader, "node1"), invalid: true},
+		{err: fmt.Errorf("%w: %q", types.ErrRemoveLastVoter, "node1"), invalid: true},
+		{err: fmt.Errorf("%w: %d of %d voters would be left", types.ErrRemoveQuorum, 1, 2), invalid: true},
+		{err: types.ErrLeaderNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			m := NewRaftManager(logger, mocks.NewMockAuthorizer(), &fakeRaft{err: tt.err})
+			err := m.RemovePeer(ctx, nil, "node")
+			require.NotNil(t, err)
+			assert.Equal(t, tt.notFound, errors.As(err, &enterrors.ErrNotFound{}))
+			assert.Equal(t, tt.invalid, errors.As(err, &enterrors.ErrUnprocessable{}))
+			assert.ErrorContains(t, err, tt.err.Error())
+		})
+	}
+}
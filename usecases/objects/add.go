@@ -36,6 +36,7 @@ func (m *Manager) AddObject(ctx context.Context, principal *models.Principal, ob
 		tenant = "*"
 	)
 	if object != nil {
+		object.Class = resolveAlias(m.schemaManager, object.Class)
 		class = object.Class
 		tenant = object.Tenant
 	}
@@ -85,6 +86,12 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 		return nil, NewErrInvalidUserInput("invalid object: %v", err)
 	}
 
+	if m.quotas != nil {
+		if err := m.quotas.CheckWrite(ctx, object.Class, object.Tenant, 1); err != nil {
+			return nil, quotaError(err)
+		}
+	}
+
 	now := m.timeSource.Now()
 	object.CreationTimeUnix = now
 	object.LastUpdateTimeUnix = now
@@ -101,6 +108,15 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 		return nil, err
 	}
 
+	if m.classifier != nil {
+		// a failing classification must not prevent the object from being added
+		if err := m.classifier.ClassifyOnInsert(ctx, object); err != nil {
+			m.logger.WithField("action", "classify_on_insert").
+				WithField("class", object.Class).
+				WithError(err).Warn("could not classify object on insert")
+		}
+	}
+
 	// Ensure that the local schema has caught up to the version we used to validate
 	if err := m.schemaManager.WaitForUpdate(ctx, schemaVersion); err != nil {
 		return nil, fmt.Errorf("error waiting for local schema to catch up to version %d: %w", schemaVersion, err)
@@ -110,6 +126,10 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 		return nil, fmt.Errorf("put object: %w", err)
 	}
 
+	if m.classifier != nil {
+		m.classifier.ClassifyAfterWrite(object.Class, object.ID, object.Tenant, object.Properties)
+	}
+
 	return object, nil
 }
 
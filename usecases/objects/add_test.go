@@ -13,6 +13,7 @@ package objects
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
 
@@ -60,6 +61,7 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 		vectorRepo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
 		schemaManager := &fakeSchemaManager{
 			GetSchemaResponse: sch,
+			aliases:           map[string]string{"FooAlias": "Foo"},
 		}
 		locks := &fakeLocks{}
 		cfg := &config.WeaviateConfig{
@@ -76,7 +78,7 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 		modulesProvider = getFakeModulesProvider()
 		metrics := &fakeMetrics{}
 		manager = NewManager(locks, schemaManager, cfg, logger, authorizer,
-			vectorRepo, modulesProvider, metrics, nil)
+			vectorRepo, modulesProvider, metrics, nil, nil, nil)
 	}
 
 	reset := func() {
@@ -103,6 +105,64 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 		assert.Equal(t, uuidDuringCreation, res.ID, "check that connector add ID and user response match")
 	})
 
+	t.Run("addressed by an alias", func(t *testing.T) {
+		reset()
+
+		ctx := context.Background()
+		object := &models.Object{
+			Vector: []float32{0.1, 0.2, 0.3},
+			Class:  "FooAlias",
+		}
+
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+
+		res, err := manager.AddObject(ctx, nil, object, nil)
+		require.Nil(t, err)
+		stored := vectorRepo.Mock.Calls[0].Arguments.Get(0).(*models.Object)
+		assert.Equal(t, "Foo", stored.Class)
+		assert.Equal(t, "Foo", res.Class)
+	})
+
+	t.Run("with a classifier", func(t *testing.T) {
+		reset()
+		classifier := &fakeInsertClassifier{}
+		manager.classifier = classifier
+
+		ctx := context.Background()
+		object := &models.Object{
+			Vector: []float32{0.1, 0.2, 0.3},
+			Class:  "Foo",
+		}
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+
+		res, err := manager.AddObject(ctx, nil, object, nil)
+		require.Nil(t, err)
+		stored := vectorRepo.Mock.Calls[0].Arguments.Get(0).(*models.Object)
+		assert.Equal(t, "classified", stored.Properties.(map[string]interface{})["label"])
+		assert.Equal(t, []strfmt.UUID{res.ID}, classifier.written,
+			"the object is queued for continuous classifications")
+	})
+
+	t.Run("with a failing classifier", func(t *testing.T) {
+		reset()
+		manager.classifier = &fakeInsertClassifier{err: errors.New("model is broken")}
+
+		ctx := context.Background()
+		object := &models.Object{
+			Vector: []float32{0.1, 0.2, 0.3},
+			Class:  "Foo",
+		}
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+
+		_, err := manager.AddObject(ctx, nil, object, nil)
+		require.Nil(t, err, "the object is added without classification")
+		stored := vectorRepo.Mock.Calls[0].Arguments.Get(0).(*models.Object)
+		assert.Nil(t, stored.Properties.(map[string]interface{})["label"])
+	})
+
 	t.Run("with an explicit (correct) ID set", func(t *testing.T) {
 		reset()
 
@@ -271,7 +331,7 @@ func Test_Add_Object_WithExternalVectorizerModule(t *testing.T) {
 		modulesProvider = getFakeModulesProvider()
 		modulesProvider.On("UsingRef2Vec", mock.Anything).Return(false)
 		manager = NewManager(locks, schemaManager, cfg, logger, authorizer,
-			vectorRepo, modulesProvider, metrics, nil)
+			vectorRepo, modulesProvider, metrics, nil, nil, nil)
 	}
 
 	t.Run("without an id set", func(t *testing.T) {
@@ -384,7 +444,7 @@ func Test_Add_Object_OverrideVectorizer(t *testing.T) {
 		modulesProvider = getFakeModulesProvider()
 		metrics := &fakeMetrics{}
 		manager = NewManager(locks, schemaManager, cfg, logger,
-			authorizer, vectorRepo, modulesProvider, metrics, nil)
+			authorizer, vectorRepo, modulesProvider, metrics, nil, nil, nil)
 	}
 
 	t.Run("overriding the vector by explicitly specifying it", func(t *testing.T) {
@@ -445,7 +505,7 @@ func Test_AddObjectEmptyProperties(t *testing.T) {
 		modulesProvider = getFakeModulesProvider()
 		metrics := &fakeMetrics{}
 		manager = NewManager(locks, schemaManager, cfg, logger,
-			authorizer, vectorRepo, modulesProvider, metrics, nil)
+			authorizer, vectorRepo, modulesProvider, metrics, nil, nil, nil)
 	}
 	reset()
 	ctx := context.Background()
@@ -501,7 +561,7 @@ func Test_AddObjectWithUUIDProps(t *testing.T) {
 		modulesProvider = getFakeModulesProvider()
 		metrics := &fakeMetrics{}
 		manager = NewManager(locks, schemaManager, cfg, logger,
-			authorizer, vectorRepo, modulesProvider, metrics, nil)
+			authorizer, vectorRepo, modulesProvider, metrics, nil, nil, nil)
 	}
 	reset()
 	ctx := context.Background()
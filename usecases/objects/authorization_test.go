@@ -181,7 +181,7 @@ func Test_Kinds_Authorization(t *testing.T) {
 				vectorRepo := &fakeVectorRepo{}
 				manager := NewManager(locks, schemaManager,
 					cfg, logger, authorizer,
-					vectorRepo, getFakeModulesProvider(), nil, nil)
+					vectorRepo, getFakeModulesProvider(), nil, nil, nil, nil)
 
 				args := append([]interface{}{context.Background(), principal}, test.additionalArgs...)
 				out, _ := callFuncByName(manager, test.methodName, args...)
@@ -219,6 +219,17 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			expectedVerb:      authorization.UPDATE,
 			expectedResources: authorization.Shards("", ""),
 		},
+		{
+			methodName: "AddObjectsIfMatch",
+			additionalArgs: []interface{}{
+				[]*models.Object{{}},
+				[]*string{},
+				&additional.ReplicationProperties{},
+				[]*int64{nil},
+			},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.Shards("", ""),
+		},
 		{
 			methodName: "AddReferences",
 			additionalArgs: []interface{}{
@@ -274,7 +285,7 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			authorizer.SetErr(errors.New("just a test fake"))
 			vectorRepo := &fakeVectorRepo{}
 			modulesProvider := getFakeModulesProvider()
-			manager := NewBatchManager(vectorRepo, modulesProvider, locks, schemaManager, cfg, logger, authorizer, nil)
+			manager := NewBatchManager(vectorRepo, modulesProvider, locks, schemaManager, cfg, logger, authorizer, nil, nil, nil)
 
 			args := append([]interface{}{context.Background(), principal}, test.additionalArgs...)
 			out, _ := callFuncByName(manager, test.methodName, args...)
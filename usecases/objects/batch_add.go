@@ -15,14 +15,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/classcache"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
+	shardingcfg "github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
 var errEmptyObjects = NewErrInvalidUserInput("invalid param 'objects': cannot be empty, need at least one object for batching")
@@ -31,8 +34,22 @@ var errEmptyObjects = NewErrInvalidUserInput("invalid param 'objects': cannot be
 func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Principal,
 	objects []*models.Object, fields []*string, repl *additional.ReplicationProperties,
 ) (BatchObjects, error) {
+	return b.AddObjectsIfMatch(ctx, principal, objects, fields, repl, nil)
+}
+
+// AddObjectsIfMatch is AddObjects with conditional writes. If set,
+// ifMatch[i] is the precondition of objects[i], see BatchObject.IfMatch.
+func (b *BatchManager) AddObjectsIfMatch(ctx context.Context, principal *models.Principal,
+	objects []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+	ifMatch []*int64,
+) (BatchObjects, error) {
+	if ifMatch != nil && len(ifMatch) != len(objects) {
+		return nil, NewErrInvalidUserInput("got %d preconditions for %d objects", len(ifMatch), len(objects))
+	}
+
 	classesShards := make(map[string][]string)
 	for _, obj := range objects {
+		obj.Class = resolveAlias(b.schemaManager, obj.Class)
 		classesShards[obj.Class] = append(classesShards[obj.Class], obj.Tenant)
 	}
 
@@ -63,6 +80,9 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 
 	var maxSchemaVersion uint64
 	batchObjects, maxSchemaVersion := b.validateAndGetVector(ctx, principal, objects, repl)
+	for i := range ifMatch {
+		batchObjects[i].IfMatch = ifMatch[i]
+	}
 	schemaVersion, tenantCount, err := b.autoSchemaManager.autoTenants(ctx, principal, objects)
 	if err != nil {
 		return nil, fmt.Errorf("auto create tenants: %w", err)
@@ -71,6 +91,8 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 		maxSchemaVersion = schemaVersion
 	}
 
+	b.checkQuotas(ctx, batchObjects)
+
 	b.metrics.BatchTenants(tenantCount)
 	b.metrics.BatchObjects(len(objects))
 	b.metrics.BatchOp("total_preprocessing", beforePreProcessing.UnixNano())
@@ -88,6 +110,15 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 		return nil, NewErrInternal("batch objects: %#v", err)
 	}
 
+	if b.classifier != nil {
+		for _, obj := range res {
+			if obj.Err == nil && obj.Object != nil {
+				b.classifier.ClassifyAfterWrite(obj.Object.Class, obj.UUID, obj.Object.Tenant,
+					obj.Object.Properties)
+			}
+		}
+	}
+
 	return res, nil
 }
 
@@ -122,7 +153,8 @@ func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *mode
 			maxSchemaVersion = schemaVersion
 		}
 
-		if obj.ID == "" {
+		generatedID := obj.ID == ""
+		if generatedID {
 			// Generate UUID for the new object
 			uid, err := generateUUID()
 			obj.ID = uid
@@ -162,6 +194,14 @@ func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *mode
 			continue
 		}
 
+		if schema.HasUniqueKey(class) {
+			if err := setUniqueKeyID(class, obj, generatedID); err != nil {
+				batchObjects[i].Err = err
+				continue
+			}
+			batchObjects[i].UUID = obj.ID
+		}
+
 		if objectsPerClass[obj.Class] == nil {
 			objectsPerClass[obj.Class] = make([]*models.Object, 0)
 			originalIndexPerClass[obj.Class] = make([]int, 0)
@@ -187,3 +227,26 @@ func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *mode
 
 	return batchObjects, maxSchemaVersion
 }
+
+// setUniqueKeyID derives the id of an object of a class with a unique key
+// from the values of the key. Ids sent by the client are kept, unless the
+// class is sharded by its unique key: then all objects with the same key
+// need to have the same id, so that they are routed to the same shard.
+func setUniqueKeyID(class *models.Class, obj *models.Object, generatedID bool) error {
+	values, err := schema.UniqueKeyValues(class, obj.Properties)
+	if err != nil {
+		return NewErrInvalidUserInput("%v", err)
+	}
+	id := schema.UniqueKeyUUID(class.Class, values)
+	if generatedID {
+		obj.ID = id
+		return nil
+	}
+
+	shardingConfig, ok := class.ShardingConfig.(shardingcfg.Config)
+	if ok && shardingConfig.RoutesByUniqueKey() && !strings.EqualFold(obj.ID.String(), id.String()) {
+		return NewErrInvalidUserInput("id %s does not match id %s derived from the unique key, "+
+			"ids must be omitted or derived from the unique key when sharding by it", obj.ID, id)
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
 	"github.com/weaviate/weaviate/usecases/config"
+	shardingcfg "github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
 func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
@@ -72,7 +73,7 @@ func Test_BatchManager_AddObjects_WithNoVectorizerModule(t *testing.T) {
 		authorizer := mocks.NewMockAuthorizer()
 		modulesProvider = getFakeModulesProvider()
 		manager = NewBatchManager(vectorRepo, modulesProvider, locks,
-			schemaManager, config, logger, authorizer, nil)
+			schemaManager, config, logger, authorizer, nil, nil, nil)
 	}
 
 	reset := func() {
@@ -326,7 +327,7 @@ func Test_BatchManager_AddObjects_WithExternalVectorizerModule(t *testing.T) {
 		authorizer := mocks.NewMockAuthorizer()
 		modulesProvider = getFakeModulesProvider()
 		manager = NewBatchManager(vectorRepo, modulesProvider, locks,
-			schemaManager, config, logger, authorizer, nil)
+			schemaManager, config, logger, authorizer, nil, nil, nil)
 	}
 
 	ctx := context.Background()
@@ -471,7 +472,7 @@ func Test_BatchManager_AddObjectsEmptyProperties(t *testing.T) {
 		authorizer := mocks.NewMockAuthorizer()
 		modulesProvider = getFakeModulesProvider()
 		manager = NewBatchManager(vectorRepo, modulesProvider, locks,
-			schemaManager, config, logger, authorizer, nil)
+			schemaManager, config, logger, authorizer, nil, nil, nil)
 	}
 	reset()
 	objects := []*models.Object{
@@ -501,3 +502,107 @@ func Test_BatchManager_AddObjectsEmptyProperties(t *testing.T) {
 	require.NotNil(t, addedObjects[0].Object.Properties)
 	require.NotNil(t, addedObjects[1].Object.Properties)
 }
+
+func Test_BatchManager_AddObjects_WithUniqueKey(t *testing.T) {
+	var (
+		vectorRepo      *fakeVectorRepo
+		modulesProvider *fakeModulesProvider
+		manager         *BatchManager
+	)
+
+	skuProperty := []*models.Property{
+		{
+			Name:     "sku",
+			DataType: schema.DataTypeText.PropString(),
+		},
+	}
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Vectorizer:        config.VectorizerModuleNone,
+					Class:             "Product",
+					VectorIndexConfig: hnsw.UserConfig{},
+					Properties:        skuProperty,
+					UniqueKey:         []string{"sku"},
+				},
+				{
+					Vectorizer:        config.VectorizerModuleNone,
+					Class:             "ProductByKey",
+					VectorIndexConfig: hnsw.UserConfig{},
+					Properties:        skuProperty,
+					UniqueKey:         []string{"sku"},
+					ShardingConfig:    shardingcfg.Config{Key: shardingcfg.UniqueKey},
+				},
+			},
+		},
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		config := &config.WeaviateConfig{}
+		locks := &fakeLocks{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: sch,
+		}
+		logger, _ := test.NewNullLogger()
+		authorizer := mocks.NewMockAuthorizer()
+		modulesProvider = getFakeModulesProvider()
+		manager = NewBatchManager(vectorRepo, modulesProvider, locks,
+			schemaManager, config, logger, authorizer, nil, nil, nil)
+	}
+	ctx := context.Background()
+
+	t.Run("ids are derived from the unique key", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		modulesProvider.On("BatchUpdateVector").Return(nil, nil)
+		explicitID := strfmt.UUID("cf918366-3d3b-4b90-a65a-a4f8b7e4c3a7")
+		objects := []*models.Object{
+			{Class: "Product", Properties: map[string]interface{}{"sku": "A-1"}},
+			{Class: "Product", Properties: map[string]interface{}{"sku": "A-1"}},
+			{Class: "Product", Properties: map[string]interface{}{"sku": "B-2"}},
+			{Class: "Product", Properties: map[string]interface{}{"sku": "C-3"}, ID: explicitID},
+		}
+
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		require.Nil(t, err)
+		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
+
+		require.Len(t, repoCalledWithObjects, 4)
+		for _, obj := range repoCalledWithObjects {
+			require.Nil(t, obj.Err)
+			assert.Equal(t, obj.UUID, obj.Object.ID)
+		}
+		expectedID := schema.UniqueKeyUUID("Product", []interface{}{"A-1"})
+		assert.Equal(t, expectedID, repoCalledWithObjects[0].UUID)
+		assert.Equal(t, expectedID, repoCalledWithObjects[1].UUID)
+		assert.NotEqual(t, expectedID, repoCalledWithObjects[2].UUID)
+		assert.Equal(t, explicitID, repoCalledWithObjects[3].UUID,
+			"ids sent by the client are kept")
+	})
+
+	t.Run("with invalid objects", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		modulesProvider.On("BatchUpdateVector").Return(nil, nil)
+		derivedID := schema.UniqueKeyUUID("ProductByKey", []interface{}{"A-1"})
+		objects := []*models.Object{
+			{Class: "Product", Properties: map[string]interface{}{}},
+			{
+				Class: "ProductByKey", Properties: map[string]interface{}{"sku": "A-1"},
+				ID: "cf918366-3d3b-4b90-a65a-a4f8b7e4c3a7",
+			},
+			{Class: "ProductByKey", Properties: map[string]interface{}{"sku": "A-1"}, ID: derivedID},
+		}
+
+		_, err := manager.AddObjects(ctx, nil, objects, []*string{}, nil)
+		require.Nil(t, err)
+		repoCalledWithObjects := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
+
+		require.Len(t, repoCalledWithObjects, 3)
+		assert.NotNil(t, repoCalledWithObjects[0].Err, "value of the unique key is missing")
+		assert.NotNil(t, repoCalledWithObjects[1].Err, "id does not match the derived id")
+		assert.Nil(t, repoCalledWithObjects[2].Err)
+	})
+}
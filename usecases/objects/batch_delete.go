@@ -33,6 +33,7 @@ func (b *BatchManager) DeleteObjects(ctx context.Context, principal *models.Prin
 ) (*BatchDeleteResponse, error) {
 	class := "*"
 	if match != nil {
+		match.Class = resolveAlias(b.schemaManager, match.Class)
 		class = match.Class
 	}
 	err := b.authorizer.Authorize(principal, authorization.UPDATE, authorization.Shards(class, tenant)...)
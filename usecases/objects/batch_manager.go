@@ -33,6 +33,8 @@ type BatchManager struct {
 	modulesProvider   ModulesProvider
 	autoSchemaManager *autoSchemaManager
 	metrics           *Metrics
+	quotas            quotaEnforcer
+	classifier        objectClassifier
 }
 
 type BatchVectorRepo interface {
@@ -53,7 +55,7 @@ type batchRepoNew interface {
 func NewBatchManager(vectorRepo BatchVectorRepo, modulesProvider ModulesProvider,
 	locks locks, schemaManager schemaManager, config *config.WeaviateConfig,
 	logger logrus.FieldLogger, authorizer authorization.Authorizer,
-	prom *monitoring.PrometheusMetrics,
+	prom *monitoring.PrometheusMetrics, quotas quotaEnforcer, classifier objectClassifier,
 ) *BatchManager {
 	return &BatchManager{
 		config:            config,
@@ -65,5 +67,7 @@ func NewBatchManager(vectorRepo BatchVectorRepo, modulesProvider ModulesProvider
 		authorizer:        authorizer,
 		autoSchemaManager: newAutoSchemaManager(schemaManager, vectorRepo, config, logger),
 		metrics:           NewMetrics(prom),
+		quotas:            quotas,
+		classifier:        classifier,
 	}
 }
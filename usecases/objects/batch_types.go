@@ -33,6 +33,9 @@ type BatchObject struct {
 	Err           error
 	Object        *models.Object
 	UUID          strfmt.UUID
+	// IfMatch is an optional write precondition on the lastUpdateTimeUnix
+	// of the stored object, 0 meaning it must not exist yet
+	IfMatch *int64
 }
 
 // BatchObjects groups many Object items together. The order matches the
@@ -55,6 +58,9 @@ type BatchReference struct {
 	From          *crossref.RefSource `json:"from"`
 	To            *crossref.Ref       `json:"to"`
 	Tenant        string              `json:"tenant"`
+	// UpdateTime (unix ms) is set on replicated references, so that all
+	// replicas update the source object at the same time
+	UpdateTime int64 `json:"updateTime,omitempty"`
 }
 
 // BatchReferences groups many Reference items together. The order matches the
@@ -32,6 +32,7 @@ func (m *Manager) DeleteObject(ctx context.Context,
 	principal *models.Principal, class string, id strfmt.UUID,
 	repl *additional.ReplicationProperties, tenant string,
 ) error {
+	class = resolveAlias(m.schemaManager, class)
 	err := m.authorizer.Authorize(principal, authorization.DELETE, authorization.Objects(class, tenant, id))
 	if err != nil {
 		return err
@@ -75,6 +76,10 @@ func (m *Manager) DeleteObject(ctx context.Context,
 		if errors.As(err, &e2) {
 			return NewErrMultiTenancy(fmt.Errorf("delete object from vector repo: %w", err))
 		}
+		var e3 ErrPreconditionFailed
+		if errors.As(err, &e3) {
+			return e3
+		}
 		return NewErrInternal("could not delete object from vector repo: %v", err)
 	}
 
@@ -77,6 +77,6 @@ func newDeleteDependency() (*Manager, *fakeVectorRepo) {
 		mocks.NewMockAuthorizer(),
 		vectorRepo,
 		getFakeModulesProvider(),
-		new(fakeMetrics), nil)
+		new(fakeMetrics), nil, nil, nil)
 	return manager, vectorRepo
 }
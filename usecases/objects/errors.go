@@ -20,6 +20,7 @@ const (
 	StatusForbidden           = 403
 	StatusBadRequest          = 400
 	StatusNotFound            = 404
+	StatusPreconditionFailed  = 412
 	StatusUnprocessableEntity = 422
 	StatusInternalServerError = 500
 )
@@ -56,6 +57,10 @@ func (e *Error) UnprocessableEntity() bool {
 	return e.Code == StatusUnprocessableEntity
 }
 
+func (e *Error) PreconditionFailed() bool {
+	return e.Code == StatusPreconditionFailed
+}
+
 // ErrInvalidUserInput indicates a client-side error
 type ErrInvalidUserInput struct {
 	msg string
@@ -172,3 +177,21 @@ func (e ErrDirtyWriteOfDeletedObject) Unwrap() error {
 func NewErrDirtyWriteOfDeletedObject(err error) ErrDirtyWriteOfDeletedObject {
 	return ErrDirtyWriteOfDeletedObject{err}
 }
+
+// ErrPreconditionFailed indicates that a conditional write was rejected
+// because the stored object did not match the expected version
+type ErrPreconditionFailed struct {
+	err error
+}
+
+func (e ErrPreconditionFailed) Error() string {
+	return e.err.Error()
+}
+
+func (e ErrPreconditionFailed) Unwrap() error {
+	return e.err
+}
+
+func NewErrPreconditionFailed(format string, args ...interface{}) ErrPreconditionFailed {
+	return ErrPreconditionFailed{fmt.Errorf(format, args...)}
+}
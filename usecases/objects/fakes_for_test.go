@@ -50,6 +50,7 @@ type fakeSchemaManager struct {
 	GetSchemaResponse schema.Schema
 	GetschemaErr      error
 	tenantsEnabled    bool
+	aliases           map[string]string
 }
 
 func (f *fakeSchemaManager) UpdatePropertyAddDataType(ctx context.Context, principal *models.Principal,
@@ -181,6 +182,10 @@ func (f *fakeSchemaManager) MultiTenancy(class string) models.MultiTenancyConfig
 	return models.MultiTenancyConfig{Enabled: f.tenantsEnabled}
 }
 
+func (f *fakeSchemaManager) ResolveAlias(alias string) string {
+	return f.aliases[alias]
+}
+
 func (f *fakeSchemaManager) WaitForUpdate(ctx context.Context, schemaVersion uint64) error {
 	return nil
 }
@@ -886,3 +891,22 @@ func (f *fakeMetrics) DeleteReferenceDec() {
 func (f *fakeMetrics) AddUsageDimensions(className, queryType, op string, dims int) {
 	f.Mock.MethodCalled("AddUsageDimensions", className, queryType, op, dims)
 }
+
+type fakeInsertClassifier struct {
+	err     error
+	written []strfmt.UUID
+}
+
+func (f *fakeInsertClassifier) ClassifyOnInsert(ctx context.Context, object *models.Object) error {
+	if f.err != nil {
+		return f.err
+	}
+	object.Properties.(map[string]interface{})["label"] = "classified"
+	return nil
+}
+
+func (f *fakeInsertClassifier) ClassifyAfterWrite(class string, id strfmt.UUID, tenant string,
+	properties interface{},
+) {
+	f.written = append(f.written, id)
+}
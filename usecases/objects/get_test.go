@@ -65,7 +65,7 @@ func Test_GetAction(t *testing.T) {
 		metrics = &fakeMetrics{}
 		manager = NewManager(locks, schemaManager, cfg, logger,
 			authorizer, vectorRepo,
-			getFakeModulesProviderWithCustomExtenders(extender, projectorFake), metrics, nil)
+			getFakeModulesProviderWithCustomExtenders(extender, projectorFake), metrics, nil, nil, nil)
 	}
 
 	t.Run("get non-existing action by id", func(t *testing.T) {
@@ -694,7 +694,7 @@ func Test_GetThing(t *testing.T) {
 		metrics := &fakeMetrics{}
 		manager = NewManager(locks, schemaManager, cfg, logger,
 			authorizer, vectorRepo,
-			getFakeModulesProviderWithCustomExtenders(extender, projectorFake), metrics, nil)
+			getFakeModulesProviderWithCustomExtenders(extender, projectorFake), metrics, nil, nil, nil)
 	}
 
 	t.Run("get non-existing thing by id", func(t *testing.T) {
@@ -1091,7 +1091,7 @@ func newFakeGetManager(schema schema.Schema, opts ...func(*fakeGetManager)) fake
 	logger, _ := test.NewNullLogger()
 	r.modulesProvider = getFakeModulesProviderWithCustomExtenders(r.extender, r.projector)
 	r.Manager = NewManager(r.locks, schemaManager, cfg, logger,
-		r.authorizer, r.repo, r.modulesProvider, r.metrics, nil)
+		r.authorizer, r.repo, r.modulesProvider, r.metrics, nil, nil, nil)
 
 	return r
 }
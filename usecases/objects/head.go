@@ -25,6 +25,7 @@ import (
 func (m *Manager) HeadObject(ctx context.Context, principal *models.Principal, class string,
 	id strfmt.UUID, repl *additional.ReplicationProperties, tenant string,
 ) (bool, *Error) {
+	class = resolveAlias(m.schemaManager, class)
 	if err := m.authorizer.Authorize(principal, authorization.READ, authorization.Objects(class, tenant, id)); err != nil {
 		return false, &Error{err.Error(), StatusForbidden, err}
 	}
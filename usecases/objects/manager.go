@@ -65,6 +65,22 @@ type schemaManager interface {
 
 	// GetConsistentSchema retrieves a locally cached copy of the schema
 	GetConsistentSchema(principal *models.Principal, consistency bool) (schema.Schema, error)
+
+	// ResolveAlias returns the class an alias points to or an empty string
+	// if there is no such alias
+	ResolveAlias(alias string) string
+}
+
+// resolveAlias returns the class name if name is an alias, otherwise the name
+// is returned unchanged.
+func resolveAlias(sm schemaManager, name string) string {
+	if name == "" {
+		return name
+	}
+	if class := sm.ResolveAlias(name); class != "" {
+		return class
+	}
+	return name
 }
 
 // Manager manages kind changes at a use-case level, i.e. agnostic of
@@ -81,6 +97,8 @@ type Manager struct {
 	autoSchemaManager *autoSchemaManager
 	metrics           objectsMetrics
 	allocChecker      *memwatch.Monitor
+	quotas            quotaEnforcer
+	classifier        objectClassifier
 }
 
 type objectsMetrics interface {
@@ -111,6 +129,14 @@ type objectsMetrics interface {
 	AddUsageDimensions(className, queryType, operation string, dims int)
 }
 
+// objectClassifier sets missing properties of new objects using previously
+// trained classification models and queues written objects for continuous
+// classifications
+type objectClassifier interface {
+	ClassifyOnInsert(ctx context.Context, object *models.Object) error
+	ClassifyAfterWrite(class string, id strfmt.UUID, tenant string, properties interface{})
+}
+
 type timeSource interface {
 	Now() int64
 }
@@ -161,6 +187,7 @@ func NewManager(locks locks, schemaManager schemaManager,
 	config *config.WeaviateConfig, logger logrus.FieldLogger,
 	authorizer authorization.Authorizer, vectorRepo VectorRepo,
 	modulesProvider ModulesProvider, metrics objectsMetrics, allocChecker *memwatch.Monitor,
+	quotas quotaEnforcer, classifier objectClassifier,
 ) *Manager {
 	if allocChecker == nil {
 		allocChecker = memwatch.NewDummyMonitor()
@@ -178,6 +205,8 @@ func NewManager(locks locks, schemaManager schemaManager,
 		autoSchemaManager: newAutoSchemaManager(schemaManager, vectorRepo, config, logger),
 		metrics:           metrics,
 		allocChecker:      allocChecker,
+		quotas:            quotas,
+		classifier:        classifier,
 	}
 }
 
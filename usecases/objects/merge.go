@@ -37,6 +37,7 @@ type MergeDocument struct {
 	UpdateTime           int64                       `json:"updateTime"`
 	AdditionalProperties models.AdditionalProperties `json:"additionalProperties"`
 	PropertiesToDelete   []string                    `json:"propertiesToDelete"`
+	IfMatch              *int64                      `json:"ifMatch,omitempty"`
 }
 
 func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
@@ -45,6 +46,7 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	if err := m.validateInputs(updates); err != nil {
 		return &Error{"bad request", StatusBadRequest, err}
 	}
+	updates.Class = resolveAlias(m.schemaManager, updates.Class)
 	cls, id := updates.Class, updates.ID
 	if err := m.authorizer.Authorize(principal, authorization.UPDATE, authorization.Objects(cls, updates.Tenant, id)); err != nil {
 		return &Error{err.Error(), StatusForbidden, err}
@@ -144,9 +146,16 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 			m.logger.WithError(err).Debugf("object %s/%s not found, possibly due to replication consistency races", cls, id)
 			return &Error{"not found", StatusNotFound, err}
 		}
+		if errors.As(err, &ErrPreconditionFailed{}) {
+			return &Error{"precondition failed", StatusPreconditionFailed, err}
+		}
 		return &Error{"repo.merge", StatusInternalServerError, err}
 	}
 
+	if m.classifier != nil {
+		m.classifier.ClassifyAfterWrite(cls, id, tenant, updates.Properties)
+	}
+
 	return nil
 }
 
@@ -70,6 +70,7 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 	tenant := "*"
 
 	if params != nil && params.Class != "" {
+		params.Class = resolveAlias(m.schemaManager, params.Class)
 		class = params.Class
 	}
 
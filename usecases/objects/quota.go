@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+type quotaEnforcer interface {
+	CheckWrite(ctx context.Context, class, tenant string, n int) error
+}
+
+// checkQuotas verifies per tenant that all valid objects of the batch fit
+// into the tenant's quotas. If they don't, all objects of that tenant are
+// rejected. Objects replacing existing ones are counted as well, which errs
+// on the side of caution.
+func (b *BatchManager) checkQuotas(ctx context.Context, batchObjects BatchObjects) {
+	if b.quotas == nil {
+		return
+	}
+
+	type classTenant struct{ class, tenant string }
+	indexes := map[classTenant][]int{}
+	for i, obj := range batchObjects {
+		if obj.Err != nil || obj.Object == nil || obj.Object.Tenant == "" {
+			continue
+		}
+		key := classTenant{obj.Object.Class, obj.Object.Tenant}
+		indexes[key] = append(indexes[key], i)
+	}
+
+	for key, idx := range indexes {
+		if err := b.quotas.CheckWrite(ctx, key.class, key.tenant, len(idx)); err != nil {
+			err = quotaError(err)
+			for _, i := range idx {
+				batchObjects[i].Err = err
+			}
+		}
+	}
+}
+
+// quotaError reports exceeded quotas as invalid user input, so they are not
+// mistaken for server errors
+func quotaError(err error) error {
+	if errors.Is(err, enterrors.ErrTenantQuotaExceeded) {
+		return NewErrInvalidUserInput("%v", err)
+	}
+	return NewErrInternal("%v", err)
+}
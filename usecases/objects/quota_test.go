@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type fakeQuotaEnforcer struct {
+	maxObjects map[string]int
+	calls      map[string]int
+}
+
+func (f *fakeQuotaEnforcer) CheckWrite(ctx context.Context, class, tenant string, n int) error {
+	f.calls[tenant] += n
+	if limit, ok := f.maxObjects[tenant]; ok && n > limit {
+		return fmt.Errorf("%w: %s", enterrors.ErrTenantQuotaExceeded, tenant)
+	}
+	return nil
+}
+
+func TestBatchManager_checkQuotas(t *testing.T) {
+	quotas := &fakeQuotaEnforcer{
+		maxObjects: map[string]int{"small": 1},
+		calls:      map[string]int{},
+	}
+	b := &BatchManager{quotas: quotas}
+
+	objs := BatchObjects{
+		{Object: &models.Object{Class: "Pizza", Tenant: "small"}},
+		{Object: &models.Object{Class: "Pizza", Tenant: "small"}},
+		{Object: &models.Object{Class: "Pizza", Tenant: "large"}},
+		{Object: &models.Object{Class: "Pizza", Tenant: "large"}, Err: errors.New("invalid")},
+		{Object: &models.Object{Class: "Pizza"}},
+	}
+	b.checkQuotas(context.Background(), objs)
+
+	assert.Equal(t, map[string]int{"small": 2, "large": 1}, quotas.calls)
+	for i := 0; i < 2; i++ {
+		require.NotNil(t, objs[i].Err)
+		assert.ErrorAs(t, objs[i].Err, &ErrInvalidUserInput{})
+	}
+	assert.Nil(t, objs[2].Err)
+	assert.Nil(t, objs[4].Err)
+}
@@ -35,6 +35,8 @@ func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Prin
 	m.metrics.AddReferenceInc()
 	defer m.metrics.AddReferenceDec()
 
+	input.Class = resolveAlias(m.schemaManager, input.Class)
+
 	ctx = classcache.ContextWithClassCache(ctx)
 
 	deprecatedEndpoint := input.Class == ""
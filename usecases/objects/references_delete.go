@@ -42,6 +42,8 @@ func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.P
 	m.metrics.DeleteReferenceInc()
 	defer m.metrics.DeleteReferenceDec()
 
+	input.Class = resolveAlias(m.schemaManager, input.Class)
+
 	ctx = classcache.ContextWithClassCache(ctx)
 
 	deprecatedEndpoint := input.Class == ""
@@ -47,6 +47,8 @@ func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.
 	m.metrics.UpdateReferenceInc()
 	defer m.metrics.UpdateReferenceDec()
 
+	input.Class = resolveAlias(m.schemaManager, input.Class)
+
 	ctx = classcache.ContextWithClassCache(ctx)
 
 	res, err := m.getObjectFromRepo(ctx, input.Class, input.ID, additional.Properties{}, nil, tenant)
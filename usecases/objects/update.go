@@ -30,6 +30,8 @@ func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
 	class string, id strfmt.UUID, updates *models.Object,
 	repl *additional.ReplicationProperties,
 ) (*models.Object, error) {
+	class = resolveAlias(m.schemaManager, class)
+	updates.Class = resolveAlias(m.schemaManager, updates.Class)
 	if err := m.authorizer.Authorize(principal, authorization.UPDATE, authorization.Objects(updates.Class, updates.Tenant, updates.ID)); err != nil {
 		return nil, err
 	}
@@ -112,5 +114,9 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 		return nil, fmt.Errorf("put object: %w", err)
 	}
 
+	if m.classifier != nil {
+		m.classifier.ClassifyAfterWrite(updates.Class, updates.ID, updates.Tenant, updates.Properties)
+	}
+
 	return updates, nil
 }
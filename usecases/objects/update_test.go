@@ -74,7 +74,7 @@ func Test_UpdateAction(t *testing.T) {
 		metrics := &fakeMetrics{}
 		modulesProvider = getFakeModulesProviderWithCustomExtenders(extender, projectorFake)
 		manager = NewManager(locks, schemaManager, cfg,
-			logger, authorizer, db, modulesProvider, metrics, nil)
+			logger, authorizer, db, modulesProvider, metrics, nil, nil, nil)
 	}
 
 	t.Run("ensure creation timestamp persists", func(t *testing.T) {
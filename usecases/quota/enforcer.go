@@ -0,0 +1,233 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package quota enforces the per-tenant resource limits configured on
+// multi-tenant classes. Object and disk limits are checked against the
+// tenant's shard stats, which are cached for a short period so that the
+// write path does not have to collect them on every request. Query limits
+// are enforced per node using a token bucket.
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	"golang.org/x/time/rate"
+)
+
+// DefaultUsageTTL is how long collected tenant usage is considered fresh
+// enough to enforce object and disk quotas
+const DefaultUsageTTL = 10 * time.Second
+
+type schemaReader interface {
+	Read(class string, reader func(*models.Class, *sharding.State) error) error
+}
+
+// UsageProvider collects the current resource usage of a tenant across the
+// cluster
+type UsageProvider interface {
+	TenantUsage(ctx context.Context, class, tenant string) (*models.TenantUsage, error)
+}
+
+// Enforcer checks writes and queries against tenant quotas. A nil *Enforcer
+// is valid and enforces nothing.
+type Enforcer struct {
+	reader   schemaReader
+	provider UsageProvider
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	tenants map[tenantKey]*tenantState
+}
+
+type tenantKey struct {
+	class, tenant string
+}
+
+type tenantState struct {
+	// queries counts all queries served for the tenant on this node
+	queries atomic.Int64
+
+	sync.Mutex
+	limiter *rate.Limiter
+	qps     int64
+	usage   *models.TenantUsage
+	fetched time.Time
+	// pending is the number of objects admitted since usage was fetched
+	pending int64
+}
+
+// NewEnforcer creates an [Enforcer] reading quotas from the schema and
+// usage from the given provider
+func NewEnforcer(reader schemaReader, provider UsageProvider) *Enforcer {
+	return &Enforcer{
+		reader:   reader,
+		provider: provider,
+		ttl:      DefaultUsageTTL,
+		now:      time.Now,
+		tenants:  make(map[tenantKey]*tenantState),
+	}
+}
+
+// Effective merges tenant specific quotas over the class defaults. Any limit
+// not set (0) on the tenant falls back to the default.
+func Effective(defaults, tenant *models.TenantQuotas) *models.TenantQuotas {
+	q := models.TenantQuotas{}
+	if defaults != nil {
+		q = *defaults
+	}
+	if tenant != nil {
+		if tenant.MaxObjects > 0 {
+			q.MaxObjects = tenant.MaxObjects
+		}
+		if tenant.MaxDiskBytes > 0 {
+			q.MaxDiskBytes = tenant.MaxDiskBytes
+		}
+		if tenant.MaxQueriesPerSecond > 0 {
+			q.MaxQueriesPerSecond = tenant.MaxQueriesPerSecond
+		}
+	}
+	return &q
+}
+
+// Quotas returns the effective quotas of a tenant
+func (e *Enforcer) Quotas(class, tenant string) (*models.TenantQuotas, error) {
+	var q *models.TenantQuotas
+	err := e.reader.Read(class, func(c *models.Class, ss *sharding.State) error {
+		if !schema.MultiTenancyEnabled(c) {
+			q = &models.TenantQuotas{}
+			return nil
+		}
+		var tq *models.TenantQuotas
+		if ss != nil {
+			if p, ok := ss.Physical[tenant]; ok {
+				tq = p.Quotas
+			}
+		}
+		q = Effective(c.MultiTenancyConfig.TenantQuotas, tq)
+		return nil
+	})
+	return q, err
+}
+
+// CheckWrite verifies that n more objects can be written to the tenant
+// without exceeding its object or disk quota
+func (e *Enforcer) CheckWrite(ctx context.Context, class, tenant string, n int) error {
+	if e == nil || tenant == "" || n <= 0 {
+		return nil
+	}
+	q, err := e.Quotas(class, tenant)
+	if err != nil || (q.MaxObjects == 0 && q.MaxDiskBytes == 0) {
+		// unknown classes or tenants are reported by the regular write path
+		return nil
+	}
+
+	st := e.state(class, tenant)
+	st.Lock()
+	defer st.Unlock()
+
+	if st.usage == nil || e.now().Sub(st.fetched) > e.ttl {
+		usage, err := e.provider.TenantUsage(ctx, class, tenant)
+		if err != nil {
+			return fmt.Errorf("collect usage of tenant %q: %w", tenant, err)
+		}
+		st.usage, st.fetched, st.pending = usage, e.now(), 0
+	}
+
+	if q.MaxObjects > 0 && st.usage.ObjectCount+st.pending+int64(n) > q.MaxObjects {
+		return fmt.Errorf("%w: tenant %q of class %q is limited to %d objects",
+			enterrors.ErrTenantQuotaExceeded, tenant, class, q.MaxObjects)
+	}
+	if q.MaxDiskBytes > 0 && st.usage.DiskUsageBytes >= q.MaxDiskBytes {
+		return fmt.Errorf("%w: tenant %q of class %q is limited to %d bytes on disk",
+			enterrors.ErrTenantQuotaExceeded, tenant, class, q.MaxDiskBytes)
+	}
+	st.pending += int64(n)
+	return nil
+}
+
+// CheckQuery counts a query against the tenant and verifies it does not
+// exceed the tenant's query rate on this node
+func (e *Enforcer) CheckQuery(class, tenant string) error {
+	if e == nil || tenant == "" {
+		return nil
+	}
+	st := e.state(class, tenant)
+	st.queries.Add(1)
+
+	q, err := e.Quotas(class, tenant)
+	if err != nil {
+		// unknown classes or tenants are reported by the regular search path
+		return nil
+	}
+
+	st.Lock()
+	defer st.Unlock()
+	if q.MaxQueriesPerSecond == 0 {
+		st.limiter, st.qps = nil, 0
+		return nil
+	}
+	if st.limiter == nil || st.qps != q.MaxQueriesPerSecond {
+		st.qps = q.MaxQueriesPerSecond
+		st.limiter = rate.NewLimiter(rate.Limit(st.qps), int(st.qps))
+	}
+	if !st.limiter.AllowN(e.now(), 1) {
+		return fmt.Errorf("%w: tenant %q of class %q is limited to %d queries per second",
+			enterrors.ErrTenantQuotaExceeded, tenant, class, q.MaxQueriesPerSecond)
+	}
+	return nil
+}
+
+// Usage collects the current usage of a tenant together with its effective
+// quotas. The query count only covers queries served by this node.
+func (e *Enforcer) Usage(ctx context.Context, class, tenant string) (*models.TenantUsage, error) {
+	q, err := e.Quotas(class, tenant)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := e.provider.TenantUsage(ctx, class, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	st := e.state(class, tenant)
+	usage.Name = tenant
+	usage.Quotas = q
+	usage.QueryCount = st.queries.Load()
+
+	// refresh the cache used for enforcement while we are at it
+	st.Lock()
+	cached := *usage
+	st.usage, st.fetched, st.pending = &cached, e.now(), 0
+	st.Unlock()
+
+	return usage, nil
+}
+
+func (e *Enforcer) state(class, tenant string) *tenantState {
+	key := tenantKey{class: class, tenant: tenant}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	st, ok := e.tenants[key]
+	if !ok {
+		st = &tenantState{}
+		e.tenants[key] = st
+	}
+	return st
+}
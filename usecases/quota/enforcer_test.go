@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeSchema struct {
+	class *models.Class
+	state *sharding.State
+}
+
+func (f *fakeSchema) Read(class string, reader func(*models.Class, *sharding.State) error) error {
+	if f.class == nil || f.class.Class != class {
+		return fmt.Errorf("class %q not found", class)
+	}
+	return reader(f.class, f.state)
+}
+
+type fakeUsage struct {
+	usage models.TenantUsage
+	calls int
+}
+
+func (f *fakeUsage) TenantUsage(ctx context.Context, class, tenant string) (*models.TenantUsage, error) {
+	f.calls++
+	u := f.usage
+	return &u, nil
+}
+
+func newTestEnforcer(defaults, tenant *models.TenantQuotas, usage models.TenantUsage) (*Enforcer, *fakeUsage, *time.Time) {
+	fs := &fakeSchema{
+		class: &models.Class{
+			Class: "Pizza",
+			MultiTenancyConfig: &models.MultiTenancyConfig{
+				Enabled:      true,
+				TenantQuotas: defaults,
+			},
+		},
+		state: &sharding.State{Physical: map[string]sharding.Physical{
+			"t1": {Name: "t1", Quotas: tenant},
+		}},
+	}
+	fu := &fakeUsage{usage: usage}
+	e := NewEnforcer(fs, fu)
+	now := time.Unix(1000, 0)
+	e.now = func() time.Time { return now }
+	return e, fu, &now
+}
+
+func TestEffective(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults *models.TenantQuotas
+		tenant   *models.TenantQuotas
+		expected models.TenantQuotas
+	}{
+		{
+			name:     "nothing set",
+			expected: models.TenantQuotas{},
+		},
+		{
+			name:     "only defaults",
+			defaults: &models.TenantQuotas{MaxObjects: 10, MaxQueriesPerSecond: 5},
+			expected: models.TenantQuotas{MaxObjects: 10, MaxQueriesPerSecond: 5},
+		},
+		{
+			name:     "tenant overrides defaults",
+			defaults: &models.TenantQuotas{MaxObjects: 10, MaxDiskBytes: 100},
+			tenant:   &models.TenantQuotas{MaxObjects: 20, MaxQueriesPerSecond: 3},
+			expected: models.TenantQuotas{MaxObjects: 20, MaxDiskBytes: 100, MaxQueriesPerSecond: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, *Effective(tt.defaults, tt.tenant))
+		})
+	}
+}
+
+func TestCheckWrite(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("nil enforcer allows everything", func(t *testing.T) {
+		var e *Enforcer
+		require.Nil(t, e.CheckWrite(ctx, "Pizza", "t1", 100))
+		require.Nil(t, e.CheckQuery("Pizza", "t1"))
+	})
+
+	t.Run("no quotas does not collect usage", func(t *testing.T) {
+		e, fu, _ := newTestEnforcer(nil, nil, models.TenantUsage{ObjectCount: 1000})
+		require.Nil(t, e.CheckWrite(ctx, "Pizza", "t1", 100))
+		assert.Equal(t, 0, fu.calls)
+	})
+
+	t.Run("object quota", func(t *testing.T) {
+		e, fu, now := newTestEnforcer(&models.TenantQuotas{MaxObjects: 10}, nil,
+			models.TenantUsage{ObjectCount: 5})
+		require.Nil(t, e.CheckWrite(ctx, "Pizza", "t1", 3))
+		// pending writes are taken into account until usage is refreshed
+		err := e.CheckWrite(ctx, "Pizza", "t1", 3)
+		require.ErrorIs(t, err, enterrors.ErrTenantQuotaExceeded)
+		require.Nil(t, e.CheckWrite(ctx, "Pizza", "t1", 2))
+		assert.Equal(t, 1, fu.calls)
+
+		*now = now.Add(2 * DefaultUsageTTL)
+		fu.usage.ObjectCount = 10
+		require.ErrorIs(t, e.CheckWrite(ctx, "Pizza", "t1", 1), enterrors.ErrTenantQuotaExceeded)
+		assert.Equal(t, 2, fu.calls)
+	})
+
+	t.Run("tenant quota overrides default", func(t *testing.T) {
+		e, _, _ := newTestEnforcer(&models.TenantQuotas{MaxObjects: 10},
+			&models.TenantQuotas{MaxObjects: 100}, models.TenantUsage{ObjectCount: 50})
+		require.Nil(t, e.CheckWrite(ctx, "Pizza", "t1", 10))
+	})
+
+	t.Run("disk quota", func(t *testing.T) {
+		e, _, _ := newTestEnforcer(nil, &models.TenantQuotas{MaxDiskBytes: 1024},
+			models.TenantUsage{DiskUsageBytes: 2048})
+		require.ErrorIs(t, e.CheckWrite(ctx, "Pizza", "t1", 1), enterrors.ErrTenantQuotaExceeded)
+	})
+}
+
+func TestCheckQuery(t *testing.T) {
+	e, _, now := newTestEnforcer(nil, &models.TenantQuotas{MaxQueriesPerSecond: 2},
+		models.TenantUsage{})
+
+	require.Nil(t, e.CheckQuery("Pizza", "t1"))
+	require.Nil(t, e.CheckQuery("Pizza", "t1"))
+	require.ErrorIs(t, e.CheckQuery("Pizza", "t1"), enterrors.ErrTenantQuotaExceeded)
+
+	*now = now.Add(time.Second)
+	require.Nil(t, e.CheckQuery("Pizza", "t1"))
+
+	usage, err := e.Usage(context.Background(), "Pizza", "t1")
+	require.Nil(t, err)
+	assert.Equal(t, "t1", usage.Name)
+	assert.Equal(t, int64(4), usage.QueryCount)
+	assert.Equal(t, int64(2), usage.Quotas.MaxQueriesPerSecond)
+}
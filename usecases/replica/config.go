@@ -31,6 +31,10 @@ func ValidateConfig(class *models.Class, globalCfg replication.GlobalConfig) err
 		return nil
 	}
 
+	if err := ValidateConsistency(class.ReplicationConfig); err != nil {
+		return err
+	}
+
 	if class.ReplicationConfig.Factor > 0 && class.ReplicationConfig.Factor < int64(globalCfg.MinimumFactor) {
 		return fmt.Errorf("invalid replication factor: setup requires a minimum replication factor of %d: got %d",
 			globalCfg.MinimumFactor, class.ReplicationConfig.Factor)
@@ -58,6 +62,10 @@ func ValidateConfigUpdate(old, updated *models.Class, nodeCounter nodeCounter) e
 		updated.ReplicationConfig = &models.ReplicationConfig{Factor: 1}
 	}
 
+	if err := ValidateConsistency(updated.ReplicationConfig); err != nil {
+		return err
+	}
+
 	if old.ReplicationConfig.Factor != updated.ReplicationConfig.Factor {
 		nc := nodeCounter.NodeCount()
 		if int(updated.ReplicationConfig.Factor) > nc {
@@ -68,3 +76,30 @@ func ValidateConfigUpdate(old, updated *models.Class, nodeCounter nodeCounter) e
 
 	return nil
 }
+
+// ValidateConsistency validates the default consistency settings of a
+// replication config
+func ValidateConsistency(cfg *models.ReplicationConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	if err := ValidateConsistencyLevel(cfg.ConsistencyLevel); err != nil {
+		return err
+	}
+	if cfg.MaxStalenessSeconds < 0 {
+		return fmt.Errorf("invalid max staleness: must not be negative: got %d", cfg.MaxStalenessSeconds)
+	}
+	return nil
+}
+
+// ValidateConsistencyLevel validates a default consistency level. An empty
+// level is valid and means no default is set
+func ValidateConsistencyLevel(l string) error {
+	switch ConsistencyLevel(l) {
+	case "", One, Quorum, All, BoundedStaleness:
+		return nil
+	default:
+		return fmt.Errorf("invalid consistency level %q: must be one of %s, %s, %s or %s",
+			l, One, Quorum, All, BoundedStaleness)
+	}
+}
@@ -65,6 +65,24 @@ func Test_ValidateConfig(t *testing.T) {
 			globalConfig:  replication.GlobalConfig{MinimumFactor: 2},
 			expectedErr:   fmt.Errorf("invalid replication factor: setup requires a minimum replication factor of 2: got 1"),
 		},
+		{
+			name:          "config provided, default consistency",
+			initialconfig: &models.ReplicationConfig{Factor: 3, ConsistencyLevel: "BOUNDED_STALENESS", MaxStalenessSeconds: 5},
+			resultConfig:  &models.ReplicationConfig{Factor: 3, ConsistencyLevel: "BOUNDED_STALENESS", MaxStalenessSeconds: 5},
+			globalConfig:  replication.GlobalConfig{MinimumFactor: 1},
+		},
+		{
+			name:          "invalid default consistency leads to error",
+			initialconfig: &models.ReplicationConfig{Factor: 3, ConsistencyLevel: "SOME"},
+			globalConfig:  replication.GlobalConfig{MinimumFactor: 1},
+			expectedErr:   fmt.Errorf(`invalid consistency level "SOME": must be one of ONE, QUORUM, ALL or BOUNDED_STALENESS`),
+		},
+		{
+			name:          "negative max staleness leads to error",
+			initialconfig: &models.ReplicationConfig{Factor: 3, MaxStalenessSeconds: -1},
+			globalConfig:  replication.GlobalConfig{MinimumFactor: 1},
+			expectedErr:   fmt.Errorf("invalid max staleness: must not be negative: got -1"),
+		},
 	}
 
 	for _, test := range tests {
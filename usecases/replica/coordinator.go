@@ -51,9 +51,52 @@ type (
 		pullBackOffPreInitialInterval time.Duration
 		pullBackOffMaxElapsedTime     time.Duration // stop retrying after this long
 		deletionStrategy              string
+		// missed is called with the names of the replicas which missed a write
+		// applied by at least one other replica
+		missed func(nodes []string)
+	}
+
+	// delivery records the replicas which applied a write
+	delivery struct {
+		sync.Mutex
+		nodes   map[string]string // node name -> host
+		applied map[string]bool   // host -> applied
 	}
 )
 
+func newDelivery(nodes map[string]string) *delivery {
+	return &delivery{nodes: nodes, applied: make(map[string]bool, len(nodes))}
+}
+
+func (d *delivery) add(host string) {
+	if d == nil {
+		return
+	}
+	d.Lock()
+	defer d.Unlock()
+	d.applied[host] = true
+}
+
+// missed returns the names of the replicas which didn't apply the write
+// if at least one replica applied it, otherwise nil
+func (d *delivery) missed() []string {
+	if d == nil {
+		return nil
+	}
+	d.Lock()
+	defer d.Unlock()
+	if len(d.applied) == 0 {
+		return nil
+	}
+	var nodes []string
+	for name, host := range d.nodes {
+		if host == "" || !d.applied[host] {
+			nodes = append(nodes, name)
+		}
+	}
+	return nodes
+}
+
 // newCoordinator used by the replicator
 func newCoordinator[T any](r *Replicator, shard, requestID string, l logrus.FieldLogger,
 ) *coordinator[T] {
@@ -152,6 +195,7 @@ func (c *coordinator[T]) broadcast(ctx context.Context,
 func (c *coordinator[T]) commitAll(ctx context.Context,
 	replicaCh <-chan string,
 	op commitOp[T],
+	dl *delivery,
 ) <-chan _Result[T] {
 	replyCh := make(chan _Result[T], cap(replicaCh))
 	f := func() { // tells active replicas to commit
@@ -162,12 +206,18 @@ func (c *coordinator[T]) commitAll(ctx context.Context,
 			g := func() {
 				defer wg.Done()
 				resp, err := op(ctx, replica, c.TxID)
+				if err == nil {
+					dl.add(replica)
+				}
 				replyCh <- _Result[T]{resp, err}
 			}
 			enterrors.GoWrapper(g, c.log)
 		}
 		wg.Wait()
 		close(replyCh)
+		if nodes := dl.missed(); len(nodes) > 0 {
+			c.missed(nodes)
+		}
 	}
 	enterrors.GoWrapper(f, c.log)
 
@@ -193,7 +243,37 @@ func (c *coordinator[T]) Push(ctx context.Context,
 		"level":    level,
 	}).Debug("context.WithTimeout")
 	nodeCh := c.broadcast(ctxWithTimeout, state.Hosts, ask, level)
-	return c.commitAll(context.Background(), nodeCh, com), level, nil
+	c.forward(ctxWithTimeout, state.Joining, ask, com)
+	var dl *delivery
+	if c.missed != nil {
+		dl = newDelivery(state.NodeMap)
+	}
+	return c.commitAll(context.Background(), nodeCh, com, dl), level, nil
+}
+
+// forward sends a write request to replicas which are being added to the shard.
+// It is best effort: the outcome neither affects the consistency level nor
+// the response to the client. Joining replicas buffer a bounded number of
+// writes, and objects missed because of failed forwards are pushed to them
+// by the existing replicas before they are committed.
+func (c *coordinator[T]) forward(ctx context.Context,
+	hosts []string,
+	ask readyOp,
+	com commitOp[T],
+) {
+	for _, host := range hosts {
+		host := host
+		f := func() {
+			if err := ask(ctx, host, c.TxID); err != nil {
+				c.log.WithField("op", "forward").WithField("host", host).Warn(err)
+				return
+			}
+			if _, err := com(ctx, host, c.TxID); err != nil {
+				c.log.WithField("op", "forward").WithField("host", host).Warn(err)
+			}
+		}
+		enterrors.GoWrapper(f, c.log)
+	}
 }
 
 // Pull data from replica depending on consistency level, trying to reach level successful calls
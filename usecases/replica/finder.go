@@ -15,11 +15,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/replication"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
@@ -55,13 +57,24 @@ type (
 	}
 )
 
+// SyncTracker reports when local replicas were last known to be in sync
+type SyncTracker interface {
+	// LastSync returns when the local replica of a shard last completed an
+	// anti-entropy sync or acknowledged a replicated write. It returns the
+	// zero time if this is unknown.
+	LastSync(shard string) time.Time
+}
+
 // Finder finds replicated objects
 type Finder struct {
 	resolver     *resolver // host names of replicas
-	finderStream           // stream of objects
+	syncs        SyncTracker
+	finderStream // stream of objects
 	// control the op backoffs in the coordinator's Pull
 	coordinatorPullBackoffInitialInterval time.Duration
 	coordinatorPullBackoffMaxElapsedTime  time.Duration
+	// token records the writes which reads must reflect
+	token *replication.ConsistencyToken
 }
 
 // NewFinder constructs a new finder instance
@@ -90,6 +103,104 @@ func NewFinder(className string,
 	}
 }
 
+// SetSyncTracker sets the source of the sync times of local replicas
+// which is required to serve bounded staleness reads from a single replica
+func (f *Finder) SetSyncTracker(t SyncTracker) {
+	f.syncs = t
+}
+
+// WithConsistencyToken returns a finder whose reads reflect at least the
+// writes recorded in token. Reads at ONE are answered by a replica whose
+// applied watermark reaches the token, or at QUORUM if there is none.
+func (f *Finder) WithConsistencyToken(token *replication.ConsistencyToken) *Finder {
+	if token == nil {
+		return f
+	}
+	g := *f
+	g.token = token
+	return &g
+}
+
+// writtenAt returns the update time of the latest write to shard recorded in
+// the consistency token, or 0 if there is none
+func (f *Finder) writtenAt(shard string) int64 {
+	return f.token.UpdateTime(f.class, shard)
+}
+
+// caughtUp reports whether the replica of shard held by host has applied
+// the writes to shard recorded in the consistency token, that is whether its
+// applied watermark reaches the token
+func (f *Finder) caughtUp(ctx context.Context, shard, host string) bool {
+	t := f.writtenAt(shard)
+	if t == 0 {
+		return true
+	}
+	if host == "" {
+		return false
+	}
+	watermark, err := f.client.AppliedWatermark(ctx, host, f.class, shard)
+	if err != nil {
+		f.log.WithField("op", "applied_watermark").WithField("host", host).
+			WithField("shard", shard).Debug(err)
+		return false
+	}
+	return watermark >= t
+}
+
+// tokenReplica returns the consistency level and the replica to be asked
+// first for a read at level l from shard which must reflect the writes
+// recorded in the consistency token. Reads at ONE are answered by a caught up
+// replica, preferably the local one, and fall back to QUORUM if there is none.
+func (f *Finder) tokenReplica(ctx context.Context, l ConsistencyLevel, shard string) (ConsistencyLevel, string) {
+	if l != One || f.writtenAt(shard) == 0 {
+		return l, ""
+	}
+	nodes, err := f.resolver.Schema.ResolveParentNodes(f.class, shard)
+	if err != nil {
+		return Quorum, ""
+	}
+	names := make([]string, 0, len(nodes))
+	for name := range nodes {
+		if name != f.resolver.NodeName {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if _, ok := nodes[f.resolver.NodeName]; ok {
+		names = append([]string{f.resolver.NodeName}, names...)
+	}
+	if len(names) == 1 {
+		// a QUORUM read would be answered by the same replica
+		return One, names[0]
+	}
+	for _, name := range names {
+		if f.caughtUp(ctx, shard, nodes[name]) {
+			return One, name
+		}
+	}
+	return Quorum, ""
+}
+
+// readLevel resolves the consistency level of a read from shard which is
+// answered by node first. An empty level is resolved to the default of the
+// shard, or to fallback if none is configured.
+//
+// Bounded staleness reads are answered by node alone if it is the local
+// node and its replica has been in sync within the maximum staleness.
+// Otherwise they fall back to QUORUM.
+func (f *Finder) readLevel(shard, node string, l, fallback ConsistencyLevel) ConsistencyLevel {
+	l, maxStaleness := f.resolver.consistency(shard, l, fallback)
+	if l != BoundedStaleness {
+		return l
+	}
+	if f.syncs != nil && node == f.resolver.NodeName {
+		if last := f.syncs.LastSync(shard); !last.IsZero() && time.Since(last) <= maxStaleness {
+			return One
+		}
+	}
+	return Quorum
+}
+
 // GetOne gets object which satisfies the giving consistency
 func (f *Finder) GetOne(ctx context.Context,
 	l ConsistencyLevel, shard string,
@@ -113,7 +224,8 @@ func (f *Finder) GetOne(ctx context.Context,
 			return findOneReply{host, x.Version, r, x.UpdateTime, true}, err
 		}
 	}
-	replyCh, state, err := c.Pull(ctx, l, op, "", 20*time.Second)
+	l, node := f.tokenReplica(ctx, f.readLevel(shard, f.resolver.NodeName, l, Quorum), shard)
+	replyCh, state, err := c.Pull(ctx, l, op, node, 20*time.Second)
 	if err != nil {
 		f.log.WithField("op", "pull.one").Error(err)
 		return nil, fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas)
@@ -138,6 +250,7 @@ func (f *Finder) FindUUIDs(ctx context.Context,
 		return f.client.FindUUIDs(ctx, host, f.class, shard, filters)
 	}
 
+	l = f.readLevel(shard, f.resolver.NodeName, l, Quorum)
 	replyCh, _, err := c.Pull(ctx, l, op, "", 30*time.Second)
 	if err != nil {
 		f.log.WithField("op", "pull.one").Error(err)
@@ -189,16 +302,22 @@ func (f *Finder) CheckConsistency(ctx context.Context,
 		}
 	}
 
-	if l == One { // already consistent
-		for i := range xs {
-			xs[i].IsConsistent = true
-		}
-		return nil
-	}
 	// check shard consistency concurrently
 	gr, ctx := enterrors.NewErrorGroupWithContextWrapper(f.logger, ctx)
 	for _, part := range cluster(createBatch(xs)) {
 		part := part
+		l := f.readLevel(part.Shard, part.Node, l, One)
+		if l == One {
+			if host, _ := f.resolver.NodeHostname(part.Node); !f.caughtUp(ctx, part.Shard, host) {
+				l = Quorum
+			}
+		}
+		if l == One { // already consistent
+			for _, idx := range part.Index {
+				part.Data[idx].IsConsistent = true
+			}
+			continue
+		}
 		gr.Go(func() error {
 			_, err := f.checkShardConsistency(ctx, l, part)
 			if err != nil {
@@ -227,7 +346,8 @@ func (f *Finder) Exists(ctx context.Context,
 		}
 		return existReply{host, x}, err
 	}
-	replyCh, state, err := c.Pull(ctx, l, op, "", 20*time.Second)
+	l, node := f.tokenReplica(ctx, f.readLevel(shard, f.resolver.NodeName, l, Quorum), shard)
+	replyCh, state, err := c.Pull(ctx, l, op, node, 20*time.Second)
 	if err != nil {
 		f.log.WithField("op", "pull.exist").Error(err)
 		return false, fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas)
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
@@ -460,6 +461,83 @@ func TestFinderGetOneWithConsistencyLevelOne(t *testing.T) {
 	})
 }
 
+type fakeSyncTracker map[string]time.Time
+
+func (f fakeSyncTracker) LastSync(shard string) time.Time {
+	return f[shard]
+}
+
+func TestFinderGetOneWithBoundedStaleness(t *testing.T) {
+	var (
+		id        = strfmt.UUID("123")
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		ctx       = context.Background()
+		adds      = additional.Properties{}
+		proj      = search.SelectProperties{}
+		digestIDs = []strfmt.UUID{id}
+		item      = objects.Replica{ID: id, Object: object(id, 3)}
+		digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+	)
+
+	t.Run("InSync", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder("A")
+		finder.SetSyncTracker(fakeSyncTracker{shard: time.Now()})
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+
+		got, err := finder.GetOne(ctx, BoundedStaleness, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		f.RClient.AssertNotCalled(t, "DigestObjects", anyVal, anyVal, cls, shard, digestIDs)
+	})
+
+	t.Run("TenantDefault", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder("A")
+		finder.resolver.Schema.(*fakeShardingState).ShardToConsistency = map[string]ConsistencyLevel{
+			shard: BoundedStaleness,
+		}
+		finder.SetSyncTracker(fakeSyncTracker{shard: time.Now()})
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+
+		got, err := finder.GetOne(ctx, "", shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		f.RClient.AssertNotCalled(t, "DigestObjects", anyVal, anyVal, cls, shard, digestIDs)
+	})
+
+	t.Run("Stale", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder("A")
+		finder.resolver.Schema.(*fakeShardingState).MaxStaleness = time.Second
+		finder.SetSyncTracker(fakeSyncTracker{shard: time.Now().Add(-time.Minute)})
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, errAny)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, nil)
+
+		// falls back to QUORUM
+		got, err := finder.GetOne(ctx, BoundedStaleness, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		f.RClient.AssertCalled(t, "DigestObjects", anyVal, nodes[2], cls, shard, digestIDs)
+	})
+
+	t.Run("NeverSynced", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder("A")
+		finder.SetSyncTracker(fakeSyncTracker{})
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, errAny)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, errAny)
+
+		got, err := finder.GetOne(ctx, BoundedStaleness, shard, id, proj, adds)
+		assert.ErrorIs(t, err, errRead)
+		assert.Nil(t, got)
+	})
+}
+
 func TestFinderExistsWithConsistencyLevelALL(t *testing.T) {
 	var (
 		id       = strfmt.UUID("123")
@@ -874,3 +952,159 @@ func TestFinderCheckConsistencyOne(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, want, xs)
 }
+
+func TestFinderWithConsistencyToken(t *testing.T) {
+	var (
+		id        = strfmt.UUID("123")
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		ctx       = context.Background()
+		adds      = additional.Properties{}
+		proj      = search.SelectProperties{}
+		digestIDs = []strfmt.UUID{id}
+		item      = objects.Replica{ID: id, Object: object(id, 3)}
+		digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+	)
+	tokenAt := func(updateTime int64) *replication.ConsistencyToken {
+		token := replication.NewConsistencyToken()
+		token.Add(cls, shard, updateTime)
+		return token
+	}
+
+	t.Run("GetOneCaughtUp", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder("A").WithConsistencyToken(tokenAt(3))
+		f.RClient.On("AppliedWatermark", anyVal, nodes[0], cls, shard).Return(int64(3), nil)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+
+		got, err := finder.GetOne(ctx, One, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		f.RClient.AssertNotCalled(t, "DigestObjects", anyVal, anyVal, cls, shard, digestIDs)
+	})
+
+	t.Run("GetOneOtherReplicaCaughtUp", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder("A").WithConsistencyToken(tokenAt(5))
+		f.RClient.On("AppliedWatermark", anyVal, nodes[0], cls, shard).Return(int64(3), nil)
+		f.RClient.On("AppliedWatermark", anyVal, nodes[1], cls, shard).Return(int64(5), nil)
+		f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).Return(item, nil)
+
+		got, err := finder.GetOne(ctx, One, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		f.RClient.AssertNotCalled(t, "FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds)
+		f.RClient.AssertNotCalled(t, "DigestObjects", anyVal, anyVal, cls, shard, digestIDs)
+	})
+
+	t.Run("GetOneBehind", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder("A").WithConsistencyToken(tokenAt(5))
+		for _, n := range nodes {
+			f.RClient.On("AppliedWatermark", anyVal, n, cls, shard).Return(int64(3), nil)
+		}
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, errAny)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, nil)
+
+		// falls back to QUORUM
+		got, err := finder.GetOne(ctx, One, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		f.RClient.AssertCalled(t, "DigestObjects", anyVal, nodes[2], cls, shard, digestIDs)
+	})
+
+	t.Run("GetOneWatermarkError", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder("A").WithConsistencyToken(tokenAt(5))
+		for _, n := range nodes {
+			f.RClient.On("AppliedWatermark", anyVal, n, cls, shard).Return(int64(0), errAny)
+		}
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, nil)
+
+		// replicas which cannot report their watermark are not caught up
+		got, err := finder.GetOne(ctx, One, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		f.RClient.AssertCalled(t, "DigestObjects", anyVal, nodes[1], cls, shard, digestIDs)
+	})
+
+	t.Run("GetOneOtherShard", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		token := replication.NewConsistencyToken()
+		token.Add(cls, "SH2", 5)
+		finder := f.newFinder("A").WithConsistencyToken(token)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+
+		got, err := finder.GetOne(ctx, One, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		f.RClient.AssertNotCalled(t, "AppliedWatermark", anyVal, anyVal, cls, shard)
+		f.RClient.AssertNotCalled(t, "DigestObjects", anyVal, anyVal, cls, shard, digestIDs)
+	})
+
+	t.Run("ExistsBehind", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder("A").WithConsistencyToken(tokenAt(5))
+		f.RClient.On("AppliedWatermark", anyVal, nodes[0], cls, shard).Return(int64(3), nil)
+		f.RClient.On("AppliedWatermark", anyVal, nodes[1], cls, shard).Return(int64(6), nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, nil)
+
+		// the object is not visible on the first replica yet
+		got, err := finder.Exists(ctx, One, shard, id)
+		assert.Nil(t, err)
+		assert.Equal(t, true, got)
+		f.RClient.AssertNotCalled(t, "DigestObjects", anyVal, nodes[0], cls, shard, digestIDs)
+	})
+
+	t.Run("CheckConsistencyBehind", func(t *testing.T) {
+		var (
+			ids = []strfmt.UUID{"10", "20"}
+			f   = newFakeFactory(cls, shard, nodes)
+			xs  = []*storobj.Object{
+				objectEx(ids[0], 1, shard, "A"),
+				objectEx(ids[1], 6, shard, "A"),
+			}
+			digestR = []RepairResponse{
+				{ID: ids[0].String(), UpdateTime: 1},
+				{ID: ids[1].String(), UpdateTime: 6},
+			}
+			want = setObjectsConsistency(xs, true)
+		)
+		// the first object might be a stale version of the write at 5,
+		// even though the second one is more recent
+		finder := f.newFinder("A").WithConsistencyToken(tokenAt(5))
+		f.RClient.On("AppliedWatermark", anyVal, nodes[0], cls, shard).Return(int64(4), nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, ids).Return(digestR, errAny)
+
+		err := finder.CheckConsistency(ctx, One, xs)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, want, xs)
+		f.RClient.AssertCalled(t, "DigestObjects", anyVal, nodes[1], cls, shard, ids)
+	})
+
+	t.Run("CheckConsistencyCaughtUp", func(t *testing.T) {
+		var (
+			ids = []strfmt.UUID{"10", "20"}
+			f   = newFakeFactory(cls, shard, nodes)
+			xs  = []*storobj.Object{
+				objectEx(ids[0], 1, shard, "A"),
+				objectEx(ids[1], 2, shard, "A"),
+			}
+			want = setObjectsConsistency(xs, true)
+		)
+		// objects older than the token are up to date if the replica
+		// has applied all writes up to it
+		finder := f.newFinder("A").WithConsistencyToken(tokenAt(5))
+		f.RClient.On("AppliedWatermark", anyVal, nodes[0], cls, shard).Return(int64(5), nil)
+
+		err := finder.CheckConsistency(ctx, One, xs)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, want, xs)
+		f.RClient.AssertNotCalled(t, "DigestObjects", anyVal, anyVal, cls, shard, ids)
+	})
+}
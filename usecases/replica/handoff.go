@@ -0,0 +1,584 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/replication"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	// hintReplayBatch is the number of hints read from the store at once
+	hintReplayBatch = 64
+	// defaultHintReplayInterval is how often hints of available replicas are
+	// replayed in case a join notification was missed or a replay failed
+	defaultHintReplayInterval = 30 * time.Second
+)
+
+// errHintRejected is returned if a replica refuses a replayed write. The hint
+// is dropped since it would be refused again.
+var errHintRejected = errors.New("hint rejected")
+
+// HintStore durably stores hints in key order
+type HintStore interface {
+	Put(key, value []byte) error
+	Delete(key []byte) error
+	// Scan calls f for each entry whose key starts with prefix in key order
+	// until f returns false
+	Scan(prefix []byte, f func(key, value []byte) bool) error
+}
+
+// hint is a write which could not be delivered to a replica
+type hint struct {
+	Op            opID   `json:"op"`
+	Class         string `json:"class"`
+	Shard         string `json:"shard"`
+	SchemaVersion uint64 `json:"schemaVersion"`
+	// CreatedAt is the time in milliseconds at which the write was coordinated
+	CreatedAt int64 `json:"createdAt"`
+
+	// Objects are the binary representations of the objects of a put
+	Objects [][]byte                `json:"objects,omitempty"`
+	Merge   *objects.MergeDocument  `json:"merge,omitempty"`
+	UUIDs   []strfmt.UUID           `json:"uuids,omitempty"`
+	DryRun  bool                    `json:"dryRun,omitempty"`
+	Refs    objects.BatchReferences `json:"refs,omitempty"`
+}
+
+func (x *hint) setObjects(objs ...*storobj.Object) error {
+	x.Objects = make([][]byte, 0, len(objs))
+	for _, obj := range objs {
+		b, err := obj.MarshalBinary()
+		if err != nil {
+			return fmt.Errorf("marshal object %s: %w", obj.ID(), err)
+		}
+		x.Objects = append(x.Objects, b)
+	}
+	return nil
+}
+
+func (x *hint) objects() ([]*storobj.Object, error) {
+	objs := make([]*storobj.Object, 0, len(x.Objects))
+	for _, b := range x.Objects {
+		obj, err := storobj.FromBinary(b)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+// pendingHints accounts for the hints stored for a single replica
+type pendingHints struct {
+	count int64
+	bytes int64
+}
+
+// Handoff implements hinted handoff. Writes which could not be delivered to
+// some replicas are stored on the coordinating node and replayed once these
+// replicas are available again. Hints don't count towards the consistency
+// level of a write, they only shorten the time replicas are stale.
+type Handoff struct {
+	nodeName string
+	store    HintStore
+	client   Client
+	nodes    interface {
+		NodeHostname(nodeName string) (string, bool)
+	}
+	cfg     replication.HintedHandoffConfig
+	log     logrus.FieldLogger
+	metrics *monitoring.PrometheusMetrics
+
+	sync.Mutex
+	lastSeq   uint64
+	pending   map[string]*pendingHints
+	replaying map[string]bool
+
+	requestCounter atomic.Uint64
+	ctx            context.Context
+	cancel         context.CancelFunc
+	done           chan struct{}
+}
+
+// NewHandoff creates the hinted handoff of the local node. It accounts for
+// the hints which are already in the store.
+func NewHandoff(nodeName string, store HintStore, client Client,
+	nodes interface {
+		NodeHostname(nodeName string) (string, bool)
+	},
+	cfg replication.HintedHandoffConfig,
+	metrics *monitoring.PrometheusMetrics,
+	logger logrus.FieldLogger,
+) (*Handoff, error) {
+	h := &Handoff{
+		nodeName:  nodeName,
+		store:     store,
+		client:    client,
+		nodes:     nodes,
+		cfg:       cfg,
+		log:       logger.WithField("action", "hinted_handoff"),
+		metrics:   metrics,
+		pending:   make(map[string]*pendingHints),
+		replaying: make(map[string]bool),
+	}
+	err := store.Scan(nil, func(key, value []byte) bool {
+		node, seq, ok := parseHintKey(key)
+		if !ok {
+			return true
+		}
+		h.account(node, 1, int64(len(value)))
+		if seq > h.lastSeq {
+			h.lastSeq = seq
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load hints: %w", err)
+	}
+	return h, nil
+}
+
+// Start replays hints of available replicas periodically
+func (h *Handoff) Start(interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultHintReplayInterval
+	}
+	h.ctx, h.cancel = context.WithCancel(context.Background())
+	h.done = make(chan struct{})
+	f := func() {
+		defer close(h.done)
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-h.ctx.Done():
+				return
+			case <-t.C:
+				for _, node := range h.nodesWithHints() {
+					h.replayOrExpire(h.ctx, node)
+				}
+			}
+		}
+	}
+	enterrors.GoWrapper(f, h.log)
+}
+
+// Shutdown stops replaying hints
+func (h *Handoff) Shutdown(ctx context.Context) error {
+	if h.cancel == nil {
+		return nil
+	}
+	h.cancel()
+	select {
+	case <-h.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// NodeJoined replays the hints of node in the background. It is called when
+// node (re)joins the cluster.
+func (h *Handoff) NodeJoined(node string) {
+	if node == h.nodeName || h.ctx == nil || !h.hasHints(node) {
+		return
+	}
+	enterrors.GoWrapper(func() { h.replayOrExpire(h.ctx, node) }, h.log)
+}
+
+// Pending returns the number of hints and their size stored for node
+func (h *Handoff) Pending(node string) (count, size int64) {
+	h.Lock()
+	defer h.Unlock()
+	if p := h.pending[node]; p != nil {
+		return p.count, p.bytes
+	}
+	return 0, 0
+}
+
+// add stores x for each of the given nodes unless this exceeds the limits
+func (h *Handoff) add(nodes []string, x *hint) {
+	value, err := json.Marshal(x)
+	if err != nil {
+		h.log.WithField("class", x.Class).WithField("shard", x.Shard).
+			Errorf("marshal hint: %v", err)
+		return
+	}
+	size := int64(len(value))
+
+	h.Lock()
+	defer h.Unlock()
+	for _, node := range nodes {
+		if node == "" {
+			continue
+		}
+		if p := h.pending[node]; h.cfg.MaxBytesPerNode > 0 && p != nil && p.bytes+size > h.cfg.MaxBytesPerNode {
+			h.dropped(node, "limit", 1)
+			h.log.WithField("node", node).WithField("class", x.Class).WithField("shard", x.Shard).
+				Warn("hints limit reached, missed write left to repair")
+			continue
+		}
+		h.lastSeq = max(h.lastSeq+1, uint64(time.Now().UnixNano()))
+		if err := h.store.Put(hintKey(node, h.lastSeq), value); err != nil {
+			h.dropped(node, "error", 1)
+			h.log.WithField("node", node).Errorf("store hint: %v", err)
+			continue
+		}
+		h.account(node, 1, size)
+		if h.metrics != nil {
+			h.metrics.ReplicationHintsStored.WithLabelValues(node).Inc()
+		}
+	}
+}
+
+// replayOrExpire replays the hints of node if it is available, otherwise it
+// drops its expired hints
+func (h *Handoff) replayOrExpire(ctx context.Context, node string) {
+	if !h.startReplay(node) {
+		return
+	}
+	defer h.stopReplay(node)
+
+	host, ok := h.nodes.NodeHostname(node)
+	if !ok {
+		if err := h.expire(node); err != nil {
+			h.log.WithField("node", node).Errorf("expire hints: %v", err)
+		}
+		return
+	}
+	n, err := h.replay(ctx, node, host)
+	if n > 0 || err != nil {
+		logger := h.log.WithField("node", node).WithField("replayed", n)
+		if err != nil {
+			logger.Warnf("replay hints: %v", err)
+		} else {
+			logger.Info("replayed hints")
+		}
+	}
+}
+
+// replay delivers the hints of node to host in the order they were stored.
+// It stops at the first hint which could not be delivered.
+func (h *Handoff) replay(ctx context.Context, node, host string) (replayed int, err error) {
+	prefix := hintPrefix(node)
+	for {
+		type entry struct{ key, value []byte }
+		batch := make([]entry, 0, hintReplayBatch)
+		err := h.store.Scan(prefix, func(key, value []byte) bool {
+			batch = append(batch, entry{key, value})
+			return len(batch) < hintReplayBatch
+		})
+		if err != nil || len(batch) == 0 {
+			return replayed, err
+		}
+		for _, e := range batch {
+			if err := ctx.Err(); err != nil {
+				return replayed, err
+			}
+			var x hint
+			reason := ""
+			if err := json.Unmarshal(e.value, &x); err != nil {
+				h.log.WithField("node", node).Errorf("unmarshal hint: %v", err)
+				reason = "invalid"
+			} else if h.expired(&x) {
+				reason = "expired"
+			} else if err := h.deliver(ctx, host, &x); errors.Is(err, errHintRejected) {
+				h.log.WithField("node", node).WithField("class", x.Class).
+					WithField("shard", x.Shard).Warn(err)
+				reason = "rejected"
+			} else if err != nil {
+				return replayed, err
+			}
+			if err := h.remove(node, e.key, int64(len(e.value))); err != nil {
+				return replayed, err
+			}
+			if reason != "" {
+				h.dropped(node, reason, 1)
+				continue
+			}
+			replayed++
+			if h.metrics != nil {
+				h.metrics.ReplicationHintsReplayed.WithLabelValues(node).Inc()
+			}
+		}
+	}
+}
+
+// expire drops the expired hints of node
+func (h *Handoff) expire(node string) error {
+	if h.cfg.MaxAge <= 0 {
+		return nil
+	}
+	type entry struct {
+		key  []byte
+		size int64
+	}
+	var expired []entry
+	err := h.store.Scan(hintPrefix(node), func(key, value []byte) bool {
+		var x hint
+		if err := json.Unmarshal(value, &x); err == nil && !h.expired(&x) {
+			return false // hints are ordered by age
+		}
+		expired = append(expired, entry{key, int64(len(value))})
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	for _, e := range expired {
+		if err := h.remove(node, e.key, e.size); err != nil {
+			return err
+		}
+		h.dropped(node, "expired", 1)
+	}
+	return nil
+}
+
+func (h *Handoff) expired(x *hint) bool {
+	return h.cfg.MaxAge > 0 && time.Since(time.UnixMilli(x.CreatedAt)) > h.cfg.MaxAge
+}
+
+// deliver replays x to host. Objects which have been updated on host since x
+// was created are skipped, so that a replayed write never overrides a newer one.
+func (h *Handoff) deliver(ctx context.Context, host string, x *hint) error {
+	var (
+		requestID = h.requestID(x.Op)
+		resp      SimpleResponse
+		err       error
+	)
+	switch x.Op {
+	case opPutObject, opPutObjects:
+		objs, err := x.objects()
+		if err != nil {
+			return fmt.Errorf("%w: %v", errHintRejected, err)
+		}
+		ids := make([]strfmt.UUID, len(objs))
+		for i, obj := range objs {
+			ids[i] = obj.ID()
+		}
+		updated, err := h.updateTimes(ctx, host, x, ids)
+		if err != nil {
+			return err
+		}
+		stale := objs[:0]
+		for _, obj := range objs {
+			if updated[obj.ID()] < obj.LastUpdateTimeUnix() {
+				stale = append(stale, obj)
+			}
+		}
+		if len(stale) == 0 {
+			return nil
+		}
+		if x.Op == opPutObject {
+			resp, err = h.client.PutObject(ctx, host, x.Class, x.Shard, requestID, stale[0], x.SchemaVersion)
+		} else {
+			resp, err = h.client.PutObjects(ctx, host, x.Class, x.Shard, requestID, stale, x.SchemaVersion)
+		}
+		if err != nil {
+			return err
+		}
+	case opMergeObject:
+		if x.Merge == nil {
+			return fmt.Errorf("%w: missing merge document", errHintRejected)
+		}
+		updated, err := h.updateTimes(ctx, host, x, []strfmt.UUID{x.Merge.ID})
+		if err != nil {
+			return err
+		}
+		if updated[x.Merge.ID] >= x.Merge.UpdateTime {
+			return nil
+		}
+		if resp, err = h.client.MergeObject(ctx, host, x.Class, x.Shard, requestID, x.Merge, x.SchemaVersion); err != nil {
+			return err
+		}
+	case opDeleteObject, opDeleteObjects:
+		updated, err := h.updateTimes(ctx, host, x, x.UUIDs)
+		if err != nil {
+			return err
+		}
+		ids := make([]strfmt.UUID, 0, len(x.UUIDs))
+		for _, id := range x.UUIDs {
+			if updated[id] <= x.CreatedAt {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if x.Op == opDeleteObject {
+			resp, err = h.client.DeleteObject(ctx, host, x.Class, x.Shard, requestID, ids[0], nil, x.SchemaVersion)
+		} else {
+			resp, err = h.client.DeleteObjects(ctx, host, x.Class, x.Shard, requestID, ids, x.DryRun, x.SchemaVersion)
+		}
+		if err != nil {
+			return err
+		}
+	case opAddReferences:
+		if resp, err = h.client.AddReferences(ctx, host, x.Class, x.Shard, requestID, x.Refs, x.SchemaVersion); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("%w: unknown operation %d", errHintRejected, x.Op)
+	}
+
+	if err := resp.FirstError(); err != nil {
+		h.client.Abort(ctx, host, x.Class, x.Shard, requestID)
+		return fmt.Errorf("%w: %v", errHintRejected, err)
+	}
+	if x.Op == opDeleteObjects {
+		resp := DeleteBatchResponse{}
+		if err := h.client.Commit(ctx, host, x.Class, x.Shard, requestID, &resp); err != nil {
+			return err
+		}
+		if err := resp.FirstError(); err != nil {
+			return fmt.Errorf("%w: %v", errHintRejected, err)
+		}
+		return nil
+	}
+	if err := h.client.Commit(ctx, host, x.Class, x.Shard, requestID, &resp); err != nil {
+		return err
+	}
+	if err := resp.FirstError(); err != nil {
+		return fmt.Errorf("%w: %v", errHintRejected, err)
+	}
+	return nil
+}
+
+// updateTimes returns the last update times of the given objects on host
+func (h *Handoff) updateTimes(ctx context.Context, host string, x *hint, ids []strfmt.UUID,
+) (map[strfmt.UUID]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	xs, err := h.client.DigestObjects(ctx, host, x.Class, x.Shard, ids, 0)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[strfmt.UUID]int64, len(xs))
+	for _, r := range xs {
+		m[strfmt.UUID(r.ID)] = r.UpdateTime
+	}
+	return m, nil
+}
+
+func (h *Handoff) remove(node string, key []byte, size int64) error {
+	if err := h.store.Delete(key); err != nil {
+		return fmt.Errorf("delete hint: %w", err)
+	}
+	h.Lock()
+	h.account(node, -1, -size)
+	h.Unlock()
+	return nil
+}
+
+// account must be called with the lock held, except during construction
+func (h *Handoff) account(node string, count, size int64) {
+	p := h.pending[node]
+	if p == nil {
+		p = &pendingHints{}
+		h.pending[node] = p
+	}
+	p.count += count
+	p.bytes += size
+	if p.count <= 0 {
+		delete(h.pending, node)
+		p = &pendingHints{}
+	}
+	if h.metrics != nil {
+		h.metrics.ReplicationHintsPending.WithLabelValues(node).Set(float64(p.count))
+		h.metrics.ReplicationHintsPendingBytes.WithLabelValues(node).Set(float64(p.bytes))
+	}
+}
+
+func (h *Handoff) dropped(node, reason string, n int) {
+	if h.metrics != nil {
+		h.metrics.ReplicationHintsDropped.WithLabelValues(node, reason).Add(float64(n))
+	}
+}
+
+func (h *Handoff) hasHints(node string) bool {
+	h.Lock()
+	defer h.Unlock()
+	return h.pending[node] != nil
+}
+
+func (h *Handoff) nodesWithHints() []string {
+	h.Lock()
+	defer h.Unlock()
+	nodes := make([]string, 0, len(h.pending))
+	for node := range h.pending {
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+func (h *Handoff) startReplay(node string) bool {
+	h.Lock()
+	defer h.Unlock()
+	if h.replaying[node] {
+		return false
+	}
+	h.replaying[node] = true
+	return true
+}
+
+func (h *Handoff) stopReplay(node string) {
+	h.Lock()
+	defer h.Unlock()
+	delete(h.replaying, node)
+}
+
+// requestID identifies a replayed write, see Replicator.requestID
+func (h *Handoff) requestID(op opID) string {
+	return fmt.Sprintf("%s-%.2x-%x-h%x",
+		h.nodeName,
+		op,
+		time.Now().UnixMilli(),
+		h.requestCounter.Add(1))
+}
+
+// hintKey is the node name followed by a separator and the big endian
+// sequence number of the hint, so that the hints of a node are stored in order
+func hintKey(node string, seq uint64) []byte {
+	key := make([]byte, len(node)+1+8)
+	copy(key, node)
+	binary.BigEndian.PutUint64(key[len(node)+1:], seq)
+	return key
+}
+
+func hintPrefix(node string) []byte {
+	return append([]byte(node), 0)
+}
+
+func parseHintKey(key []byte) (node string, seq uint64, ok bool) {
+	i := bytes.IndexByte(key, 0)
+	if i < 0 || len(key)-i-1 != 8 {
+		return "", 0, false
+	}
+	return string(key[:i]), binary.BigEndian.Uint64(key[i+1:]), true
+}
@@ -0,0 +1,250 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/replication"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestHandoff(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		id    = strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168241")
+		obj   = storobj.FromObject(&models.Object{ID: id, Class: cls, LastUpdateTimeUnix: 10}, nil, nil)
+		resp  = SimpleResponse{}
+	)
+
+	// putObject writes obj with consistency level l while B is unavailable
+	putObject := func(t *testing.T, f *fakeFactory, h *Handoff, l ConsistencyLevel) error {
+		rep := f.newReplicator()
+		rep.SetHandoff(h)
+		for _, n := range []string{"A", "C"} {
+			f.WClient.On("PutObject", anyVal, n, cls, shard, anyVal, obj, uint64(123)).Return(resp, nil)
+			f.WClient.On("Commit", anyVal, n, cls, shard, anyVal, anyVal).Return(nil)
+		}
+		f.WClient.On("PutObject", anyVal, "B", cls, shard, anyVal, obj, uint64(123)).Return(resp, errAny).Once()
+		f.WClient.On("Abort", anyVal, anyVal, cls, shard, anyVal).Return(resp, nil)
+		return rep.PutObject(ctx, shard, obj, l, 123)
+	}
+
+	t.Run("StoreMissedWrite", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		h := f.newHandoff(t, newMemHintStore(), replication.HintedHandoffConfig{})
+		require.Nil(t, putObject(t, f, h, Quorum))
+
+		assert.Eventually(t, func() bool {
+			n, _ := h.Pending("B")
+			return n == 1
+		}, time.Second, time.Millisecond)
+		n, _ := h.Pending("A")
+		assert.Zero(t, n)
+		n, _ = h.Pending("C")
+		assert.Zero(t, n)
+	})
+
+	t.Run("NoHintIfNotApplied", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		h := f.newHandoff(t, newMemHintStore(), replication.HintedHandoffConfig{})
+		require.NotNil(t, putObject(t, f, h, All))
+
+		time.Sleep(20 * time.Millisecond)
+		assert.Empty(t, h.nodesWithHints())
+	})
+
+	t.Run("Replay", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		h := f.newHandoff(t, newMemHintStore(), replication.HintedHandoffConfig{})
+		require.Nil(t, putObject(t, f, h, Quorum))
+		require.Eventually(t, func() bool { return h.hasHints("B") }, time.Second, time.Millisecond)
+
+		digest := []RepairResponse{{ID: id.String(), UpdateTime: 0}}
+		f.RClient.On("DigestObjects", anyVal, "B", cls, shard, []strfmt.UUID{id}).Return(digest, nil)
+		f.WClient.On("PutObject", anyVal, "B", cls, shard, anyVal, anyVal, uint64(123)).Return(resp, nil)
+		f.WClient.On("Commit", anyVal, "B", cls, shard, anyVal, anyVal).Return(nil)
+
+		n, err := h.replay(ctx, "B", "B")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, n)
+		assert.False(t, h.hasHints("B"))
+		f.WClient.AssertCalled(t, "Commit", anyVal, "B", cls, shard, anyVal, anyVal)
+	})
+
+	t.Run("SkipOutdatedWrite", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		h := f.newHandoff(t, newMemHintStore(), replication.HintedHandoffConfig{})
+		require.Nil(t, putObject(t, f, h, Quorum))
+		require.Eventually(t, func() bool { return h.hasHints("B") }, time.Second, time.Millisecond)
+
+		// B has received a newer version of the object in the meantime
+		digest := []RepairResponse{{ID: id.String(), UpdateTime: 20}}
+		f.RClient.On("DigestObjects", anyVal, "B", cls, shard, []strfmt.UUID{id}).Return(digest, nil)
+
+		n, err := h.replay(ctx, "B", "B")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, n)
+		assert.False(t, h.hasHints("B"))
+		f.WClient.AssertNotCalled(t, "Commit", anyVal, "B", cls, shard, anyVal, anyVal)
+	})
+
+	t.Run("KeepHintsOnTransportError", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		h := f.newHandoff(t, newMemHintStore(), replication.HintedHandoffConfig{})
+		require.Nil(t, putObject(t, f, h, Quorum))
+		require.Eventually(t, func() bool { return h.hasHints("B") }, time.Second, time.Millisecond)
+
+		f.RClient.On("DigestObjects", anyVal, "B", cls, shard, []strfmt.UUID{id}).Return([]RepairResponse{}, errAny)
+
+		n, err := h.replay(ctx, "B", "B")
+		assert.ErrorIs(t, err, errAny)
+		assert.Zero(t, n)
+		assert.True(t, h.hasHints("B"))
+	})
+
+	t.Run("DropRejectedHint", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		h := f.newHandoff(t, newMemHintStore(), replication.HintedHandoffConfig{})
+		require.Nil(t, putObject(t, f, h, Quorum))
+		require.Eventually(t, func() bool { return h.hasHints("B") }, time.Second, time.Millisecond)
+
+		digest := []RepairResponse{{ID: id.String(), UpdateTime: 0}}
+		rejected := SimpleResponse{Errors: []Error{{Msg: "conflict"}}}
+		f.RClient.On("DigestObjects", anyVal, "B", cls, shard, []strfmt.UUID{id}).Return(digest, nil)
+		f.WClient.On("PutObject", anyVal, "B", cls, shard, anyVal, anyVal, uint64(123)).Return(rejected, nil)
+
+		n, err := h.replay(ctx, "B", "B")
+		assert.Nil(t, err)
+		assert.Zero(t, n)
+		assert.False(t, h.hasHints("B"))
+		f.WClient.AssertCalled(t, "Abort", anyVal, "B", cls, shard, anyVal)
+	})
+
+	t.Run("SizeLimit", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		h := f.newHandoff(t, newMemHintStore(), replication.HintedHandoffConfig{MaxBytesPerNode: 1})
+		x := &hint{Op: opDeleteObject, Class: cls, Shard: shard, UUIDs: []strfmt.UUID{id}}
+
+		h.add([]string{"B"}, x) // the first hint is always stored
+		h.add([]string{"B"}, x)
+		n, size := h.Pending("B")
+		assert.Equal(t, int64(1), n)
+		assert.Greater(t, size, int64(1))
+	})
+
+	t.Run("Expire", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		h := f.newHandoff(t, newMemHintStore(), replication.HintedHandoffConfig{MaxAge: time.Minute})
+		old := time.Now().Add(-time.Hour).UnixMilli()
+		h.add([]string{"B"}, &hint{Op: opDeleteObject, Class: cls, Shard: shard, CreatedAt: old})
+		h.add([]string{"B"}, &hint{Op: opDeleteObject, Class: cls, Shard: shard, CreatedAt: time.Now().UnixMilli()})
+
+		require.Nil(t, h.expire("B"))
+		n, _ := h.Pending("B")
+		assert.Equal(t, int64(1), n)
+	})
+
+	t.Run("Reload", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		store := newMemHintStore()
+		h := f.newHandoff(t, store, replication.HintedHandoffConfig{})
+		x := &hint{Op: opDeleteObject, Class: cls, Shard: shard, UUIDs: []strfmt.UUID{id}}
+		h.add([]string{"B", "C"}, x)
+		h.add([]string{"B"}, x)
+
+		h = f.newHandoff(t, store, replication.HintedHandoffConfig{})
+		n, _ := h.Pending("B")
+		assert.Equal(t, int64(2), n)
+		n, _ = h.Pending("C")
+		assert.Equal(t, int64(1), n)
+	})
+}
+
+func TestHintKey(t *testing.T) {
+	key := hintKey("node-1", 42)
+	node, seq, ok := parseHintKey(key)
+	assert.True(t, ok)
+	assert.Equal(t, "node-1", node)
+	assert.Equal(t, uint64(42), seq)
+	assert.True(t, bytes.HasPrefix(key, hintPrefix("node-1")))
+	assert.False(t, bytes.HasPrefix(key, hintPrefix("node")))
+	assert.Negative(t, bytes.Compare(hintKey("A", 255), hintKey("A", 256)))
+}
+
+func (f fakeFactory) newHandoff(t *testing.T, store HintStore, cfg replication.HintedHandoffConfig) *Handoff {
+	h, err := NewHandoff("A", store, struct {
+		rClient
+		wClient
+	}{f.RClient, f.WClient}, newFakeNodeResolver(f.Nodes), cfg, nil, f.log)
+	require.Nil(t, err)
+	return h
+}
+
+// memHintStore is an in-memory HintStore
+type memHintStore struct {
+	sync.Mutex
+	m map[string][]byte
+}
+
+func newMemHintStore() *memHintStore {
+	return &memHintStore{m: map[string][]byte{}}
+}
+
+func (s *memHintStore) Put(key, value []byte) error {
+	s.Lock()
+	defer s.Unlock()
+	s.m[string(key)] = value
+	return nil
+}
+
+func (s *memHintStore) Delete(key []byte) error {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.m, string(key))
+	return nil
+}
+
+func (s *memHintStore) Scan(prefix []byte, f func(key, value []byte) bool) error {
+	s.Lock()
+	keys := make([]string, 0, len(s.m))
+	for k := range s.m {
+		if bytes.HasPrefix([]byte(k), prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	values := make([][]byte, len(keys))
+	for i, k := range keys {
+		values[i] = s.m[k]
+	}
+	s.Unlock()
+
+	for i, k := range keys {
+		if !f([]byte(k), values[i]) {
+			break
+		}
+	}
+	return nil
+}
@@ -14,6 +14,7 @@ package replica
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/mock"
@@ -79,6 +80,13 @@ func (f *fakeRClient) HashTreeLevel(ctx context.Context,
 	return args.Get(0).([]hashtree.Digest), args.Error(1)
 }
 
+func (f *fakeRClient) AppliedWatermark(ctx context.Context,
+	host, index, shard string,
+) (int64, error) {
+	args := f.Called(ctx, host, index, shard)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 type fakeClient struct {
 	mock.Mock
 }
@@ -91,9 +99,9 @@ func (f *fakeClient) PutObject(ctx context.Context, host, index, shard, requestI
 }
 
 func (f *fakeClient) DeleteObject(ctx context.Context, host, index, shard, requestID string,
-	id strfmt.UUID, schemaVersion uint64,
+	id strfmt.UUID, ifMatch *int64, schemaVersion uint64,
 ) (SimpleResponse, error) {
-	args := f.Called(ctx, host, index, shard, requestID, id, schemaVersion)
+	args := f.Called(ctx, host, index, shard, requestID, id, ifMatch, schemaVersion)
 	return args.Get(0).(SimpleResponse), args.Error(1)
 }
 
@@ -139,7 +147,11 @@ func (f *fakeClient) Abort(ctx context.Context, host, index, shard, requestID st
 type fakeShardingState struct {
 	thisNode        string
 	ShardToReplicas map[string][]string
-	nodeResolver    *fakeNodeResolver
+	ShardToJoining  map[string][]string
+	// default consistency level of shards
+	ShardToConsistency map[string]ConsistencyLevel
+	MaxStaleness       time.Duration
+	nodeResolver       *fakeNodeResolver
 }
 
 func newFakeShardingState(thisNode string, shardToReplicas map[string][]string, resolver *fakeNodeResolver) *fakeShardingState {
@@ -170,6 +182,19 @@ func (f *fakeShardingState) ResolveParentNodes(_ string, shard string) (map[stri
 	return m, nil
 }
 
+func (f *fakeShardingState) ShardConsistency(_ string, shard string) (ConsistencyLevel, time.Duration) {
+	return f.ShardToConsistency[shard], f.MaxStaleness
+}
+
+func (f *fakeShardingState) ResolveJoiningNodes(_ string, shard string) (map[string]string, error) {
+	m := make(map[string]string)
+	for _, name := range f.ShardToJoining[shard] {
+		addr, _ := f.nodeResolver.NodeHostname(name)
+		m[name] = addr
+	}
+	return m, nil
+}
+
 // node resolver
 type fakeNodeResolver struct {
 	hosts map[string]string
@@ -36,7 +36,7 @@ type RemoteIndexIncomingRepo interface {
 	ReplicateObject(ctx context.Context, shardName, requestID string, object *storobj.Object) SimpleResponse
 	ReplicateObjects(ctx context.Context, shardName, requestID string, objects []*storobj.Object, schemaVersion uint64) SimpleResponse
 	ReplicateUpdate(ctx context.Context, shardName, requestID string, mergeDoc *objects.MergeDocument) SimpleResponse
-	ReplicateDeletion(ctx context.Context, shardName, requestID string, uuid strfmt.UUID) SimpleResponse
+	ReplicateDeletion(ctx context.Context, shardName, requestID string, uuid strfmt.UUID, ifMatch *int64) SimpleResponse
 	ReplicateDeletions(ctx context.Context, shardName, requestID string, uuids []strfmt.UUID, dryRun bool, schemaVersion uint64) SimpleResponse
 	ReplicateReferences(ctx context.Context, shardName, requestID string, refs []objects.BatchReference) SimpleResponse
 	CommitReplication(shardName, requestID string) interface{}
@@ -50,6 +50,7 @@ type RemoteIndexIncomingRepo interface {
 		initialToken, finalToken uint64, limit int) (result []RepairResponse, lastTokenRead uint64, err error)
 	HashTreeLevel(ctx context.Context, shardName string,
 		level int, discriminant *hashtree.Bitset) (digests []hashtree.Digest, err error)
+	AppliedWatermark(shardName string) int64
 }
 
 type RemoteReplicaIncoming struct {
@@ -95,13 +96,13 @@ func (rri *RemoteReplicaIncoming) ReplicateUpdate(ctx context.Context, indexName
 }
 
 func (rri *RemoteReplicaIncoming) ReplicateDeletion(ctx context.Context, indexName,
-	shardName, requestID string, uuid strfmt.UUID, schemaVersion uint64,
+	shardName, requestID string, uuid strfmt.UUID, ifMatch *int64, schemaVersion uint64,
 ) SimpleResponse {
 	index, simpleResp := rri.indexForIncomingWrite(ctx, indexName, schemaVersion)
 	if simpleResp != nil {
 		return *simpleResp
 	}
-	return index.ReplicateDeletion(ctx, shardName, requestID, uuid)
+	return index.ReplicateDeletion(ctx, shardName, requestID, uuid, ifMatch)
 }
 
 func (rri *RemoteReplicaIncoming) ReplicateDeletions(ctx context.Context, indexName,
@@ -225,3 +226,13 @@ func (rri *RemoteReplicaIncoming) HashTreeLevel(ctx context.Context,
 
 	return index.HashTreeLevel(ctx, shardName, level, discriminant)
 }
+
+func (rri *RemoteReplicaIncoming) AppliedWatermark(ctx context.Context,
+	indexName, shardName string,
+) (int64, error) {
+	index, simpleResp := rri.indexForIncomingRead(ctx, indexName)
+	if simpleResp != nil {
+		return 0, simpleResp.Errors[0].Err
+	}
+	return index.AppliedWatermark(shardName), nil
+}
@@ -13,7 +13,9 @@ package replica
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -62,6 +64,7 @@ type Replicator struct {
 	log            logrus.FieldLogger
 	requestCounter atomic.Uint64
 	stream         replicatorStream
+	handoff        *Handoff
 	*Finder
 }
 
@@ -89,32 +92,55 @@ func NewReplicator(className string,
 	}
 }
 
+// SetHandoff enables hinted handoff of writes missed by some replicas
+func (r *Replicator) SetHandoff(h *Handoff) {
+	r.handoff = h
+}
+
 func (r *Replicator) AllHostnames() []string {
 	return r.resolver.AllHostnames()
 }
 
+// conditionalLevel returns the consistency level of a conditional write to
+// shard at level l. A single replica might check the precondition against a
+// stale copy of the object, so conditional writes need at least QUORUM.
+func (r *Replicator) conditionalLevel(shard string, l ConsistencyLevel) ConsistencyLevel {
+	if l, _ = r.resolver.consistency(shard, l, Quorum); l == One || l == BoundedStaleness {
+		return Quorum
+	}
+	return l
+}
+
 func (r *Replicator) PutObject(ctx context.Context,
 	shard string,
 	obj *storobj.Object,
 	l ConsistencyLevel,
 	schemaVersion uint64,
 ) error {
+	if obj != nil && obj.IfMatch != nil {
+		l = r.conditionalLevel(shard, l)
+	}
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opPutObject), r.log)
+	cond := &conditionCheck{}
 	isReady := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.PutObject(ctx, host, r.class, shard, requestID, obj, schemaVersion)
 		if err == nil {
 			err = resp.FirstError()
 		}
 		if err != nil {
+			cond.record(err)
 			return fmt.Errorf("%q: %w", host, err)
 		}
 		return nil
 	}
+	coord.missed = r.missedBy(shard, opPutObject, schemaVersion, func(x *hint) error {
+		return x.setObjects(obj)
+	})
 	replyCh, level, err := coord.Push(ctx, l, isReady, r.simpleCommit(shard))
 	if err != nil {
 		r.log.WithField("op", "push.one").WithField("class", r.class).
 			WithField("shard", shard).Error(err)
-		return fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas)
+		return cond.wrap(fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas))
 
 	}
 	err = r.stream.readErrors(1, level, replyCh)[0]
@@ -122,7 +148,7 @@ func (r *Replicator) PutObject(ctx context.Context,
 		r.log.WithField("op", "put").WithField("class", r.class).
 			WithField("shard", shard).WithField("uuid", obj.ID()).Error(err)
 	}
-	return err
+	return cond.wrap(err)
 }
 
 func (r *Replicator) MergeObject(ctx context.Context,
@@ -131,22 +157,35 @@ func (r *Replicator) MergeObject(ctx context.Context,
 	l ConsistencyLevel,
 	schemaVersion uint64,
 ) error {
+	if doc != nil && doc.IfMatch != nil {
+		l = r.conditionalLevel(shard, l)
+	}
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opMergeObject), r.log)
+	cond := &conditionCheck{}
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.MergeObject(ctx, host, r.class, shard, requestID, doc, schemaVersion)
 		if err == nil {
 			err = resp.FirstError()
 		}
 		if err != nil {
+			cond.record(err)
 			return fmt.Errorf("%q: %w", host, err)
 		}
 		return nil
 	}
+	coord.missed = r.missedBy(shard, opMergeObject, schemaVersion, func(x *hint) error {
+		// the precondition has been checked by the replicas which applied
+		// the write, replaying it must not be rejected by a lagging replica
+		hinted := *doc
+		hinted.IfMatch = nil
+		x.Merge = &hinted
+		return nil
+	})
 	replyCh, level, err := coord.Push(ctx, l, op, r.simpleCommit(shard))
 	if err != nil {
 		r.log.WithField("op", "push.merge").WithField("class", r.class).
 			WithField("shard", shard).Error(err)
-		return fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas)
+		return cond.wrap(fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas))
 	}
 	err = r.stream.readErrors(1, level, replyCh)[0]
 	if err != nil {
@@ -157,38 +196,61 @@ func (r *Replicator) MergeObject(ctx context.Context,
 			return objects.NewErrDirtyWriteOfDeletedObject(replicaErr)
 		}
 	}
-	return err
+	return cond.wrap(err)
 }
 
+// DeleteObject deletes the object with the given id on all replicas of the
+// shard. It returns the latest update time of the deleted versions reported
+// by the replicas which committed the deletion, or 0 if nothing was deleted.
+// Deletions with a precondition are written at least at QUORUM.
 func (r *Replicator) DeleteObject(ctx context.Context,
 	shard string,
 	id strfmt.UUID,
+	ifMatch *int64,
 	l ConsistencyLevel,
 	schemaVersion uint64,
-) error {
+) (int64, error) {
+	if ifMatch != nil {
+		l = r.conditionalLevel(shard, l)
+	}
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opDeleteObject), r.log)
+	cond := &conditionCheck{}
 	op := func(ctx context.Context, host, requestID string) error {
-		resp, err := r.client.DeleteObject(ctx, host, r.class, shard, requestID, id, schemaVersion)
+		resp, err := r.client.DeleteObject(ctx, host, r.class, shard, requestID, id, ifMatch, schemaVersion)
 		if err == nil {
 			err = resp.FirstError()
 		}
 		if err != nil {
+			cond.record(err)
 			return fmt.Errorf("%q: %w", host, err)
 		}
 		return nil
 	}
-	replyCh, level, err := coord.Push(ctx, l, op, r.simpleCommit(shard))
+	coord.missed = r.missedBy(shard, opDeleteObject, schemaVersion, func(x *hint) error {
+		x.UUIDs = []strfmt.UUID{id}
+		return nil
+	})
+	var deletedUpdateTime atomic.Int64
+	simpleCommit := r.simpleCommit(shard)
+	commit := func(ctx context.Context, host, requestID string) (SimpleResponse, error) {
+		resp, err := simpleCommit(ctx, host, requestID)
+		if err == nil {
+			storeMax(&deletedUpdateTime, resp.DeletedUpdateTime)
+		}
+		return resp, err
+	}
+	replyCh, level, err := coord.Push(ctx, l, op, commit)
 	if err != nil {
 		r.log.WithField("op", "push.delete").WithField("class", r.class).
 			WithField("shard", shard).Error(err)
-		return fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas)
+		return 0, cond.wrap(fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas))
 	}
 	err = r.stream.readErrors(1, level, replyCh)[0]
 	if err != nil {
 		r.log.WithField("op", "put").WithField("class", r.class).
 			WithField("shard", shard).WithField("uuid", id).Error(err)
 	}
-	return err
+	return deletedUpdateTime.Load(), cond.wrap(err)
 }
 
 func (r *Replicator) PutObjects(ctx context.Context,
@@ -197,6 +259,12 @@ func (r *Replicator) PutObjects(ctx context.Context,
 	l ConsistencyLevel,
 	schemaVersion uint64,
 ) []error {
+	for _, obj := range objs {
+		if obj != nil && obj.IfMatch != nil {
+			l = r.conditionalLevel(shard, l)
+			break
+		}
+	}
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opPutObjects), r.log)
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.PutObjects(ctx, host, r.class, shard, requestID, objs, schemaVersion)
@@ -209,6 +277,9 @@ func (r *Replicator) PutObjects(ctx context.Context,
 		return nil
 	}
 
+	coord.missed = r.missedBy(shard, opPutObjects, schemaVersion, func(x *hint) error {
+		return x.setObjects(objs...)
+	})
 	replyCh, level, err := coord.Push(ctx, l, op, r.simpleCommit(shard))
 	if err != nil {
 		r.log.WithField("op", "push.many").WithField("class", r.class).
@@ -225,16 +296,26 @@ func (r *Replicator) PutObjects(ctx context.Context,
 		r.log.WithField("op", "put.many").WithField("class", r.class).
 			WithField("shard", shard).Error(errs)
 	}
+	for i, err := range errs {
+		var replicaErr *Error
+		if errors.As(err, &replicaErr) && replicaErr.Code == StatusConditionFailed {
+			errs[i] = objects.NewErrPreconditionFailed("%s", replicaErr.Msg)
+		}
+	}
 	return errs
 }
 
+// DeleteObjects deletes the objects with the given ids on all replicas of
+// the shard. Besides the result per object, it returns the latest update
+// time of the deleted versions reported by the replicas which committed the
+// deletion, or 0 if nothing was deleted.
 func (r *Replicator) DeleteObjects(ctx context.Context,
 	shard string,
 	uuids []strfmt.UUID,
 	dryRun bool,
 	l ConsistencyLevel,
 	schemaVersion uint64,
-) []objects.BatchSimpleObject {
+) ([]objects.BatchSimpleObject, int64) {
 	coord := newCoordinator[DeleteBatchResponse](r, shard, r.requestID(opDeleteObjects), r.log)
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.DeleteObjects(ctx, host, r.class, shard, requestID, uuids, dryRun, schemaVersion)
@@ -246,10 +327,12 @@ func (r *Replicator) DeleteObjects(ctx context.Context,
 		}
 		return nil
 	}
+	var deletedUpdateTime atomic.Int64
 	commit := func(ctx context.Context, host, requestID string) (DeleteBatchResponse, error) {
 		resp := DeleteBatchResponse{}
 		err := r.client.Commit(ctx, host, r.class, shard, requestID, &resp)
 		if err == nil {
+			storeMax(&deletedUpdateTime, resp.DeletedUpdateTime)
 			err = resp.FirstError()
 		}
 		if err != nil {
@@ -258,6 +341,12 @@ func (r *Replicator) DeleteObjects(ctx context.Context,
 		return resp, err
 	}
 
+	if !dryRun {
+		coord.missed = r.missedBy(shard, opDeleteObjects, schemaVersion, func(x *hint) error {
+			x.UUIDs = uuids
+			return nil
+		})
+	}
 	replyCh, level, err := coord.Push(ctx, l, op, commit)
 	if err != nil {
 		r.log.WithField("op", "push.deletes").WithField("class", r.class).
@@ -267,14 +356,14 @@ func (r *Replicator) DeleteObjects(ctx context.Context,
 		for i := 0; i < len(uuids); i++ {
 			errs[i].Err = err
 		}
-		return errs
+		return errs, 0
 	}
 	rs := r.stream.readDeletions(len(uuids), level, replyCh)
 	if err := firstBatchError(rs); err != nil {
 		r.log.WithField("op", "put.deletes").WithField("class", r.class).
 			WithField("shard", shard).Error(rs)
 	}
-	return rs
+	return rs, deletedUpdateTime.Load()
 }
 
 func (r *Replicator) AddReferences(ctx context.Context,
@@ -294,6 +383,10 @@ func (r *Replicator) AddReferences(ctx context.Context,
 		}
 		return nil
 	}
+	coord.missed = r.missedBy(shard, opAddReferences, schemaVersion, func(x *hint) error {
+		x.Refs = refs
+		return nil
+	})
 	replyCh, level, err := coord.Push(ctx, l, op, r.simpleCommit(shard))
 	if err != nil {
 		r.log.WithField("op", "push.refs").WithField("class", r.class).
@@ -313,6 +406,32 @@ func (r *Replicator) AddReferences(ctx context.Context,
 	return errs
 }
 
+// missedBy returns a function which hands off a write to the replicas which
+// missed it. fill adds the payload of the write to the hint.
+func (r *Replicator) missedBy(shard string, op opID, schemaVersion uint64,
+	fill func(*hint) error,
+) func(nodes []string) {
+	if r.handoff == nil {
+		return nil
+	}
+	createdAt := time.Now().UnixMilli()
+	return func(nodes []string) {
+		x := &hint{
+			Op:            op,
+			Class:         r.class,
+			Shard:         shard,
+			SchemaVersion: schemaVersion,
+			CreatedAt:     createdAt,
+		}
+		if err := fill(x); err != nil {
+			r.log.WithField("op", "handoff").WithField("class", r.class).
+				WithField("shard", shard).Error(err)
+			return
+		}
+		r.handoff.add(nodes, x)
+	}
+}
+
 // simpleCommit generate commit function for the coordinator
 func (r *Replicator) simpleCommit(shard string) commitOp[SimpleResponse] {
 	return func(ctx context.Context, host, requestID string) (SimpleResponse, error) {
@@ -328,6 +447,16 @@ func (r *Replicator) simpleCommit(shard string) commitOp[SimpleResponse] {
 	}
 }
 
+// storeMax stores v in x if it is greater than the current value of x
+func storeMax(x *atomic.Int64, v int64) {
+	for {
+		old := x.Load()
+		if v <= old || x.CompareAndSwap(old, v) {
+			return
+		}
+	}
+}
+
 // requestID returns ID as [CoordinatorName-OpCode-TimeStamp-Counter].
 // The coordinator uses it to uniquely identify a transaction.
 // ID makes the request observable in the cluster by specifying its origin
@@ -339,3 +468,37 @@ func (r *Replicator) requestID(op opID) string {
 		time.Now().UnixMilli(),
 		r.requestCounter.Add(1))
 }
+
+// conditionCheck tracks whether any replica rejected the precondition of a
+// conditional write, so that the caller can be told about the conflict
+// instead of a generic replication failure
+type conditionCheck struct {
+	sync.Mutex
+	err *Error
+}
+
+func (c *conditionCheck) record(err error) {
+	var replicaErr *Error
+	if errors.As(err, &replicaErr) && replicaErr.Code == StatusConditionFailed {
+		c.Lock()
+		if c.err == nil {
+			c.err = replicaErr.Clone()
+		}
+		c.Unlock()
+	}
+}
+
+// wrap turns err into objects.ErrPreconditionFailed if the write failed
+// because its precondition was not met
+func (c *conditionCheck) wrap(err error) error {
+	if err == nil {
+		return nil
+	}
+	c.record(err)
+	c.Lock()
+	defer c.Unlock()
+	if c.err != nil {
+		return objects.NewErrPreconditionFailed("%s", c.err.Msg)
+	}
+	return err
+}
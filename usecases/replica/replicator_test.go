@@ -54,7 +54,7 @@ func TestReplicatorReplicaNotFound(t *testing.T) {
 	t.Run("DeleteObject", func(t *testing.T) {
 		f := newFakeFactory("C1", "S", []string{})
 		rep := f.newReplicator()
-		err := rep.DeleteObject(ctx, "S", "id", All, 0)
+		_, err := rep.DeleteObject(ctx, "S", "id", nil, All, 0)
 		assert.ErrorIs(t, err, errReplicas)
 		f.assertLogErrorContains(t, errNoReplicaFound.Error())
 	})
@@ -73,7 +73,7 @@ func TestReplicatorReplicaNotFound(t *testing.T) {
 	t.Run("DeleteObjects", func(t *testing.T) {
 		f := newFakeFactory("C1", "S", []string{})
 		rep := f.newReplicator()
-		xs := rep.DeleteObjects(ctx, "S", []strfmt.UUID{strfmt.UUID("1"), strfmt.UUID("2"), strfmt.UUID("3")}, false, All, 0)
+		xs, _ := rep.DeleteObjects(ctx, "S", []strfmt.UUID{strfmt.UUID("1"), strfmt.UUID("2"), strfmt.UUID("3")}, false, All, 0)
 		assert.Equal(t, 3, len(xs))
 		for _, x := range xs {
 			assert.ErrorIs(t, x.Err, errReplicas)
@@ -166,7 +166,7 @@ func TestReplicatorPutObject(t *testing.T) {
 		rep := f.newReplicator()
 		resp := SimpleResponse{}
 		f.WClient.On("PutObject", mock.Anything, nodes[0], cls, shard, anyVal, obj, uint64(123)).Return(resp, nil)
-		resp2 := SimpleResponse{[]Error{{Err: errAny}}}
+		resp2 := SimpleResponse{Errors: []Error{{Err: errAny}}}
 		f.WClient.On("PutObject", mock.Anything, nodes[1], cls, shard, anyVal, obj, uint64(123)).Return(resp2, nil)
 		f.WClient.On("Abort", mock.Anything, nodes[0], "C1", shard, anyVal).Return(resp, nil)
 		f.WClient.On("Abort", mock.Anything, nodes[1], "C1", shard, anyVal).Return(resp, nil)
@@ -188,6 +188,87 @@ func TestReplicatorPutObject(t *testing.T) {
 		err := rep.PutObject(ctx, shard, obj, All, 123)
 		assert.ErrorIs(t, err, errAny)
 	})
+
+	t.Run("PhaseOneConditionFailed", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		rep := f.newReplicator()
+		resp := SimpleResponse{}
+		f.WClient.On("PutObject", mock.Anything, nodes[0], cls, shard, anyVal, obj, uint64(123)).Return(resp, nil)
+		resp2 := SimpleResponse{Errors: []Error{{Code: StatusConditionFailed, Msg: "version mismatch"}}}
+		f.WClient.On("PutObject", mock.Anything, nodes[1], cls, shard, anyVal, obj, uint64(123)).Return(resp2, nil)
+		f.WClient.On("Abort", mock.Anything, nodes[0], "C1", shard, anyVal).Return(resp, nil)
+		f.WClient.On("Abort", mock.Anything, nodes[1], "C1", shard, anyVal).Return(resp, nil)
+
+		err := rep.PutObject(ctx, shard, obj, All, 123)
+		assert.ErrorAs(t, err, &objects.ErrPreconditionFailed{})
+		assert.ErrorContains(t, err, "version mismatch")
+	})
+
+	t.Run("ConditionalWriteWithStaleReplica", func(t *testing.T) {
+		nodes := []string{"A", "B", "C"}
+		f := newFakeFactory("C1", shard, nodes)
+		rep := f.newReplicator()
+		ifMatch := int64(1000)
+		obj := &storobj.Object{IfMatch: &ifMatch}
+		resp := SimpleResponse{}
+		// A has not seen the latest version of the object yet
+		f.WClient.On("PutObject", mock.Anything, "A", cls, shard, anyVal, obj, uint64(123)).Return(resp, nil)
+		f.WClient.On("Commit", ctx, "A", cls, shard, anyVal, anyVal).Return(nil)
+		resp2 := SimpleResponse{Errors: []Error{{Code: StatusConditionFailed, Msg: "version mismatch"}}}
+		for _, n := range nodes[1:] {
+			f.WClient.On("PutObject", mock.Anything, n, cls, shard, anyVal, obj, uint64(123)).Return(resp2, nil)
+		}
+		for _, n := range nodes {
+			f.WClient.On("Abort", mock.Anything, n, "C1", shard, anyVal).Return(resp, nil)
+		}
+
+		// ONE is raised to QUORUM, which the stale replica cannot satisfy alone
+		err := rep.PutObject(ctx, shard, obj, One, 123)
+		assert.ErrorAs(t, err, &objects.ErrPreconditionFailed{})
+		f.WClient.AssertNotCalled(t, "Commit", ctx, "A", cls, shard, anyVal, anyVal)
+	})
+}
+
+func TestReplicatorForwardToJoiningReplica(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B"}
+		ctx   = context.Background()
+		obj   = &storobj.Object{}
+		resp  = SimpleResponse{}
+	)
+	f := newFakeFactory(cls, shard, nodes)
+	nodeResolver := newFakeNodeResolver([]string{"A", "B", "C"})
+	shardingState := newFakeShardingState("A", f.Shard2replicas, nodeResolver)
+	shardingState.ShardToJoining = map[string][]string{shard: {"C"}}
+	rep := NewReplicator(cls, shardingState, nodeResolver,
+		models.ReplicationConfigDeletionStrategyNoAutomatedResolution,
+		struct {
+			rClient
+			wClient
+		}{f.RClient, f.WClient}, f.log)
+
+	for _, n := range nodes {
+		f.WClient.On("PutObject", mock.Anything, n, cls, shard, anyVal, obj, uint64(123)).Return(resp, nil)
+		f.WClient.On("Commit", ctx, n, cls, shard, anyVal, anyVal).Return(nil)
+	}
+	committed := make(chan struct{})
+	f.WClient.On("PutObject", mock.Anything, "C", cls, shard, anyVal, obj, uint64(123)).Return(resp, errAny).Once()
+	f.WClient.On("PutObject", mock.Anything, "C", cls, shard, anyVal, obj, uint64(123)).Return(resp, nil)
+	f.WClient.On("Commit", mock.Anything, "C", cls, shard, anyVal, anyVal).Return(nil).Run(func(mock.Arguments) {
+		close(committed)
+	})
+
+	// a failing joining replica doesn't affect the outcome
+	assert.Nil(t, rep.PutObject(ctx, shard, obj, All, 123))
+
+	assert.Nil(t, rep.PutObject(ctx, shard, obj, All, 123))
+	select {
+	case <-committed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("write has not been forwarded to joining replica")
+	}
 }
 
 func TestReplicatorMergeObject(t *testing.T) {
@@ -229,7 +310,7 @@ func TestReplicatorMergeObject(t *testing.T) {
 		rep := f.newReplicator()
 		resp := SimpleResponse{}
 		f.WClient.On("MergeObject", mock.Anything, nodes[0], cls, shard, anyVal, merge, uint64(123)).Return(resp, nil)
-		resp2 := SimpleResponse{[]Error{{Err: errAny}}}
+		resp2 := SimpleResponse{Errors: []Error{{Err: errAny}}}
 		f.WClient.On("MergeObject", mock.Anything, nodes[1], cls, shard, anyVal, merge, uint64(123)).Return(resp2, nil)
 		f.WClient.On("Abort", mock.Anything, nodes[0], cls, shard, anyVal).Return(resp, nil)
 		f.WClient.On("Abort", mock.Anything, nodes[1], cls, shard, anyVal).Return(resp, nil)
@@ -267,15 +348,15 @@ func TestReplicatorDeleteObject(t *testing.T) {
 		rep := factory.newReplicator()
 		resp := SimpleResponse{Errors: make([]Error, 1)}
 		for _, n := range nodes[:2] {
-			client.On("DeleteObject", mock.Anything, n, cls, shard, anyVal, uuid, uint64(123)).Return(resp, nil)
+			client.On("DeleteObject", mock.Anything, n, cls, shard, anyVal, uuid, (*int64)(nil), uint64(123)).Return(resp, nil)
 			client.On("Commit", ctx, n, "C1", shard, anyVal, anyVal).Return(nil)
 		}
-		client.On("DeleteObject", mock.Anything, "C", cls, shard, anyVal, uuid, uint64(123)).Return(SimpleResponse{}, errAny)
+		client.On("DeleteObject", mock.Anything, "C", cls, shard, anyVal, uuid, (*int64)(nil), uint64(123)).Return(SimpleResponse{}, errAny)
 		for _, n := range nodes {
 			client.On("Abort", mock.Anything, n, "C1", shard, anyVal).Return(resp, nil)
 		}
 
-		err := rep.DeleteObject(ctx, shard, uuid, All, 123)
+		_, err := rep.DeleteObject(ctx, shard, uuid, nil, All, 123)
 		assert.NotNil(t, err)
 		assert.ErrorIs(t, err, errReplicas)
 	})
@@ -286,12 +367,15 @@ func TestReplicatorDeleteObject(t *testing.T) {
 		rep := factory.newReplicator()
 		resp := SimpleResponse{Errors: make([]Error, 1)}
 		for _, n := range nodes {
-			client.On("DeleteObject", mock.Anything, n, cls, shard, anyVal, uuid, uint64(123)).Return(resp, nil)
+			client.On("DeleteObject", mock.Anything, n, cls, shard, anyVal, uuid, (*int64)(nil), uint64(123)).Return(resp, nil)
 			client.On("Commit", ctx, n, "C1", shard, anyVal, anyVal).Return(nil)
 		}
-		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, All, 123))
-		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, Quorum, 123))
-		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, One, 123))
+		_, err := rep.DeleteObject(ctx, shard, uuid, nil, All, 123)
+		assert.Nil(t, err)
+		_, err = rep.DeleteObject(ctx, shard, uuid, nil, Quorum, 123)
+		assert.Nil(t, err)
+		_, err = rep.DeleteObject(ctx, shard, uuid, nil, One, 123)
+		assert.Nil(t, err)
 	})
 	t.Run("SuccessWithConsistencyQuorum", func(t *testing.T) {
 		factory := newFakeFactory("C1", shard, nodes)
@@ -299,7 +383,7 @@ func TestReplicatorDeleteObject(t *testing.T) {
 		rep := factory.newReplicator()
 		resp := SimpleResponse{Errors: make([]Error, 1)}
 		for _, n := range nodes[:2] {
-			client.On("DeleteObject", mock.Anything, n, cls, shard, anyVal, uuid, uint64(123)).Return(resp, nil)
+			client.On("DeleteObject", mock.Anything, n, cls, shard, anyVal, uuid, (*int64)(nil), uint64(123)).Return(resp, nil)
 			client.On("Commit", ctx, n, "C1", shard, anyVal, anyVal).Return(nil).RunFn = func(a mock.Arguments) {
 				resp := a[5].(*SimpleResponse)
 				*resp = SimpleResponse{
@@ -307,7 +391,7 @@ func TestReplicatorDeleteObject(t *testing.T) {
 				}
 			}
 		}
-		client.On("DeleteObject", mock.Anything, "C", cls, shard, anyVal, uuid, uint64(123)).Return(resp, nil)
+		client.On("DeleteObject", mock.Anything, "C", cls, shard, anyVal, uuid, (*int64)(nil), uint64(123)).Return(resp, nil)
 		client.On("Commit", ctx, "C", "C1", shard, anyVal, anyVal).Return(nil).RunFn = func(a mock.Arguments) {
 			resp := a[5].(*SimpleResponse)
 			*resp = SimpleResponse{
@@ -315,9 +399,12 @@ func TestReplicatorDeleteObject(t *testing.T) {
 			}
 		}
 
-		assert.NotNil(t, rep.DeleteObject(ctx, shard, uuid, All, 123))
-		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, Quorum, 123))
-		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, One, 123))
+		_, err := rep.DeleteObject(ctx, shard, uuid, nil, All, 123)
+		assert.NotNil(t, err)
+		_, err = rep.DeleteObject(ctx, shard, uuid, nil, Quorum, 123)
+		assert.Nil(t, err)
+		_, err = rep.DeleteObject(ctx, shard, uuid, nil, One, 123)
+		assert.Nil(t, err)
 	})
 
 	t.Run("SuccessWithConsistencyQuorum", func(t *testing.T) {
@@ -326,7 +413,7 @@ func TestReplicatorDeleteObject(t *testing.T) {
 		rep := factory.newReplicator()
 		resp := SimpleResponse{Errors: make([]Error, 1)}
 		for _, n := range nodes[:2] {
-			client.On("DeleteObject", mock.Anything, n, cls, shard, anyVal, uuid, uint64(123)).Return(resp, nil)
+			client.On("DeleteObject", mock.Anything, n, cls, shard, anyVal, uuid, (*int64)(nil), uint64(123)).Return(resp, nil)
 			client.On("Commit", ctx, n, "C1", shard, anyVal, anyVal).Return(nil).RunFn = func(a mock.Arguments) {
 				resp := a[5].(*SimpleResponse)
 				*resp = SimpleResponse{
@@ -334,7 +421,7 @@ func TestReplicatorDeleteObject(t *testing.T) {
 				}
 			}
 		}
-		client.On("DeleteObject", mock.Anything, "C", cls, shard, anyVal, uuid, uint64(123)).Return(resp, nil)
+		client.On("DeleteObject", mock.Anything, "C", cls, shard, anyVal, uuid, (*int64)(nil), uint64(123)).Return(resp, nil)
 		client.On("Commit", ctx, "C", "C1", shard, anyVal, anyVal).Return(nil).RunFn = func(a mock.Arguments) {
 			resp := a[5].(*SimpleResponse)
 			*resp = SimpleResponse{
@@ -342,9 +429,74 @@ func TestReplicatorDeleteObject(t *testing.T) {
 			}
 		}
 
-		assert.NotNil(t, rep.DeleteObject(ctx, shard, uuid, All, 123))
-		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, Quorum, 123))
-		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, One, 123))
+		_, err := rep.DeleteObject(ctx, shard, uuid, nil, All, 123)
+		assert.NotNil(t, err)
+		_, err = rep.DeleteObject(ctx, shard, uuid, nil, Quorum, 123)
+		assert.Nil(t, err)
+		_, err = rep.DeleteObject(ctx, shard, uuid, nil, One, 123)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DeletedUpdateTime", func(t *testing.T) {
+		factory := newFakeFactory("C1", shard, nodes)
+		client := factory.WClient
+		rep := factory.newReplicator()
+		resp := SimpleResponse{Errors: make([]Error, 1)}
+		for i, n := range nodes {
+			updateTime := int64(5 + i)
+			client.On("DeleteObject", mock.Anything, n, cls, shard, anyVal, uuid, (*int64)(nil), uint64(123)).Return(resp, nil)
+			client.On("Commit", ctx, n, "C1", shard, anyVal, anyVal).Return(nil).RunFn = func(a mock.Arguments) {
+				resp := a[5].(*SimpleResponse)
+				*resp = SimpleResponse{DeletedUpdateTime: updateTime}
+			}
+		}
+
+		updateTime, err := rep.DeleteObject(ctx, shard, uuid, nil, All, 123)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(7), updateTime)
+	})
+
+	t.Run("CommitConditionFailed", func(t *testing.T) {
+		factory := newFakeFactory("C1", shard, nodes)
+		client := factory.WClient
+		rep := factory.newReplicator()
+		ifMatch := int64(1000)
+		resp := SimpleResponse{Errors: make([]Error, 1)}
+		for _, n := range nodes {
+			client.On("DeleteObject", mock.Anything, n, cls, shard, anyVal, uuid, &ifMatch, uint64(123)).Return(resp, nil)
+			client.On("Commit", ctx, n, "C1", shard, anyVal, anyVal).Return(nil).RunFn = func(a mock.Arguments) {
+				resp := a[5].(*SimpleResponse)
+				*resp = SimpleResponse{
+					Errors: []Error{{Code: StatusConditionFailed, Msg: "version mismatch"}},
+				}
+			}
+		}
+
+		_, err := rep.DeleteObject(ctx, shard, uuid, &ifMatch, All, 123)
+		assert.ErrorAs(t, err, &objects.ErrPreconditionFailed{})
+	})
+
+	t.Run("ConditionalDeletionWithStaleReplica", func(t *testing.T) {
+		nodes := []string{"A", "B", "C"}
+		factory := newFakeFactory("C1", shard, nodes)
+		client := factory.WClient
+		rep := factory.newReplicator()
+		ifMatch := int64(1000)
+		resp := SimpleResponse{}
+		// A has not seen the latest version of the object yet
+		client.On("DeleteObject", mock.Anything, "A", cls, shard, anyVal, uuid, &ifMatch, uint64(123)).Return(resp, nil)
+		client.On("Commit", ctx, "A", cls, shard, anyVal, anyVal).Return(nil)
+		resp2 := SimpleResponse{Errors: []Error{{Code: StatusConditionFailed, Msg: "version mismatch"}}}
+		for _, n := range nodes[1:] {
+			client.On("DeleteObject", mock.Anything, n, cls, shard, anyVal, uuid, &ifMatch, uint64(123)).Return(resp2, nil)
+		}
+		for _, n := range nodes {
+			client.On("Abort", mock.Anything, n, "C1", shard, anyVal).Return(resp, nil)
+		}
+
+		_, err := rep.DeleteObject(ctx, shard, uuid, &ifMatch, One, 123)
+		assert.ErrorAs(t, err, &objects.ErrPreconditionFailed{})
+		client.AssertNotCalled(t, "Commit", ctx, "A", cls, shard, anyVal, anyVal)
 	})
 }
 
@@ -365,7 +517,7 @@ func TestReplicatorDeleteObjects(t *testing.T) {
 		for _, n := range nodes {
 			client.On("Abort", mock.Anything, n, "C1", shard, anyVal).Return(SimpleResponse{}, nil)
 		}
-		result := factory.newReplicator().DeleteObjects(ctx, shard, docIDs, false, All, 123)
+		result, _ := factory.newReplicator().DeleteObjects(ctx, shard, docIDs, false, All, 123)
 		assert.Equal(t, len(result), 2)
 		for _, r := range result {
 			assert.ErrorIs(t, r.Err, errReplicas)
@@ -380,7 +532,7 @@ func TestReplicatorDeleteObjects(t *testing.T) {
 			client.On("DeleteObjects", mock.Anything, n, cls, shard, anyVal, docIDs, false, uint64(123)).Return(SimpleResponse{}, nil)
 			client.On("Commit", ctx, n, cls, shard, anyVal, anyVal).Return(errAny)
 		}
-		result := factory.newReplicator().DeleteObjects(ctx, shard, docIDs, false, All, 123)
+		result, _ := factory.newReplicator().DeleteObjects(ctx, shard, docIDs, false, All, 123)
 		assert.Equal(t, len(result), 2)
 	})
 	t.Run("PartialSuccess", func(t *testing.T) {
@@ -398,7 +550,7 @@ func TestReplicatorDeleteObjects(t *testing.T) {
 				}
 			}
 		}
-		result := rep.DeleteObjects(ctx, shard, docIDs, false, All, 123)
+		result, _ := rep.DeleteObjects(ctx, shard, docIDs, false, All, 123)
 		assert.Equal(t, len(result), 2)
 		assert.Equal(t, objects.BatchSimpleObject{UUID: "1", Err: nil}, result[0])
 		assert.Equal(t, objects.BatchSimpleObject{UUID: "2", Err: &Error{Msg: "e1"}}, result[1])
@@ -418,7 +570,7 @@ func TestReplicatorDeleteObjects(t *testing.T) {
 				}
 			}
 		}
-		result := rep.DeleteObjects(ctx, shard, docIDs, false, All, 123)
+		result, _ := rep.DeleteObjects(ctx, shard, docIDs, false, All, 123)
 		assert.Equal(t, len(result), 2)
 		assert.Equal(t, objects.BatchSimpleObject{UUID: "1", Err: nil}, result[0])
 		assert.Equal(t, objects.BatchSimpleObject{UUID: "2", Err: nil}, result[1])
@@ -438,7 +590,7 @@ func TestReplicatorDeleteObjects(t *testing.T) {
 				Batch: []UUID2Error{{UUID: "1"}, {UUID: "2"}},
 			}
 		}
-		result := rep.DeleteObjects(ctx, shard, docIDs, false, One, 123)
+		result, _ := rep.DeleteObjects(ctx, shard, docIDs, false, One, 123)
 		assert.Equal(t, len(result), 2)
 		assert.Equal(t, []objects.BatchSimpleObject{{UUID: "1"}, {UUID: "2"}}, result)
 	})
@@ -466,10 +618,32 @@ func TestReplicatorDeleteObjects(t *testing.T) {
 				Batch: []UUID2Error{{UUID: "1"}, {UUID: "2", Error: Error{Msg: "e2"}}},
 			}
 		}
-		result := rep.DeleteObjects(ctx, shard, docIDs, false, Quorum, 123)
+		result, _ := rep.DeleteObjects(ctx, shard, docIDs, false, Quorum, 123)
 		assert.Equal(t, len(result), 2)
 		assert.Equal(t, []objects.BatchSimpleObject{{UUID: "1"}, {UUID: "2"}}, result)
 	})
+
+	t.Run("DeletedUpdateTime", func(t *testing.T) {
+		nodes := []string{"A", "B"}
+		factory := newFakeFactory("C1", shard, nodes)
+		client := factory.WClient
+		rep := factory.newReplicator()
+		docIDs := []strfmt.UUID{strfmt.UUID("1"), strfmt.UUID("2")}
+		for i, n := range nodes {
+			updateTime := int64(7 - i)
+			client.On("DeleteObjects", mock.Anything, n, cls, shard, anyVal, docIDs, false, uint64(123)).Return(SimpleResponse{}, nil)
+			client.On("Commit", ctx, n, cls, shard, anyVal, anyVal).Return(nil).RunFn = func(args mock.Arguments) {
+				resp := args[5].(*DeleteBatchResponse)
+				*resp = DeleteBatchResponse{
+					Batch:             []UUID2Error{{UUID: "1"}, {UUID: "2"}},
+					DeletedUpdateTime: updateTime,
+				}
+			}
+		}
+		result, updateTime := rep.DeleteObjects(ctx, shard, docIDs, false, All, 123)
+		assert.Equal(t, []objects.BatchSimpleObject{{UUID: "1"}, {UUID: "2"}}, result)
+		assert.Equal(t, int64(7), updateTime)
+	})
 }
 
 func TestReplicatorPutObjects(t *testing.T) {
@@ -479,7 +653,7 @@ func TestReplicatorPutObjects(t *testing.T) {
 		nodes = []string{"A", "B"}
 		ctx   = context.Background()
 		objs  = []*storobj.Object{{}, {}, {}}
-		resp1 = SimpleResponse{[]Error{{}}}
+		resp1 = SimpleResponse{Errors: []Error{{}}}
 	)
 	t.Run("SuccessWithConsistencyLevelAll", func(t *testing.T) {
 		f := newFakeFactory("C1", shard, nodes)
@@ -549,7 +723,7 @@ func TestReplicatorPutObjects(t *testing.T) {
 		f := newFakeFactory("C1", shard, nodes)
 		rep := f.newReplicator()
 		f.WClient.On("PutObjects", mock.Anything, nodes[0], cls, shard, anyVal, objs, uint64(0)).Return(resp1, nil)
-		resp2 := SimpleResponse{[]Error{{Msg: "E1"}, {Msg: "E2"}}}
+		resp2 := SimpleResponse{Errors: []Error{{Msg: "E1"}, {Msg: "E2"}}}
 		f.WClient.On("PutObjects", mock.Anything, nodes[1], cls, shard, anyVal, objs, uint64(0)).Return(resp2, nil)
 		f.WClient.On("Abort", mock.Anything, nodes[0], "C1", shard, anyVal).Return(resp1, nil)
 		f.WClient.On("Abort", mock.Anything, nodes[1], "C1", shard, anyVal).Return(resp1, nil)
@@ -643,7 +817,7 @@ func TestReplicatorAddReferences(t *testing.T) {
 		rep := f.newReplicator()
 		resp := SimpleResponse{}
 		f.WClient.On("AddReferences", mock.Anything, nodes[0], cls, shard, anyVal, refs, uint64(123)).Return(resp, nil)
-		resp2 := SimpleResponse{[]Error{{Msg: "E1"}, {Msg: "E2"}}}
+		resp2 := SimpleResponse{Errors: []Error{{Msg: "E1"}, {Msg: "E2"}}}
 		f.WClient.On("AddReferences", mock.Anything, nodes[1], cls, shard, anyVal, refs, uint64(123)).Return(resp2, nil)
 		f.WClient.On("Abort", mock.Anything, nodes[0], "C1", shard, anyVal).Return(resp, nil)
 		f.WClient.On("Abort", mock.Anything, nodes[1], "C1", shard, anyVal).Return(resp, nil)
@@ -13,6 +13,7 @@ package replica
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -24,8 +25,16 @@ const (
 	One    ConsistencyLevel = "ONE"
 	Quorum ConsistencyLevel = "QUORUM"
 	All    ConsistencyLevel = "ALL"
+	// BoundedStaleness reads are answered by a single replica if it has been
+	// in sync within the maximum staleness, otherwise they fall back to QUORUM.
+	// Writes use QUORUM.
+	BoundedStaleness ConsistencyLevel = "BOUNDED_STALENESS"
 )
 
+// DefaultMaxStaleness is the maximum staleness of bounded staleness reads
+// unless configured otherwise
+const DefaultMaxStaleness = 10 * time.Second
+
 // cLevel returns min number of replicas to fulfill the consistency level
 func cLevel(l ConsistencyLevel, n int) int {
 	switch l {
@@ -43,6 +52,23 @@ var (
 	errUnresolvedName = errors.New("unresolved node name")
 )
 
+// joiningResolver is implemented by sharding states which keep track of
+// replicas being added to a shard
+type joiningResolver interface {
+	// ResolveJoiningNodes returns the nodes a shard is being copied to,
+	// it maps node names to their addresses
+	ResolveJoiningNodes(class, shardName string) (map[string]string, error)
+}
+
+// consistencyResolver is implemented by sharding states which keep the
+// default consistency level of classes and tenants
+type consistencyResolver interface {
+	// ShardConsistency returns the default consistency level of a shard, which
+	// is empty if none has been configured, together with the maximum staleness
+	// of bounded staleness reads, which is 0 if none has been configured.
+	ShardConsistency(class, shardName string) (ConsistencyLevel, time.Duration)
+}
+
 // resolver finds replicas and resolves theirs names
 type resolver struct {
 	Schema shardingState
@@ -53,6 +79,9 @@ type resolver struct {
 
 // State returns replicas state
 func (r *resolver) State(shardName string, cl ConsistencyLevel, directCandidate string) (res rState, err error) {
+	if cl, _ = r.consistency(shardName, cl, Quorum); cl == BoundedStaleness {
+		cl = Quorum // bounded staleness only applies to reads
+	}
 	res.CLevel = cl
 	m, err := r.Schema.ResolveParentNodes(r.Class, shardName)
 	if err != nil {
@@ -86,17 +115,60 @@ func (r *resolver) State(shardName string, cl ConsistencyLevel, directCandidate
 	if res.Len() == 0 {
 		return res, errNoReplicaFound
 	}
+	res.Joining = r.joining(shardName, m)
 
 	res.Level, err = res.ConsistencyLevel(cl)
 	return res, err
 }
 
+// consistency returns l, or the default consistency level of the shard if l
+// is empty. fallback is used if no default has been configured either.
+// It also returns the maximum staleness of bounded staleness reads.
+func (r *resolver) consistency(shardName string, l, fallback ConsistencyLevel) (ConsistencyLevel, time.Duration) {
+	maxStaleness := DefaultMaxStaleness
+	if cr, ok := r.Schema.(consistencyResolver); ok {
+		def, d := cr.ShardConsistency(r.Class, shardName)
+		if l == "" {
+			l = def
+		}
+		if d > 0 {
+			maxStaleness = d
+		}
+	}
+	if l == "" {
+		l = fallback
+	}
+	return l, maxStaleness
+}
+
+// joining returns the addresses of nodes the shard is being copied to
+func (r *resolver) joining(shardName string, replicas map[string]string) []string {
+	jr, ok := r.Schema.(joiningResolver)
+	if !ok {
+		return nil
+	}
+	m, err := jr.ResolveJoiningNodes(r.Class, shardName)
+	if err != nil || len(m) == 0 {
+		return nil
+	}
+	hosts := make([]string, 0, len(m))
+	for name, addr := range m {
+		if _, isReplica := replicas[name]; !isReplica && addr != "" {
+			hosts = append(hosts, addr)
+		}
+	}
+	return hosts
+}
+
 // rState replicas state
 type rState struct {
 	CLevel  ConsistencyLevel
 	Level   int
 	Hosts   []string // successfully resolved names
 	NodeMap map[string]string
+	// Joining are the hosts of replicas being added, they don't count
+	// towards the consistency level
+	Joining []string
 }
 
 // Len returns the number of replicas
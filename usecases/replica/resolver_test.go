@@ -13,6 +13,7 @@ package replica
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -62,7 +63,7 @@ func TestResolver(t *testing.T) {
 		for _, k := range ss["S1"] {
 			m[k] = nr.hosts[k]
 		}
-		want := rState{All, len(ss["S1"]), ss["S1"], m}
+		want := rState{All, len(ss["S1"]), ss["S1"], m, nil}
 		assertSameHosts(want, got, "B")
 	})
 
@@ -73,7 +74,7 @@ func TestResolver(t *testing.T) {
 		for _, k := range ss["S1"] {
 			m[k] = nr.hosts[k]
 		}
-		want := rState{All, len(ss["S1"]), ss["S1"], m}
+		want := rState{All, len(ss["S1"]), ss["S1"], m, nil}
 		assertSameHosts(want, got, "B")
 	})
 	t.Run("Quorum", func(t *testing.T) {
@@ -84,7 +85,7 @@ func TestResolver(t *testing.T) {
 		for _, k := range ss["S3"] {
 			m[k] = nr.hosts[k]
 		}
-		want := rState{Quorum, len(ss["S1"]), ss["S1"], m} // ss["S2"]}
+		want := rState{Quorum, len(ss["S1"]), ss["S1"], m, nil} // ss["S2"]}
 		assertSameHosts(want, got, "A")
 		_, err = got.ConsistencyLevel(All)
 		assert.ErrorIs(t, err, errUnresolvedName)
@@ -100,7 +101,7 @@ func TestResolver(t *testing.T) {
 		for _, k := range ss["S5"] {
 			m[k] = nr.hosts[k]
 		}
-		want := rState{Quorum, 0, ss["S1"], m} // ss["S4"]}
+		want := rState{Quorum, 0, ss["S1"], m, nil} // ss["S4"]}
 		assertSameHosts(want, got, "A")
 
 		_, err = got.ConsistencyLevel(All)
@@ -110,4 +111,51 @@ func TestResolver(t *testing.T) {
 		_, err = got.ConsistencyLevel(One)
 		assert.Nil(t, err)
 	})
+	t.Run("JoiningNodes", func(t *testing.T) {
+		nr := newFakeNodeResolver([]string{"A", "B", "C", "D"})
+		state := newFakeShardingState("A", ss, nr)
+		state.ShardToJoining = map[string][]string{"S1": {"D", "X"}}
+		r := resolver{nodeResolver: nr, Class: "C", NodeName: "A", Schema: state}
+
+		got, err := r.State("S1", All, "")
+		assert.Nil(t, err)
+		// joining replicas are not counted
+		assert.Equal(t, 3, got.Level)
+		assert.ElementsMatch(t, []string{"A", "B", "C"}, got.Hosts)
+		// unresolved joining replicas are ignored
+		assert.Equal(t, []string{"D"}, got.Joining)
+	})
+	t.Run("DefaultConsistency", func(t *testing.T) {
+		nr := newFakeNodeResolver([]string{"A", "B", "C", "D", "E", "F"})
+		state := newFakeShardingState("A", ss, nr)
+		state.ShardToConsistency = map[string]ConsistencyLevel{"S1": All, "S2": BoundedStaleness}
+		r := resolver{nodeResolver: nr, Class: "C", NodeName: "A", Schema: state}
+
+		got, err := r.State("S1", "", "")
+		assert.Nil(t, err)
+		assert.Equal(t, All, got.CLevel)
+		assert.Equal(t, 3, got.Level)
+
+		// an explicit level overrides the default
+		got, err = r.State("S1", One, "")
+		assert.Nil(t, err)
+		assert.Equal(t, One, got.CLevel)
+
+		// bounded staleness only applies to reads
+		got, err = r.State("S2", "", "")
+		assert.Nil(t, err)
+		assert.Equal(t, Quorum, got.CLevel)
+
+		got, err = r.State("S3", "", "")
+		assert.Nil(t, err)
+		assert.Equal(t, Quorum, got.CLevel)
+
+		l, d := r.consistency("S2", "", One)
+		assert.Equal(t, BoundedStaleness, l)
+		assert.Equal(t, DefaultMaxStaleness, d)
+		state.MaxStaleness = time.Minute
+		l, d = r.consistency("S3", "", One)
+		assert.Equal(t, One, l)
+		assert.Equal(t, time.Minute, d)
+	})
 }
@@ -50,6 +50,9 @@ const (
 	StatusPreconditionFailed
 	StatusReadOnly
 	StatusObjectNotFound
+	// StatusConditionFailed reports that the precondition of a
+	// conditional write did not match the replica's copy of the object
+	StatusConditionFailed
 )
 
 // Error reports error happening during replication
@@ -108,6 +111,8 @@ func statusText(code StatusCode) string {
 		return "read only"
 	case StatusObjectNotFound:
 		return "object not found"
+	case StatusConditionFailed:
+		return "condition failed"
 	default:
 		return ""
 	}
@@ -122,6 +127,9 @@ func (e *Error) Timeout() bool {
 
 type SimpleResponse struct {
 	Errors []Error `json:"errors,omitempty"`
+	// DeletedUpdateTime is the update time (unix ms) of the object version
+	// removed by a deletion, if any
+	DeletedUpdateTime int64 `json:"deletedUpdateTime,omitempty"`
 }
 
 func (r *SimpleResponse) FirstError() error {
@@ -136,6 +144,9 @@ func (r *SimpleResponse) FirstError() error {
 // DeleteBatchResponse represents the response returned by DeleteObjects
 type DeleteBatchResponse struct {
 	Batch []UUID2Error `json:"batch,omitempty"`
+	// DeletedUpdateTime is the latest update time (unix ms) of the object
+	// versions removed by the deletion, if any
+	DeletedUpdateTime int64 `json:"deletedUpdateTime,omitempty"`
 }
 
 type UUID2Error struct {
@@ -185,7 +196,7 @@ type wClient interface {
 	PutObject(ctx context.Context, host, index, shard, requestID string,
 		obj *storobj.Object, schemaVersion uint64) (SimpleResponse, error)
 	DeleteObject(ctx context.Context, host, index, shard, requestID string,
-		id strfmt.UUID, schemaVersion uint64) (SimpleResponse, error)
+		id strfmt.UUID, ifMatch *int64, schemaVersion uint64) (SimpleResponse, error)
 	PutObjects(ctx context.Context, host, index, shard, requestID string,
 		objs []*storobj.Object, schemaVersion uint64) (SimpleResponse, error)
 	MergeObject(ctx context.Context, host, index, shard, requestID string,
@@ -228,6 +239,10 @@ type rClient interface {
 
 	HashTreeLevel(ctx context.Context, host, index, shard string, level int,
 		discriminant *hashtree.Bitset) (digests []hashtree.Digest, err error)
+
+	// AppliedWatermark returns the latest update time of the replicated
+	// writes applied to the replica of shard held by host
+	AppliedWatermark(ctx context.Context, host, index, shard string) (int64, error)
 }
 
 // finderClient extends RClient with consistency checks
@@ -252,6 +267,14 @@ func (fc finderClient) HashTreeLevel(ctx context.Context,
 	return fc.cl.HashTreeLevel(ctx, host, index, shard, level, discriminant)
 }
 
+// AppliedWatermark returns the latest update time of the replicated writes
+// applied to the replica of shard held by host
+func (fc finderClient) AppliedWatermark(ctx context.Context,
+	host, index, shard string,
+) (int64, error) {
+	return fc.cl.AppliedWatermark(ctx, host, index, shard)
+}
+
 // DigestReads reads digests of all specified objects
 func (fc finderClient) DigestReads(ctx context.Context,
 	host, index, shard string,
@@ -23,8 +23,42 @@ type (
 	ShardDist map[string][]string
 	// nodeShardDist map a node its shard distribution
 	nodeShardDist map[string]ShardDist
+	// nodeShards maps a node to a list of shards
+	nodeShards map[string][]string
 )
 
+// plan adjusts the replicas of all shards in a copy of ss to replFactor.
+// It returns the new state together with the replicas which have been
+// added or removed, depending on the direction of the change.
+func plan(ss *sharding.State, replFactor int64, nodes cluster.NodeSelector) (*sharding.State, nodeShards, error) {
+	after := ss.DeepCopy()
+	changes := make(nodeShards)
+	for name, shard := range after.Physical {
+		if err := shard.AdjustReplicas(int(replFactor), nodes); err != nil {
+			return nil, nil, err
+		}
+		after.Physical[name] = shard
+		before := ss.Physical[name].BelongsToNodes
+		changed := difference(shard.BelongsToNodes, before)
+		if len(changed) == 0 {
+			changed = difference(before, shard.BelongsToNodes)
+		}
+		for _, node := range changed {
+			changes[node] = append(changes[node], name)
+		}
+	}
+	return &after, changes, nil
+}
+
+// nodes return node names
+func (m nodeShards) nodes() []string {
+	ns := make([]string, 0, len(m))
+	for node := range m {
+		ns = append(ns, node)
+	}
+	return ns
+}
+
 // distributions returns shard distribution for local node as well as remote nodes
 func distributions(before, after *sharding.State) (ShardDist, nodeShardDist) {
 	localDist := make(ShardDist, len(before.Physical))
@@ -46,6 +80,35 @@ func distributions(before, after *sharding.State) (ShardDist, nodeShardDist) {
 	return localDist, nodeDist
 }
 
+// syncs returns the replicas which need to push their objects to other
+// replicas of the same shard when changing from the before to the after state.
+// New replicas are synced from all existing ones, while remaining replicas
+// are synced from the removed ones.
+func syncs(before, after *sharding.State) nodeShardDist {
+	res := make(nodeShardDist)
+	add := func(node, shard string, targets []string) {
+		dist := res[node]
+		if dist == nil {
+			dist = make(ShardDist)
+			res[node] = dist
+		}
+		dist[shard] = targets
+	}
+	for name, shard := range before.Physical {
+		nodes := after.Physical[name].BelongsToNodes
+		if added := difference(nodes, shard.BelongsToNodes); len(added) > 0 {
+			for _, node := range shard.BelongsToNodes {
+				add(node, name, added)
+			}
+			continue
+		}
+		for _, node := range difference(shard.BelongsToNodes, nodes) {
+			add(node, name, nodes)
+		}
+	}
+	return res
+}
+
 // nodes return node names
 func (m nodeShardDist) nodes() []string {
 	ns := make([]string, 0, len(m))
@@ -15,6 +15,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 func TestDifference(t *testing.T) {
@@ -49,3 +50,28 @@ func TestDifference(t *testing.T) {
 		assert.Equal(t, c.zs, difference(c.xs, c.ys))
 	}
 }
+
+func TestSyncs(t *testing.T) {
+	state := func(m map[string][]string) *sharding.State {
+		ss := &sharding.State{Physical: map[string]sharding.Physical{}}
+		for name, nodes := range m {
+			ss.Physical[name] = sharding.Physical{BelongsToNodes: nodes}
+		}
+		return ss
+	}
+	before := state(map[string][]string{"S1": {"N1", "N2"}, "S2": {"N3"}})
+
+	// new replicas are synced from all existing ones
+	after := state(map[string][]string{"S1": {"N1", "N2", "N3"}, "S2": {"N3", "N1"}})
+	assert.Equal(t, nodeShardDist{
+		"N1": {"S1": {"N3"}},
+		"N2": {"S1": {"N3"}},
+		"N3": {"S2": {"N1"}},
+	}, syncs(before, after))
+
+	// remaining replicas are synced from the removed ones
+	after = state(map[string][]string{"S1": {"N1"}, "S2": {"N3"}})
+	assert.Equal(t, nodeShardDist{
+		"N2": {"S1": {"N1"}},
+	}, syncs(before, after))
+}
@@ -15,11 +15,13 @@ import (
 	"context"
 	"errors"
 	"io"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/mock"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/cluster/mocks"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -40,6 +42,7 @@ type fakeFactory struct {
 	ShardingState fakeShardingState
 	NodeHostMap   map[string]string
 	Source        *fakeSource
+	Replicas      *fakeReplicas
 	Client        *fakeClient
 	logger        logrus.FieldLogger
 }
@@ -60,6 +63,7 @@ func newFakeFactory() *fakeFactory {
 		},
 		NodeHostMap: nodeHostMap,
 		Source:      &fakeSource{},
+		Replicas:    &fakeReplicas{},
 		Client:      &fakeClient{},
 		logger:      logger,
 	}
@@ -70,19 +74,34 @@ func (f *fakeFactory) Scaler(dataPath string) *Scaler {
 	scaler := New(
 		nodeResolver,
 		f.Source,
+		f.Replicas,
 		f.Client,
 		f.logger,
 		dataPath)
 	scaler.SetSchemaReader(&f.ShardingState)
+	scaler.SetSchemaManager(&f.ShardingState)
 	return scaler
 }
 
+// fakeShardingState serves the sharding state built from M until
+// it is replaced by an update
 type fakeShardingState struct {
 	LocalNode string
 	M         map[string][]string
+
+	sync.Mutex
+	class   *models.Class
+	state   *sharding.State
+	updates []*sharding.State
 }
 
 func (f *fakeShardingState) CopyShardingState(class string) *sharding.State {
+	f.Lock()
+	defer f.Unlock()
+	if f.state != nil {
+		state := f.state.DeepCopy()
+		return &state
+	}
 	if len(f.M) == 0 {
 		return nil
 	}
@@ -95,6 +114,36 @@ func (f *fakeShardingState) CopyShardingState(class string) *sharding.State {
 	return &state
 }
 
+func (f *fakeShardingState) ReadOnlyClass(name string) *models.Class {
+	f.Lock()
+	defer f.Unlock()
+	if f.class != nil {
+		return f.class
+	}
+	return &models.Class{Class: name, ReplicationConfig: &models.ReplicationConfig{Factor: 1}}
+}
+
+func (f *fakeShardingState) WaitForUpdate(ctx context.Context, version uint64) error {
+	return nil
+}
+
+func (f *fakeShardingState) UpdateClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error) {
+	f.Lock()
+	defer f.Unlock()
+	state := ss.DeepCopy()
+	state.SetLocalName(f.LocalNode)
+	f.class, f.state = cls, &state
+	f.updates = append(f.updates, &state)
+	return uint64(len(f.updates)), nil
+}
+
+// Updates returns the committed sharding states
+func (f *fakeShardingState) Updates() []*sharding.State {
+	f.Lock()
+	defer f.Unlock()
+	return f.updates
+}
+
 // node resolver
 type fakeNodeResolver struct {
 	cluster.NodeSelector
@@ -116,6 +165,11 @@ func (r *fakeNodeResolver) NodeHostname(nodeName string) (string, bool) {
 	return host, ok
 }
 
+// LocalName needed to override the common cluster.NodeSelector
+func (r *fakeNodeResolver) LocalName() string {
+	return r.NodeName
+}
+
 type fakeSource struct {
 	mock.Mock
 }
@@ -132,6 +186,25 @@ func (s *fakeSource) ShardsBackup(
 	return args.Get(0).(backup.ClassDescriptor), args.Error(1)
 }
 
+type fakeReplicas struct {
+	mock.Mock
+}
+
+func (f *fakeReplicas) JoinShardReplicas(ctx context.Context, class string, shards []string) error {
+	args := f.Called(ctx, class, shards)
+	return args.Error(0)
+}
+
+func (f *fakeReplicas) DropShardReplicas(ctx context.Context, class string, shards []string) error {
+	args := f.Called(ctx, class, shards)
+	return args.Error(0)
+}
+
+func (f *fakeReplicas) SyncShardReplicas(ctx context.Context, class string, dist map[string][]string) error {
+	args := f.Called(ctx, class, dist)
+	return args.Error(0)
+}
+
 type fakeClient struct {
 	mock.Mock
 }
@@ -160,3 +233,24 @@ func (f *fakeClient) IncreaseReplicationFactor(ctx context.Context,
 	args := f.Called(ctx, host, class, dist)
 	return args.Error(0)
 }
+
+func (f *fakeClient) JoinReplicas(ctx context.Context,
+	host, class string, shards []string,
+) error {
+	args := f.Called(ctx, host, class, shards)
+	return args.Error(0)
+}
+
+func (f *fakeClient) DecreaseReplicationFactor(ctx context.Context,
+	host, class string, shards []string,
+) error {
+	args := f.Called(ctx, host, class, shards)
+	return args.Error(0)
+}
+
+func (f *fakeClient) SyncReplicas(ctx context.Context,
+	host, class string, dist ShardDist,
+) error {
+	args := f.Called(ctx, host, class, dist)
+	return args.Error(0)
+}
@@ -0,0 +1,194 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// maxFinishedOperations is the number of finished operations kept for inspection
+const maxFinishedOperations = 64
+
+// Status of a replication factor change
+type Status string
+
+const (
+	// StatusStarted means replicas are being prepared
+	StatusStarted Status = "STARTED"
+	// StatusCopying means shards are being copied to new replicas
+	StatusCopying Status = "COPYING"
+	// StatusSyncing means objects missing on new or remaining replicas are
+	// being pushed to them
+	StatusSyncing Status = "SYNCING"
+	// StatusRemoving means surplus replicas are being removed
+	StatusRemoving Status = "REMOVING"
+	StatusSuccess  Status = "SUCCESS"
+	StatusFailed   Status = "FAILED"
+)
+
+// Operation is a change of the replication factor of a class
+// which is run in the background
+type Operation struct {
+	ID          string
+	Class       string
+	From        int64
+	To          int64
+	Status      Status
+	StartedAt   time.Time
+	CompletedAt time.Time
+	Error       string
+}
+
+// Running reports whether the operation hasn't finished yet
+func (op *Operation) Running() bool {
+	return op.Status != StatusSuccess && op.Status != StatusFailed
+}
+
+func (op *Operation) toModel() *models.ReplicationScaleOperation {
+	m := &models.ReplicationScaleOperation{
+		ID:         op.ID,
+		Class:      op.Class,
+		FromFactor: op.From,
+		ToFactor:   op.To,
+		Status:     string(op.Status),
+		StartedAt:  strfmt.DateTime(op.StartedAt),
+		Error:      op.Error,
+	}
+	if !op.CompletedAt.IsZero() {
+		completedAt := strfmt.DateTime(op.CompletedAt)
+		m.CompletedAt = &completedAt
+	}
+	return m
+}
+
+// operations keeps track of the operations coordinated by this node
+type operations struct {
+	sync.Mutex
+	ops []*Operation // oldest first
+}
+
+// start registers a new operation unless there is one running for the class
+func (o *operations) start(class string, from, to int64) (Operation, bool) {
+	o.Lock()
+	defer o.Unlock()
+	for _, op := range o.ops {
+		if op.Class == class && op.Running() {
+			return *op, false
+		}
+	}
+	op := &Operation{
+		ID:        uuid.NewString(),
+		Class:     class,
+		From:      from,
+		To:        to,
+		Status:    StatusStarted,
+		StartedAt: time.Now().UTC(),
+	}
+	o.ops = append(o.ops, op)
+	o.trim()
+	return *op, true
+}
+
+// running reports whether an operation on class is running
+func (o *operations) running(class string) bool {
+	o.Lock()
+	defer o.Unlock()
+	for _, op := range o.ops {
+		if op.Class == class && op.Running() {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *operations) setStatus(id string, status Status) {
+	o.Lock()
+	defer o.Unlock()
+	if op := o.get(id); op != nil {
+		op.Status = status
+	}
+}
+
+func (o *operations) finish(id string, err error) {
+	o.Lock()
+	defer o.Unlock()
+	op := o.get(id)
+	if op == nil {
+		return
+	}
+	op.Status, op.CompletedAt = StatusSuccess, time.Now().UTC()
+	if err != nil {
+		op.Status, op.Error = StatusFailed, err.Error()
+	}
+}
+
+func (o *operations) get(id string) *Operation {
+	for _, op := range o.ops {
+		if op.ID == id {
+			return op
+		}
+	}
+	return nil
+}
+
+// trim drops the oldest finished operations
+func (o *operations) trim() {
+	finished := 0
+	for _, op := range o.ops {
+		if !op.Running() {
+			finished++
+		}
+	}
+	if finished <= maxFinishedOperations {
+		return
+	}
+	kept := o.ops[:0]
+	for _, op := range o.ops {
+		if op.Running() || finished <= maxFinishedOperations {
+			kept = append(kept, op)
+			continue
+		}
+		finished--
+	}
+	o.ops = kept
+}
+
+// list returns the operations of class, or of all classes if class is empty
+func (o *operations) list(class string) []Operation {
+	o.Lock()
+	defer o.Unlock()
+	res := make([]Operation, 0, len(o.ops))
+	for _, op := range o.ops {
+		if class == "" || op.Class == class {
+			res = append(res, *op)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool { return res[i].StartedAt.Before(res[j].StartedAt) })
+	return res
+}
+
+// Operations returns the replication factor changes coordinated by this node.
+// All operations are returned if class is empty.
+func (s *Scaler) Operations(class string) []*models.ReplicationScaleOperation {
+	ops := s.ops.list(class)
+	res := make([]*models.ReplicationScaleOperation, len(ops))
+	for i := range ops {
+		res[i] = ops[i].toModel()
+	}
+	return res
+}
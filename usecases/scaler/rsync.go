@@ -41,6 +41,16 @@ type client interface {
 	ReInitShard(ctx context.Context,
 		hostName, indexName, shardName string) error
 	IncreaseReplicationFactor(ctx context.Context, host, class string, dist ShardDist) error
+
+	// JoinReplicas prepares the remote node to receive copies of shards
+	JoinReplicas(ctx context.Context, host, class string, shards []string) error
+
+	// DecreaseReplicationFactor removes replicas of shards from the remote node
+	DecreaseReplicationFactor(ctx context.Context, host, class string, shards []string) error
+
+	// SyncReplicas makes the remote node push the objects of its replicas of
+	// the shards in dist to the nodes the shards are mapped to
+	SyncReplicas(ctx context.Context, host, class string, dist ShardDist) error
 }
 
 // rsync synchronizes shards with remote nodes
@@ -22,6 +22,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"github.com/weaviate/weaviate/usecases/sharding/config"
@@ -33,34 +34,39 @@ import (
 // We could concurrently sync same files to different nodes  while avoiding overlapping
 //
 // 2. To fail fast, we might consider creating all shards at once and re-initialize them in the final step
-//
-// 3. implement scaler.scaleIn
 
 var (
 	// ErrUnresolvedName cannot resolve the host address of a node
 	ErrUnresolvedName = errors.New("cannot resolve node name")
-	_NUMCPU           = runtime.NumCPU()
+	// ErrScaleInProgress another replication factor change of the same class is running
+	ErrScaleInProgress = errors.New("replication factor change in progress")
+	_NUMCPU            = runtime.NumCPU()
 )
 
 // Scaler scales out/in class replicas.
 //
 // It scales out a class by replicating its shards on new replicas
+// and scales it in by removing surplus replicas.
 type Scaler struct {
 	schemaReader    SchemaReader
+	schemaManager   SchemaManager
 	cluster         cluster.NodeSelector
-	source          BackUpper // data source
-	client          client    // client for remote nodes
+	source          BackUpper     // data source
+	replicas        LocalReplicas // local shard replicas
+	client          client        // client for remote nodes
 	logger          logrus.FieldLogger
 	persistenceRoot string
+	ops             operations
 }
 
 // New returns a new instance of Scaler
-func New(cl cluster.NodeSelector, source BackUpper,
+func New(cl cluster.NodeSelector, source BackUpper, replicas LocalReplicas,
 	c client, logger logrus.FieldLogger, persistenceRoot string,
 ) *Scaler {
 	return &Scaler{
 		cluster:         cl,
 		source:          source,
+		replicas:        replicas,
 		client:          c,
 		logger:          logger,
 		persistenceRoot: persistenceRoot,
@@ -75,15 +81,38 @@ type BackUpper interface {
 	ReleaseBackup(ctx context.Context, id, className string) error
 }
 
+// LocalReplicas manages the replicas of shards held by this node
+type LocalReplicas interface {
+	// JoinShardReplicas buffers writes to shards being copied to this node
+	JoinShardReplicas(ctx context.Context, class string, shards []string) error
+	// DropShardReplicas removes local replicas of shards
+	DropShardReplicas(ctx context.Context, class string, shards []string) error
+	// SyncShardReplicas pushes the objects of local shards which are missing
+	// or stale on the nodes the shards are mapped to
+	SyncShardReplicas(ctx context.Context, class string, dist map[string][]string) error
+}
+
 // SchemaReader is used by the scaler to get and update sharding states
 type SchemaReader interface {
 	CopyShardingState(class string) *sharding.State
+	ReadOnlyClass(name string) *models.Class
+	// WaitForUpdate ensures that the local schema has caught up to version.
+	WaitForUpdate(ctx context.Context, version uint64) error
+}
+
+// SchemaManager is used by the scaler to commit sharding states
+type SchemaManager interface {
+	UpdateClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error)
 }
 
 func (s *Scaler) SetSchemaReader(sr SchemaReader) {
 	s.schemaReader = sr
 }
 
+func (s *Scaler) SetSchemaManager(sm SchemaManager) {
+	s.schemaManager = sm
+}
+
 // Scale increase/decrease class replicas.
 //
 // It returns the updated sharding state if successful. The caller must then
@@ -104,12 +133,314 @@ func (s *Scaler) Scale(ctx context.Context, className string,
 	}
 
 	if newReplFactor < prevReplFactor {
-		return nil, errors.Errorf("scaling in not supported yet")
+		ssAfter, _, err := plan(ssBefore, newReplFactor, s.cluster)
+		if err != nil {
+			return nil, err
+		}
+		ssAfter.Config = updated
+		return ssAfter, nil
 	}
 
 	return nil, nil
 }
 
+// StartScale changes the replication factor of a class in the background.
+//
+// Scaling out first registers the new replicas as joining nodes, which
+// receive writes while the shards are copied to them, and then commits them
+// as replicas. Scaling in first commits the reduced replica set and then
+// removes the surplus replicas. In both cases, objects missing on the new or
+// remaining replicas are pushed to them before the change is committed.
+// Progress can be followed using Operations.
+//
+// Joining replicas left behind by an operation whose coordinator has been
+// restarted or has left the cluster are removed before the new operation
+// starts.
+func (s *Scaler) StartScale(ctx context.Context, className string, prevReplFactor, newReplFactor int64) (Operation, error) {
+	if newReplFactor < 1 {
+		return Operation{}, fmt.Errorf("invalid replication factor %d", newReplFactor)
+	}
+	ssBefore := s.schemaReader.CopyShardingState(className)
+	if ssBefore == nil {
+		return Operation{}, fmt.Errorf("no sharding state for class %q", className)
+	}
+	abandoned := false
+	for name, shard := range ssBefore.Physical {
+		if len(shard.JoiningNodes) == 0 {
+			continue
+		}
+		if !s.abandoned(className, shard) {
+			return Operation{}, fmt.Errorf("%w: shard %q has joining replicas coordinated by node %q",
+				ErrScaleInProgress, name, shard.JoiningCoordinator)
+		}
+		abandoned = true
+	}
+	ssAfter, changes, err := plan(ssBefore, newReplFactor, s.cluster)
+	if err != nil {
+		return Operation{}, err
+	}
+	// fail fast if new or remaining replicas are unknown
+	if _, err := hosts(changes.nodes(), s.cluster); err != nil {
+		return Operation{}, err
+	}
+
+	op, ok := s.ops.start(className, prevReplFactor, newReplFactor)
+	if !ok {
+		return op, fmt.Errorf("%w: operation %s", ErrScaleInProgress, op.ID)
+	}
+	logger := s.logger.WithField("action", "scale").WithField("class", className).
+		WithField("operation", op.ID)
+	logger.WithField("from", prevReplFactor).WithField("to", newReplFactor).Info("replication factor change started")
+
+	enterrors.GoWrapper(func() {
+		// the operation must not be bound to the request which started it
+		ctx := context.Background()
+		var err error
+		if abandoned {
+			err = s.removeAbandoned(ctx, className, ssBefore)
+		}
+		if err != nil {
+			err = fmt.Errorf("remove abandoned joining replicas: %w", err)
+		} else if newReplFactor > prevReplFactor {
+			err = s.runScaleOut(ctx, op.ID, className, ssBefore, ssAfter, changes, newReplFactor)
+		} else {
+			err = s.runScaleIn(ctx, op.ID, className, ssBefore, ssAfter, changes, newReplFactor)
+		}
+		s.ops.finish(op.ID, err)
+		if err != nil {
+			logger.WithError(err).Error("replication factor change failed")
+			return
+		}
+		logger.Info("replication factor change completed")
+	}, s.logger)
+
+	return op, nil
+}
+
+// abandoned reports whether the joining replicas of shard have been left
+// behind by an operation which can't complete anymore
+func (s *Scaler) abandoned(className string, shard sharding.Physical) bool {
+	if shard.JoiningCoordinator == s.cluster.LocalName() {
+		return !s.ops.running(className)
+	}
+	_, ok := s.cluster.NodeHostname(shard.JoiningCoordinator)
+	return !ok
+}
+
+// removeAbandoned unregisters the joining replicas of ss and removes them
+// from the nodes which are still part of the cluster
+func (s *Scaler) removeAbandoned(ctx context.Context, className string, ss *sharding.State) error {
+	joining := make(nodeShards)
+	for name, shard := range ss.Physical {
+		for _, node := range shard.JoiningNodes {
+			if _, ok := s.cluster.NodeHostname(node); ok {
+				joining[node] = append(joining[node], name)
+			}
+		}
+	}
+	if err := s.commit(ctx, className, 0, func(_ string, p *sharding.Physical) error {
+		p.JoiningNodes, p.JoiningCoordinator = nil, ""
+		return nil
+	}); err != nil {
+		return err
+	}
+	return s.removeReplicas(ctx, className, joining)
+}
+
+// runScaleOut registers the new replicas as joining, copies the shards over,
+// pushes the objects the new replicas might have missed in the meantime and
+// then commits the new replicas.
+//
+// Writes are forwarded to joining replicas on a best effort basis, the final
+// sync makes up for writes which failed to be forwarded.
+func (s *Scaler) runScaleOut(ctx context.Context, opID, className string,
+	ssBefore, ssAfter *sharding.State, joining nodeShards, replFactor int64,
+) (err error) {
+	if err := s.forEachNode(ctx, joining, func(ctx context.Context, node, host string, shards []string) error {
+		if host == "" {
+			return s.replicas.JoinShardReplicas(ctx, className, shards)
+		}
+		return s.client.JoinReplicas(ctx, host, className, shards)
+	}); err != nil {
+		return fmt.Errorf("join replicas: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			s.rollbackScaleOut(ctx, className, joining)
+		}
+	}()
+
+	if err := s.commit(ctx, className, 0, func(name string, p *sharding.Physical) error {
+		p.JoiningNodes = difference(ssAfter.Physical[name].BelongsToNodes, p.BelongsToNodes)
+		p.JoiningCoordinator = s.cluster.LocalName()
+		return nil
+	}); err != nil {
+		return fmt.Errorf("register joining replicas: %w", err)
+	}
+
+	s.ops.setStatus(opID, StatusCopying)
+	if err := s.copyShards(ctx, className, ssBefore, ssAfter); err != nil {
+		return fmt.Errorf("copy shards: %w", err)
+	}
+
+	s.ops.setStatus(opID, StatusSyncing)
+	if err := s.syncReplicas(ctx, className, syncs(ssBefore, ssAfter)); err != nil {
+		return fmt.Errorf("sync replicas: %w", err)
+	}
+
+	return s.commit(ctx, className, replFactor, func(_ string, p *sharding.Physical) error {
+		p.BelongsToNodes = append(p.BelongsToNodes, difference(p.JoiningNodes, p.BelongsToNodes)...)
+		p.JoiningNodes, p.JoiningCoordinator = nil, ""
+		return nil
+	})
+}
+
+// rollbackScaleOut removes joining replicas after a failed scale-out
+func (s *Scaler) rollbackScaleOut(ctx context.Context, className string, joining nodeShards) {
+	logger := s.logger.WithField("action", "scale_rollback").WithField("class", className)
+	if err := s.commit(ctx, className, 0, func(_ string, p *sharding.Physical) error {
+		p.JoiningNodes, p.JoiningCoordinator = nil, ""
+		return nil
+	}); err != nil {
+		logger.WithError(err).Error("unregister joining replicas")
+		return
+	}
+	if err := s.removeReplicas(ctx, className, joining); err != nil {
+		logger.WithError(err).Error("remove joining replicas")
+	}
+}
+
+// runScaleIn commits the reduced replica set first, so that surplus replicas
+// stop receiving traffic before they are removed.
+//
+// Surplus replicas might hold objects the remaining ones have missed. They are
+// pushed to the remaining replicas before the commit and once more after it,
+// for writes which have been accepted in the meantime. Surplus replicas are
+// kept if the latter fails.
+func (s *Scaler) runScaleIn(ctx context.Context, opID, className string,
+	ssBefore, ssAfter *sharding.State, removed nodeShards, replFactor int64,
+) error {
+	s.ops.setStatus(opID, StatusSyncing)
+	dist := syncs(ssBefore, ssAfter)
+	if err := s.syncReplicas(ctx, className, dist); err != nil {
+		return fmt.Errorf("sync replicas: %w", err)
+	}
+
+	if err := s.commit(ctx, className, replFactor, func(_ string, p *sharding.Physical) error {
+		return p.AdjustReplicas(int(replFactor), s.cluster)
+	}); err != nil {
+		return err
+	}
+
+	if err := s.syncReplicas(ctx, className, dist); err != nil {
+		return fmt.Errorf("sync replicas after commit: %w", err)
+	}
+
+	s.ops.setStatus(opID, StatusRemoving)
+	if err := s.removeReplicas(ctx, className, removed); err != nil {
+		return fmt.Errorf("remove replicas: %w", err)
+	}
+	return nil
+}
+
+func (s *Scaler) removeReplicas(ctx context.Context, className string, replicas nodeShards) error {
+	return s.forEachNode(ctx, replicas, func(ctx context.Context, node, host string, shards []string) error {
+		if host == "" {
+			return s.LocalScaleIn(ctx, className, shards)
+		}
+		return s.client.DecreaseReplicationFactor(ctx, host, className, shards)
+	})
+}
+
+// syncReplicas makes each node in dist push the objects of its replicas to
+// the nodes the shards are mapped to
+func (s *Scaler) syncReplicas(ctx context.Context, className string, dist nodeShardDist) error {
+	nodes := dist.nodes()
+	hosts, err := hosts(nodes, s.cluster)
+	if err != nil {
+		return err
+	}
+	localNode := s.cluster.LocalName()
+	g, ctx := enterrors.NewErrorGroupWithContextWrapper(s.logger, ctx)
+	for i, node := range nodes {
+		i, node := i, node
+		g.Go(func() error {
+			var err error
+			if node == localNode {
+				err = s.replicas.SyncShardReplicas(ctx, className, dist[node])
+			} else {
+				err = s.client.SyncReplicas(ctx, hosts[i], className, dist[node])
+			}
+			if err != nil {
+				return fmt.Errorf("node %q: %w", node, err)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// forEachNode calls f concurrently for each node. host is empty for the local node.
+func (s *Scaler) forEachNode(ctx context.Context, dist nodeShards,
+	f func(ctx context.Context, node, host string, shards []string) error,
+) error {
+	nodes := dist.nodes()
+	hosts, err := hosts(nodes, s.cluster)
+	if err != nil {
+		return err
+	}
+	localNode := s.cluster.LocalName()
+	g, ctx := enterrors.NewErrorGroupWithContextWrapper(s.logger, ctx)
+	for i, node := range nodes {
+		i, node := i, node
+		host := hosts[i]
+		if node == localNode {
+			host = ""
+		}
+		g.Go(func() error {
+			if err := f(ctx, node, host, dist[node]); err != nil {
+				return fmt.Errorf("node %q: %w", node, err)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// commit applies update to all shards of the current sharding state of
+// class and commits it to the cluster. The replication factor is changed
+// as well unless replFactor is 0.
+func (s *Scaler) commit(ctx context.Context, className string, replFactor int64,
+	update func(name string, p *sharding.Physical) error,
+) error {
+	cls := s.schemaReader.ReadOnlyClass(className)
+	ss := s.schemaReader.CopyShardingState(className)
+	if cls == nil || ss == nil {
+		return fmt.Errorf("class %q not found", className)
+	}
+	clsCopy := *cls
+	if replFactor > 0 {
+		rc := models.ReplicationConfig{}
+		if cls.ReplicationConfig != nil {
+			rc = *cls.ReplicationConfig
+		}
+		rc.Factor = replFactor
+		clsCopy.ReplicationConfig = &rc
+	}
+	for name, shard := range ss.Physical {
+		if err := update(name, &shard); err != nil {
+			return err
+		}
+		ss.Physical[name] = shard
+	}
+	version, err := s.schemaManager.UpdateClass(ctx, &clsCopy, ss)
+	if err != nil {
+		return fmt.Errorf("update class: %w", err)
+	}
+	return s.schemaReader.WaitForUpdate(ctx, version)
+}
+
 // scaleOut replicate class shards on new replicas (nodes):
 //
 // * It calculates new sharding state
@@ -133,13 +464,30 @@ func (s *Scaler) scaleOut(ctx context.Context, className string, ssBefore *shard
 		}
 		ssAfter.Physical[name] = shard
 	}
-	lDist, nodeDist := distributions(ssBefore, &ssAfter)
+	if err := s.copyShards(ctx, className, ssBefore, &ssAfter); err != nil {
+		return nil, err
+	}
+
+	// Finally, return sharding state back to schema manager. The schema manager
+	// will then broadcast this updated state to the cluster. This is essentially
+	// what will take the new replication shards live: On the new nodes, if
+	// traffic is incoming, IsShardLocal() would have returned false before. But
+	// now that a copy of the local shard is present it will return true and
+	// serve the traffic.
+	return &ssAfter, nil
+}
+
+// copyShards copies the shards of ssBefore to the new replicas in ssAfter.
+// Local shards are pushed by this node while the replication of remote
+// shards is delegated to their owner nodes.
+func (s *Scaler) copyShards(ctx context.Context, className string, ssBefore, ssAfter *sharding.State) error {
+	lDist, nodeDist := distributions(ssBefore, ssAfter)
 	g, ctx := enterrors.NewErrorGroupWithContextWrapper(s.logger, ctx)
 	// resolve hosts beforehand
 	nodes := nodeDist.nodes()
 	hosts, err := hosts(nodes, s.cluster)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	for i, node := range nodes {
 		dist := nodeDist[node]
@@ -159,17 +507,7 @@ func (s *Scaler) scaleOut(ctx context.Context, className string, ssBefore *shard
 		}
 		return nil
 	})
-	if err := g.Wait(); err != nil {
-		return nil, err
-	}
-
-	// Finally, return sharding state back to schema manager. The schema manager
-	// will then broadcast this updated state to the cluster. This is essentially
-	// what will take the new replication shards live: On the new nodes, if
-	// traffic is incoming, IsShardLocal() would have returned false before. But
-	// now that a copy of the local shard is present it will return true and
-	// serve the traffic.
-	return &ssAfter, nil
+	return g.Wait()
 }
 
 // LocalScaleOut syncs local shards with new replicas.
@@ -205,3 +543,27 @@ func (s *Scaler) LocalScaleOut(ctx context.Context,
 	rsync := newRSync(s.client, s.cluster, s.persistenceRoot)
 	return rsync.Push(ctx, bak.Shards, dist, className, s.logger)
 }
+
+// LocalJoinReplicas prepares this node to receive copies of shards.
+// Writes to these shards are buffered until the copies have been loaded.
+func (s *Scaler) LocalJoinReplicas(ctx context.Context, className string, shards []string) error {
+	return s.replicas.JoinShardReplicas(ctx, className, shards)
+}
+
+// LocalSyncReplicas pushes the objects of local shards which are missing
+// or stale on the nodes the shards are mapped to
+func (s *Scaler) LocalSyncReplicas(ctx context.Context, className string, dist ShardDist) error {
+	return s.replicas.SyncShardReplicas(ctx, className, dist)
+}
+
+// LocalScaleIn removes local replicas of shards which don't belong to this node anymore
+func (s *Scaler) LocalScaleIn(ctx context.Context, className string, shards []string) error {
+	if ss := s.schemaReader.CopyShardingState(className); ss != nil {
+		for _, name := range shards {
+			if ss.IsLocalShard(name) {
+				return fmt.Errorf("shard %q still belongs to this node", name)
+			}
+		}
+	}
+	return s.replicas.DropShardReplicas(ctx, className, shards)
+}
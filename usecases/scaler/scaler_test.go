@@ -17,9 +17,12 @@ import (
 	"path"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
 	"github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
@@ -40,12 +43,230 @@ func TestScalerScale(t *testing.T) {
 		_, err := scaler.Scale(ctx, "C", old, 2, 2)
 		assert.Nil(t, err)
 	})
-	t.Run("ScaleInNotSupported", func(t *testing.T) {
+	t.Run("ScaleIn", func(t *testing.T) {
 		scaler := newFakeFactory().Scaler("")
 		old := config.Config{}
-		_, err := scaler.Scale(ctx, "C", old, 2, 1)
-		assert.NotNil(t, err)
-		assert.Contains(t, err.Error(), "not supported")
+		ss, err := scaler.Scale(ctx, "C", old, 2, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"N1"}, ss.Physical["S1"].BelongsToNodes)
+		assert.Equal(t, []string{"N3"}, ss.Physical["S3"].BelongsToNodes)
+	})
+}
+
+func TestScalerStartScale(t *testing.T) {
+	var (
+		dataDir = t.TempDir()
+		ctx     = context.Background()
+		cls     = "C"
+		bak     = backup.ClassDescriptor{
+			Name: "C",
+			Shards: []*backup.ShardDescriptor{
+				{
+					Name: "S1", Files: []string{"f1"},
+					PropLengthTrackerPath: "f1",
+					ShardVersionPath:      "f1",
+					DocIDCounterPath:      "f1",
+				},
+			},
+		}
+	)
+	file, err := os.Create(path.Join(dataDir, "f1"))
+	assert.Nil(t, err)
+	file.Close()
+
+	// newFactory returns a two node cluster with a single shard
+	newFactory := func(nodes ...string) *fakeFactory {
+		f := newFakeFactory()
+		f.NodeHostMap = map[string]string{"N1": "H1", "N2": "H2"}
+		f.ShardingState.M = map[string][]string{"S1": nodes}
+		return f
+	}
+	wait := func(t *testing.T, s *Scaler, id string) *models.ReplicationScaleOperation {
+		var op *models.ReplicationScaleOperation
+		assert.Eventually(t, func() bool {
+			for _, x := range s.Operations(cls) {
+				if x.ID == id && x.CompletedAt != nil {
+					op = x
+					return true
+				}
+			}
+			return false
+		}, 5*time.Second, 10*time.Millisecond)
+		return op
+	}
+
+	t.Run("NoShardingState", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.M = nil
+		_, err := f.Scaler("").StartScale(ctx, cls, 1, 2)
+		assert.ErrorContains(t, err, "no sharding state")
+	})
+
+	t.Run("ScaleOut", func(t *testing.T) {
+		f := newFactory("N1")
+		f.Client.On("JoinReplicas", anyVal, "H2", cls, []string{"S1"}).Return(nil)
+		f.Source.On("ShardsBackup", anyVal, anyVal, cls, []string{"S1"}).Return(bak, nil)
+		f.Client.On("CreateShard", anyVal, "H2", cls, "S1").Return(nil)
+		f.Client.On("PutFile", anyVal, "H2", cls, "S1", "f1", anyVal).Return(nil)
+		f.Client.On("ReInitShard", anyVal, "H2", cls, "S1").Return(nil)
+		f.Source.On("ReleaseBackup", anyVal, anyVal, cls).Return(nil)
+		f.Replicas.On("SyncShardReplicas", anyVal, cls, map[string][]string{"S1": {"N2"}}).Return(nil)
+		scaler := f.Scaler(dataDir)
+
+		op, err := scaler.StartScale(ctx, cls, 1, 2)
+		assert.Nil(t, err)
+		res := wait(t, scaler, op.ID)
+		assert.Equal(t, models.ReplicationScaleOperationStatusSUCCESS, res.Status)
+
+		updates := f.ShardingState.Updates()
+		assert.Len(t, updates, 2)
+		assert.Equal(t, []string{"N2"}, updates[0].Physical["S1"].JoiningNodes)
+		assert.Equal(t, "N1", updates[0].Physical["S1"].JoiningCoordinator)
+		assert.Equal(t, []string{"N1"}, updates[0].Physical["S1"].BelongsToNodes)
+		assert.Empty(t, updates[1].Physical["S1"].JoiningNodes)
+		assert.Empty(t, updates[1].Physical["S1"].JoiningCoordinator)
+		assert.Equal(t, []string{"N1", "N2"}, updates[1].Physical["S1"].BelongsToNodes)
+		assert.Equal(t, int64(2), f.ShardingState.ReadOnlyClass(cls).ReplicationConfig.Factor)
+		f.Replicas.AssertExpectations(t)
+	})
+
+	t.Run("ScaleOutSyncFails", func(t *testing.T) {
+		f := newFactory("N1")
+		f.Client.On("JoinReplicas", anyVal, "H2", cls, []string{"S1"}).Return(nil)
+		f.Source.On("ShardsBackup", anyVal, anyVal, cls, []string{"S1"}).Return(bak, nil)
+		f.Client.On("CreateShard", anyVal, "H2", cls, "S1").Return(nil)
+		f.Client.On("PutFile", anyVal, "H2", cls, "S1", "f1", anyVal).Return(nil)
+		f.Client.On("ReInitShard", anyVal, "H2", cls, "S1").Return(nil)
+		f.Source.On("ReleaseBackup", anyVal, anyVal, cls).Return(nil)
+		f.Replicas.On("SyncShardReplicas", anyVal, cls, map[string][]string{"S1": {"N2"}}).Return(errAny)
+		f.Client.On("DecreaseReplicationFactor", anyVal, "H2", cls, []string{"S1"}).Return(nil)
+		scaler := f.Scaler(dataDir)
+
+		op, err := scaler.StartScale(ctx, cls, 1, 2)
+		assert.Nil(t, err)
+		res := wait(t, scaler, op.ID)
+		assert.Equal(t, models.ReplicationScaleOperationStatusFAILED, res.Status)
+		assert.Contains(t, res.Error, "sync replicas")
+
+		updates := f.ShardingState.Updates()
+		assert.Len(t, updates, 2)
+		assert.Empty(t, updates[1].Physical["S1"].JoiningNodes)
+		assert.Equal(t, []string{"N1"}, updates[1].Physical["S1"].BelongsToNodes)
+		f.Client.AssertExpectations(t)
+	})
+
+	t.Run("ScaleOutRollback", func(t *testing.T) {
+		f := newFactory("N1")
+		f.Client.On("JoinReplicas", anyVal, "H2", cls, []string{"S1"}).Return(nil)
+		f.Source.On("ShardsBackup", anyVal, anyVal, cls, []string{"S1"}).Return(bak, errAny)
+		f.Client.On("DecreaseReplicationFactor", anyVal, "H2", cls, []string{"S1"}).Return(nil)
+		scaler := f.Scaler(dataDir)
+
+		op, err := scaler.StartScale(ctx, cls, 1, 2)
+		assert.Nil(t, err)
+		res := wait(t, scaler, op.ID)
+		assert.Equal(t, models.ReplicationScaleOperationStatusFAILED, res.Status)
+		assert.Contains(t, res.Error, errAny.Error())
+
+		updates := f.ShardingState.Updates()
+		assert.Len(t, updates, 2)
+		assert.Empty(t, updates[1].Physical["S1"].JoiningNodes)
+		assert.Equal(t, []string{"N1"}, updates[1].Physical["S1"].BelongsToNodes)
+		assert.Equal(t, int64(1), f.ShardingState.ReadOnlyClass(cls).ReplicationConfig.Factor)
+		f.Client.AssertExpectations(t)
+	})
+
+	t.Run("ScaleInRemote", func(t *testing.T) {
+		f := newFactory("N1", "N2")
+		f.Client.On("SyncReplicas", anyVal, "H2", cls, ShardDist{"S1": {"N1"}}).Return(nil).Twice()
+		f.Client.On("DecreaseReplicationFactor", anyVal, "H2", cls, []string{"S1"}).Return(nil)
+		scaler := f.Scaler(dataDir)
+
+		op, err := scaler.StartScale(ctx, cls, 2, 1)
+		assert.Nil(t, err)
+		res := wait(t, scaler, op.ID)
+		assert.Equal(t, models.ReplicationScaleOperationStatusSUCCESS, res.Status)
+
+		updates := f.ShardingState.Updates()
+		assert.Len(t, updates, 1)
+		assert.Equal(t, []string{"N1"}, updates[0].Physical["S1"].BelongsToNodes)
+		f.Client.AssertExpectations(t)
+	})
+
+	t.Run("ScaleInLocal", func(t *testing.T) {
+		f := newFactory("N2", "N1")
+		f.Replicas.On("SyncShardReplicas", anyVal, cls, map[string][]string{"S1": {"N2"}}).Return(nil)
+		f.Replicas.On("DropShardReplicas", anyVal, cls, []string{"S1"}).Return(nil)
+		scaler := f.Scaler(dataDir)
+
+		op, err := scaler.StartScale(ctx, cls, 2, 1)
+		assert.Nil(t, err)
+		res := wait(t, scaler, op.ID)
+		assert.Equal(t, models.ReplicationScaleOperationStatusSUCCESS, res.Status)
+		f.Replicas.AssertExpectations(t)
+	})
+
+	t.Run("ScaleInSyncFails", func(t *testing.T) {
+		f := newFactory("N1", "N2")
+		f.Client.On("SyncReplicas", anyVal, "H2", cls, ShardDist{"S1": {"N1"}}).Return(errAny)
+		scaler := f.Scaler(dataDir)
+
+		op, err := scaler.StartScale(ctx, cls, 2, 1)
+		assert.Nil(t, err)
+		res := wait(t, scaler, op.ID)
+		assert.Equal(t, models.ReplicationScaleOperationStatusFAILED, res.Status)
+		assert.Contains(t, res.Error, errAny.Error())
+		assert.Empty(t, f.ShardingState.Updates())
+		f.Client.AssertNotCalled(t, "DecreaseReplicationFactor", anyVal, anyVal, anyVal, anyVal)
+	})
+
+	t.Run("InProgress", func(t *testing.T) {
+		f := newFactory("N1")
+		f.ShardingState.UpdateClass(ctx, &models.Class{Class: cls}, &sharding.State{
+			Physical: map[string]sharding.Physical{
+				"S1": {BelongsToNodes: []string{"N1"}, JoiningNodes: []string{"N2"}, JoiningCoordinator: "N2"},
+			},
+		})
+		_, err := f.Scaler(dataDir).StartScale(ctx, cls, 1, 2)
+		assert.ErrorIs(t, err, ErrScaleInProgress)
+	})
+
+	t.Run("AbandonedJoiningReplicas", func(t *testing.T) {
+		for _, coordinator := range []string{"N1", "N3"} {
+			f := newFactory("N1")
+			f.ShardingState.UpdateClass(ctx, &models.Class{Class: cls}, &sharding.State{
+				Physical: map[string]sharding.Physical{
+					"S1": {BelongsToNodes: []string{"N1"}, JoiningNodes: []string{"N2"}, JoiningCoordinator: coordinator},
+				},
+			})
+			f.Client.On("DecreaseReplicationFactor", anyVal, "H2", cls, []string{"S1"}).Return(nil)
+			f.Client.On("JoinReplicas", anyVal, "H2", cls, []string{"S1"}).Return(nil)
+			f.Source.On("ShardsBackup", anyVal, anyVal, cls, []string{"S1"}).Return(bak, nil)
+			f.Client.On("CreateShard", anyVal, "H2", cls, "S1").Return(nil)
+			f.Client.On("PutFile", anyVal, "H2", cls, "S1", "f1", anyVal).Return(nil)
+			f.Client.On("ReInitShard", anyVal, "H2", cls, "S1").Return(nil)
+			f.Source.On("ReleaseBackup", anyVal, anyVal, cls).Return(nil)
+			f.Replicas.On("SyncShardReplicas", anyVal, cls, map[string][]string{"S1": {"N2"}}).Return(nil)
+			scaler := f.Scaler(dataDir)
+
+			op, err := scaler.StartScale(ctx, cls, 1, 2)
+			assert.Nil(t, err)
+			res := wait(t, scaler, op.ID)
+			assert.Equal(t, models.ReplicationScaleOperationStatusSUCCESS, res.Status)
+
+			updates := f.ShardingState.Updates()
+			assert.Len(t, updates, 4)
+			assert.Empty(t, updates[1].Physical["S1"].JoiningNodes)
+			assert.Empty(t, updates[1].Physical["S1"].JoiningCoordinator)
+			assert.Equal(t, []string{"N1", "N2"}, updates[3].Physical["S1"].BelongsToNodes)
+			f.Client.AssertExpectations(t)
+		}
+	})
+
+	t.Run("LocalScaleInOwnedShard", func(t *testing.T) {
+		f := newFactory("N1")
+		err := f.Scaler(dataDir).LocalScaleIn(ctx, cls, []string{"S1"})
+		assert.ErrorContains(t, err, "still belongs to this node")
 	})
 }
 
@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// GetAliases returns all aliases ordered by name. If class is not empty only
+// the aliases pointing to this class are returned.
+func (h *Handler) GetAliases(ctx context.Context, principal *models.Principal, class string) ([]*models.Alias, error) {
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.Collections(class)...)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := h.schemaReader.ListAliases(class)
+	res := make([]*models.Alias, 0, len(aliases))
+	for alias, target := range aliases {
+		res = append(res, &models.Alias{Alias: alias, Class: target})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Alias < res[j].Alias })
+	return res, nil
+}
+
+// GetAlias returns the alias with the given name
+func (h *Handler) GetAlias(ctx context.Context, principal *models.Principal, name string) (*models.Alias, error) {
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.Collections(name)...)
+	if err != nil {
+		return nil, err
+	}
+
+	class := h.schemaReader.ResolveAlias(name)
+	if class == "" {
+		return nil, fmt.Errorf("alias %q: %w", name, ErrNotFound)
+	}
+	return &models.Alias{Alias: name, Class: class}, nil
+}
+
+// AddAlias creates a new alias pointing to an existing class. Aliases share
+// the namespace of classes, so an alias cannot be named like a class.
+func (h *Handler) AddAlias(ctx context.Context, principal *models.Principal, alias *models.Alias) (*models.Alias, error) {
+	var class string
+	if alias != nil {
+		class = alias.Class
+	}
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.Collections(class)...)
+	if err != nil {
+		return nil, err
+	}
+	if alias == nil {
+		return nil, fmt.Errorf("alias must not be empty")
+	}
+
+	name, err := schema.ValidateClassName(schema.UppercaseClassName(alias.Alias))
+	if err != nil {
+		return nil, fmt.Errorf("invalid alias name: %w", err)
+	}
+	class = schema.UppercaseClassName(class)
+	if h.schemaReader.ReadOnlyClass(class) == nil {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if other := h.schemaReader.ClassEqual(name.String()); other != "" {
+		return nil, fmt.Errorf("alias %q conflicts with class %q", name, other)
+	}
+	if h.schemaReader.ResolveAlias(name.String()) != "" {
+		return nil, fmt.Errorf("alias %q already exists", name)
+	}
+
+	version, err := h.schemaManager.CreateAlias(ctx, name.String(), class)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.schemaReader.WaitForUpdate(ctx, version); err != nil {
+		return nil, err
+	}
+	return &models.Alias{Alias: name.String(), Class: class}, nil
+}
+
+// UpdateAlias points an existing alias to another class. The switch is
+// atomic: every request addressing the alias is either served by the old or
+// by the new class.
+func (h *Handler) UpdateAlias(ctx context.Context, principal *models.Principal,
+	name, class string,
+) (*models.Alias, error) {
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.Collections(class)...)
+	if err != nil {
+		return nil, err
+	}
+
+	current := h.schemaReader.ResolveAlias(name)
+	if current == "" {
+		return nil, fmt.Errorf("alias %q: %w", name, ErrNotFound)
+	}
+	// moving the alias away from a class changes what its users are served
+	err = h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.Collections(current)...)
+	if err != nil {
+		return nil, err
+	}
+	class = schema.UppercaseClassName(class)
+	if h.schemaReader.ReadOnlyClass(class) == nil {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+
+	version, err := h.schemaManager.ReplaceAlias(ctx, name, class)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.schemaReader.WaitForUpdate(ctx, version); err != nil {
+		return nil, err
+	}
+	return &models.Alias{Alias: name, Class: class}, nil
+}
+
+// DeleteAlias removes an alias, the class it points to is left untouched
+func (h *Handler) DeleteAlias(ctx context.Context, principal *models.Principal, name string) error {
+	err := h.Authorizer.Authorize(principal, authorization.DELETE, authorization.Collections(name)...)
+	if err != nil {
+		return err
+	}
+
+	if h.schemaReader.ResolveAlias(name) == "" {
+		return fmt.Errorf("alias %q: %w", name, ErrNotFound)
+	}
+	version, err := h.schemaManager.DeleteAlias(ctx, name)
+	if err != nil {
+		return err
+	}
+	return h.schemaReader.WaitForUpdate(ctx, version)
+}
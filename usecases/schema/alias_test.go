@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+)
+
+func TestAddAlias(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Movies_v1").Return(&models.Class{Class: "Movies_v1"})
+		fakeSchemaManager.On("ResolveAlias", "Movies").Return("")
+		fakeSchemaManager.On("CreateAlias", "Movies", "Movies_v1").Return(nil)
+
+		alias, err := handler.AddAlias(ctx, nil, &models.Alias{Alias: "movies", Class: "movies_v1"})
+		require.Nil(t, err)
+		assert.Equal(t, &models.Alias{Alias: "Movies", Class: "Movies_v1"}, alias)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("class not found", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Movies_v1").Return(nil)
+
+		_, err := handler.AddAlias(ctx, nil, &models.Alias{Alias: "Movies", Class: "Movies_v1"})
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("name of a class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.countClassEqual = true
+		fakeSchemaManager.On("ReadOnlyClass", "Movies_v1").Return(&models.Class{Class: "Movies_v1"})
+		fakeSchemaManager.On("ClassEqual", "Movies").Return("Movies")
+
+		_, err := handler.AddAlias(ctx, nil, &models.Alias{Alias: "Movies", Class: "Movies_v1"})
+		require.ErrorContains(t, err, "conflicts with class")
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+		_, err := handler.AddAlias(ctx, nil, &models.Alias{Alias: "Mov-ies", Class: "Movies_v1"})
+		require.ErrorContains(t, err, "invalid alias name")
+	})
+}
+
+func TestUpdateAlias(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ResolveAlias", "Movies").Return("Movies_v1")
+		fakeSchemaManager.On("ReadOnlyClass", "Movies_v2").Return(&models.Class{Class: "Movies_v2"})
+		fakeSchemaManager.On("ReplaceAlias", "Movies", "Movies_v2").Return(nil)
+
+		alias, err := handler.UpdateAlias(ctx, nil, "Movies", "Movies_v2")
+		require.Nil(t, err)
+		assert.Equal(t, &models.Alias{Alias: "Movies", Class: "Movies_v2"}, alias)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("alias not found", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ResolveAlias", "Movies").Return("")
+
+		_, err := handler.UpdateAlias(ctx, nil, "Movies", "Movies_v2")
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("current class is authorized", func(t *testing.T) {
+		authorizer := mocks.NewMockAuthorizer()
+		handler, fakeSchemaManager := newTestHandlerWithCustomAuthorizer(t, &fakeDB{}, authorizer)
+		fakeSchemaManager.On("ResolveAlias", "Movies").Return("Movies_v1")
+		fakeSchemaManager.On("ReadOnlyClass", "Movies_v2").Return(&models.Class{Class: "Movies_v2"})
+		fakeSchemaManager.On("ReplaceAlias", "Movies", "Movies_v2").Return(nil)
+
+		_, err := handler.UpdateAlias(ctx, nil, "Movies", "Movies_v2")
+		require.Nil(t, err)
+		calls := authorizer.Calls()
+		require.Len(t, calls, 2)
+		assert.Equal(t, authorization.Collections("Movies_v2"), calls[0].Resources)
+		assert.Equal(t, authorization.Collections("Movies_v1"), calls[1].Resources)
+	})
+}
+
+func TestGetAndDeleteAliases(t *testing.T) {
+	ctx := context.Background()
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	fakeSchemaManager.On("ListAliases", "").Return(map[string]string{"B": "C1", "A": "C2"})
+	fakeSchemaManager.On("ResolveAlias", "A").Return("C2")
+	fakeSchemaManager.On("ResolveAlias", "Unknown").Return("")
+	fakeSchemaManager.On("DeleteAlias", "A").Return(nil)
+
+	aliases, err := handler.GetAliases(ctx, nil, "")
+	require.Nil(t, err)
+	assert.Equal(t, []*models.Alias{{Alias: "A", Class: "C2"}, {Alias: "B", Class: "C1"}}, aliases)
+
+	alias, err := handler.GetAlias(ctx, nil, "A")
+	require.Nil(t, err)
+	assert.Equal(t, "C2", alias.Class)
+	_, err = handler.GetAlias(ctx, nil, "Unknown")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.Nil(t, handler.DeleteAlias(ctx, nil, "A"))
+	require.ErrorIs(t, handler.DeleteAlias(ctx, nil, "Unknown"), ErrNotFound)
+}
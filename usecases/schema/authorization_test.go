@@ -74,7 +74,7 @@ func Test_Schema_Authorization(t *testing.T) {
 		},
 		{
 			methodName:        "UpdateClass",
-			additionalArgs:    []interface{}{"class", &models.Class{Class: "class"}},
+			additionalArgs:    []interface{}{"class", &models.Class{Class: "class"}, false},
 			expectedVerb:      authorization.UPDATE,
 			expectedResources: authorization.Collections("class"),
 		},
@@ -96,6 +96,36 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:      authorization.UPDATE,
 			expectedResources: authorization.Collections("somename"),
 		},
+		{
+			methodName:        "GetAliases",
+			additionalArgs:    []interface{}{"classname"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.Collections("classname"),
+		},
+		{
+			methodName:        "GetAlias",
+			additionalArgs:    []interface{}{"aliasname"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.Collections("aliasname"),
+		},
+		{
+			methodName:        "AddAlias",
+			additionalArgs:    []interface{}{&models.Alias{Alias: "aliasname", Class: "classname"}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.Collections("classname"),
+		},
+		{
+			methodName:        "UpdateAlias",
+			additionalArgs:    []interface{}{"aliasname", "classname"},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.Collections("classname"),
+		},
+		{
+			methodName:        "DeleteAlias",
+			additionalArgs:    []interface{}{"aliasname"},
+			expectedVerb:      authorization.DELETE,
+			expectedResources: authorization.Collections("aliasname"),
+		},
 		{
 			methodName:        "UpdateShardStatus",
 			additionalArgs:    []interface{}{"className", "shardName", "targetStatus"},
@@ -146,6 +176,30 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:      authorization.READ,
 			expectedResources: authorization.Shards("className"),
 		},
+		{
+			methodName:        "TenantUsage",
+			additionalArgs:    []interface{}{"className", "P1"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.Shards("className", "P1"),
+		},
+		{
+			methodName:        "GetSchemaHistory",
+			additionalArgs:    []interface{}{"ClassName"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.Collections("ClassName"),
+		},
+		{
+			methodName:        "GetSchemaAtVersion",
+			additionalArgs:    []interface{}{uint64(1)},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.Collections(),
+		},
+		{
+			methodName:        "DiffSchema",
+			additionalArgs:    []interface{}{(*uint64)(nil), (*uint64)(nil)},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.Collections(),
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -164,7 +218,8 @@ func Test_Schema_Authorization(t *testing.T) {
 				// internal methods to indicate readiness state
 				"StartServing", "Shutdown", "Statistics",
 				// Cluster/nodes related endpoint
-				"JoinNode", "RemoveNode", "Nodes", "NodeName", "ClusterHealthScore", "ClusterStatus", "ResolveParentNodes",
+				"JoinNode", "RemoveNode", "Nodes", "NodeName", "ClusterHealthScore", "ClusterStatus", "ResolveParentNodes", "ResolveJoiningNodes",
+				"ShardConsistency",
 				// revert to schema v0 (non raft)
 				"StoreSchemaV1":
 				// don't require auth on methods which are exported because other
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"slices"
 	"strings"
 
 	entcfg "github.com/weaviate/weaviate/entities/config"
@@ -30,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/entities/classcache"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/entities/versioned"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
@@ -106,6 +108,9 @@ func (h *Handler) AddClass(ctx context.Context, principal *models.Principal,
 
 	cls.Class = schema.UppercaseClassName(cls.Class)
 	cls.Properties = schema.LowercaseAllPropertyNames(cls.Properties)
+	for i, name := range cls.UniqueKey {
+		cls.UniqueKey[i] = schema.LowercaseFirstLetter(name)
+	}
 	if cls.ShardingConfig != nil && schema.MultiTenancyEnabled(cls) {
 		return nil, 0, fmt.Errorf("cannot have both shardingConfig and multiTenancyConfig")
 	} else if cls.MultiTenancyConfig == nil {
@@ -132,6 +137,10 @@ func (h *Handler) AddClass(ctx context.Context, principal *models.Principal,
 		return nil, 0, err
 	}
 
+	if cls.ShardingConfig.(shardingcfg.Config).RoutesByUniqueKey() && !schema.HasUniqueKey(cls) {
+		return nil, 0, fmt.Errorf("sharding by %q requires a uniqueKey", shardingcfg.UniqueKey)
+	}
+
 	shardState, err := sharding.InitState(cls.Class,
 		cls.ShardingConfig.(shardingcfg.Config),
 		h.clusterState.LocalName(), h.schemaManager.StorageCandidates(), cls.ReplicationConfig.Factor,
@@ -139,7 +148,7 @@ func (h *Handler) AddClass(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return nil, 0, fmt.Errorf("init sharding state: %w", err)
 	}
-	version, err := h.schemaManager.AddClass(ctx, cls, shardState)
+	version, err := h.schemaManager.AddClass(withUser(ctx, principal), cls, shardState)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -202,12 +211,12 @@ func (h *Handler) DeleteClass(ctx context.Context, principal *models.Principal,
 		return err
 	}
 
-	_, err = h.schemaManager.DeleteClass(ctx, class)
+	_, err = h.schemaManager.DeleteClass(withUser(ctx, principal), class)
 	return err
 }
 
 func (h *Handler) UpdateClass(ctx context.Context, principal *models.Principal,
-	className string, updated *models.Class,
+	className string, updated *models.Class, rebuildVectorIndex bool,
 ) error {
 	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.Collections(className)...)
 	if err != nil || updated == nil {
@@ -224,6 +233,10 @@ func (h *Handler) UpdateClass(ctx context.Context, principal *models.Principal,
 		return err
 	}
 
+	if err := replica.ValidateConsistency(updated.ReplicationConfig); err != nil {
+		return err
+	}
+
 	// ideally, these calls would be encapsulated in ParseClass but ParseClass is
 	// used in many different areas of the codebase that may cause BC issues with the
 	// new validation logic. Issue ref: gh-5860
@@ -241,7 +254,7 @@ func (h *Handler) UpdateClass(ctx context.Context, principal *models.Principal,
 	}
 
 	initial := h.schemaReader.ReadOnlyClass(className)
-	var shardingState *sharding.State
+	var initialRF, updatedRF int64
 
 	// first layer of defense is basic validation if class already exists
 	if initial != nil {
@@ -250,29 +263,54 @@ func (h *Handler) UpdateClass(ctx context.Context, principal *models.Principal,
 			return err
 		}
 
-		initialRF := initial.ReplicationConfig.Factor
-		updatedRF := updated.ReplicationConfig.Factor
-
-		if initialRF != updatedRF {
-			ss, _, err := h.schemaManager.QueryShardingState(className)
-			if err != nil {
-				return fmt.Errorf("query sharding state for %q: %w", className, err)
-			}
-			shardingState, err = h.scaleOut.Scale(ctx, className, ss.Config, initialRF, updatedRF)
-			if err != nil {
-				return fmt.Errorf(
-					"scale %q from %d replicas to %d: %w",
-					className, initialRF, updatedRF, err)
-			}
+		// the unique key can't be changed, an unset key keeps the current one
+		if updated.UniqueKey == nil {
+			updated.UniqueKey = initial.UniqueKey
+		}
+		if err := validateUniqueKey(updated); err != nil {
+			return err
 		}
 
+		initialRF = initial.ReplicationConfig.Factor
+		updatedRF = updated.ReplicationConfig.Factor
+
 		if err := validateImmutableFields(initial, updated); err != nil {
 			return err
 		}
+
+		if err := h.validatePropertyIndexUpdates(initial, updated); err != nil {
+			return err
+		}
+
+		if !rebuildVectorIndex {
+			if err := h.validateVectorIndexRebuilds(initial, updated); err != nil {
+				return err
+			}
+		}
 	}
 
-	_, err = h.schemaManager.UpdateClass(ctx, updated, shardingState)
-	return err
+	// A change of the replication factor runs in the background and is
+	// committed once the replicas have been added or removed. All other
+	// changes are committed right away.
+	if initialRF != updatedRF {
+		rc := *updated.ReplicationConfig
+		rc.Factor = initialRF
+		updated.ReplicationConfig = &rc
+	}
+	version, err := h.schemaManager.UpdateClass(withUser(ctx, principal), updated, nil)
+	if err != nil || initialRF == updatedRF {
+		return err
+	}
+
+	if err := h.schemaReader.WaitForUpdate(ctx, version); err != nil {
+		return err
+	}
+	if _, err := h.scaleOut.StartScale(ctx, className, initialRF, updatedRF); err != nil {
+		return fmt.Errorf(
+			"scale %q from %d replicas to %d: %w",
+			className, initialRF, updatedRF, err)
+	}
+	return nil
 }
 
 func (m *Handler) setNewClassDefaults(class *models.Class, globalCfg replication.GlobalConfig) error {
@@ -615,6 +653,10 @@ func (h *Handler) validateCanAddClass(
 		existingPropertyNames[strings.ToLower(property.Name)] = true
 	}
 
+	if err := validateUniqueKey(class); err != nil {
+		return err
+	}
+
 	if err := h.validateVectorSettings(class); err != nil {
 		return err
 	}
@@ -795,6 +837,46 @@ func validateMT(class *models.Class) error {
 		return fmt.Errorf("can't enable autoTenantActivation on a non-multi-tenant class")
 	}
 
+	if class.MultiTenancyConfig != nil && class.MultiTenancyConfig.TenantQuotas != nil {
+		if !enabled {
+			return fmt.Errorf("can't set tenantQuotas on a non-multi-tenant class")
+		}
+		if err := validateTenantQuotas(class.MultiTenancyConfig.TenantQuotas); err != nil {
+			return fmt.Errorf("tenantQuotas: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateUniqueKey validates that the unique key of a class consists of
+// distinct, filterable primitive properties of the class. Text properties
+// must be tokenized as a whole, so that filters match the exact value.
+func validateUniqueKey(class *models.Class) error {
+	seen := make(map[string]struct{}, len(class.UniqueKey))
+	for _, name := range class.UniqueKey {
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("uniqueKey: duplicate property %q", name)
+		}
+		seen[name] = struct{}{}
+
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil || prop.Name != name {
+			return fmt.Errorf("uniqueKey: property %q does not exist", name)
+		}
+		if len(prop.DataType) != 1 || !schema.IsUniqueKeyDataType(schema.DataType(prop.DataType[0])) {
+			return fmt.Errorf("uniqueKey: property %q of data type %v can't be part of a unique key, "+
+				"supported are text, int, number, boolean, date and uuid", name, prop.DataType)
+		}
+		if prop.IndexFilterable != nil && !*prop.IndexFilterable {
+			return fmt.Errorf("uniqueKey: property %q must have a filterable index", name)
+		}
+		if prop.DataType[0] == schema.DataTypeText.String() &&
+			prop.Tokenization != models.PropertyTokenizationField {
+			return fmt.Errorf("uniqueKey: text property %q must use tokenization %q",
+				name, models.PropertyTokenizationField)
+		}
+	}
 	return nil
 }
 
@@ -826,6 +908,11 @@ func validateImmutableFields(initial, updated *models.Class) error {
 		return err
 	}
 
+	if !slices.Equal(initial.UniqueKey, updated.UniqueKey) {
+		return fmt.Errorf("uniqueKey is immutable: attempted change from %v to %v",
+			initial.UniqueKey, updated.UniqueKey)
+	}
+
 	for k, v := range updated.VectorConfig {
 		if _, ok := initial.VectorConfig[k]; !ok {
 			return fmt.Errorf("vector config is immutable")
@@ -838,6 +925,80 @@ func validateImmutableFields(initial, updated *models.Class) error {
 	return nil
 }
 
+// validateVectorIndexRebuilds rejects changes of vector index configs which
+// can only be applied by rebuilding the vector index. Rebuilding takes long
+// and uses additional resources, so it has to be requested explicitly.
+func (h *Handler) validateVectorIndexRebuilds(initial, updated *models.Class) error {
+	requiresRebuild := func(current, next interface{}) bool {
+		currentCfg, ok1 := current.(schemaConfig.VectorIndexConfig)
+		nextCfg, ok2 := next.(schemaConfig.VectorIndexConfig)
+		return ok1 && ok2 && h.validator.VectorIndexRequiresRebuild(currentCfg, nextCfg)
+	}
+
+	if requiresRebuild(initial.VectorIndexConfig, updated.VectorIndexConfig) {
+		return fmt.Errorf("the vector index config change requires rebuilding the vector index, " +
+			"set rebuildVectorIndex to apply it")
+	}
+	for name, cfg := range initial.VectorConfig {
+		if requiresRebuild(cfg.VectorIndexConfig, updated.VectorConfig[name].VectorIndexConfig) {
+			return fmt.Errorf("the vector index config change of vector %q requires rebuilding "+
+				"the vector index, set rebuildVectorIndex to apply it", name)
+		}
+	}
+	return nil
+}
+
+// validatePropertyIndexUpdates validates the tokenization and inverted index
+// flags of properties changed by a class update. Settings left unset keep
+// their current value. Changed settings are applied by reindexing the
+// property in the background.
+func (h *Handler) validatePropertyIndexUpdates(initial, updated *models.Class) error {
+	if len(initial.Properties) != len(updated.Properties) {
+		// rejected when parsing the update
+		return nil
+	}
+
+	for i, prop := range updated.Properties {
+		current := initial.Properties[i]
+		if prop == nil || current == nil || prop.Name != current.Name {
+			continue
+		}
+
+		if prop.Tokenization == "" {
+			prop.Tokenization = current.Tokenization
+		}
+		if prop.IndexFilterable == nil {
+			prop.IndexFilterable = current.IndexFilterable
+		}
+		if prop.IndexSearchable == nil {
+			prop.IndexSearchable = current.IndexSearchable
+		}
+		if prop.IndexRangeFilters == nil {
+			prop.IndexRangeFilters = current.IndexRangeFilters
+		}
+
+		if prop.Tokenization == current.Tokenization &&
+			reflect.DeepEqual(prop.IndexFilterable, current.IndexFilterable) &&
+			reflect.DeepEqual(prop.IndexSearchable, current.IndexSearchable) &&
+			reflect.DeepEqual(prop.IndexRangeFilters, current.IndexRangeFilters) {
+			continue
+		}
+
+		propertyDataType, err := schema.FindPropertyDataTypeWithRefs(h.schemaReader.ReadOnlyClass,
+			prop.DataType, false, schema.ClassName(updated.Class))
+		if err != nil {
+			return fmt.Errorf("property '%s': invalid dataType: %v", prop.Name, err)
+		}
+		if err := h.validatePropertyTokenization(prop.Tokenization, propertyDataType); err != nil {
+			return fmt.Errorf("property '%s': %w", prop.Name, err)
+		}
+		if err := h.validatePropertyIndexing(prop); err != nil {
+			return fmt.Errorf("property '%s': %w", prop.Name, err)
+		}
+	}
+	return nil
+}
+
 type immutableText struct {
 	accessor func(c *models.Class) string
 	name     string
@@ -857,15 +1018,14 @@ func validateImmutableTextFields(previous, next *models.Class,
 	return nil
 }
 
+// validateVectorIndexConfigImmutableFields validates fields of the legacy
+// vector that cannot be changed. The vector index type is not among them, as
+// changing it rebuilds the vector index from the stored vectors.
 func validateVectorIndexConfigImmutableFields(initial, updated *models.Class) error {
 	return validateImmutableTextFields(initial, updated, []immutableText{
 		{
 			name:     "vectorizer",
 			accessor: func(c *models.Class) string { return c.Vectorizer },
 		},
-		{
-			name:     "vector index type",
-			accessor: func(c *models.Class) string { return c.VectorIndexType },
-		},
 	}...)
 }
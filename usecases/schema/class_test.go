@@ -68,6 +68,89 @@ func Test_AddClass(t *testing.T) {
 		fakeSchemaManager.AssertExpectations(t)
 	})
 
+	t.Run("with unique key", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+
+		class := models.Class{
+			Class: "NewClass",
+			Properties: []*models.Property{
+				{DataType: []string{"text"}, Name: "sku", Tokenization: models.PropertyTokenizationField},
+				{DataType: []string{"int"}, Name: "region"},
+			},
+			UniqueKey:      []string{"Sku", "region"},
+			ShardingConfig: map[string]interface{}{"key": "_uniqueKey"},
+			Vectorizer:     "none",
+		}
+		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+
+		_, _, err := handler.AddClass(ctx, nil, &class)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"sku", "region"}, class.UniqueKey)
+
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("with invalid unique key", func(t *testing.T) {
+		vFalse := false
+		tests := []struct {
+			name           string
+			uniqueKey      []string
+			shardingConfig interface{}
+			expectedErr    string
+		}{
+			{
+				name:        "unknown property",
+				uniqueKey:   []string{"missing"},
+				expectedErr: `uniqueKey: property "missing" does not exist`,
+			},
+			{
+				name:        "duplicate property",
+				uniqueKey:   []string{"sku", "sku"},
+				expectedErr: `uniqueKey: duplicate property "sku"`,
+			},
+			{
+				name:        "unsupported data type",
+				uniqueKey:   []string{"tags"},
+				expectedErr: `uniqueKey: property "tags" of data type [text[]] can't be part of a unique key`,
+			},
+			{
+				name:        "not filterable",
+				uniqueKey:   []string{"hidden"},
+				expectedErr: `uniqueKey: property "hidden" must have a filterable index`,
+			},
+			{
+				name:        "text not tokenized as a whole",
+				uniqueKey:   []string{"title"},
+				expectedErr: `uniqueKey: text property "title" must use tokenization "field"`,
+			},
+			{
+				name:           "sharding by missing unique key",
+				shardingConfig: map[string]interface{}{"key": "_uniqueKey"},
+				expectedErr:    `sharding by "_uniqueKey" requires a uniqueKey`,
+			},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				handler, _ := newTestHandler(t, &fakeDB{})
+				class := models.Class{
+					Class: "NewClass",
+					Properties: []*models.Property{
+						{DataType: []string{"text"}, Name: "sku", Tokenization: models.PropertyTokenizationField},
+						{DataType: []string{"text[]"}, Name: "tags"},
+						{DataType: []string{"text"}, Name: "hidden", IndexFilterable: &vFalse},
+						{DataType: []string{"text"}, Name: "title"},
+					},
+					UniqueKey:      test.uniqueKey,
+					ShardingConfig: test.shardingConfig,
+					Vectorizer:     "none",
+				}
+				_, _, err := handler.AddClass(ctx, nil, &class)
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+			})
+		}
+	})
+
 	t.Run("with empty class name", func(t *testing.T) {
 		handler, _ := newTestHandler(t, &fakeDB{})
 		class := models.Class{}
@@ -1034,7 +1117,7 @@ func Test_UpdateClass(t *testing.T) {
 		fakeSchemaManager.On("ReadOnlyClass", "WrongClass", mock.Anything).Return(nil)
 		fakeSchemaManager.On("UpdateClass", mock.Anything, mock.Anything).Return(ErrNotFound)
 
-		err := handler.UpdateClass(context.Background(), nil, "WrongClass", &models.Class{})
+		err := handler.UpdateClass(context.Background(), nil, "WrongClass", &models.Class{}, false)
 		require.NotNil(t, err)
 		assert.Equal(t, ErrNotFound, err)
 		fakeSchemaManager.AssertExpectations(t)
@@ -1042,10 +1125,11 @@ func Test_UpdateClass(t *testing.T) {
 
 	t.Run("Fields validation", func(t *testing.T) {
 		tests := []struct {
-			name          string
-			initial       *models.Class
-			update        *models.Class
-			expectedError error
+			name               string
+			initial            *models.Class
+			update             *models.Class
+			rebuildVectorIndex bool
+			expectedError      error
 		}{
 			{
 				name:    "ChangeName",
@@ -1067,9 +1151,15 @@ func Test_UpdateClass(t *testing.T) {
 				name:    "ModifyVectorIndexType",
 				initial: &models.Class{Class: "InitialName", VectorIndexType: "hnsw", Vectorizer: "none"},
 				update:  &models.Class{Class: "InitialName", VectorIndexType: "flat", Vectorizer: "none"},
-				expectedError: fmt.Errorf(
-					"vector index type is immutable: " +
-						"attempted change from \"hnsw\" to \"flat\""),
+				expectedError: fmt.Errorf("the vector index config change requires rebuilding " +
+					"the vector index, set rebuildVectorIndex to apply it"),
+			},
+			{
+				name:               "ModifyVectorIndexTypeWithRebuild",
+				initial:            &models.Class{Class: "InitialName", VectorIndexType: "hnsw", Vectorizer: "none"},
+				update:             &models.Class{Class: "InitialName", VectorIndexType: "flat", Vectorizer: "none"},
+				rebuildVectorIndex: true,
+				expectedError:      nil,
 			},
 			{
 				name:          "UnsupportedVectorIndex",
@@ -1118,6 +1208,71 @@ func Test_UpdateClass(t *testing.T) {
 				},
 				expectedError: nil,
 			},
+			{
+				name: "leaving the unique key unset",
+				initial: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "none",
+					Properties: []*models.Property{
+						{
+							Name:         "aProp",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationField,
+						},
+					},
+					UniqueKey: []string{"aProp"},
+				},
+				update: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "none",
+					Properties: []*models.Property{
+						{
+							Name:         "aProp",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationField,
+						},
+					},
+				},
+				expectedError: nil,
+			},
+			{
+				name: "attempting to change the unique key",
+				initial: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "none",
+					Properties: []*models.Property{
+						{
+							Name:         "aProp",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationField,
+						},
+						{
+							Name:         "bProp",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationField,
+						},
+					},
+					UniqueKey: []string{"aProp"},
+				},
+				update: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "none",
+					Properties: []*models.Property{
+						{
+							Name:         "aProp",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationField,
+						},
+						{
+							Name:         "bProp",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationField,
+						},
+					},
+					UniqueKey: []string{"aProp", "bProp"},
+				},
+				expectedError: fmt.Errorf("uniqueKey is immutable"),
+			},
 			{
 				name: "attempting to rename a property",
 				initial: &models.Class{
@@ -1145,6 +1300,58 @@ func Test_UpdateClass(t *testing.T) {
 						"property feature (e.g. \"POST /v1/schema/{className}/properties\") " +
 						"to add additional properties"),
 			},
+			{
+				name: "changing the tokenization of a property",
+				initial: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "none",
+					Properties: []*models.Property{
+						{
+							Name:         "aProp",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationWord,
+						},
+					},
+				},
+				update: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "none",
+					Properties: []*models.Property{
+						{
+							Name:         "aProp",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationField,
+						},
+					},
+				},
+				expectedError: nil,
+			},
+			{
+				name: "changing the tokenization of a property to an invalid one",
+				initial: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "none",
+					Properties: []*models.Property{
+						{
+							Name:         "aProp",
+							DataType:     schema.DataTypeInt.PropString(),
+							Tokenization: "",
+						},
+					},
+				},
+				update: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "none",
+					Properties: []*models.Property{
+						{
+							Name:         "aProp",
+							DataType:     schema.DataTypeInt.PropString(),
+							Tokenization: models.PropertyTokenizationWord,
+						},
+					},
+				},
+				expectedError: fmt.Errorf("property 'aProp': "),
+			},
 			{
 				name: "attempting to update the inverted index cleanup interval",
 				initial: &models.Class{
@@ -1349,7 +1556,7 @@ func Test_UpdateClass(t *testing.T) {
 				store.AddClass(test.initial)
 
 				fakeSchemaManager.On("UpdateClass", mock.Anything, mock.Anything).Return(nil)
-				err = handler.UpdateClass(ctx, nil, test.initial.Class, test.update)
+				err = handler.UpdateClass(ctx, nil, test.initial.Class, test.update, test.rebuildVectorIndex)
 				if err == nil {
 					err = store.UpdateClass(test.update)
 				}
@@ -105,6 +105,10 @@ func (e *executor) UpdateClass(req api.UpdateClassRequest) error {
 		return fmt.Errorf("inverted index config: %w", err)
 	}
 
+	if err := e.migrator.UpdatePropertyIndexes(ctx, className, req.Class.Properties); err != nil {
+		return fmt.Errorf("property indexes: %w", err)
+	}
+
 	if err := e.migrator.UpdateReplicationConfig(ctx, className, req.Class.ReplicationConfig); err != nil {
 		return fmt.Errorf("update replication config: %w", err)
 	}
@@ -150,6 +154,20 @@ func (e *executor) AddProperty(className string, req api.AddPropertyRequest) err
 	return nil
 }
 
+func (e *executor) DeleteProperty(className string, req api.DeletePropertyRequest) error {
+	ctx := context.Background()
+	if err := e.migrator.DropProperty(ctx, className, req.Name); err != nil {
+		return err
+	}
+
+	e.logger.WithFields(logrus.Fields{
+		"action":   "delete_property",
+		"class":    className,
+		"property": req.Name,
+	}).Debug("deleting property")
+	return nil
+}
+
 func (e *executor) AddTenants(class string, req *api.AddTenantsRequest) error {
 	if len(req.Tenants) == 0 {
 		return nil
@@ -271,9 +289,11 @@ func (e *executor) TriggerSchemaUpdateCallbacks() {
 	defer e.callbacksLock.RUnlock()
 
 	s := e.schemaReader.ReadOnlySchema()
+	aliases := e.schemaReader.ListAliases("")
 	for _, cb := range e.callbacks {
 		cb(schema.Schema{
 			Objects: &s,
+			Aliases: aliases,
 		})
 	}
 }
@@ -55,6 +55,26 @@ func (f *fakeSchemaManager) AddProperty(_ context.Context, class string, p ...*m
 	return 0, args.Error(0)
 }
 
+func (f *fakeSchemaManager) DeleteProperty(_ context.Context, class, name string) (uint64, error) {
+	args := f.Called(class, name)
+	return 0, args.Error(0)
+}
+
+func (f *fakeSchemaManager) CreateAlias(_ context.Context, alias, class string) (uint64, error) {
+	args := f.Called(alias, class)
+	return 0, args.Error(0)
+}
+
+func (f *fakeSchemaManager) ReplaceAlias(_ context.Context, alias, class string) (uint64, error) {
+	args := f.Called(alias, class)
+	return 0, args.Error(0)
+}
+
+func (f *fakeSchemaManager) DeleteAlias(_ context.Context, alias string) (uint64, error) {
+	args := f.Called(alias)
+	return 0, args.Error(0)
+}
+
 func (f *fakeSchemaManager) UpdateShardStatus(c_ context.Context, class, shard, status string) (uint64, error) {
 	args := f.Called(class, shard, status)
 	return 0, args.Error(0)
@@ -101,6 +121,26 @@ func (f *fakeSchemaManager) ClassEqual(name string) string {
 	return ""
 }
 
+func (f *fakeSchemaManager) ResolveAlias(alias string) string {
+	args := f.Called(alias)
+	return args.String(0)
+}
+
+func (f *fakeSchemaManager) ListAliases(class string) map[string]string {
+	args := f.Called(class)
+	return args.Get(0).(map[string]string)
+}
+
+func (f *fakeSchemaManager) History(class string) ([]clusterSchema.HistoryEntry, uint64) {
+	args := f.Called(class)
+	return args.Get(0).([]clusterSchema.HistoryEntry), args.Get(1).(uint64)
+}
+
+func (f *fakeSchemaManager) SchemaAt(version uint64) (models.Schema, error) {
+	args := f.Called(version)
+	return args.Get(0).(models.Schema), args.Error(1)
+}
+
 func (f *fakeSchemaManager) MultiTenancy(class string) models.MultiTenancyConfig {
 	args := f.Called(class)
 	return args.Get(0).(models.MultiTenancyConfig)
@@ -44,10 +44,14 @@ type SchemaManager interface {
 	UpdateClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error)
 	DeleteClass(ctx context.Context, name string) (uint64, error)
 	AddProperty(ctx context.Context, class string, p ...*models.Property) (uint64, error)
+	DeleteProperty(ctx context.Context, class, name string) (uint64, error)
 	UpdateShardStatus(ctx context.Context, class, shard, status string) (uint64, error)
 	AddTenants(ctx context.Context, class string, req *command.AddTenantsRequest) (uint64, error)
 	UpdateTenants(ctx context.Context, class string, req *command.UpdateTenantsRequest) (uint64, error)
 	DeleteTenants(ctx context.Context, class string, req *command.DeleteTenantsRequest) (uint64, error)
+	CreateAlias(ctx context.Context, alias, class string) (uint64, error)
+	ReplaceAlias(ctx context.Context, alias, class string) (uint64, error)
+	DeleteAlias(ctx context.Context, alias string) (uint64, error)
 
 	// Cluster related operations
 	Join(_ context.Context, nodeID, raftAddr string, voter bool) error
@@ -75,6 +79,8 @@ type SchemaReader interface {
 	// consistent.
 	// For details about each endpoint see [github.com/weaviate/weaviate/cluster/schema.SchemaReader].
 	ClassEqual(name string) string
+	ResolveAlias(alias string) string
+	ListAliases(class string) map[string]string
 	MultiTenancy(class string) models.MultiTenancyConfig
 	ClassInfo(class string) (ci clusterSchema.ClassInfo)
 	ReadOnlyClass(name string) *models.Class
@@ -85,6 +91,8 @@ type SchemaReader interface {
 	ShardOwner(class, shard string) (string, error)
 	Read(class string, reader func(*models.Class, *sharding.State) error) error
 	GetShardsStatus(class, tenant string) (models.ShardStatusList, error)
+	History(class string) ([]clusterSchema.HistoryEntry, uint64)
+	SchemaAt(version uint64) (models.Schema, error)
 
 	// These schema reads function (...WithVersion) return the metadata once the local schema has caught up to the
 	// version parameter. If version is 0 is behaves exactly the same as eventual consistent reads.
@@ -103,6 +111,7 @@ type validator interface {
 	ValidateVectorIndexConfigUpdate(old, updated schemaConfig.VectorIndexConfig) error
 	ValidateInvertedIndexConfigUpdate(old, updated *models.InvertedIndexConfig) error
 	ValidateVectorIndexConfigsUpdate(old, updated map[string]schemaConfig.VectorIndexConfig) error
+	VectorIndexRequiresRebuild(old, updated schemaConfig.VectorIndexConfig) bool
 }
 
 // The handler manages API requests for manipulating class schemas.
@@ -128,6 +137,7 @@ type Handler struct {
 	invertedConfigValidator InvertedConfigValidator
 	scaleOut                scaleOut
 	parser                  Parser
+	tenantUsage             tenantUsageProvider
 }
 
 // NewHandler creates a new handler
@@ -141,6 +151,7 @@ func NewHandler(
 	moduleConfig ModuleConfig, clusterState clusterState,
 	scaleoutManager scaleOut,
 	cloud modulecapabilities.OffloadCloud,
+	tenantUsage tenantUsageProvider,
 ) (Handler, error) {
 	handler := Handler{
 		config:                  config,
@@ -157,9 +168,11 @@ func NewHandler(
 		clusterState:            clusterState,
 		scaleOut:                scaleoutManager,
 		cloud:                   cloud,
+		tenantUsage:             tenantUsage,
 	}
 
 	handler.scaleOut.SetSchemaReader(schemaReader)
+	handler.scaleOut.SetSchemaManager(schemaManager)
 
 	return handler, nil
 }
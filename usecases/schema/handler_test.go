@@ -341,29 +341,26 @@ func testAddInvalidPropertyWithEmptyDataTypeDuringCreation(t *testing.T, handler
 }
 
 func testDropProperty(t *testing.T, handler *Handler, fakeSchemaManager *fakeSchemaManager) {
-	// TODO: https://github.com/weaviate/weaviate/issues/973
-	// Remove skip
-
-	t.Skip()
-
 	t.Parallel()
 
-	fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{})
-
-	var properties []*models.Property = []*models.Property{
-		{Name: "color", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
-	}
 	class := &models.Class{
-		Class:      "Car",
-		Properties: properties,
+		Class: "Car",
+		Properties: []*models.Property{
+			{Name: "color", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+		},
 	}
-	fakeSchemaManager.On("AddClass", class, mock.Anything).Return(nil)
-	_, _, err := handler.AddClass(context.Background(), nil, class)
+	fakeSchemaManager.On("ReadOnlyClass", "Car").Return(class)
+	fakeSchemaManager.On("ReadOnlyClass", "Bike").Return(nil)
+	fakeSchemaManager.On("DeleteProperty", "Car", "color").Return(nil)
+
+	err := handler.DeleteClassProperty(context.Background(), nil, "Car", "Color")
 	assert.Nil(t, err)
 
-	// Now drop the property
-	handler.DeleteClassProperty(context.Background(), nil, "Car", "color")
-	// TODO: add the mock necessary to verify that the property is deleted
+	err = handler.DeleteClassProperty(context.Background(), nil, "Car", "unknown")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	err = handler.DeleteClassProperty(context.Background(), nil, "Bike", "color")
+	assert.ErrorIs(t, err, ErrNotFound)
 }
 
 // This grant parent test setups up the temporary directory needed for the tests.
@@ -31,7 +31,6 @@ import (
 	"github.com/weaviate/weaviate/usecases/fakes"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/sharding"
-	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
 func newTestHandler(t *testing.T, db clusterSchema.Indexer) (*Handler, *fakeSchemaManager) {
@@ -47,7 +46,7 @@ func newTestHandler(t *testing.T, db clusterSchema.Indexer) (*Handler, *fakeSche
 	handler, err := NewHandler(
 		schemaManager, schemaManager, &fakeValidator{}, logger, mocks.NewMockAuthorizer(),
 		cfg, dummyParseVectorConfig, vectorizerValidator, dummyValidateInvertedConfig,
-		&fakeModuleConfig{}, fakes.NewFakeClusterState(), &fakeScaleOutManager{}, nil)
+		&fakeModuleConfig{}, fakes.NewFakeClusterState(), &fakeScaleOutManager{}, nil, nil)
 	require.Nil(t, err)
 	return &handler, schemaManager
 }
@@ -64,7 +63,7 @@ func newTestHandlerWithCustomAuthorizer(t *testing.T, db clusterSchema.Indexer,
 	handler, err := NewHandler(
 		metaHandler, metaHandler, &fakeValidator{}, logger, authorizer,
 		cfg, dummyParseVectorConfig, vectorizerValidator, dummyValidateInvertedConfig,
-		&fakeModuleConfig{}, fakes.NewFakeClusterState(), &fakeScaleOutManager{}, nil)
+		&fakeModuleConfig{}, fakes.NewFakeClusterState(), &fakeScaleOutManager{}, nil, nil)
 	require.Nil(t, err)
 	return &handler, metaHandler
 }
@@ -109,6 +108,10 @@ func (f *fakeDB) AddProperty(prop string, cmd command.AddPropertyRequest) error
 	return nil
 }
 
+func (f *fakeDB) DeleteProperty(class string, cmd command.DeletePropertyRequest) error {
+	return nil
+}
+
 func (f *fakeDB) AddTenants(class string, cmd *command.AddTenantsRequest) error {
 	return nil
 }
@@ -140,15 +143,18 @@ func (f *fakeDB) TriggerSchemaUpdateCallbacks() {
 
 type fakeScaleOutManager struct{}
 
-func (f *fakeScaleOutManager) Scale(ctx context.Context,
-	className string, updated shardingConfig.Config, _, _ int64,
-) (*sharding.State, error) {
-	return nil, nil
+func (f *fakeScaleOutManager) StartScale(ctx context.Context,
+	className string, _, _ int64,
+) (scaler.Operation, error) {
+	return scaler.Operation{}, nil
 }
 
 func (f *fakeScaleOutManager) SetSchemaReader(sr scaler.SchemaReader) {
 }
 
+func (f *fakeScaleOutManager) SetSchemaManager(sm scaler.SchemaManager) {
+}
+
 type fakeValidator struct{}
 
 func (f fakeValidator) ValidateVectorIndexConfigUpdate(
@@ -168,6 +174,10 @@ func (fakeValidator) ValidateVectorIndexConfigsUpdate(old, updated map[string]sc
 	return nil
 }
 
+func (fakeValidator) VectorIndexRequiresRebuild(old, updated schemaConfig.VectorIndexConfig) bool {
+	return old.IndexType() != updated.IndexType() || old.DistanceName() != updated.DistanceName()
+}
+
 type fakeModuleConfig struct{}
 
 func (f *fakeModuleConfig) SetClassDefaults(class *models.Class) {
@@ -230,10 +240,14 @@ func (f *fakeVectorizerValidator) ValidateVectorizer(moduleName string) error {
 }
 
 type fakeVectorConfig struct {
-	raw interface{}
+	raw       interface{}
+	indexType string
 }
 
 func (f fakeVectorConfig) IndexType() string {
+	if f.indexType != "" {
+		return f.indexType
+	}
 	return "fake"
 }
 
@@ -242,7 +256,7 @@ func (f fakeVectorConfig) DistanceName() string {
 }
 
 func dummyParseVectorConfig(in interface{}, vectorIndexType string) (schemaConfig.VectorIndexConfig, error) {
-	return fakeVectorConfig{raw: in}, nil
+	return fakeVectorConfig{raw: in, indexType: vectorIndexType}, nil
 }
 
 func dummyValidateInvertedConfig(in *models.InvertedIndexConfig) error {
@@ -272,6 +286,11 @@ func (f *fakeMigrator) AddProperty(ctx context.Context, className string, prop .
 	return args.Error(0)
 }
 
+func (f *fakeMigrator) DropProperty(ctx context.Context, className string, propName string) error {
+	args := f.Called(ctx, className, propName)
+	return args.Error(0)
+}
+
 func (f *fakeMigrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
 	return nil
 }
@@ -326,6 +345,10 @@ func (f *fakeMigrator) UpdateInvertedIndexConfig(ctx context.Context, className
 	return args.Error(0)
 }
 
+func (f *fakeMigrator) UpdatePropertyIndexes(ctx context.Context, className string, props []*models.Property) error {
+	return nil
+}
+
 func (f *fakeMigrator) UpdateReplicationConfig(ctx context.Context, className string, cfg *models.ReplicationConfig) error {
 	return nil
 }
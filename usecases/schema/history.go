@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	clusterTypes "github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// GetSchemaHistory returns the recorded changes of class definitions in the
+// order they were applied. If class is not empty only its changes are returned.
+func (h *Handler) GetSchemaHistory(ctx context.Context, principal *models.Principal, class string) (*models.SchemaHistoryResponse, error) {
+	if class != "" {
+		class = schema.UppercaseClassName(class)
+	}
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.Collections(class)...)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, oldest := h.schemaReader.History(class)
+	res := &models.SchemaHistoryResponse{
+		Changes:       make([]*models.SchemaChange, len(entries)),
+		OldestVersion: oldest,
+	}
+	for i, e := range entries {
+		res.Changes[i] = &models.SchemaChange{
+			Version:  e.Version,
+			TimeUnix: e.Time.UnixMilli(),
+			User:     e.User,
+			Type:     e.Type,
+			Class:    e.Class,
+		}
+	}
+	return res, nil
+}
+
+// GetSchemaAtVersion returns the class definitions as they were right after the
+// change with the given version had been applied
+func (h *Handler) GetSchemaAtVersion(ctx context.Context, principal *models.Principal, version uint64) (*models.Schema, error) {
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.Collections()...)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.schemaAt(version)
+}
+
+// DiffSchema describes how the schema changed between the versions from and to.
+// If from is nil the oldest version in the history is used, if to is nil the
+// latest one.
+func (h *Handler) DiffSchema(ctx context.Context, principal *models.Principal, from, to *uint64) (*models.SchemaDiff, error) {
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.Collections()...)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, oldest := h.schemaReader.History("")
+	res := &models.SchemaDiff{From: oldest, To: oldest}
+	if n := len(entries); n > 0 {
+		res.To = entries[n-1].Version
+	}
+	if from != nil {
+		res.From = *from
+	}
+	if to != nil {
+		res.To = *to
+	}
+	if res.From > res.To {
+		return nil, fmt.Errorf("version %d to compare from is newer than version %d to compare to", res.From, res.To)
+	}
+
+	left, err := h.schemaAt(res.From)
+	if err != nil {
+		return nil, err
+	}
+	right, err := h.schemaAt(res.To)
+	if err != nil {
+		return nil, err
+	}
+	res.Differences = Diff(
+		fmt.Sprintf("version %d", res.From), &State{ObjectSchema: left},
+		fmt.Sprintf("version %d", res.To), &State{ObjectSchema: right},
+	)
+	if res.Differences == nil {
+		res.Differences = []string{}
+	}
+	return res, nil
+}
+
+func (h *Handler) schemaAt(version uint64) (*models.Schema, error) {
+	s, err := h.schemaReader.SchemaAt(version)
+	if err != nil {
+		if errors.Is(err, clusterSchema.ErrHistoryTruncated) {
+			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		return nil, err
+	}
+	return &s, nil
+}
+
+// withUser attaches the name of principal to ctx, so that it is recorded in the
+// schema history of the changes issued with ctx
+func withUser(ctx context.Context, principal *models.Principal) context.Context {
+	if principal == nil {
+		return ctx
+	}
+	return clusterTypes.ContextWithUser(ctx, principal.Username)
+}
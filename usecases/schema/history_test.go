@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestGetSchemaHistory(t *testing.T) {
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	at := time.UnixMilli(1700000000000)
+	fakeSchemaManager.On("History", "Movies").Return([]clusterSchema.HistoryEntry{
+		{Version: 3, Time: at, User: "alice", Type: "ADD_CLASS", Class: "Movies", State: &models.Class{Class: "Movies"}},
+		{Version: 7, Time: at, Type: "UPDATE_CLASS", Class: "Movies", State: &models.Class{Class: "Movies"}},
+	}, uint64(2))
+
+	history, err := handler.GetSchemaHistory(context.Background(), nil, "movies")
+	require.Nil(t, err)
+	assert.Equal(t, &models.SchemaHistoryResponse{
+		Changes: []*models.SchemaChange{
+			{Version: 3, TimeUnix: 1700000000000, User: "alice", Type: "ADD_CLASS", Class: "Movies"},
+			{Version: 7, TimeUnix: 1700000000000, Type: "UPDATE_CLASS", Class: "Movies"},
+		},
+		OldestVersion: 2,
+	}, history)
+}
+
+func TestGetSchemaAtVersion(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		s := models.Schema{Classes: []*models.Class{{Class: "Movies"}}}
+		fakeSchemaManager.On("SchemaAt", uint64(3)).Return(s, nil)
+
+		res, err := handler.GetSchemaAtVersion(ctx, nil, 3)
+		require.Nil(t, err)
+		assert.Equal(t, &s, res)
+	})
+
+	t.Run("history truncated", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("SchemaAt", uint64(1)).
+			Return(models.Schema{}, fmt.Errorf("version 1: %w", clusterSchema.ErrHistoryTruncated))
+
+		_, err := handler.GetSchemaAtVersion(ctx, nil, 1)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestDiffSchema(t *testing.T) {
+	ctx := context.Background()
+	v := func(v uint64) *uint64 { return &v }
+	ef := func(ef int) *models.Class {
+		return &models.Class{Class: "Movies", VectorIndexConfig: map[string]interface{}{"ef": ef}}
+	}
+
+	t.Run("defaults to the whole history", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("History", "").Return([]clusterSchema.HistoryEntry{
+			{Version: 3, Class: "Movies"}, {Version: 7, Class: "Movies"},
+		}, uint64(2))
+		fakeSchemaManager.On("SchemaAt", uint64(2)).Return(models.Schema{}, nil)
+		fakeSchemaManager.On("SchemaAt", uint64(7)).Return(models.Schema{Classes: []*models.Class{ef(64)}}, nil)
+
+		diff, err := handler.DiffSchema(ctx, nil, nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(2), diff.From)
+		assert.Equal(t, uint64(7), diff.To)
+		assert.Contains(t, diff.Differences, "class Movies exists in version 7, but not in version 2")
+	})
+
+	t.Run("changed setting", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("History", "").Return([]clusterSchema.HistoryEntry{}, uint64(0))
+		fakeSchemaManager.On("SchemaAt", uint64(3)).Return(models.Schema{Classes: []*models.Class{ef(64)}}, nil)
+		fakeSchemaManager.On("SchemaAt", uint64(7)).Return(models.Schema{Classes: []*models.Class{ef(128)}}, nil)
+
+		diff, err := handler.DiffSchema(ctx, nil, v(3), v(7))
+		require.Nil(t, err)
+		assert.Contains(t, diff.Differences, `class Movies: vector index config mismatch: version 3 has {"ef":64}, but version 7 has {"ef":128}`)
+	})
+
+	t.Run("identical", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("History", "").Return([]clusterSchema.HistoryEntry{}, uint64(0))
+		fakeSchemaManager.On("SchemaAt", uint64(3)).Return(models.Schema{Classes: []*models.Class{ef(64)}}, nil)
+
+		diff, err := handler.DiffSchema(ctx, nil, v(3), v(3))
+		require.Nil(t, err)
+		assert.Equal(t, []string{}, diff.Differences)
+	})
+
+	t.Run("from after to", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("History", "").Return([]clusterSchema.HistoryEntry{}, uint64(0))
+
+		_, err := handler.DiffSchema(ctx, nil, v(7), v(3))
+		assert.ErrorContains(t, err, "newer")
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/cluster/proto/api"
@@ -27,9 +28,9 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/sharding"
-	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
 // Manager Manages schema changes at a use-case level, i.e. agnostic of
@@ -189,8 +190,9 @@ type clusterState interface {
 
 type scaleOut interface {
 	SetSchemaReader(sr scaler.SchemaReader)
-	Scale(ctx context.Context, className string,
-		updated shardingConfig.Config, prevReplFactor, newReplFactor int64) (*sharding.State, error)
+	SetSchemaManager(sm scaler.SchemaManager)
+	StartScale(ctx context.Context, className string,
+		prevReplFactor, newReplFactor int64) (scaler.Operation, error)
 }
 
 // NewManager creates a new manager
@@ -204,6 +206,7 @@ func NewManager(validator validator,
 	moduleConfig ModuleConfig, clusterState clusterState,
 	scaleoutManager scaleOut,
 	cloud modulecapabilities.OffloadCloud,
+	tenantUsage tenantUsageProvider,
 ) (*Manager, error) {
 	handler, err := NewHandler(
 		schemaReader,
@@ -211,7 +214,7 @@ func NewManager(validator validator,
 		validator,
 		logger, authorizer,
 		config, configParser, vectorizerValidator, invertedConfigValidator,
-		moduleConfig, clusterState, scaleoutManager, cloud)
+		moduleConfig, clusterState, scaleoutManager, cloud, tenantUsage)
 	if err != nil {
 		return nil, fmt.Errorf("cannot init handler: %w", err)
 	}
@@ -330,6 +333,51 @@ func (m *Manager) ResolveParentNodes(class, shardName string) (map[string]string
 	return name2Addr, nil
 }
 
+// ResolveJoiningNodes gets the nodes a shard is being copied to while the
+// replication factor of its class is increased and resolves their names
+func (m *Manager) ResolveJoiningNodes(class, shardName string) (map[string]string, error) {
+	var nodes []string
+	err := m.schemaReader.Read(class, func(_ *models.Class, state *sharding.State) error {
+		nodes = append(nodes, state.Physical[shardName].JoiningNodes...)
+		return nil
+	})
+	if err != nil || len(nodes) == 0 {
+		return nil, err
+	}
+
+	name2Addr := make(map[string]string, len(nodes))
+	for _, node := range nodes {
+		host, _ := m.clusterState.NodeHostname(node)
+		name2Addr[node] = host
+	}
+	return name2Addr, nil
+}
+
+// ShardConsistency returns the default consistency level of a shard and the
+// maximum staleness of bounded staleness reads. The level of a tenant takes
+// precedence over the level of its class.
+func (m *Manager) ShardConsistency(class, shardName string) (replica.ConsistencyLevel, time.Duration) {
+	var (
+		level        string
+		maxStaleness int64
+	)
+	err := m.schemaReader.Read(class, func(cls *models.Class, state *sharding.State) error {
+		if rc := cls.ReplicationConfig; rc != nil {
+			level, maxStaleness = rc.ConsistencyLevel, rc.MaxStalenessSeconds
+		}
+		if state != nil {
+			if l := state.Physical[shardName].ConsistencyLevel; l != "" {
+				level = l
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", 0
+	}
+	return replica.ConsistencyLevel(level), time.Duration(maxStaleness) * time.Second
+}
+
 func (m *Manager) TenantsShards(ctx context.Context, class string, tenants ...string) (map[string]string, error) {
 	slices.Sort(tenants)
 	tenants = slices.Compact(tenants)
@@ -255,11 +255,8 @@ func (p *Parser) ParseClassUpdate(class, update *models.Class) (*models.Class, e
 		return nil, fmt.Errorf("validate sharding config: %w", err)
 	}
 
-	if !reflect.DeepEqual(class.Properties, update.Properties) {
-		return nil, errors.Errorf(
-			"properties cannot be updated through updating the class. Use the add " +
-				"property feature (e.g. \"POST /v1/schema/{className}/properties\") " +
-				"to add additional properties")
+	if err := validatePropertiesUpdate(class.Properties, update.Properties); err != nil {
+		return nil, err
 	}
 
 	if err := p.validator.ValidateInvertedIndexConfigUpdate(
@@ -271,6 +268,68 @@ func (p *Parser) ParseClassUpdate(class, update *models.Class) (*models.Class, e
 	return update, nil
 }
 
+// validatePropertiesUpdate only allows changing the tokenization and the
+// inverted index flags of existing properties. Any other change, including
+// adding, removing or renaming properties, has to go through the dedicated
+// property endpoints.
+func validatePropertiesUpdate(initial, updated []*models.Property) error {
+	errImmutable := errors.Errorf(
+		"properties cannot be updated through updating the class. Use the add " +
+			"property feature (e.g. \"POST /v1/schema/{className}/properties\") " +
+			"to add additional properties")
+
+	if len(initial) != len(updated) {
+		return errImmutable
+	}
+
+	for i := range initial {
+		if initial[i] == nil || updated[i] == nil {
+			if initial[i] != updated[i] {
+				return errImmutable
+			}
+			continue
+		}
+		if reflect.DeepEqual(initial[i], updated[i]) {
+			continue
+		}
+
+		prev, next := *initial[i], *updated[i]
+		prev.Tokenization, next.Tokenization = "", ""
+		prev.IndexFilterable, next.IndexFilterable = nil, nil
+		prev.IndexSearchable, next.IndexSearchable = nil, nil
+		prev.IndexRangeFilters, next.IndexRangeFilters = nil, nil
+		if !reflect.DeepEqual(&prev, &next) {
+			return errImmutable
+		}
+
+		if !IsPropertyReindexable(updated[i]) {
+			return fmt.Errorf("property %q: tokenization and index settings of data type %v cannot be changed",
+				updated[i].Name, updated[i].DataType)
+		}
+	}
+	return nil
+}
+
+// IsPropertyReindexable returns whether the tokenization and the inverted
+// index flags of the property can be changed after it was created.
+func IsPropertyReindexable(prop *models.Property) bool {
+	if prop.IndexInverted != nil {
+		// deprecated setting, it would have to be migrated to the new
+		// flags first
+		return false
+	}
+	dt, ok := schema.AsPrimitive(prop.DataType)
+	if !ok {
+		return false
+	}
+	switch dt {
+	case schema.DataTypeGeoCoordinates, schema.DataTypePhoneNumber, schema.DataTypeBlob:
+		return false
+	default:
+		return true
+	}
+}
+
 func hasTargetVectors(class *models.Class) bool {
 	return len(class.VectorConfig) > 0
 }
@@ -399,11 +458,8 @@ func validateVectorConfigsParityAndImmutables(initial, updated *models.Class) er
 	for vecName, initialCfg := range initial.VectorConfig {
 		updatedCfg := updated.VectorConfig[vecName]
 
-		// immutable vector type
-		if initialCfg.VectorIndexType != updatedCfg.VectorIndexType {
-			return fmt.Errorf("vector index type of vector %q is immutable: attempted change from %q to %q",
-				vecName, initialCfg.VectorIndexType, updatedCfg.VectorIndexType)
-		}
+		// the vector index type is mutable, changing it rebuilds the
+		// vector index from the stored vectors
 
 		// immutable vectorizer
 		if imap, ok := initialCfg.Vectorizer.(map[string]interface{}); ok && len(imap) == 1 {
@@ -474,5 +530,10 @@ func validateShardingConfig(current, update *models.Class, mtEnabled bool) error
 			"attempted change from \"%d\" to \"%d\"", first.VirtualPerPhysical,
 			second.VirtualPerPhysical)
 	}
+
+	if first.Key != second.Key {
+		return fmt.Errorf("sharding key is immutable: "+
+			"attempted change from %q to %q", first.Key, second.Key)
+	}
 	return nil
 }
@@ -17,6 +17,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/vectorindex"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/fakes"
@@ -33,6 +34,13 @@ func TestParser(t *testing.T) {
 	vic := enthnsw.NewDefaultUserConfig()
 	emptyMap := map[string]interface{}{}
 	valueMap := map[string]interface{}{"something": emptyMap}
+	vFalse := false
+	textProp := func(tokenization string) []*models.Property {
+		return []*models.Property{{Name: "text", DataType: schema.DataTypeText.PropString(), Tokenization: tokenization}}
+	}
+	geoProp := func(filterable *bool) []*models.Property {
+		return []*models.Property{{Name: "geo", DataType: schema.DataTypeGeoCoordinates.PropString(), IndexFilterable: filterable}}
+	}
 
 	testCases := []struct {
 		name     string
@@ -122,6 +130,19 @@ func TestParser(t *testing.T) {
 			expected: &models.Class{Class: "Test", VectorIndexType: hnswT, VectorIndexConfig: vic, ShardingConfig: sc, ModuleConfig: map[string]interface{}{"text2vec-random": valueMap}},
 			error:    false,
 		},
+		{
+			name:     "update property tokenization",
+			old:      &models.Class{Class: "Test", VectorIndexType: hnswT, VectorIndexConfig: vic, ShardingConfig: sc, Properties: textProp(models.PropertyTokenizationWord)},
+			update:   &models.Class{Class: "Test", VectorIndexType: hnswT, VectorIndexConfig: vic, Properties: textProp(models.PropertyTokenizationField)},
+			expected: &models.Class{Class: "Test", VectorIndexType: hnswT, VectorIndexConfig: vic, ShardingConfig: sc, Properties: textProp(models.PropertyTokenizationField)},
+			error:    false,
+		},
+		{
+			name:   "update index settings of geo property => error",
+			old:    &models.Class{Class: "Test", VectorIndexType: hnswT, VectorIndexConfig: vic, ShardingConfig: sc, Properties: geoProp(nil)},
+			update: &models.Class{Class: "Test", VectorIndexType: hnswT, VectorIndexConfig: vic, Properties: geoProp(&vFalse)},
+			error:  true,
+		},
 	}
 
 	for _, test := range testCases {
@@ -14,6 +14,7 @@ package schema
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
@@ -76,7 +77,7 @@ func (h *Handler) AddClassProperty(ctx context.Context, principal *models.Princi
 	migratePropertySettings(props...)
 
 	class.Properties = clusterSchema.MergeProps(class.Properties, props)
-	version, err := h.schemaManager.AddProperty(ctx, class.Class, props...)
+	version, err := h.schemaManager.AddProperty(withUser(ctx, principal), class.Class, props...)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -92,9 +93,25 @@ func (h *Handler) DeleteClassProperty(ctx context.Context, principal *models.Pri
 		return err
 	}
 
-	return fmt.Errorf("deleting a property is currently not supported, see " +
-		"https://github.com/weaviate/weaviate/issues/973 for details.")
-	// return h.deleteClassProperty(ctx, class, property, kind.Action)
+	cls := h.schemaReader.ReadOnlyClass(class)
+	if cls == nil {
+		return fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	prop, err := schema.GetPropertyByName(cls, schema.LowercaseFirstLetter(property))
+	if err != nil {
+		return fmt.Errorf("property %q: %w", property, ErrNotFound)
+	}
+	if slices.Contains(cls.UniqueKey, prop.Name) {
+		return fmt.Errorf("property %q is part of the uniqueKey and can't be deleted", prop.Name)
+	}
+
+	// The property is removed from the schema right away. Its indexes are
+	// dropped in the background and values still stored on objects are
+	// stripped when they are read or merged. Objects which have not been
+	// rewritten yet expose their old value again if a property with the
+	// same name is added later on.
+	_, err = h.schemaManager.DeleteProperty(withUser(ctx, principal), cls.Class, prop.Name)
+	return err
 }
 
 func (h *Handler) setNewPropDefaults(class *models.Class, props ...*models.Property) error {
@@ -26,6 +26,7 @@ import (
 	modsloads3 "github.com/weaviate/weaviate/modules/offload-s3"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
@@ -63,6 +64,9 @@ func (h *Handler) AddTenants(ctx context.Context,
 		request.Tenants = append(request.Tenants, &api.Tenant{
 			Name:   tenant.Name,
 			Status: schema.ActivityStatus(validated[i].ActivityStatus),
+			Quotas: tenantQuotasToProto(tenant.Quotas),
+
+			ConsistencyLevel: tenant.ConsistencyLevel,
 		})
 	}
 
@@ -76,6 +80,14 @@ func validateTenants(tenants []*models.Tenant, allowOverHundred bool) (validated
 	}
 	uniq := make(map[string]*models.Tenant)
 	for i, requested := range tenants {
+		if err = validateTenantQuotas(requested.Quotas); err != nil {
+			err = uco.NewErrInvalidUserInput("tenant %q: %v", requested.Name, err)
+			return
+		}
+		if err = replica.ValidateConsistencyLevel(requested.ConsistencyLevel); err != nil {
+			err = uco.NewErrInvalidUserInput("tenant %q: %v", requested.Name, err)
+			return
+		}
 		if !regexTenantName.MatchString(requested.Name) {
 			var msg string
 			if requested.Name == "" {
@@ -179,7 +191,13 @@ func (h *Handler) UpdateTenants(ctx context.Context, principal *models.Principal
 	tNames := make([]string, len(tenants))
 	for i, tenant := range tenants {
 		tNames[i] = tenant.Name
-		req.Tenants[i] = &api.Tenant{Name: tenant.Name, Status: tenant.ActivityStatus}
+		req.Tenants[i] = &api.Tenant{
+			Name:   tenant.Name,
+			Status: tenant.ActivityStatus,
+			Quotas: tenantQuotasToProto(tenant.Quotas),
+
+			ConsistencyLevel: tenant.ConsistencyLevel,
+		}
 	}
 
 	if _, err = h.schemaManager.UpdateTenants(ctx, class, &req); err != nil {
@@ -216,6 +234,32 @@ func (h *Handler) DeleteTenants(ctx context.Context, principal *models.Principal
 	return err
 }
 
+type tenantUsageProvider interface {
+	Usage(ctx context.Context, class, tenant string) (*models.TenantUsage, error)
+}
+
+// TenantUsage reports the resource usage of a tenant together with its
+// effective quotas.
+//
+// Class must exist and has partitioning enabled
+func (h *Handler) TenantUsage(ctx context.Context, principal *models.Principal, class, tenant string) (*models.TenantUsage, error) {
+	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.Shards(class, tenant)...); err != nil {
+		return nil, err
+	}
+
+	tenants, err := h.getTenantsByNames(class, []string{tenant})
+	if err != nil {
+		return nil, err
+	}
+	if len(tenants) == 0 {
+		return nil, ErrNotFound
+	}
+	if h.tenantUsage == nil {
+		return nil, fmt.Errorf("tenant usage reporting is not enabled")
+	}
+	return h.tenantUsage.Usage(ctx, class, tenant)
+}
+
 // GetTenants is used to get tenants of a class.
 //
 // Class must exist and has partitioning enabled
@@ -258,6 +302,9 @@ func (h *Handler) getTenants(class string) ([]*models.Tenant, error) {
 			ts[i] = &models.Tenant{
 				Name:           tenant,
 				ActivityStatus: schema.ActivityStatus(ss.Physical[tenant].Status),
+				Quotas:         ss.Physical[tenant].TenantQuotas(),
+
+				ConsistencyLevel: ss.Physical[tenant].ConsistencyLevel,
 			}
 			i++
 		}
@@ -323,7 +370,10 @@ func (h *Handler) getTenantsByNames(class string, names []string) ([]*models.Ten
 				continue
 			}
 			physical := ss.Physical[name]
-			ts = append(ts, clusterSchema.MakeTenantWithDataVersion(name, schema.ActivityStatus(physical.Status), physical.BelongsToNodes, physical.DataVersion))
+			t := clusterSchema.MakeTenantWithDataVersion(name, schema.ActivityStatus(physical.Status), physical.BelongsToNodes, physical.DataVersion)
+			t.Quotas = physical.TenantQuotas()
+			t.ConsistencyLevel = physical.ConsistencyLevel
+			ts = append(ts, t)
 		}
 		return nil
 	}
@@ -349,9 +399,35 @@ func tenantResponseToTenant(tenantResponse *models.TenantResponse) *models.Tenan
 	return &models.Tenant{
 		Name:           tenantResponse.Name,
 		ActivityStatus: tenantResponse.ActivityStatus,
+		Quotas:         tenantResponse.Quotas,
+
+		ConsistencyLevel: tenantResponse.ConsistencyLevel,
 	}
 }
 
+// tenantQuotasToProto converts the quotas of a tenant to their raft representation
+func tenantQuotasToProto(q *models.TenantQuotas) *api.TenantQuotas {
+	if q == nil {
+		return nil
+	}
+	return &api.TenantQuotas{
+		MaxObjects:          q.MaxObjects,
+		MaxDiskBytes:        q.MaxDiskBytes,
+		MaxQueriesPerSecond: q.MaxQueriesPerSecond,
+	}
+}
+
+// validateTenantQuotas makes sure no negative limits are set. A limit of 0 means unlimited
+func validateTenantQuotas(q *models.TenantQuotas) error {
+	if q == nil {
+		return nil
+	}
+	if q.MaxObjects < 0 || q.MaxDiskBytes < 0 || q.MaxQueriesPerSecond < 0 {
+		return fmt.Errorf("quotas must not be negative")
+	}
+	return nil
+}
+
 // TenantResponsesToTenants converts a slice of TenantResponses to a slice of Tenants
 func TenantResponsesToTenants(tenantResponses []*models.TenantResponse) []*models.Tenant {
 	tenants := make([]*models.Tenant, len(tenantResponses))
@@ -25,6 +25,11 @@ const (
 	DefaultFunction           = "murmur3"
 )
 
+// UniqueKey routes objects by the unique key of the class. Objects of such
+// classes always have ids derived from their key, so that all objects with
+// the same key end up in the same shard.
+const UniqueKey = "_uniqueKey"
+
 type Config struct {
 	VirtualPerPhysical  int    `json:"virtualPerPhysical"`
 	DesiredCount        int    `json:"desiredCount"`
@@ -51,9 +56,9 @@ func (c *Config) setDefaults(nodeCount int) {
 }
 
 func (c *Config) validate() error {
-	if c.Key != "_id" {
-		return errors.Errorf("sharding only supported on key '_id' for now, "+
-			"got: %s", c.Key)
+	if c.Key != DefaultKey && c.Key != UniqueKey {
+		return errors.Errorf("sharding only supported on keys '%s' and '%s' for now, "+
+			"got: %s", DefaultKey, UniqueKey, c.Key)
 	}
 
 	if c.Strategy != "hash" {
@@ -69,6 +74,12 @@ func (c *Config) validate() error {
 	return nil
 }
 
+// RoutesByUniqueKey returns whether objects are routed by the unique key
+// of their class rather than by their id
+func (c Config) RoutesByUniqueKey() bool {
+	return c.Key == UniqueKey
+}
+
 func (c Config) DeepCopy() Config {
 	return Config{
 		VirtualPerPhysical:  c.VirtualPerPhysical,
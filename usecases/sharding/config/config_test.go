@@ -97,10 +97,29 @@ func Test_Config(t *testing.T) {
 				"strategy": "hash",
 				"function": "murmur3",
 			},
-			expectedErr: errors.New("sharding only supported on key '_id' " +
+			expectedErr: errors.New("sharding only supported on keys '_id' and '_uniqueKey' " +
 				"for now, got: myCustomField"),
 		},
 
+		{
+			name: "sharding by unique key",
+			input: map[string]interface{}{
+				"key":      "_uniqueKey",
+				"strategy": "hash",
+				"function": "murmur3",
+			},
+			expected: Config{
+				VirtualPerPhysical:  DefaultVirtualPerPhysical,
+				DesiredCount:        7,
+				DesiredVirtualCount: DefaultVirtualPerPhysical * 7,
+				ActualCount:         7,
+				ActualVirtualCount:  DefaultVirtualPerPhysical * 7,
+				Key:                 UniqueKey,
+				Strategy:            DefaultStrategy,
+				Function:            DefaultFunction,
+			},
+		},
+
 		{
 			name: "unsupported sharding strategy",
 			input: map[string]interface{}{
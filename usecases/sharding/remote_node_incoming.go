@@ -20,6 +20,7 @@ import (
 type RemoteNodeIncomingRepo interface {
 	IncomingGetNodeStatus(ctx context.Context, className, output string) (*models.NodeStatus, error)
 	IncomingGetNodeStatistics() (*models.Statistics, error)
+	IncomingGetTenantUsage(ctx context.Context, className, tenant string) (*models.TenantUsage, error)
 }
 
 type RemoteNodeIncoming struct {
@@ -39,3 +40,7 @@ func (rni *RemoteNodeIncoming) GetNodeStatus(ctx context.Context, className, out
 func (rni *RemoteNodeIncoming) GetStatistics(ctx context.Context) (*models.Statistics, error) {
 	return rni.repo.IncomingGetNodeStatistics()
 }
+
+func (rni *RemoteNodeIncoming) GetTenantUsage(ctx context.Context, className, tenant string) (*models.TenantUsage, error) {
+	return rni.repo.IncomingGetTenantUsage(ctx, className, tenant)
+}
@@ -66,12 +66,23 @@ type Physical struct {
 
 	LegacyBelongsToNodeForBackwardCompat string   `json:"belongsToNode,omitempty"`
 	BelongsToNodes                       []string `json:"belongsToNodes,omitempty"`
+	// JoiningNodes are nodes the shard is being copied to while the replication
+	// factor is increased. They receive writes, but don't serve reads yet.
+	JoiningNodes []string `json:"joiningNodes,omitempty"`
+	// JoiningCoordinator is the node which copies the shard to JoiningNodes
+	JoiningCoordinator string `json:"joiningCoordinator,omitempty"`
 
 	Status string `json:"status,omitempty"`
 	// DataVersion is experimental. Starts at 0 and is incremented each time this tenant is offloaded
 	// to cloud storage. It can be used to see if externally cached data is up to date with the data
 	// in S3. Wraps around to 0 if it reaches math.MaxInt64
 	DataVersion int64 `json:"dataVersion,omitempty"`
+	// Quotas holds the per-tenant resource limits. Nil means the class
+	// defaults (if any) apply.
+	Quotas *models.TenantQuotas `json:"quotas,omitempty"`
+	// ConsistencyLevel is the default consistency level of requests to the
+	// tenant. Empty means the default of the class applies.
+	ConsistencyLevel string `json:"consistencyLevel,omitempty"`
 }
 
 // BelongsToNode for backward-compatibility when there was no replication. It
@@ -80,6 +91,16 @@ func (p Physical) BelongsToNode() string {
 	return p.BelongsToNodes[0]
 }
 
+// TenantQuotas returns a copy of the tenant specific quotas or nil if none
+// are set
+func (p Physical) TenantQuotas() *models.TenantQuotas {
+	if p.Quotas == nil {
+		return nil
+	}
+	q := *p.Quotas
+	return &q
+}
+
 // AdjustReplicas shrinks or extends the replica set (p.BelongsToNodes)
 func (p *Physical) AdjustReplicas(count int, nodes cluster.NodeSelector) error {
 	if count < 0 {
@@ -510,13 +531,25 @@ func (p Physical) DeepCopy() Physical {
 	belongsCopy := make([]string, len(p.BelongsToNodes))
 	copy(belongsCopy, p.BelongsToNodes)
 
+	var joiningCopy []string
+	if len(p.JoiningNodes) > 0 {
+		joiningCopy = make([]string, len(p.JoiningNodes))
+		copy(joiningCopy, p.JoiningNodes)
+	}
+
 	return Physical{
 		Name:           p.Name,
 		OwnsVirtual:    ownsVirtualCopy,
 		OwnsPercentage: p.OwnsPercentage,
 		BelongsToNodes: belongsCopy,
+		JoiningNodes:   joiningCopy,
 		Status:         p.Status,
 		DataVersion:    p.DataVersion,
+		Quotas:         p.TenantQuotas(),
+
+		JoiningCoordinator: p.JoiningCoordinator,
+
+		ConsistencyLevel: p.ConsistencyLevel,
 	}
 }
 
@@ -45,6 +45,11 @@ type Traverser struct {
 	targetVectorParamHelper *TargetVectorParamHelper
 	metrics                 *Metrics
 	ratelimiter             *ratelimiter.Limiter
+	quotas                  quotaEnforcer
+}
+
+type quotaEnforcer interface {
+	CheckQuery(class, tenant string) error
 }
 
 type VectorSearcher interface {
@@ -67,7 +72,7 @@ func NewTraverser(config *config.WeaviateConfig, locks locks,
 	vectorSearcher VectorSearcher,
 	explorer explorer, schemaGetter schema.SchemaGetter,
 	modulesProvider ModulesProvider,
-	metrics *Metrics, maxGetRequests int,
+	metrics *Metrics, maxGetRequests int, quotas quotaEnforcer,
 ) *Traverser {
 	return &Traverser{
 		config:                  config,
@@ -81,6 +86,7 @@ func NewTraverser(config *config.WeaviateConfig, locks locks,
 		targetVectorParamHelper: NewTargetParamHelper(),
 		metrics:                 metrics,
 		ratelimiter:             ratelimiter.New(maxGetRequests),
+		quotas:                  quotas,
 	}
 }
 
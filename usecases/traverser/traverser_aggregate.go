@@ -36,6 +36,12 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	if t.quotas != nil {
+		if err := t.quotas.CheckQuery(params.ClassName.String(), params.Tenant); err != nil {
+			return nil, err
+		}
+	}
+
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
 		return nil, enterrors.NewErrLockConnector(err)
@@ -13,6 +13,7 @@ package traverser
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/sirupsen/logrus/hooks/test"
@@ -36,7 +37,7 @@ func Test_Traverser_Aggregate(t *testing.T) {
 	schemaGetter := &fakeSchemaGetter{aggregateTestSchema}
 
 	traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-		vectorRepo, explorer, schemaGetter, nil, nil, -1)
+		vectorRepo, explorer, schemaGetter, nil, nil, -1, nil)
 
 	t.Run("with aggregation only", func(t *testing.T) {
 		params := aggregation.Params{
@@ -368,3 +369,26 @@ var aggregateTestSchema = schema.Schema{
 		},
 	},
 }
+
+type fakeQueryQuotas struct {
+	err     error
+	checked []string
+}
+
+func (f *fakeQueryQuotas) CheckQuery(class, tenant string) error {
+	f.checked = append(f.checked, class+"/"+tenant)
+	return f.err
+}
+
+func Test_Traverser_AggregateQuota(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	quotas := &fakeQueryQuotas{err: errors.New("quota exceeded")}
+	traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+		mocks.NewMockAuthorizer(), &fakeVectorRepo{}, &fakeExplorer{},
+		&fakeSchemaGetter{aggregateTestSchema}, nil, nil, -1, quotas)
+
+	_, err := traverser.Aggregate(context.Background(), &models.Principal{},
+		&aggregation.Params{ClassName: "MyClass", Tenant: "tenant1"})
+	assert.ErrorIs(t, err, quotas.err)
+	assert.Equal(t, []string{"MyClass/tenant1"}, quotas.checked)
+}
@@ -47,6 +47,12 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	if t.quotas != nil {
+		if err := t.quotas.CheckQuery(params.ClassName, params.Tenant); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := t.probeForRefDepthLimit(params.Properties); err != nil {
 		return nil, err
 	}
@@ -160,7 +160,7 @@ func TestGet_NestedRefDepthLimit(t *testing.T) {
 			},
 		}
 		return NewTraverser(&cfg, &fakeLocks{}, logger, mocks.NewMockAuthorizer(),
-			&fakeVectorRepo{}, &fakeExplorer{}, schemaGetter, nil, nil, -1)
+			&fakeVectorRepo{}, &fakeExplorer{}, schemaGetter, nil, nil, -1, nil)
 	}
 
 	tests := []testcase{